@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/storage"
+	bolt_storage "nn-blockchain-api/pkg/storage/bolt"
+	postgres_storage "nn-blockchain-api/pkg/storage/postgres"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Generates a new API key and prints its AUTH_API_KEYS entry, or stores it when a storage is given.
+func main() {
+	name := flag.String("name", "", "key name")
+	scopes := flag.String("scopes", string(auth.ScopeRead), "scopes separated by |")
+	chains := flag.String("chains", "", "allowed chains separated by |, empty for any")
+	networks := flag.String("networks", "", "allowed networks separated by |, empty for any")
+	driver := flag.String("driver", "", "store the key in bolt or postgres storage")
+	target := flag.String("storage", "", "bolt storage path or postgres dsn")
+	flag.Parse()
+
+	raw, err := auth.GenerateKey()
+	if err != nil {
+		log.Fatalf("failed to generate api key: %v", err)
+	}
+
+	definition := strings.Join([]string{*name, auth.HashKey(raw), *scopes, *chains, *networks}, ":")
+	key, err := auth.ParseKey(definition)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("API key: %s\n", raw)
+
+	if *driver == "" {
+		fmt.Printf("AUTH_API_KEYS entry: %s\n", definition)
+		return
+	}
+
+	var store storage.Storage
+	switch *driver {
+	case "bolt":
+		store, err = bolt_storage.NewStorage(*target)
+	case "postgres":
+		store, err = postgres_storage.NewStorage(*target)
+	default:
+		err = fmt.Errorf("unknown storage driver %q", *driver)
+	}
+	if err != nil {
+		log.Fatalf("failed to open storage: %v", err)
+	}
+	defer store.Close()
+
+	err = store.APIKeys().Create(context.Background(), &storage.APIKey{
+		Id:        uuid.NewString(),
+		Name:      key.Name,
+		Hash:      key.Hash,
+		Scopes:    key.Scopes,
+		Chains:    key.Chains,
+		Networks:  key.Networks,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		log.Fatalf("failed to store api key: %v", err)
+	}
+
+	fmt.Printf("Stored api key %q\n", key.Name)
+}
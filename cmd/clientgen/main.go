@@ -0,0 +1,25 @@
+// Command clientgen writes the typed API client in pkg/client from the routes documented in internal/api.
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"nn-blockchain-api/internal/api"
+	"nn-blockchain-api/pkg/openapi"
+)
+
+func main() {
+	out := flag.String("out", "client_gen.go", "output file")
+	pkg := flag.String("package", "client", "package name")
+	flag.Parse()
+
+	src, err := openapi.GenerateClient("clientgen", *pkg, api.Groups()...)
+	if err != nil {
+		log.Fatalf("failed to generate client: %v", err)
+	}
+
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("failed to write client: %v", err)
+	}
+}
@@ -1,24 +1,48 @@
 package main
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"go.uber.org/zap"
 	"log"
-	"net/http"
+	"net/url"
 	"nn-blockchain-api/config"
+	"nn-blockchain-api/internal/api"
 	"nn-blockchain-api/internal/bitcoin"
 	"nn-blockchain-api/internal/ethereum"
 	"nn-blockchain-api/internal/health"
+	keystore_handler "nn-blockchain-api/internal/keystore"
+	networks_handler "nn-blockchain-api/internal/networks"
+	policy_handler "nn-blockchain-api/internal/policy"
+	"nn-blockchain-api/internal/quota"
 	"nn-blockchain-api/internal/wallet"
+	"nn-blockchain-api/pkg/auth"
 	"nn-blockchain-api/pkg/grpc_client"
+	"nn-blockchain-api/pkg/grpc_server"
+	"nn-blockchain-api/pkg/keystore"
 	"nn-blockchain-api/pkg/logger"
+	"nn-blockchain-api/pkg/metrics"
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/policy"
+	"nn-blockchain-api/pkg/ratelimit"
 	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
 	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"nn-blockchain-api/pkg/server"
+	"nn-blockchain-api/pkg/storage"
+	bolt_storage "nn-blockchain-api/pkg/storage/bolt"
+	postgres_storage "nn-blockchain-api/pkg/storage/postgres"
+	"nn-blockchain-api/pkg/tracing"
+	"os/signal"
 	"syscall"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -33,6 +57,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("can't create logger: %v", err)
 	}
+	if err := newLogger.SetLevel(cfg.LogLevel); err != nil {
+		log.Fatalf("can't set log level: %v", err)
+	}
 
 	zapLogger, err := newLogger.SetupZapLogger()
 	if err != nil {
@@ -45,98 +72,664 @@ func main() {
 		}
 	}(zapLogger)
 
+	// Tracing
+	shutdownTracing, err := tracing.Setup(tracing.Config{
+		Exporter:    cfg.TracingExporter,
+		Endpoint:    cfg.TracingEndpoint,
+		Headers:     cfg.TracingHeaders,
+		ServiceName: cfg.TracingServiceName,
+		SampleRatio: cfg.TracingSampleRatio,
+	})
+	if err != nil {
+		zapLogger.Fatalf("failed to set-up tracing: %v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			zapLogger.Errorf("failed to flush traces: %v", err)
+		}
+	}()
+
+	// Storage
+	store, err := newStorage(cfg.Storage)
+	if err != nil {
+		zapLogger.Fatalf("failed to set-up storage: %v", err)
+	}
+	defer func(store storage.Storage) {
+		if err := store.Close(); err != nil {
+			zapLogger.Errorf("failed to close storage: %v", err)
+		}
+	}(store)
+
 	// Set-up gRPC client
-	walletClient, err := grpc_client.NewWalletClient(cfg.GRps.GRpcHost)
+	walletClient, walletConn, err := grpc_client.NewWalletClient(cfg.GRps.GRpcHost)
 	if err != nil {
 		zapLogger.Fatalf("failed to set-up wallet client: %v", err)
 	}
 
 	// Rpc clients
-	bitcoinRpcClient, err := bitcoin_rpc.NewClient(cfg.BtcRpc.BtcRpcEndpointTest, cfg.BtcRpc.BtcRpcEndpointMain, cfg.BtcRpc.BtcRpcUser, cfg.BtcRpc.BtcRpcPassword)
+	utxoChains, err := newUTXOChains(cfg)
 	if err != nil {
-		zapLogger.Fatalf("failed to set-up btc rpc client: %v", err)
+		zapLogger.Fatalf("failed to load utxo chains: %v", err)
 	}
 
-	ethereumRpcClient, err := ethereum_rpc.NewClient(cfg.EthRpc.EthRpcEndpointTest, cfg.EthRpc.EthRpcEndpointMain)
+	bitcoinRpcClients := map[string]bitcoin_rpc.Client{}
+	for _, chain := range utxoChains {
+		bitcoinRpcClients[chain.Name], err = bitcoin_rpc.NewClient(chain)
+		if err != nil {
+			zapLogger.Fatalf("failed to set-up %s rpc client: %v", chain.Name, err)
+		}
+	}
+
+	evmChains, err := newEVMRegistry(cfg.EthRpc)
+	if err != nil {
+		zapLogger.Fatalf("failed to load evm chains: %v", err)
+	}
+
+	networkRegistry, err := newNetworkRegistry(utxoChains, evmChains.Chains())
 	if err != nil {
-		zapLogger.Fatalf("failed to set-up btc rpc client: %v", err)
+		zapLogger.Fatalf("failed to create network registry: %v", err)
+	}
+
+	ethereumRpcClients := map[string]ethereum_rpc.Client{}
+	for _, chain := range evmChains.Chains() {
+		ethereumRpcClients[chain.Name], err = ethereum_rpc.NewClient(chain)
+		if err != nil {
+			zapLogger.Fatalf("failed to set-up %s rpc client: %v", chain.Name, err)
+		}
 	}
 
 	// Rpc services
-	bitcoinRpcService, err := bitcoin_rpc.NewService(bitcoinRpcClient)
+	bitcoinRpcServices := map[string]bitcoin_rpc.Service{}
+	for _, chain := range utxoChains {
+		bitcoinRpcServices[chain.Name], err = bitcoin_rpc.NewService(bitcoinRpcClients[chain.Name], chain)
+		if err != nil {
+			zapLogger.Fatalf("failed to create %s rpc service: %v", chain.Name, err)
+		}
+	}
+
+	ethereumRpcServices := map[string]ethereum_rpc.Service{}
+	for _, chain := range evmChains.Chains() {
+		ethereumRpcServices[chain.Name], err = ethereum_rpc.NewService(ethereumRpcClients[chain.Name], chain)
+		if err != nil {
+			zapLogger.Fatalf("failed to create %s rpc service: %v", chain.Name, err)
+		}
+	}
+
+	// Keystore
+	signingKeys, err := newKeystore(cfg)
 	if err != nil {
-		zapLogger.Fatalf("failed to create bitcoin service: %v", err)
+		zapLogger.Fatalf("failed to create keystore: %v", err)
+	}
+	if signingKeys.AllowsRawKeys() {
+		zapLogger.Warn("sign requests may carry raw private keys outside production")
 	}
 
-	ethereumRpcService, err := ethereum_rpc.NewService(ethereumRpcClient)
+	// Signing policies
+	policies, err := newPolicyEngine(cfg, networkRegistry, store)
 	if err != nil {
-		zapLogger.Fatalf("failed to create bitcoin service: %v", err)
+		zapLogger.Fatalf("failed to create policy engine: %v", err)
 	}
 
 	// Services
-	walletService, err := wallet.NewService(walletClient, zapLogger)
+	walletService, err := wallet.NewService(walletClient, store, zapLogger)
 	if err != nil {
 		zapLogger.Fatalf("failed to create wallet service: %v", err)
 	}
 
-	bitcoinService, err := bitcoin.NewService(bitcoinRpcService, zapLogger)
+	bitcoinServices := map[string]bitcoin.Service{}
+	for _, chain := range utxoChains {
+		bitcoinServices[chain.Name], err = bitcoin.NewService(chain, bitcoinRpcServices[chain.Name], walletService, signingKeys, policies, store, zapLogger)
+		if err != nil {
+			zapLogger.Fatalf("failed to create %s service: %v", chain.Name, err)
+		}
+	}
+
+	ethereumServices := map[string]ethereum.Service{}
+	for _, chain := range evmChains.Chains() {
+		ethereumServices[chain.Name], err = ethereum.NewService(chain, ethereumRpcServices[chain.Name], walletService, signingKeys, policies, store, zapLogger)
+		if err != nil {
+			zapLogger.Fatalf("failed to create %s service: %v", chain.Name, err)
+		}
+	}
+
+	// Authentication
+	keys, err := newKeyStore(cfg.Auth, store)
+	if err != nil {
+		zapLogger.Fatalf("failed to create api key store: %v", err)
+	}
+
+	guard, err := auth.NewGuard(keys, cfg.AuthEnabled)
 	if err != nil {
-		zapLogger.Fatalf("failed to create bitcoin service: %v", err)
+		zapLogger.Fatalf("failed to create auth guard: %v", err)
+	}
+	if !cfg.AuthEnabled {
+		zapLogger.Warn("API key authentication is disabled")
 	}
 
-	ethereumService, err := ethereum.NewService(ethereumRpcService, zapLogger)
+	// Rate limiting
+	limiter, err := newLimiter(cfg.RateLimit)
 	if err != nil {
-		zapLogger.Fatalf("failed to create bitcoin service: %v", err)
+		zapLogger.Fatalf("failed to create rate limiter: %v", err)
+	}
+	if cfg.RateLimitEnabled {
+		guard, err = ratelimit.NewGuard(guard, limiter)
+		if err != nil {
+			zapLogger.Fatalf("failed to create rate limit guard: %v", err)
+		}
 	}
 
 	// Handlers
-	healthHandler := health.NewHandler()
+	var checks []health.Check
+	for _, info := range networkRegistry.Networks() {
+		if btcRpcSvc, ok := bitcoinRpcServices[info.Chain]; ok {
+			checks = append(checks, health.UTXOCheck(info.Chain, btcRpcSvc, string(info.Network), cfg.ReadyBtcMaxTipAge, cfg.ReadyMinPeers, cfg.ReadyTimeout))
+			continue
+		}
+		checks = append(checks, health.EVMCheck(info.Chain, ethereumRpcServices[info.Chain], string(info.Network), cfg.ReadyEthMaxTipAge, cfg.ReadyMinPeers, cfg.ReadyTimeout))
+	}
+	checks = append(checks, health.GRPCCheck("wallet_grpc", walletConn, cfg.ReadyTimeout))
+	healthHandler := health.NewHandler(cfg.ReadyCacheTTL, checks...)
+
+	networksHandler, err := networks_handler.NewHandler(networkRegistry, guard)
+	if err != nil {
+		zapLogger.Fatalf("failed to create networks handler: %v", err)
+	}
+
+	quotaHandler, err := quota.NewHandler(limiter, guard)
+	if err != nil {
+		zapLogger.Fatalf("failed to create quota handler: %v", err)
+	}
 
-	walletHandler, err := wallet.NewHandler(walletService)
+	walletHandler, err := wallet.NewHandler(walletService, guard)
 	if err != nil {
 		zapLogger.Fatalf("failed to create wallet handler: %v", err)
 	}
 
-	bitcoinHandler, err := bitcoin.NewHandler(bitcoinService)
+	bitcoinHandler, err := bitcoin.NewHandler(bitcoinServices, guard)
 	if err != nil {
 		zapLogger.Fatalf("failed to create bitcoin handler: %v", err)
 	}
 
-	ethereumHandler, err := ethereum.NewHandler(ethereumService)
+	ethereumHandler, err := ethereum.NewHandler(ethereumServices, guard)
 	if err != nil {
-		zapLogger.Fatalf("failed to create bitcoin handler: %v", err)
+		zapLogger.Fatalf("failed to create ethereum handler: %v", err)
+	}
+
+	keystoreHandler, err := keystore_handler.NewHandler(signingKeys, guard)
+	if err != nil {
+		zapLogger.Fatalf("failed to create keystore handler: %v", err)
+	}
+
+	policyHandler, err := policy_handler.NewHandler(policies, guard)
+	if err != nil {
+		zapLogger.Fatalf("failed to create policy handler: %v", err)
 	}
 
 	// Set-up Route
 	router := chi.NewRouter()
+	router.Use(tracing.Middleware)
 	router.Use(middleware.Logger)
+	if cfg.MetricsEnabled {
+		router.Use(metrics.Middleware)
+	}
 	router.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"OPTIONS", "GET", "POST", "PATCH", "DELETE"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Access-Control-Allow-Origin"},
-		ExposedHeaders:   []string{"Content-Type", "JWT-Token"},
+		AllowedHeaders:   []string{"Accept", "Authorization", auth.HeaderAPIKey, "Content-Type", "traceparent", "tracestate", "X-CSRF-Token", "Access-Control-Allow-Origin"},
+		ExposedHeaders:   []string{"Content-Type", "JWT-Token", "Retry-After", "X-Quota-Limit", "X-Quota-Remaining"},
 		AllowCredentials: false,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	}))
-	//router.Use(middleware.BasicAuth("authentication", map[string]string{cfg.User: cfg.Password}))
 
-	router.Route("/api/v1", func(r chi.Router) {
-		healthHandler.SetupRoutes(r)
-		walletHandler.SetupRoutes(r)
+	err = api.Mount(router, api.Handlers{
+		Health:   healthHandler,
+		Networks: networksHandler,
+		Quota:    quotaHandler,
+		Wallet:   walletHandler,
+		Bitcoin:  bitcoinHandler,
+		Ethereum: ethereumHandler,
+		Keystore: keystoreHandler,
+		Policy:   policyHandler,
 	})
+	if err != nil {
+		zapLogger.Fatalf("failed to mount api routes: %v", err)
+	}
 
-	router.Route("/api/v1/bitcoin", func(r chi.Router) {
-		bitcoinHandler.SetupRoutes(r)
-	})
+	// Start App
+	srv, err := server.New(server.Config{
+		Addr:              cfg.PORT,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+		ShutdownTimeout:   cfg.ServerShutdownTimeout,
+		TLSCertFile:       cfg.ServerTLSCertFile,
+		TLSKeyFile:        cfg.ServerTLSKeyFile,
+		TLSClientCAFile:   cfg.ServerTLSClientCAFile,
+	}, router, zapLogger)
+	if err != nil {
+		zapLogger.Fatalf("failed to create HTTP server: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	if cfg.GRpcServerEnabled {
+		grpcSrv, err := newGRPCServer(cfg, keys, limiter, zapLogger, walletService, bitcoinServices, ethereumServices)
+		if err != nil {
+			zapLogger.Fatalf("failed to create gRPC server: %v", err)
+		}
 
-	router.Route("/api/v1/ethereum", func(r chi.Router) {
-		ethereumHandler.SetupRoutes(r)
+		srv.Go(ctx, func(ctx context.Context) {
+			if err := grpcSrv.Run(ctx); err != nil {
+				zapLogger.Errorf("gRPC server stopped: %v", err)
+				stop()
+			}
+		})
+	}
+
+	if cfg.ConfigFile != "" || cfg.SecretsRefreshInterval > 0 {
+		srv.Go(ctx, func(ctx context.Context) {
+			config.Watch(ctx, cfg, zapLogger, func(reloaded *config.Config) {
+				if err := newLogger.SetLevel(reloaded.LogLevel); err != nil {
+					zapLogger.Errorf("failed to apply log level: %v", err)
+				}
+				limiter.SetLimits(rateLimits(reloaded.RateLimits))
+				if err := setCredentials(bitcoinRpcClients, utxoChains, reloaded); err != nil {
+					zapLogger.Errorf("failed to apply rpc credentials: %v", err)
+				}
+				if err := setEndpoints(ethereumRpcClients, evmChains.Chains(), reloaded); err != nil {
+					zapLogger.Errorf("failed to apply evm rpc endpoints: %v", err)
+				}
+			})
+		})
+	}
+
+	if cfg.MetricsEnabled {
+		metricsRouter := chi.NewRouter()
+		metricsRouter.Handle("/metrics", metrics.Handler())
+		metricsSrv, err := server.New(server.Config{
+			Addr:              cfg.MetricsAddr,
+			ReadTimeout:       cfg.ServerReadTimeout,
+			ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+			WriteTimeout:      cfg.ServerWriteTimeout,
+			IdleTimeout:       cfg.ServerIdleTimeout,
+			ShutdownTimeout:   cfg.ServerShutdownTimeout,
+		}, metricsRouter, zapLogger)
+		if err != nil {
+			zapLogger.Fatalf("failed to create metrics server: %v", err)
+		}
+
+		srv.Go(ctx, func(ctx context.Context) {
+			if err := metricsSrv.Run(ctx); err != nil {
+				zapLogger.Errorf("metrics server stopped: %v", err)
+				stop()
+			}
+		})
+		srv.Go(ctx, func(ctx context.Context) {
+			metrics.PollHeights(ctx, cfg.MetricsPollInterval, zapLogger, heightSources(networkRegistry, bitcoinRpcServices, ethereumRpcServices)...)
+		})
+	}
+
+	if err := srv.Run(ctx); err != nil {
+		zapLogger.Errorf("HTTP server stopped: %v", err)
+	}
+
+	// Release upstream connections; storage, tracing and the logger are flushed by the deferred calls.
+	if err := walletConn.Close(); err != nil {
+		zapLogger.Errorf("failed to close wallet grpc connection: %v", err)
+	}
+	for _, client := range bitcoinRpcClients {
+		client.Close()
+	}
+	for _, client := range ethereumRpcClients {
+		client.Close()
+	}
+
+	zapLogger.Info("shutdown complete")
+}
+
+func newStorage(cfg config.Storage) (storage.Storage, error) {
+	switch cfg.StorageDriver {
+	case "bolt":
+		return bolt_storage.NewStorage(cfg.StoragePath)
+	case "postgres":
+		return postgres_storage.NewStorage(cfg.StorageDSN)
+	}
+
+	return nil, fmt.Errorf("unknown storage driver %q", cfg.StorageDriver)
+}
+
+// newKeystore opens the signing keystore, raw keys in sign requests are refused in production.
+func newKeystore(cfg *config.Config) (keystore.Keystore, error) {
+	var kek []byte
+	if cfg.KeystoreKEK != "" {
+		var err error
+		if kek, err = hex.DecodeString(cfg.KeystoreKEK); err != nil {
+			return nil, err
+		}
+	}
+
+	return keystore.New(keystore.Config{
+		Dir:          cfg.KeystoreDir,
+		KEK:          kek,
+		AllowRawKeys: cfg.AppEnv != "production",
 	})
+}
 
-	// Start App
-	err = http.ListenAndServe(cfg.PORT, router)
+func newPolicyEngine(cfg *config.Config, served *networks.Registry, store storage.Storage) (policy.Engine, error) {
+	var policies []policy.Policy
+	if cfg.PolicyFile != "" {
+		var err error
+		if policies, err = policy.Load(cfg.PolicyFile); err != nil {
+			return nil, err
+		}
+	}
+	return policy.NewEngine(policies, served, store, cfg.PolicyApprovalTTL)
+}
+
+// newEVMRegistry serves ethereum on the networks that have ETH_RPC endpoints, next to the
+// chains of the chains file and of the config file. The first endpoint of a pool is the
+// primary, the others its fallbacks.
+func newEVMRegistry(cfg config.EthRpc) (*ethereum_rpc.Registry, error) {
+	var chains []ethereum_rpc.Chain
+	defaultChain := ethereum_rpc.Chain{Name: ethereum_rpc.DefaultChain, Networks: map[networks.Network]ethereum_rpc.Network{}}
+	for name, endpoints := range map[networks.Network][]string{networks.Test: cfg.EthRpcEndpointTest, networks.Main: cfg.EthRpcEndpointMain} {
+		if len(endpoints) > 0 {
+			defaultChain.Networks[name] = ethereum_rpc.Network{Endpoint: endpoints[0], Fallbacks: endpoints[1:]}
+		}
+	}
+	if len(defaultChain.Networks) > 0 {
+		chains = append(chains, ethereum_rpc.WithDefaults(defaultChain))
+	}
+
+	if cfg.EvmChainsFile != "" {
+		loaded, err := ethereum_rpc.LoadChains(cfg.EvmChainsFile)
+		if err != nil {
+			return nil, err
+		}
+		chains = append(chains, loaded...)
+	}
+
+	for _, chain := range cfg.EvmChains {
+		configured := ethereum_rpc.Chain{
+			Name:     chain.Name,
+			Currency: chain.Currency,
+			Decimals: chain.Decimals,
+			EIP1559:  chain.EIP1559,
+			Rollup:   chain.Rollup,
+			Networks: map[networks.Network]ethereum_rpc.Network{},
+		}
+		for name, network := range chain.Networks {
+			configured.Networks[networks.Network(name)] = ethereum_rpc.Network{ChainId: network.ChainId, Endpoint: network.Endpoints[0], Fallbacks: network.Endpoints[1:]}
+		}
+		chains = append(chains, ethereum_rpc.WithDefaults(configured))
+	}
+	return ethereum_rpc.NewRegistry(chains...)
+}
+
+// newUTXOChains serves the networks of the UTXO chains that have endpoints, the first of a
+// pool is the primary and the others its fallbacks. Credentials in the primary URL take
+// precedence over the user and password of the chain.
+func newUTXOChains(cfg *config.Config) ([]bitcoin_rpc.Chain, error) {
+	type node struct {
+		chain          string
+		endpoints      map[networks.Network][]string
+		user, password string
+	}
+
+	var chains []bitcoin_rpc.Chain
+	for _, node := range []node{
+		{bitcoin_rpc.DefaultChain, map[networks.Network][]string{networks.Test: cfg.BtcRpcEndpointTest, networks.Main: cfg.BtcRpcEndpointMain, networks.Signet: cfg.BtcRpcEndpointSignet}, cfg.BtcRpcUser, cfg.BtcRpcPassword},
+		{bitcoin_rpc.ChainLitecoin, map[networks.Network][]string{networks.Test: cfg.LtcRpcEndpointTest, networks.Main: cfg.LtcRpcEndpointMain}, cfg.LtcRpcUser, cfg.LtcRpcPassword},
+		{bitcoin_rpc.ChainDogecoin, map[networks.Network][]string{networks.Test: cfg.DogeRpcEndpointTest, networks.Main: cfg.DogeRpcEndpointMain}, cfg.DogeRpcUser, cfg.DogeRpcPassword},
+		{bitcoin_rpc.ChainBitcoinCash, map[networks.Network][]string{networks.Test: cfg.BchRpcEndpointTest, networks.Main: cfg.BchRpcEndpointMain}, cfg.BchRpcUser, cfg.BchRpcPassword},
+	} {
+		chain := bitcoin_rpc.KnownChains[node.chain]
+		served := map[networks.Network]bitcoin_rpc.Network{}
+		for name, endpoints := range node.endpoints {
+			if len(endpoints) == 0 {
+				continue
+			}
+
+			network := chain.Networks[name]
+			network.Endpoint, network.Fallbacks = endpoints[0], endpoints[1:]
+			network.User, network.Password = node.user, node.password
+			parsed, err := url.Parse(network.Endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %s endpoint: %w", node.chain, name, err)
+			}
+			if parsed.User != nil {
+				network.User = parsed.User.Username()
+				network.Password, _ = parsed.User.Password()
+				parsed.User = nil
+				network.Endpoint = parsed.String()
+			}
+			served[name] = network
+		}
+		if len(served) == 0 {
+			continue
+		}
+		chain.Networks = served
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
+// setCredentials rotates the credentials of the networks the rpc clients were set up for,
+// chains and networks a reload adds take a restart.
+func setCredentials(clients map[string]bitcoin_rpc.Client, served []bitcoin_rpc.Chain, cfg *config.Config) error {
+	chains, err := newUTXOChains(cfg)
 	if err != nil {
-		zapLogger.Fatal("Failed to start HTTP server!")
-		return
+		return err
 	}
+
+	reloaded := make(map[string]bitcoin_rpc.Chain, len(chains))
+	for _, chain := range chains {
+		reloaded[chain.Name] = chain
+	}
+	for _, chain := range served {
+		for name := range chain.Networks {
+			network, ok := reloaded[chain.Name].Networks[name]
+			if !ok {
+				continue
+			}
+			if err := clients[chain.Name].SetCredentials(string(name), network.User, network.Password); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setEndpoints rotates the endpoints of the EVM networks the rpc clients were set up for,
+// their credentials and API keys are part of the URLs. Chains and networks a reload adds
+// take a restart.
+func setEndpoints(clients map[string]ethereum_rpc.Client, served []ethereum_rpc.Chain, cfg *config.Config) error {
+	registry, err := newEVMRegistry(cfg.EthRpc)
+	if err != nil {
+		return err
+	}
+
+	for _, chain := range served {
+		reloaded, ok := registry.Chain(chain.Name)
+		if !ok {
+			continue
+		}
+		for name := range chain.Networks {
+			network, ok := reloaded.Networks[name]
+			if !ok {
+				continue
+			}
+			if err := clients[chain.Name].SetEndpoints(string(name), network.Endpoints()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// newNetworkRegistry lists the networks every served chain is configured for.
+func newNetworkRegistry(utxoChains []bitcoin_rpc.Chain, evmChains []ethereum_rpc.Chain) (*networks.Registry, error) {
+	var infos []networks.Info
+	for _, chain := range utxoChains {
+		for name, network := range chain.Networks {
+			infos = append(infos, networks.Info{Chain: chain.Name, Network: name, Currency: chain.Currency, Params: network.Params.Name})
+		}
+	}
+	for _, chain := range evmChains {
+		for name, network := range chain.Networks {
+			infos = append(infos, networks.Info{Chain: chain.Name, Network: name, Currency: chain.Currency, ChainId: network.ChainId})
+		}
+	}
+	return networks.NewRegistry(infos...)
+}
+
+func newKeyStore(cfg config.Auth, store storage.Storage) (auth.KeyStore, error) {
+	configKeys, err := auth.NewConfigKeyStore(cfg.AuthAPIKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	storageKeys, err := auth.NewStorageKeyStore(store.APIKeys())
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewMultiKeyStore(configKeys, storageKeys), nil
+}
+
+func newGRPCServer(cfg *config.Config, keys auth.KeyStore, limiter *ratelimit.Limiter, logger *zap.SugaredLogger, walletSvc wallet.Service, btcSvcs map[string]bitcoin.Service, ethSvcs map[string]ethereum.Service) (*grpc_server.Server, error) {
+	tlsConfig, err := server.NewTLSConfig(cfg.ServerTLSCertFile, cfg.ServerTLSKeyFile, cfg.ServerTLSClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	walletServer, err := wallet.NewGRPCServer(walletSvc)
+	if err != nil {
+		return nil, err
+	}
+	bitcoinServer, err := bitcoin.NewGRPCServer(btcSvcs)
+	if err != nil {
+		return nil, err
+	}
+	ethereumServer, err := ethereum.NewGRPCServer(ethSvcs)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := map[string]auth.Rule{}
+	for _, serviceRules := range []map[string]auth.Rule{walletServer.Rules(), bitcoinServer.Rules(), ethereumServer.Rules()} {
+		for method, rule := range serviceRules {
+			rules[method] = rule
+		}
+	}
+
+	interceptors := []grpc.UnaryServerInterceptor{auth.UnaryServerInterceptor(keys, cfg.AuthEnabled, rules)}
+	if cfg.RateLimitEnabled {
+		interceptors = append(interceptors, ratelimit.UnaryServerInterceptor(limiter, rules))
+	}
+
+	grpcSrv, err := grpc_server.New(grpc_server.Config{
+		Addr:            cfg.GRpcServerAddr,
+		ShutdownTimeout: cfg.ServerShutdownTimeout,
+		Reflection:      cfg.GRpcServerReflection,
+		TLSConfig:       tlsConfig,
+	}, logger, interceptors...)
+	if err != nil {
+		return nil, err
+	}
+
+	walletServer.Register(grpcSrv)
+	bitcoinServer.Register(grpcSrv)
+	ethereumServer.Register(grpcSrv)
+
+	return grpcSrv, nil
+}
+
+func newLimiter(cfg config.RateLimit) (*ratelimit.Limiter, error) {
+	var store ratelimit.Store
+	switch cfg.RateLimitStore {
+	case "memory":
+		store = ratelimit.NewMemoryStore()
+	case "redis":
+		options, err := redis.ParseURL(cfg.RateLimitRedisURL)
+		if err != nil {
+			return nil, err
+		}
+
+		store, err = ratelimit.NewRedisStore(redis.NewClient(options), "nn-blockchain-api:")
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown rate limit store %q", cfg.RateLimitStore)
+	}
+
+	return ratelimit.NewLimiter(store, rateLimits(cfg.RateLimits))
+}
+
+func rateLimits(cfg config.RateLimits) map[ratelimit.Class]ratelimit.Limit {
+	return map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads:      {Rate: cfg.RateLimitReadsRPS, Burst: cfg.RateLimitReadsBurst, Daily: cfg.RateLimitReadsDaily},
+		ratelimit.ClassBuilds:     {Rate: cfg.RateLimitBuildsRPS, Burst: cfg.RateLimitBuildsBurst, Daily: cfg.RateLimitBuildsDaily},
+		ratelimit.ClassBroadcasts: {Rate: cfg.RateLimitBroadcastsRPS, Burst: cfg.RateLimitBroadcastsBurst, Daily: cfg.RateLimitBroadcastsDaily},
+	}
+}
+
+func heightSources(registry *networks.Registry, btcRpcSvcs map[string]bitcoin_rpc.Service, ethRpcSvcs map[string]ethereum_rpc.Service) []metrics.HeightSource {
+	var sources []metrics.HeightSource
+	for _, info := range registry.Networks() {
+		network := string(info.Network)
+
+		if btcRpcSvc, ok := btcRpcSvcs[info.Chain]; ok {
+			sources = append(sources, metrics.HeightSource{
+				Chain:   info.Chain,
+				Network: network,
+				Fetch: func(ctx context.Context) (float64, float64, error) {
+					status, err := btcRpcSvc.Status(ctx, network)
+					if err != nil {
+						return 0, 0, err
+					}
+
+					blocks, _ := status.Blocks.(float64)
+					headers, _ := status.Headers.(float64)
+					return blocks, headers, nil
+				},
+			})
+			continue
+		}
+
+		ethRpcSvc := ethRpcSvcs[info.Chain]
+		sources = append(sources, metrics.HeightSource{
+			Chain:   info.Chain,
+			Network: network,
+			Fetch: func(ctx context.Context) (float64, float64, error) {
+				status, err := ethRpcSvc.Status(ctx, network)
+				if err != nil {
+					return 0, 0, err
+				}
+
+				if status.CurrentBlock != "" {
+					current, err := hexutil.DecodeUint64(status.CurrentBlock)
+					if err != nil {
+						return 0, 0, err
+					}
+					highest, err := hexutil.DecodeUint64(status.HighestBlock)
+					if err != nil {
+						return 0, 0, err
+					}
+					return float64(current), float64(highest), nil
+				}
+
+				number, err := ethRpcSvc.BlockNumber(ctx, network)
+				if err != nil {
+					return 0, 0, err
+				}
+				return float64(number), float64(number), nil
+			},
+		})
+	}
+
+	return sources
 }
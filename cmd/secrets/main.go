@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"nn-blockchain-api/pkg/keystore"
+	"nn-blockchain-api/pkg/secrets"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Stores a secret read from stdin in the encrypted SECRETS_FILE, created on the first
+// secret, or lists the names it holds. The passphrase comes from SECRETS_PASSPHRASE or the
+// file SECRETS_PASSPHRASE_FILE names, settings reference the secrets as secret:local:<name>.
+func main() {
+	file := flag.String("file", os.Getenv("SECRETS_FILE"), "encrypted secrets file")
+	name := flag.String("name", "", "name of the secret to store, its value is read from stdin")
+	remove := flag.Bool("delete", false, "delete the secret instead of storing it")
+	flag.Parse()
+
+	if *file == "" {
+		log.Fatal("the secrets file is required")
+	}
+	passphrase := os.Getenv("SECRETS_PASSPHRASE")
+	if path, ok := os.LookupEnv("SECRETS_PASSPHRASE_FILE"); ok {
+		var err error
+		if passphrase, err = secrets.ReadFile(path); err != nil {
+			log.Fatalf("failed to read the passphrase file: %v", err)
+		}
+	}
+	if passphrase == "" {
+		log.Fatal("SECRETS_PASSPHRASE or SECRETS_PASSPHRASE_FILE is required")
+	}
+
+	stored := map[string]string{}
+	if _, err := os.Stat(*file); err == nil {
+		if stored, err = secrets.ReadLocalFile(*file, passphrase); err != nil {
+			log.Fatalf("failed to open %s: %v", *file, err)
+		}
+	}
+
+	if *name == "" {
+		names := make([]string, 0, len(stored))
+		for name := range stored {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Println(strings.Join(names, "\n"))
+		return
+	}
+
+	if *remove {
+		delete(stored, *name)
+	} else {
+		value, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		value = strings.TrimRight(value, "\r\n")
+		if value == "" {
+			log.Fatalf("no value of %s on stdin", *name)
+		}
+		stored[*name] = value
+	}
+
+	if err := secrets.WriteLocalFile(*file, stored, passphrase, keystore.StandardScryptN, keystore.StandardScryptP); err != nil {
+		log.Fatalf("failed to write %s: %v", *file, err)
+	}
+	fmt.Printf("Stored %d secrets in %s\n", len(stored), *file)
+}
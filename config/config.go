@@ -1,35 +1,230 @@
 package config
 
 import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	gErrors "errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/secrets"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/BurntSushi/toml"
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
 )
 
+// Config is read from the defaults, then the YAML or TOML file CONFIG_FILE names and
+// finally the environment, each layer overriding the one before. File keys are the
+// yaml/toml tags, grouped by the section of the embedded struct. Any setting NAME may
+// be read from the file NAME_FILE names instead, and any text setting may reference a
+// secret, see Secrets.
 type Config struct {
-	PORT   string `required:"true" default:"5000" envconfig:"PORT"`
-	AppEnv string `required:"true" envconfig:"APP_ENV"`
+	PORT     string `default:"5000" envconfig:"PORT" yaml:"port" toml:"port"`
+	AppEnv   string `envconfig:"APP_ENV" yaml:"app_env" toml:"app_env"`
+	LogLevel string `default:"debug" envconfig:"LOG_LEVEL" yaml:"log_level" toml:"log_level"`
 
-	GRps
-	BtcRpc
-	EthRpc
+	Server     `yaml:"server" toml:"server"`
+	GRps       `yaml:"wallet_grpc" toml:"wallet_grpc"`
+	GRpcServer `yaml:"grpc_server" toml:"grpc_server"`
+	BtcRpc     `yaml:"bitcoin" toml:"bitcoin"`
+	LtcRpc     `yaml:"litecoin" toml:"litecoin"`
+	DogeRpc    `yaml:"dogecoin" toml:"dogecoin"`
+	BchRpc     `yaml:"bitcoincash" toml:"bitcoincash"`
+	EthRpc     `yaml:"ethereum" toml:"ethereum"`
+	Storage    `yaml:"storage" toml:"storage"`
+	Keystore   `yaml:"keystore" toml:"keystore"`
+	Policy     `yaml:"policy" toml:"policy"`
+	Auth       `yaml:"auth" toml:"auth"`
+	RateLimit  `yaml:"rate_limit" toml:"rate_limit"`
+	Metrics    `yaml:"metrics" toml:"metrics"`
+	Tracing    `yaml:"tracing" toml:"tracing"`
+	Readiness  `yaml:"readiness" toml:"readiness"`
+	Reload     `yaml:"reload" toml:"reload"`
+	Secrets    `yaml:"secrets" toml:"secrets"`
+}
+
+type Server struct {
+	ServerReadTimeout       time.Duration `default:"15s" envconfig:"SERVER_READ_TIMEOUT" yaml:"read_timeout" toml:"read_timeout"`
+	ServerReadHeaderTimeout time.Duration `default:"5s" envconfig:"SERVER_READ_HEADER_TIMEOUT" yaml:"read_header_timeout" toml:"read_header_timeout"`
+	ServerWriteTimeout      time.Duration `default:"60s" envconfig:"SERVER_WRITE_TIMEOUT" yaml:"write_timeout" toml:"write_timeout"`
+	ServerIdleTimeout       time.Duration `default:"120s" envconfig:"SERVER_IDLE_TIMEOUT" yaml:"idle_timeout" toml:"idle_timeout"`
+	ServerShutdownTimeout   time.Duration `default:"30s" envconfig:"SERVER_SHUTDOWN_TIMEOUT" yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+	ServerTLSCertFile       string        `envconfig:"SERVER_TLS_CERT_FILE" yaml:"tls_cert_file" toml:"tls_cert_file"`
+	ServerTLSKeyFile        string        `envconfig:"SERVER_TLS_KEY_FILE" yaml:"tls_key_file" toml:"tls_key_file"`
+	ServerTLSClientCAFile   string        `envconfig:"SERVER_TLS_CLIENT_CA_FILE" yaml:"tls_client_ca_file" toml:"tls_client_ca_file"`
 }
 
 type GRps struct {
-	GRpcHost string `required:"true" envconfig:"GRPC_HOST"`
+	GRpcHost string `envconfig:"GRPC_HOST" yaml:"host" toml:"host"`
+}
+
+// GRpcServer serves the API over gRPC next to HTTP, reusing the SERVER_* timeouts and TLS files.
+type GRpcServer struct {
+	GRpcServerEnabled    bool   `default:"true" envconfig:"GRPC_SERVER_ENABLED" yaml:"enabled" toml:"enabled"`
+	GRpcServerAddr       string `default:":9090" envconfig:"GRPC_SERVER_ADDR" yaml:"addr" toml:"addr"`
+	GRpcServerReflection bool   `default:"true" envconfig:"GRPC_SERVER_REFLECTION" yaml:"reflection" toml:"reflection"`
 }
 
+// BtcRpc, LtcRpc, DogeRpc and BchRpc are optional, a chain is served on the networks that
+// have endpoints. Every network takes a pool of endpoints, comma separated in the
+// environment, tried in order while nodes are unavailable. Credentials in the URL of the
+// first endpoint take precedence over the user and password. Bitcoin may be served on
+// signet as well.
 type BtcRpc struct {
-	BtcRpcEndpointTest string `required:"true" envconfig:"BTC_RPC_ENDPOINT_TEST"`
-	BtcRpcEndpointMain string `required:"true" envconfig:"BTC_RPC_ENDPOINT_MAIN"`
-	BtcRpcUser         string `required:"true" envconfig:"BTC_RPC_USER"`
-	BtcRpcPassword     string `required:"true" envconfig:"BTC_RPC_PASSWORD"`
+	BtcRpcEndpointTest   []string `envconfig:"BTC_RPC_ENDPOINT_TEST" yaml:"endpoints_test" toml:"endpoints_test"`
+	BtcRpcEndpointMain   []string `envconfig:"BTC_RPC_ENDPOINT_MAIN" yaml:"endpoints_main" toml:"endpoints_main"`
+	BtcRpcEndpointSignet []string `envconfig:"BTC_RPC_ENDPOINT_SIGNET" yaml:"endpoints_signet" toml:"endpoints_signet"`
+	BtcRpcUser           string   `envconfig:"BTC_RPC_USER" yaml:"user" toml:"user"`
+	BtcRpcPassword       string   `envconfig:"BTC_RPC_PASSWORD" yaml:"password" toml:"password"`
+}
+
+type LtcRpc struct {
+	LtcRpcEndpointTest []string `envconfig:"LTC_RPC_ENDPOINT_TEST" yaml:"endpoints_test" toml:"endpoints_test"`
+	LtcRpcEndpointMain []string `envconfig:"LTC_RPC_ENDPOINT_MAIN" yaml:"endpoints_main" toml:"endpoints_main"`
+	LtcRpcUser         string   `envconfig:"LTC_RPC_USER" yaml:"user" toml:"user"`
+	LtcRpcPassword     string   `envconfig:"LTC_RPC_PASSWORD" yaml:"password" toml:"password"`
+}
+
+type DogeRpc struct {
+	DogeRpcEndpointTest []string `envconfig:"DOGE_RPC_ENDPOINT_TEST" yaml:"endpoints_test" toml:"endpoints_test"`
+	DogeRpcEndpointMain []string `envconfig:"DOGE_RPC_ENDPOINT_MAIN" yaml:"endpoints_main" toml:"endpoints_main"`
+	DogeRpcUser         string   `envconfig:"DOGE_RPC_USER" yaml:"user" toml:"user"`
+	DogeRpcPassword     string   `envconfig:"DOGE_RPC_PASSWORD" yaml:"password" toml:"password"`
+}
+
+type BchRpc struct {
+	BchRpcEndpointTest []string `envconfig:"BCH_RPC_ENDPOINT_TEST" yaml:"endpoints_test" toml:"endpoints_test"`
+	BchRpcEndpointMain []string `envconfig:"BCH_RPC_ENDPOINT_MAIN" yaml:"endpoints_main" toml:"endpoints_main"`
+	BchRpcUser         string   `envconfig:"BCH_RPC_USER" yaml:"user" toml:"user"`
+	BchRpcPassword     string   `envconfig:"BCH_RPC_PASSWORD" yaml:"password" toml:"password"`
 }
 
+// EthRpc serves ethereum on the networks that have endpoints. Further EVM chains come
+// from the chains file and the chains of the config file.
 type EthRpc struct {
-	EthRpcEndpointTest string `required:"true" envconfig:"ETH_RPC_ENDPOINT_TEST"`
-	EthRpcEndpointMain string `required:"true" envconfig:"ETH_RPC_ENDPOINT_MAIN"`
+	EthRpcEndpointTest []string   `envconfig:"ETH_RPC_ENDPOINT_TEST" yaml:"endpoints_test" toml:"endpoints_test"`
+	EthRpcEndpointMain []string   `envconfig:"ETH_RPC_ENDPOINT_MAIN" yaml:"endpoints_main" toml:"endpoints_main"`
+	EvmChainsFile      string     `envconfig:"EVM_CHAINS_FILE" yaml:"chains_file" toml:"chains_file"`
+	EvmChains          []EvmChain `ignored:"true" yaml:"chains" toml:"chains"`
+}
+
+// EvmChain is an EVM chain of the config file, known chains only need the endpoints of
+// the networks they are served on.
+type EvmChain struct {
+	Name     string                `yaml:"name" toml:"name"`
+	Currency string                `yaml:"currency" toml:"currency"`
+	Decimals int                   `yaml:"decimals" toml:"decimals"`
+	EIP1559  *bool                 `yaml:"eip1559" toml:"eip1559"`
+	Rollup   string                `yaml:"rollup" toml:"rollup"`
+	Networks map[string]EvmNetwork `yaml:"networks" toml:"networks"`
+}
+
+type EvmNetwork struct {
+	ChainId   int64    `yaml:"chain_id" toml:"chain_id"`
+	Endpoints []string `yaml:"endpoints" toml:"endpoints"`
+}
+
+type Storage struct {
+	StorageDriver string `default:"bolt" envconfig:"STORAGE_DRIVER" yaml:"driver" toml:"driver"`
+	StoragePath   string `default:"data/nn-blockchain-api.db" envconfig:"STORAGE_PATH" yaml:"path" toml:"path"`
+	StorageDSN    string `envconfig:"STORAGE_DSN" yaml:"dsn" toml:"dsn"`
+}
+
+// Keystore keeps encrypted signing keys, the optional KEK is a hex encoded 32 byte key
+// that unlocks keys imported without a passphrase.
+type Keystore struct {
+	KeystoreDir string `default:"data/keystore" envconfig:"KEYSTORE_DIR" yaml:"dir" toml:"dir"`
+	KeystoreKEK string `envconfig:"KEYSTORE_KEK" yaml:"kek" toml:"kek"`
+}
+
+// Policy points at a JSON array of signing policies, signing is unrestricted without one.
+// Pending approvals nobody decided on within the approval TTL expire.
+type Policy struct {
+	PolicyFile        string        `envconfig:"POLICY_FILE" yaml:"file" toml:"file"`
+	PolicyApprovalTTL time.Duration `default:"24h" envconfig:"POLICY_APPROVAL_TTL" yaml:"approval_ttl" toml:"approval_ttl"`
+}
+
+type Auth struct {
+	AuthEnabled bool     `default:"true" envconfig:"AUTH_ENABLED" yaml:"enabled" toml:"enabled"`
+	AuthAPIKeys []string `envconfig:"AUTH_API_KEYS" yaml:"api_keys" toml:"api_keys"`
+}
+
+type RateLimit struct {
+	RateLimitEnabled  bool   `default:"true" envconfig:"RATE_LIMIT_ENABLED" yaml:"enabled" toml:"enabled"`
+	RateLimitStore    string `default:"memory" envconfig:"RATE_LIMIT_STORE" yaml:"store" toml:"store"`
+	RateLimitRedisURL string `envconfig:"RATE_LIMIT_REDIS_URL" yaml:"redis_url" toml:"redis_url"`
+
+	RateLimits `yaml:",inline"`
+}
+
+// RateLimits take effect on a config reload, the other settings need a restart.
+type RateLimits struct {
+	RateLimitReadsRPS        float64 `default:"10" envconfig:"RATE_LIMIT_READS_RPS" yaml:"reads_rps" toml:"reads_rps"`
+	RateLimitReadsBurst      int     `default:"20" envconfig:"RATE_LIMIT_READS_BURST" yaml:"reads_burst" toml:"reads_burst"`
+	RateLimitReadsDaily      int64   `default:"100000" envconfig:"RATE_LIMIT_READS_DAILY" yaml:"reads_daily" toml:"reads_daily"`
+	RateLimitBuildsRPS       float64 `default:"2" envconfig:"RATE_LIMIT_BUILDS_RPS" yaml:"builds_rps" toml:"builds_rps"`
+	RateLimitBuildsBurst     int     `default:"5" envconfig:"RATE_LIMIT_BUILDS_BURST" yaml:"builds_burst" toml:"builds_burst"`
+	RateLimitBuildsDaily     int64   `default:"10000" envconfig:"RATE_LIMIT_BUILDS_DAILY" yaml:"builds_daily" toml:"builds_daily"`
+	RateLimitBroadcastsRPS   float64 `default:"1" envconfig:"RATE_LIMIT_BROADCASTS_RPS" yaml:"broadcasts_rps" toml:"broadcasts_rps"`
+	RateLimitBroadcastsBurst int     `default:"2" envconfig:"RATE_LIMIT_BROADCASTS_BURST" yaml:"broadcasts_burst" toml:"broadcasts_burst"`
+	RateLimitBroadcastsDaily int64   `default:"1000" envconfig:"RATE_LIMIT_BROADCASTS_DAILY" yaml:"broadcasts_daily" toml:"broadcasts_daily"`
+}
+
+// Metrics are served on an address of their own, kept off the public API listener.
+type Metrics struct {
+	MetricsEnabled      bool          `default:"true" envconfig:"METRICS_ENABLED" yaml:"enabled" toml:"enabled"`
+	MetricsAddr         string        `default:":9100" envconfig:"METRICS_ADDR" yaml:"addr" toml:"addr"`
+	MetricsPollInterval time.Duration `default:"30s" envconfig:"METRICS_POLL_INTERVAL" yaml:"poll_interval" toml:"poll_interval"`
+}
+
+type Tracing struct {
+	TracingExporter    string            `default:"none" envconfig:"TRACING_EXPORTER" yaml:"exporter" toml:"exporter"`
+	TracingEndpoint    string            `envconfig:"TRACING_OTLP_ENDPOINT" yaml:"otlp_endpoint" toml:"otlp_endpoint"`
+	TracingHeaders     map[string]string `envconfig:"TRACING_OTLP_HEADERS" yaml:"otlp_headers" toml:"otlp_headers"`
+	TracingServiceName string            `default:"nn-blockchain-api" envconfig:"TRACING_SERVICE_NAME" yaml:"service_name" toml:"service_name"`
+	TracingSampleRatio float64           `default:"1" envconfig:"TRACING_SAMPLE_RATIO" yaml:"sample_ratio" toml:"sample_ratio"`
+}
+
+// Readiness tunes /ready. A zero ReadyMinPeers skips the peer check, hosted RPC providers
+// report no peers. Reports are reused for ReadyCacheTTL.
+type Readiness struct {
+	ReadyTimeout      time.Duration `default:"5s" envconfig:"READY_TIMEOUT" yaml:"timeout" toml:"timeout"`
+	ReadyBtcMaxTipAge time.Duration `default:"2h" envconfig:"READY_BTC_MAX_TIP_AGE" yaml:"btc_max_tip_age" toml:"btc_max_tip_age"`
+	ReadyEthMaxTipAge time.Duration `default:"5m" envconfig:"READY_ETH_MAX_TIP_AGE" yaml:"eth_max_tip_age" toml:"eth_max_tip_age"`
+	ReadyMinPeers     int64         `default:"0" envconfig:"READY_MIN_PEERS" yaml:"min_peers" toml:"min_peers"`
+	ReadyCacheTTL     time.Duration `default:"2s" envconfig:"READY_CACHE_TTL" yaml:"cache_ttl" toml:"cache_ttl"`
+}
+
+// Reload re-reads the config file on SIGHUP and every watch interval it was modified in,
+// zero only reloads on SIGHUP.
+type Reload struct {
+	ConfigFile          string        `envconfig:"CONFIG_FILE" yaml:"-" toml:"-"`
+	ConfigWatchInterval time.Duration `default:"10s" envconfig:"CONFIG_WATCH_INTERVAL" yaml:"watch_interval" toml:"watch_interval"`
+}
+
+// Secrets resolve settings of the form secret:<provider>:<name>. The file provider reads
+// the file name is the path of, local the SECRETS_FILE sealed by cmd/secrets and vault the
+// KV version 2 engine at VAULT_MOUNT. Secrets and NAME_FILE files are read again every
+// refresh interval, so rotated RPC credentials apply without a restart, zero disables it.
+// The passphrase and the token are never read from the config file.
+type Secrets struct {
+	SecretsFile            string        `envconfig:"SECRETS_FILE" yaml:"file" toml:"file"`
+	SecretsPassphrase      string        `envconfig:"SECRETS_PASSPHRASE" yaml:"-" toml:"-"`
+	VaultAddr              string        `envconfig:"VAULT_ADDR" yaml:"vault_addr" toml:"vault_addr"`
+	VaultToken             string        `envconfig:"VAULT_TOKEN" yaml:"-" toml:"-"`
+	VaultMount             string        `default:"secret" envconfig:"VAULT_MOUNT" yaml:"vault_mount" toml:"vault_mount"`
+	SecretsRefreshInterval time.Duration `default:"5m" envconfig:"SECRETS_REFRESH_INTERVAL" yaml:"refresh_interval" toml:"refresh_interval"`
 }
 
 var (
@@ -40,16 +235,376 @@ var (
 func Get() (*Config, error) {
 	var err error
 	once.Do(func() {
-		var cfg Config
 		// If you run it locally and through terminal please set up this in Load function (../.env)
 		_ = godotenv.Load(".env")
 
-		if err = envconfig.Process("", &cfg); err != nil {
+		config, err = Load(os.Getenv("CONFIG_FILE"))
+	})
+
+	return config, err
+}
+
+// Load reads and validates the config, path may be empty to only read the environment.
+func Load(path string) (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, err
+	}
+
+	if path != "" {
+		// The environment is read into a copy of its own, the file must not write through
+		// the slices and maps it overrides.
+		var env Config
+		if err := envconfig.Process("", &env); err != nil {
+			return nil, err
+		}
+		if err := decodeFile(path, &cfg); err != nil {
+			return nil, err
+		}
+		overlay(reflect.ValueOf(&cfg).Elem(), reflect.ValueOf(env))
+		cfg.ConfigFile = path
+	}
+
+	var errs Errors
+	readFiles(reflect.ValueOf(&cfg).Elem(), &errs)
+	if len(errs) == 0 {
+		cfg.resolveSecrets(&errs)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// decodeFile reads a YAML or TOML file by its extension, unknown keys are an error.
+func decodeFile(path string, cfg *Config) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(cfg); err != nil {
+			return fmt.Errorf("invalid config file %s: %w", path, err)
+		}
+	case ".toml":
+		meta, err := toml.Decode(string(data), cfg)
+		if err != nil {
+			return fmt.Errorf("invalid config file %s: %w", path, err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("invalid config file %s: unknown key %s", path, undecoded[0])
+		}
+	default:
+		return fmt.Errorf("config file %s is neither .yaml, .yml nor .toml", path)
+	}
+	return nil
+}
+
+// overlay copies the settings the environment sets from env to cfg.
+func overlay(cfg, env reflect.Value) {
+	for i := 0; i < cfg.NumField(); i++ {
+		field := cfg.Type().Field(i)
+		if field.Anonymous {
+			overlay(cfg.Field(i), env.Field(i))
+			continue
+		}
+
+		if key := field.Tag.Get("envconfig"); key != "" {
+			if _, ok := os.LookupEnv(key); ok {
+				cfg.Field(i).Set(env.Field(i))
+			}
+		}
+	}
+}
+
+// readFiles sets every setting NAME the NAME_FILE variable of is set for from that file.
+func readFiles(cfg reflect.Value, errs *Errors) {
+	for i := 0; i < cfg.NumField(); i++ {
+		field := cfg.Type().Field(i)
+		if field.Anonymous {
+			readFiles(cfg.Field(i), errs)
+			continue
+		}
+
+		key := field.Tag.Get("envconfig")
+		if key == "" {
+			continue
+		}
+		path, ok := os.LookupEnv(key + "_FILE")
+		if !ok {
+			continue
+		}
+		if _, ok := os.LookupEnv(key); ok {
+			errs.add("%s and %s_FILE must not be set together", key, key)
+			continue
+		}
+
+		value, err := secrets.ReadFile(path)
+		if err != nil {
+			errs.add("%s_FILE %s is not readable", key, path)
+			continue
+		}
+		if err := setText(cfg.Field(i), value); err != nil {
+			errs.add("%s_FILE %s: %v", key, path, err)
+		}
+	}
+}
+
+// setText parses a setting the way envconfig does, for the kinds secrets are kept in.
+func setText(field reflect.Value, value string) error {
+	switch field.Interface().(type) {
+	case string:
+		field.SetString(value)
+	case []string:
+		field.Set(reflect.ValueOf(strings.Split(value, ",")))
+	case map[string]string:
+		items := map[string]string{}
+		for _, item := range strings.Split(value, ",") {
+			pair := strings.SplitN(item, ":", 2)
+			if len(pair) != 2 {
+				return gErrors.New("map items must be key:value")
+			}
+			items[pair[0]] = pair[1]
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return gErrors.New("only text settings are read from files")
+	}
+	return nil
+}
+
+// resolveSecrets replaces the secret references of every setting but those of the secrets
+// section, which configure the providers.
+func (c *Config) resolveSecrets(errs *Errors) {
+	providers := map[string]secrets.Provider{"file": secrets.NewFileProvider()}
+	if c.SecretsFile != "" {
+		local, err := secrets.NewLocalProvider(c.SecretsFile, c.SecretsPassphrase)
+		if err != nil {
+			errs.add("SECRETS_*: %v", err)
+		} else {
+			providers["local"] = local
+		}
+	}
+	if c.VaultAddr != "" {
+		vault, err := secrets.NewVaultProvider(c.VaultAddr, c.VaultToken, c.VaultMount)
+		if err != nil {
+			errs.add("VAULT_*: %v", err)
+		} else {
+			providers["vault"] = vault
+		}
+	}
+
+	resolver, err := secrets.NewResolver(providers)
+	if err != nil {
+		errs.add("%v", err)
+		return
+	}
+	resolve(context.Background(), resolver, reflect.ValueOf(c).Elem(), errs)
+}
+
+// resolve walks the strings of a setting, including those of slices, maps and the EVM chains.
+func resolve(ctx context.Context, resolver *secrets.Resolver, value reflect.Value, errs *Errors) {
+	switch value.Kind() {
+	case reflect.String:
+		secret, err := resolver.Resolve(ctx, value.String())
+		if err != nil {
+			errs.add("%v", err)
+			return
+		}
+		value.SetString(secret)
+	case reflect.Struct:
+		if value.Type() == reflect.TypeOf(Secrets{}) {
 			return
 		}
+		for i := 0; i < value.NumField(); i++ {
+			resolve(ctx, resolver, value.Field(i), errs)
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			resolve(ctx, resolver, value.Index(i), errs)
+		}
+	case reflect.Map:
+		// Map elements are not addressable, they are resolved in a copy and stored back.
+		for _, key := range value.MapKeys() {
+			elem := reflect.New(value.Type().Elem()).Elem()
+			elem.Set(value.MapIndex(key))
+			resolve(ctx, resolver, elem, errs)
+			value.SetMapIndex(key, elem)
+		}
+	}
+}
 
-		config = &cfg
-	})
+// Errors lists every invalid setting of a config.
+type Errors []string
 
-	return config, err
+func (e Errors) Error() string {
+	return "invalid config: " + strings.Join(e, "; ")
+}
+
+func (e *Errors) add(format string, args ...interface{}) {
+	*e = append(*e, fmt.Sprintf(format, args...))
+}
+
+// Validate checks the settings of the config and reports all problems at once. Settings
+// are named by their environment variable.
+func (c *Config) Validate() error {
+	var errs Errors
+
+	if c.PORT == "" {
+		errs.add("PORT is required")
+	}
+	if c.AppEnv != "development" && c.AppEnv != "production" {
+		errs.add("APP_ENV must be development or production")
+	}
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(c.LogLevel)); err != nil {
+		errs.add("LOG_LEVEL %q is unknown", c.LogLevel)
+	}
+
+	if (c.ServerTLSCertFile == "") != (c.ServerTLSKeyFile == "") {
+		errs.add("SERVER_TLS_CERT_FILE and SERVER_TLS_KEY_FILE must be set together")
+	}
+	if c.ServerTLSClientCAFile != "" && c.ServerTLSCertFile == "" {
+		errs.add("SERVER_TLS_CLIENT_CA_FILE requires SERVER_TLS_CERT_FILE")
+	}
+	if c.GRpcHost == "" {
+		errs.add("GRPC_HOST is required")
+	}
+	if c.GRpcServerEnabled && c.GRpcServerAddr == "" {
+		errs.add("GRPC_SERVER_ADDR is required")
+	}
+
+	for _, node := range []struct {
+		prefix         string
+		endpoints      map[networks.Network][]string
+		user, password string
+	}{
+		{"BTC", map[networks.Network][]string{networks.Test: c.BtcRpcEndpointTest, networks.Main: c.BtcRpcEndpointMain, networks.Signet: c.BtcRpcEndpointSignet}, c.BtcRpcUser, c.BtcRpcPassword},
+		{"LTC", map[networks.Network][]string{networks.Test: c.LtcRpcEndpointTest, networks.Main: c.LtcRpcEndpointMain}, c.LtcRpcUser, c.LtcRpcPassword},
+		{"DOGE", map[networks.Network][]string{networks.Test: c.DogeRpcEndpointTest, networks.Main: c.DogeRpcEndpointMain}, c.DogeRpcUser, c.DogeRpcPassword},
+		{"BCH", map[networks.Network][]string{networks.Test: c.BchRpcEndpointTest, networks.Main: c.BchRpcEndpointMain}, c.BchRpcUser, c.BchRpcPassword},
+	} {
+		for _, network := range []networks.Network{networks.Main, networks.Test, networks.Signet} {
+			if _, ok := node.endpoints[network]; !ok {
+				continue
+			}
+			key := fmt.Sprintf("%s_RPC_ENDPOINT_%s", node.prefix, strings.ToUpper(string(network)))
+			endpoints := node.endpoints[network]
+			validateEndpoints(&errs, key, endpoints)
+			if len(endpoints) == 0 || node.user != "" && node.password != "" {
+				continue
+			}
+			if parsed, err := url.Parse(endpoints[0]); err == nil && parsed.User == nil {
+				errs.add("%s needs credentials in its first URL or %s_RPC_USER and %s_RPC_PASSWORD", key, node.prefix, node.prefix)
+			}
+		}
+	}
+
+	validateEndpoints(&errs, "ETH_RPC_ENDPOINT_TEST", c.EthRpcEndpointTest)
+	validateEndpoints(&errs, "ETH_RPC_ENDPOINT_MAIN", c.EthRpcEndpointMain)
+	for i, chain := range c.EvmChains {
+		if chain.Name == "" {
+			errs.add("ethereum chain %d has no name", i)
+			continue
+		}
+		if len(chain.Networks) == 0 {
+			errs.add("ethereum chain %s has no networks", chain.Name)
+		}
+		for name, network := range chain.Networks {
+			if _, err := networks.Parse(name); err != nil {
+				errs.add("ethereum chain %s has invalid network name %q", chain.Name, name)
+				continue
+			}
+			key := fmt.Sprintf("ethereum chain %s %s endpoints", chain.Name, name)
+			if len(network.Endpoints) == 0 {
+				errs.add("%s are required", key)
+			}
+			validateEndpoints(&errs, key, network.Endpoints)
+		}
+	}
+
+	switch c.StorageDriver {
+	case "bolt":
+		if c.StoragePath == "" {
+			errs.add("STORAGE_PATH is required by the bolt driver")
+		}
+	case "postgres":
+		if c.StorageDSN == "" {
+			errs.add("STORAGE_DSN is required by the postgres driver")
+		}
+	default:
+		errs.add("STORAGE_DRIVER %q is unknown", c.StorageDriver)
+	}
+	if c.KeystoreKEK != "" {
+		if kek, err := hex.DecodeString(c.KeystoreKEK); err != nil || len(kek) != 32 {
+			errs.add("KEYSTORE_KEK must be 64 hex characters")
+		}
+	}
+	if c.PolicyApprovalTTL <= 0 {
+		errs.add("POLICY_APPROVAL_TTL must be positive")
+	}
+
+	switch c.RateLimitStore {
+	case "memory":
+	case "redis":
+		if c.RateLimitRedisURL == "" {
+			errs.add("RATE_LIMIT_REDIS_URL is required by the redis store")
+		}
+	default:
+		errs.add("RATE_LIMIT_STORE %q is unknown", c.RateLimitStore)
+	}
+	if c.RateLimitReadsRPS < 0 || c.RateLimitBuildsRPS < 0 || c.RateLimitBroadcastsRPS < 0 ||
+		c.RateLimitReadsBurst < 0 || c.RateLimitBuildsBurst < 0 || c.RateLimitBroadcastsBurst < 0 ||
+		c.RateLimitReadsDaily < 0 || c.RateLimitBuildsDaily < 0 || c.RateLimitBroadcastsDaily < 0 {
+		errs.add("RATE_LIMIT_* limits must not be negative")
+	}
+
+	if c.MetricsEnabled && c.MetricsAddr == "" {
+		errs.add("METRICS_ADDR is required")
+	}
+	if c.MetricsEnabled && c.MetricsPollInterval <= 0 {
+		errs.add("METRICS_POLL_INTERVAL must be positive")
+	}
+	switch c.TracingExporter {
+	case "none":
+	case "otlp":
+		if c.TracingEndpoint == "" {
+			errs.add("TRACING_OTLP_ENDPOINT is required by the otlp exporter")
+		}
+	default:
+		errs.add("TRACING_EXPORTER %q is unknown", c.TracingExporter)
+	}
+	if c.TracingSampleRatio < 0 || c.TracingSampleRatio > 1 {
+		errs.add("TRACING_SAMPLE_RATIO must be between 0 and 1")
+	}
+	if c.ConfigWatchInterval < 0 {
+		errs.add("CONFIG_WATCH_INTERVAL must not be negative")
+	}
+	if c.SecretsRefreshInterval < 0 {
+		errs.add("SECRETS_REFRESH_INTERVAL must not be negative")
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateEndpoints checks every endpoint of a pool is an http(s) URL, without echoing
+// the credentials it may carry.
+func validateEndpoints(errs *Errors, key string, endpoints []string) {
+	for i, endpoint := range endpoints {
+		parsed, err := url.Parse(endpoint)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			errs.add("%s endpoint %d is not an http(s) URL", key, i+1)
+		}
+	}
 }
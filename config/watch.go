@@ -0,0 +1,123 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Watch reloads the config on SIGHUP and whenever its file was modified, until ctx is done.
+// Every secrets refresh interval it is reloaded too, to pick up rotated secrets, and only
+// applied if it changed. apply gets every reload that validates and is expected to only
+// apply the log level, the rate limits, the RPC credentials and the EVM endpoints, reloads
+// that change other settings are logged as needing a restart.
+func Watch(ctx context.Context, cfg *Config, logger *zap.SugaredLogger, apply func(*Config)) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	watch(ctx, cfg, logger, hangup, apply)
+}
+
+func watch(ctx context.Context, cfg *Config, logger *zap.SugaredLogger, hangup <-chan os.Signal, apply func(*Config)) {
+	var tick <-chan time.Time
+	if cfg.ConfigWatchInterval > 0 {
+		ticker := time.NewTicker(cfg.ConfigWatchInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	var refresh <-chan time.Time
+	if cfg.SecretsRefreshInterval > 0 {
+		ticker := time.NewTicker(cfg.SecretsRefreshInterval)
+		defer ticker.Stop()
+		refresh = ticker.C
+	}
+
+	source := cfg.ConfigFile
+	if source == "" {
+		source = "from the environment"
+	}
+	current := cfg
+	reload := func(changed bool) {
+		reloaded, err := Load(cfg.ConfigFile)
+		if err != nil {
+			logger.Errorf("failed to reload config, keeping the running settings: %v", err)
+			return
+		}
+		if !changed && reflect.DeepEqual(current, reloaded) {
+			return
+		}
+
+		if !reflect.DeepEqual(cfg.static(), reloaded.static()) {
+			logger.Warnf("config %s changes settings that take effect on restart, only LOG_LEVEL, the RATE_LIMIT_* limits, the *_RPC_USER and *_RPC_PASSWORD credentials and the EVM endpoints were applied", source)
+		}
+		apply(reloaded)
+		current = reloaded
+		logger.Infof("reloaded config %s", source)
+	}
+
+	modified := modTime(cfg.ConfigFile)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			reload(true)
+		case <-tick:
+			if latest := modTime(cfg.ConfigFile); !latest.Equal(modified) {
+				modified = latest
+				reload(true)
+			}
+		case <-refresh:
+			reload(false)
+		}
+	}
+}
+
+// static drops the settings a reload applies. The secrets section is dropped as well, the
+// providers it configures are set up anew by every reload. EVM endpoints carry their
+// credentials in the URL and rotate, only whether a network is served is kept.
+func (c Config) static() Config {
+	c.LogLevel = ""
+	c.RateLimits = RateLimits{}
+	c.BtcRpcUser, c.BtcRpcPassword = "", ""
+	c.LtcRpcUser, c.LtcRpcPassword = "", ""
+	c.DogeRpcUser, c.DogeRpcPassword = "", ""
+	c.BchRpcUser, c.BchRpcPassword = "", ""
+	c.Secrets = Secrets{}
+	c.EthRpcEndpointTest, c.EthRpcEndpointMain = served(c.EthRpcEndpointTest), served(c.EthRpcEndpointMain)
+	chains := make([]EvmChain, len(c.EvmChains))
+	for i, chain := range c.EvmChains {
+		nodes := make(map[string]EvmNetwork, len(chain.Networks))
+		for name, network := range chain.Networks {
+			network.Endpoints = served(network.Endpoints)
+			nodes[name] = network
+		}
+		chain.Networks = nodes
+		chains[i] = chain
+	}
+	c.EvmChains = chains
+	return c
+}
+
+// served replaces the endpoints of a network with a placeholder when it has any.
+func served(endpoints []string) []string {
+	if len(endpoints) == 0 {
+		return nil
+	}
+	return []string{""}
+}
+
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -1,11 +1,15 @@
+# Development settings, the RPC password is kept in secrets/ which is not committed.
+mkdir -p secrets
+[ -f secrets/btc_rpc_password ] || echo password > secrets/btc_rpc_password
+
 cat > .env << EOF
 PORT=:5000
 APP_ENV=development
 GRPC_HOST=localhost
-BTC_RPC_ENDPOINT_TEST=localhost
-BTC_RPC_ENDPOINT_MAIN=localhost
+BTC_RPC_ENDPOINT_TEST=http://localhost:18332
+BTC_RPC_ENDPOINT_MAIN=http://localhost:8332
 BTC_RPC_USER=user
-BTC_RPC_PASSWORD=password
-ETH_RPC_ENDPOINT_TEST=localhost
-ETH_RPC_ENDPOINT_MAIN=localhost
+BTC_RPC_PASSWORD_FILE=secrets/btc_rpc_password
+ETH_RPC_ENDPOINT_TEST=http://localhost:8545
+ETH_RPC_ENDPOINT_MAIN=http://localhost:8545
 EOF
\ No newline at end of file
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"nn-blockchain-api/internal/bitcoin"
+	"nn-blockchain-api/internal/ethereum"
+	"nn-blockchain-api/internal/health"
+	"nn-blockchain-api/internal/keystore"
+	"nn-blockchain-api/internal/networks"
+	"nn-blockchain-api/internal/policy"
+	"nn-blockchain-api/internal/quota"
+	"nn-blockchain-api/internal/wallet"
+	"nn-blockchain-api/pkg/openapi"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	prefix = "/api/v1"
+
+	SpecPath = prefix + "/openapi.json"
+	DocsPath = prefix + "/docs"
+)
+
+type Handlers struct {
+	Health   *health.Handler
+	Networks *networks.Handler
+	Quota    *quota.Handler
+	Wallet   *wallet.Handler
+	Bitcoin  *bitcoin.Handler
+	Ethereum *ethereum.Handler
+	Keystore *keystore.Handler
+	Policy   *policy.Handler
+}
+
+// Groups lists every documented route by the prefix Mount registers it under.
+func Groups() []openapi.Group {
+	return []openapi.Group{
+		{Tag: "health", Prefix: prefix, Routes: health.Routes()},
+		{Tag: "networks", Prefix: prefix, Routes: networks.Routes()},
+		{Tag: "quota", Prefix: prefix, Routes: quota.Routes()},
+		{Tag: "wallet", Prefix: prefix, Routes: wallet.Routes()},
+		{Tag: "bitcoin", Prefix: prefix + "/bitcoin", Routes: bitcoin.Routes()},
+		{Tag: "litecoin", Prefix: prefix + "/" + bitcoin_rpc.ChainLitecoin, Routes: bitcoin.Routes()},
+		{Tag: "dogecoin", Prefix: prefix + "/" + bitcoin_rpc.ChainDogecoin, Routes: bitcoin.Routes()},
+		{Tag: "bitcoincash", Prefix: prefix + "/" + bitcoin_rpc.ChainBitcoinCash, Routes: bitcoin.Routes()},
+		{Tag: "ethereum", Prefix: prefix + "/ethereum", Routes: ethereum.Routes()},
+		{Tag: "evm", Prefix: prefix + "/evm/{" + ethereum.ChainParam + "}", Routes: ethereum.Routes()},
+		{Tag: "keystore", Prefix: prefix + "/keystore", Routes: keystore.Routes()},
+		{Tag: "policy", Prefix: prefix + "/policy", Routes: policy.Routes()},
+	}
+}
+
+func Spec() *openapi.Document {
+	return openapi.Build(openapi.Info{
+		Title:       "Multi Blockchain API",
+		Description: "Build, sign and broadcast Bitcoin, Litecoin, Dogecoin, Bitcoin Cash, Ethereum and EVM chain transactions.",
+		Version:     "1.0.0",
+	}, Groups()...)
+}
+
+// Mount registers the API routes together with the OpenAPI document and docs UI.
+func Mount(router chi.Router, h Handlers) error {
+	specHandler, err := openapi.Handler(Spec())
+	if err != nil {
+		return err
+	}
+
+	router.Route(prefix, func(r chi.Router) {
+		r.Get(strings.TrimPrefix(SpecPath, prefix), specHandler)
+		r.Get(strings.TrimPrefix(DocsPath, prefix), openapi.DocsHandler("Multi Blockchain API", SpecPath))
+
+		h.Health.SetupRoutes(r)
+		h.Networks.SetupRoutes(r)
+		h.Quota.SetupRoutes(r)
+		h.Wallet.SetupRoutes(r)
+	})
+
+	router.Route(prefix+"/bitcoin", func(r chi.Router) {
+		h.Bitcoin.SetupRoutes(r)
+	})
+
+	for _, name := range []string{bitcoin_rpc.ChainLitecoin, bitcoin_rpc.ChainDogecoin, bitcoin_rpc.ChainBitcoinCash} {
+		name := name
+		router.Route(prefix+"/"+name, func(r chi.Router) {
+			h.Bitcoin.SetupChainRoutes(name, r)
+		})
+	}
+
+	router.Route(prefix+"/ethereum", func(r chi.Router) {
+		h.Ethereum.SetupRoutes(r)
+	})
+
+	router.Route(prefix+"/evm/{"+ethereum.ChainParam+"}", func(r chi.Router) {
+		h.Ethereum.SetupRoutes(r)
+	})
+
+	router.Route(prefix+"/keystore", func(r chi.Router) {
+		h.Keystore.SetupRoutes(r)
+	})
+
+	router.Route(prefix+"/policy", func(r chi.Router) {
+		h.Policy.SetupRoutes(r)
+	})
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/internal/bitcoin"
+	mock_bitcoin "nn-blockchain-api/internal/bitcoin/mocks"
+	"nn-blockchain-api/internal/ethereum"
+	mock_ethereum "nn-blockchain-api/internal/ethereum/mocks"
+	"nn-blockchain-api/internal/health"
+	"nn-blockchain-api/internal/keystore"
+	networks_handler "nn-blockchain-api/internal/networks"
+	"nn-blockchain-api/internal/policy"
+	"nn-blockchain-api/internal/quota"
+	"nn-blockchain-api/internal/wallet"
+	mock_wallet "nn-blockchain-api/internal/wallet/mocks"
+	"nn-blockchain-api/pkg/auth"
+	mock_keystore "nn-blockchain-api/pkg/keystore/mocks"
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/openapi"
+	mock_policy "nn-blockchain-api/pkg/policy/mocks"
+	"nn-blockchain-api/pkg/ratelimit"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMount_DocumentsEveryRoute(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router := newRouter(t, controller)
+
+	documented := map[string]bool{}
+	for _, group := range Groups() {
+		for _, route := range group.Routes {
+			documented[route.Method+" "+group.Prefix+route.Path] = true
+		}
+	}
+
+	registered := map[string]bool{}
+	err := chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if route == SpecPath || route == DocsPath {
+			return nil
+		}
+		registered[method+" "+route] = true
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, documented, registered)
+}
+
+func TestMount_ServesSpecAndDocs(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router := newRouter(t, controller)
+
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, SpecPath, nil))
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+	assert.Contains(t, res.Body.String(), `"operationId":"BitcoinSendRawTransaction"`)
+
+	res = httptest.NewRecorder()
+	router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, DocsPath, nil))
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Contains(t, res.Body.String(), "SwaggerUIBundle")
+	assert.Contains(t, res.Body.String(), SpecPath)
+}
+
+func TestClientIsGenerated(t *testing.T) {
+	generated, err := openapi.GenerateClient("clientgen", "client", Groups()...)
+	assert.Nil(t, err)
+
+	current, err := ioutil.ReadFile("../../pkg/client/client_gen.go")
+	assert.Nil(t, err)
+
+	assert.True(t, string(generated) == string(current), "pkg/client is out of date, run go generate ./pkg/client")
+}
+
+func newRouter(t *testing.T, controller *gomock.Controller) chi.Router {
+	guard, err := auth.NewGuard(auth.NewMultiKeyStore(), false)
+	assert.Nil(t, err)
+
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Limit{})
+	assert.Nil(t, err)
+
+	registry, err := networks.NewRegistry(networks.Info{Chain: "bitcoin", Network: networks.Main, Currency: "BTC", Params: "mainnet"})
+	assert.Nil(t, err)
+	networksHandler, err := networks_handler.NewHandler(registry, guard)
+	assert.Nil(t, err)
+	quotaHandler, err := quota.NewHandler(limiter, guard)
+	assert.Nil(t, err)
+	walletHandler, err := wallet.NewHandler(mock_wallet.NewMockService(controller), guard)
+	assert.Nil(t, err)
+	bitcoinHandler, err := bitcoin.NewHandler(map[string]bitcoin.Service{"bitcoin": mock_bitcoin.NewMockService(controller)}, guard)
+	assert.Nil(t, err)
+	ethereumHandler, err := ethereum.NewHandler(map[string]ethereum.Service{"ethereum": mock_ethereum.NewMockService(controller)}, guard)
+	assert.Nil(t, err)
+	keystoreHandler, err := keystore.NewHandler(mock_keystore.NewMockKeystore(controller), guard)
+	assert.Nil(t, err)
+	policyHandler, err := policy.NewHandler(mock_policy.NewMockEngine(controller), guard)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	err = Mount(router, Handlers{
+		Health:   health.NewHandler(0),
+		Networks: networksHandler,
+		Quota:    quotaHandler,
+		Wallet:   walletHandler,
+		Bitcoin:  bitcoinHandler,
+		Ethereum: ethereumHandler,
+		Keystore: keystoreHandler,
+		Policy:   policyHandler,
+	})
+	assert.Nil(t, err)
+
+	return router
+}
@@ -0,0 +1,187 @@
+package bitcoin
+
+import (
+	"context"
+	"nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"nn-blockchain-api/pkg/storage"
+	"nn-blockchain-api/pkg/tracing"
+	"strings"
+)
+
+// maxDerivedAddresses bounds the range of a single deriveaddresses call.
+const maxDerivedAddresses = 1000
+
+func (s *service) DescriptorInfo(ctx context.Context, dto *DescriptorDTO) (*DescriptorInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/DescriptorInfo")
+	defer span.End()
+
+	descriptor, info, err := s.checksummed(ctx, dto.Descriptor, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed get descriptor info: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetDescriptorInfo, err)
+	}
+
+	return &DescriptorInfoDTO{
+		Descriptor: descriptor.String(),
+		Checksum:   descriptor.Checksum,
+		Type:       descriptor.Type,
+		IsRange:    info.IsRange,
+		IsSolvable: info.IsSolvable,
+	}, nil
+}
+
+// ImportDescriptors imports into a descriptor wallet, which rejects the importaddress call
+// ImportAddress uses. Imported addr() descriptors are recorded as addresses of the wallet.
+func (s *service) ImportDescriptors(ctx context.Context, dto *ImportDescriptorsDTO) (*ImportDescriptorsInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/ImportDescriptors")
+	defer span.End()
+
+	imported, err := s.importDescriptors(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed import descriptors: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedImportDescriptors, err)
+	}
+
+	return imported, nil
+}
+
+func (s *service) importDescriptors(ctx context.Context, dto *ImportDescriptorsDTO) (*ImportDescriptorsInfoDTO, error) {
+	descriptors, requests, err := s.descriptorImports(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.btcRpcSvc.ImportDescriptors(ctx, dto.WalletId, requests, dto.Network)
+	if err != nil {
+		return nil, err
+	}
+	return s.importedDescriptors(ctx, dto, descriptors, results), nil
+}
+
+func (s *service) descriptorImports(ctx context.Context, dto *ImportDescriptorsDTO) ([]*bitcoin_rpc.Descriptor, []bitcoin_rpc.DescriptorImport, error) {
+	descriptors := make([]*bitcoin_rpc.Descriptor, 0, len(dto.Descriptors))
+	requests := make([]bitcoin_rpc.DescriptorImport, 0, len(dto.Descriptors))
+	for _, item := range dto.Descriptors {
+		descriptor, _, err := s.checksummed(ctx, item.Descriptor, dto.Network)
+		if err != nil {
+			return nil, nil, err
+		}
+		rng, err := descriptorRange(descriptor, item.Range)
+		if err != nil {
+			return nil, nil, err
+		}
+		if item.Active && !descriptor.Ranged {
+			return nil, nil, errors.WithMessage(ErrInvalidRequest, "%s: only ranged descriptors can be active", descriptor.Body)
+		}
+
+		request := bitcoin_rpc.DescriptorImport{
+			Desc:      descriptor.String(),
+			Timestamp: "now",
+			Range:     rng,
+			Active:    item.Active,
+			Internal:  item.Internal,
+			Label:     item.Label,
+		}
+		if item.Timestamp != 0 {
+			request.Timestamp = item.Timestamp
+		}
+		descriptors = append(descriptors, descriptor)
+		requests = append(requests, request)
+	}
+	return descriptors, requests, nil
+}
+
+func (s *service) importedDescriptors(ctx context.Context, dto *ImportDescriptorsDTO, descriptors []*bitcoin_rpc.Descriptor, results []bitcoin_rpc.DescriptorImportResult) *ImportDescriptorsInfoDTO {
+	imported := &ImportDescriptorsInfoDTO{}
+	for i, result := range results {
+		if i >= len(descriptors) {
+			break
+		}
+		item := ImportedDescriptorDTO{Descriptor: descriptors[i].String(), Success: result.Success, Warnings: result.Warnings}
+		if result.Error != nil {
+			item.Error = result.Error.Message
+		}
+		imported.Result = append(imported.Result, item)
+
+		if result.Success && descriptors[i].Type == bitcoin_rpc.DescriptorAddress {
+			address := &storage.Address{
+				Address:  strings.TrimSuffix(strings.TrimPrefix(descriptors[i].Body, "addr("), ")"),
+				WalletId: dto.WalletId,
+				Chain:    s.coin.Name,
+				Network:  dto.Network,
+			}
+			if err := storage.RecordAddress(ctx, s.store, address); err != nil {
+				tracing.Logger(ctx, s.logger).Warnf("failed record address: %v", err)
+			}
+		}
+	}
+	return imported
+}
+
+func (s *service) DeriveAddresses(ctx context.Context, dto *DeriveAddressesDTO) (*DerivedAddressesDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/DeriveAddresses")
+	defer span.End()
+
+	addresses, err := s.deriveAddresses(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed derive addresses: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedDeriveAddresses, err)
+	}
+
+	return &DerivedAddressesDTO{Addresses: addresses}, nil
+}
+
+func (s *service) deriveAddresses(ctx context.Context, dto *DeriveAddressesDTO) ([]string, error) {
+	descriptor, _, err := s.checksummed(ctx, dto.Descriptor, dto.Network)
+	if err != nil {
+		return nil, err
+	}
+	rng, err := descriptorRange(descriptor, dto.Range)
+	if err != nil {
+		return nil, err
+	}
+	if rng != nil && rng[1]-rng[0] >= maxDerivedAddresses {
+		return nil, errors.WithMessage(ErrInvalidRequest, "at most %d addresses can be derived at once", maxDerivedAddresses)
+	}
+	return s.btcRpcSvc.DeriveAddresses(ctx, descriptor.String(), rng, dto.Network)
+}
+
+// checksummed validates the descriptor and completes it with the checksum computed by the
+// node, which also rejects a supplied checksum that does not match.
+func (s *service) checksummed(ctx context.Context, raw, network string) (*bitcoin_rpc.Descriptor, *bitcoin_rpc.DescriptorInfo, error) {
+	descriptor, err := bitcoin_rpc.ParseDescriptor(s.coin, raw, network)
+	if err != nil {
+		return nil, nil, errors.WithMessage(ErrInvalidRequest, "%v", err)
+	}
+
+	info, err := s.btcRpcSvc.GetDescriptorInfo(ctx, descriptor.String(), network)
+	if err != nil {
+		return nil, nil, err
+	}
+	descriptor.Checksum = info.Checksum
+	return descriptor, info, nil
+}
+
+// descriptorRange turns [end] or [begin, end] into the range of a ranged descriptor.
+func descriptorRange(descriptor *bitcoin_rpc.Descriptor, rng []int64) ([]int64, error) {
+	if !descriptor.Ranged {
+		if len(rng) != 0 {
+			return nil, errors.WithMessage(ErrInvalidRequest, "%s is not ranged", descriptor.Body)
+		}
+		return nil, nil
+	}
+
+	switch len(rng) {
+	case 0:
+		return nil, errors.WithMessage(ErrInvalidRequest, "%s is ranged and needs a range", descriptor.Body)
+	case 1:
+		return []int64{0, rng[0]}, nil
+	}
+	if rng[0] > rng[1] {
+		return nil, errors.WithMessage(ErrInvalidRequest, "range begins after its end")
+	}
+	return rng, nil
+}
@@ -0,0 +1,215 @@
+package bitcoin_test
+
+import (
+	"context"
+	"nn-blockchain-api/internal/bitcoin"
+	"nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// tpub is the BIP32 test vector 1 master key on the test network.
+const tpub = "tpubD6NzVbkrYhZ4XgiXtGrdW5XDAPFCL9h7we1vwNCpn8tGbBcgfVYjXyhWo4E1xkh56hjod1RhGjxbaTLV3X4FyWuejifB9jusQ46QzG87VKp"
+
+func TestService_DescriptorInfo(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+	descriptor := "wpkh(" + tpub + "/0/*)"
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.DescriptorDTO
+		setup  func()
+		expect func(t *testing.T, info *bitcoin.DescriptorInfoDTO, err error)
+	}{
+		{
+			name: "should add checksum",
+			dto:  &bitcoin.DescriptorDTO{Descriptor: descriptor, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), descriptor, "test").
+					Return(&bitcoin_rpc.DescriptorInfo{Descriptor: descriptor + "#qwertyuu", Checksum: "qwertyuu", IsRange: true, IsSolvable: true}, nil)
+			},
+			expect: func(t *testing.T, info *bitcoin.DescriptorInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.DescriptorInfoDTO{Descriptor: descriptor + "#qwertyuu", Checksum: "qwertyuu", Type: "wpkh", IsRange: true, IsSolvable: true}, info)
+			},
+		},
+		{
+			name:  "should reject invalid descriptor",
+			dto:   &bitcoin.DescriptorDTO{Descriptor: "pk(" + tpub + ")", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, info *bitcoin.DescriptorInfoDTO, err error) {
+				assert.Nil(t, info)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "unsupported descriptor pk(%s)", tpub), err)
+			},
+		},
+		{
+			name: "should keep node error",
+			dto:  &bitcoin.DescriptorDTO{Descriptor: descriptor + "#qqqqqqqq", Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), descriptor+"#qqqqqqqq", "test").
+					Return(nil, errors.FromBitcoinRPC(-5, "Provided checksum 'qqqqqqqq' does not match computed checksum 'qwertyuu'"))
+			},
+			expect: func(t *testing.T, info *bitcoin.DescriptorInfoDTO, err error) {
+				assert.Nil(t, info)
+				assert.Equal(t, errors.FromBitcoinRPC(-5, "Provided checksum 'qqqqqqqq' does not match computed checksum 'qwertyuu'"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			info, err := service.DescriptorInfo(context.Background(), tc.dto)
+			tc.expect(t, info, err)
+		})
+	}
+}
+
+func TestService_ImportDescriptors(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+	ranged := "wpkh(" + tpub + "/0/*)"
+	address := "addr(tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx)"
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.ImportDescriptorsDTO
+		setup  func()
+		expect func(t *testing.T, imported *bitcoin.ImportDescriptorsInfoDTO, err error)
+	}{
+		{
+			name: "should import with ranges and timestamps",
+			dto: &bitcoin.ImportDescriptorsDTO{
+				WalletId: "wallet",
+				Descriptors: []bitcoin.ImportDescriptorDTO{
+					{Descriptor: ranged, Range: []int64{99}, Timestamp: 1700000000, Active: true},
+					{Descriptor: address, Label: "cold"},
+				},
+				Network: "test",
+			},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), ranged, "test").Return(&bitcoin_rpc.DescriptorInfo{Checksum: "qwertyuu"}, nil)
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), address, "test").Return(&bitcoin_rpc.DescriptorInfo{Checksum: "lllllll0"}, nil)
+				btcRpcSvc.EXPECT().ImportDescriptors(gomock.Any(), "wallet", []bitcoin_rpc.DescriptorImport{
+					{Desc: ranged + "#qwertyuu", Timestamp: int64(1700000000), Range: []int64{0, 99}, Active: true},
+					{Desc: address + "#lllllll0", Timestamp: "now", Label: "cold"},
+				}, "test").Return([]bitcoin_rpc.DescriptorImportResult{{Success: true}, {Success: true, Warnings: []string{"rescan needed"}}}, nil)
+			},
+			expect: func(t *testing.T, imported *bitcoin.ImportDescriptorsInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.ImportDescriptorsInfoDTO{Result: []bitcoin.ImportedDescriptorDTO{
+					{Descriptor: ranged + "#qwertyuu", Success: true},
+					{Descriptor: address + "#lllllll0", Success: true, Warnings: []string{"rescan needed"}},
+				}}, imported)
+			},
+		},
+		{
+			name: "should require range of ranged descriptor",
+			dto:  &bitcoin.ImportDescriptorsDTO{WalletId: "wallet", Descriptors: []bitcoin.ImportDescriptorDTO{{Descriptor: ranged}}, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), ranged, "test").Return(&bitcoin_rpc.DescriptorInfo{Checksum: "qwertyuu"}, nil)
+			},
+			expect: func(t *testing.T, imported *bitcoin.ImportDescriptorsInfoDTO, err error) {
+				assert.Nil(t, imported)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "%s is ranged and needs a range", ranged), err)
+			},
+		},
+		{
+			name: "should reject active descriptor that is not ranged",
+			dto:  &bitcoin.ImportDescriptorsDTO{WalletId: "wallet", Descriptors: []bitcoin.ImportDescriptorDTO{{Descriptor: address, Active: true}}, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), address, "test").Return(&bitcoin_rpc.DescriptorInfo{Checksum: "lllllll0"}, nil)
+			},
+			expect: func(t *testing.T, imported *bitcoin.ImportDescriptorsInfoDTO, err error) {
+				assert.Nil(t, imported)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "%s: only ranged descriptors can be active", address), err)
+			},
+		},
+		{
+			name: "should return error",
+			dto:  &bitcoin.ImportDescriptorsDTO{WalletId: "wallet", Descriptors: []bitcoin.ImportDescriptorDTO{{Descriptor: address}}, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), address, "test").Return(&bitcoin_rpc.DescriptorInfo{Checksum: "lllllll0"}, nil)
+				btcRpcSvc.EXPECT().ImportDescriptors(gomock.Any(), "wallet", gomock.Any(), "test").Return(nil, bitcoin.ErrFailedImportDescriptors)
+			},
+			expect: func(t *testing.T, imported *bitcoin.ImportDescriptorsInfoDTO, err error) {
+				assert.Nil(t, imported)
+				assert.Equal(t, bitcoin.ErrFailedImportDescriptors, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			imported, err := service.ImportDescriptors(context.Background(), tc.dto)
+			tc.expect(t, imported, err)
+		})
+	}
+}
+
+func TestService_DeriveAddresses(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+	ranged := "sh(wsh(multi(1," + tpub + "/0/*)))"
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.DeriveAddressesDTO
+		setup  func()
+		expect func(t *testing.T, derived *bitcoin.DerivedAddressesDTO, err error)
+	}{
+		{
+			name: "should derive range",
+			dto:  &bitcoin.DeriveAddressesDTO{Descriptor: ranged, Range: []int64{5, 6}, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), ranged, "test").Return(&bitcoin_rpc.DescriptorInfo{Checksum: "qwertyuu"}, nil)
+				btcRpcSvc.EXPECT().DeriveAddresses(gomock.Any(), ranged+"#qwertyuu", []int64{5, 6}, "test").Return([]string{"2N1", "2N2"}, nil)
+			},
+			expect: func(t *testing.T, derived *bitcoin.DerivedAddressesDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.DerivedAddressesDTO{Addresses: []string{"2N1", "2N2"}}, derived)
+			},
+		},
+		{
+			name: "should reject range above limit",
+			dto:  &bitcoin.DeriveAddressesDTO{Descriptor: ranged, Range: []int64{1000}, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), ranged, "test").Return(&bitcoin_rpc.DescriptorInfo{Checksum: "qwertyuu"}, nil)
+			},
+			expect: func(t *testing.T, derived *bitcoin.DerivedAddressesDTO, err error) {
+				assert.Nil(t, derived)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "at most 1000 addresses can be derived at once"), err)
+			},
+		},
+		{
+			name: "should reject reversed range",
+			dto:  &bitcoin.DeriveAddressesDTO{Descriptor: ranged, Range: []int64{6, 5}, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetDescriptorInfo(gomock.Any(), ranged, "test").Return(&bitcoin_rpc.DescriptorInfo{Checksum: "qwertyuu"}, nil)
+			},
+			expect: func(t *testing.T, derived *bitcoin.DerivedAddressesDTO, err error) {
+				assert.Nil(t, derived)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "range begins after its end"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			derived, err := service.DeriveAddresses(context.Background(), tc.dto)
+			tc.expect(t, derived, err)
+		})
+	}
+}
@@ -1,48 +1,16 @@
 package bitcoin
 
 import (
-	"fmt"
-	"github.com/go-playground/validator/v10"
 	"nn-blockchain-api/pkg/errors"
-	"strings"
+	"nn-blockchain-api/pkg/validation"
 )
 
-type ApiError struct {
-	Field string
-	Msg   string
-}
-
-func msgForTag(tag string) string {
-	switch tag {
-	case "required":
-		return "is required"
-	}
-	return ""
-}
-
 func Validate(dto interface{}) error {
-	validate := validator.New()
-
-	if err := validate.Struct(dto); err != nil {
-		if _, ok := err.(*validator.InvalidValidationError); ok {
-			return errors.WithMessage(ErrInvalidRequest, err.Error())
-		}
-
-		var out []string
-		for _, err := range err.(validator.ValidationErrors) {
-			//out = append(out, ApiError{
-			//	Field: err.Field(),
-			//	Msg:   msgForTag(err.Tag()),
-			//})
-			out = append(out, fmt.Sprintf("%v - %v", err.Field(), msgForTag(err.Tag())))
-		}
-		return errors.WithMessage(ErrInvalidRequest, strings.Join(out, ", "))
-	}
-	return nil
+	return validation.Validate(ErrInvalidRequest, dto)
 }
 
 type StatusNodeDTO struct {
-	Network string `json:"network" validate:"required"`
+	Network string `json:"network" validate:"required,network"`
 }
 
 type StatusNodeInfoDTO struct {
@@ -100,20 +68,20 @@ type CreatedRawTransactionDTO struct {
 
 type CreateRawTransactionDTO struct {
 	Utxo []struct {
-		TxId     string `json:"txid" validate:"required"`
-		Vout     int64  `json:"vout" validate:"required"`
+		TxId     string `json:"txid" validate:"required,txid"`
+		Vout     int64  `json:"vout" validate:"gte=0"`
 		Amount   int64  `json:"amount" validate:"required"`
-		PKScript string `json:"pk_script" validate:"required"`
+		PKScript string `json:"pk_script" validate:"required,hexadecimal"`
 	} `json:"utxo" validate:"dive"`
-	FromAddress string `json:"from_address" validate:"required"`
-	ToAddress   string `json:"to_address" validate:"required"`
+	FromAddress string `json:"from_address" validate:"required,btc_address"`
+	ToAddress   string `json:"to_address" validate:"required,btc_address"`
 	Amount      int64  `json:"amount" validate:"required"`
-	Network     string `json:"network" validate:"required"`
+	Network     string `json:"network" validate:"required,network"`
 }
 
 type DecodeRawTransactionDTO struct {
-	Tx      string `json:"tx" validate:"required"`
-	Network string `json:"network" validate:"required"`
+	Tx      string `json:"tx" validate:"required,hex_tx"`
+	Network string `json:"network" validate:"required,network"`
 }
 
 type DecodedRawTransactionDTO struct {
@@ -147,9 +115,9 @@ type DecodedRawTransactionDTO struct {
 }
 
 type FundForRawTransactionDTO struct {
-	CreatedTxHex  string `json:"created_tx_hex" validate:"required"`
-	ChangeAddress string `json:"change_address" validate:"required"`
-	Network       string `json:"network" validate:"required"`
+	CreatedTxHex  string `json:"created_tx_hex" validate:"required,hex_tx"`
+	ChangeAddress string `json:"change_address" validate:"required,btc_address"`
+	Network       string `json:"network" validate:"required,network"`
 }
 
 type FundedRawTransactionDTO struct {
@@ -157,35 +125,71 @@ type FundedRawTransactionDTO struct {
 	Fee float64 `json:"fee"`
 }
 
+// SignRawTransactionDTO signs with a pasted key, the key of a wallet held by the wallet service or a keystore key.
 type SignRawTransactionDTO struct {
-	Tx         string `json:"tx" validate:"required"`
-	PrivateKey string `json:"privateKey" validate:"required"`
+	Tx         string `json:"tx" validate:"required,hex_tx"`
+	PrivateKey string `json:"privateKey,omitempty" validate:"omitempty,wif"`
+	WalletId   string `json:"wallet_id,omitempty" validate:"excluded_with=PrivateKey"`
+	KeyId      string `json:"key_id,omitempty" validate:"required_without_all=PrivateKey WalletId,excluded_with=PrivateKey WalletId"`
+	Passphrase string `json:"passphrase,omitempty"`
+	ApprovalId string `json:"approval_id,omitempty"`
 	Utxo       []struct {
-		TxId     string `json:"txid" validate:"required"`
-		Vout     int64  `json:"vout" validate:"required"`
+		TxId     string `json:"txid" validate:"required,txid"`
+		Vout     int64  `json:"vout" validate:"gte=0"`
 		Amount   int64  `json:"amount" validate:"required"`
-		PKScript string `json:"pk_script" validate:"required"`
+		PKScript string `json:"pk_script" validate:"required,hexadecimal"`
 	} `json:"utxo" validate:"dive"`
-	Network string `json:"network" validate:"required"`
+	Network string `json:"network" validate:"required,network"`
 }
 
 type SignedRawTransactionDTO struct {
 	Hash string `json:"hash"`
 }
 
+// SendRawTransactionDTO broadcasts a transaction once the node would accept it to its
+// mempool. A dry run stops after the check.
 type SendRawTransactionDTO struct {
-	SignedTx string `json:"signed_tx" validate:"required"`
-	Network  string `json:"network" validate:"required"`
+	SignedTx string `json:"signed_tx" validate:"required,hex_tx"`
+	Network  string `json:"network" validate:"required,network"`
+	DryRun   bool   `json:"dry_run,omitempty"`
 }
 
 type SentRawTransactionDTO struct {
-	TxId string `json:"tx_id"`
+	TxId       string         `json:"tx_id"`
+	DryRun     bool           `json:"dry_run,omitempty"`
+	Simulation *SimulationDTO `json:"simulation,omitempty"`
+}
+
+type SimulateTransactionDTO struct {
+	SignedTx string `json:"signed_tx" validate:"required,hex_tx"`
+	Network  string `json:"network" validate:"required,network"`
+}
+
+// CheckDTO is the outcome of a pre-flight check, Status is the error status a failure is
+// reported with on send.
+type CheckDTO struct {
+	Name    string        `json:"name"`
+	Passed  bool          `json:"passed"`
+	Status  errors.Status `json:"status,omitempty"`
+	Message string        `json:"message,omitempty"`
+}
+
+// SimulationDTO reports the testmempoolaccept verdict, fee in BTC and fee rate in sat/vB
+// are set for accepted transactions.
+type SimulationDTO struct {
+	TxId    string     `json:"tx_id"`
+	WTxId   string     `json:"wtxid"`
+	Passed  bool       `json:"passed"`
+	Checks  []CheckDTO `json:"checks"`
+	Vsize   int64      `json:"vsize,omitempty"`
+	Fee     float64    `json:"fee,omitempty"`
+	FeeRate float64    `json:"fee_rate,omitempty"`
 }
 
 type ImportAddressDTO struct {
-	Address  string `json:"address" validate:"required"`
+	Address  string `json:"address" validate:"required,btc_address"`
 	WalletId string `json:"wallet_id" validate:"required"`
-	Network  string `json:"network" validate:"required"`
+	Network  string `json:"network" validate:"required,network"`
 }
 
 type ImportAddressInfoDTO struct {
@@ -194,7 +198,7 @@ type ImportAddressInfoDTO struct {
 
 type WalletDTO struct {
 	WalletId string `json:"wallet_id" validate:"required"`
-	Network  string `json:"network" validate:"required"`
+	Network  string `json:"network" validate:"required,network"`
 }
 
 type WalletInfoDTO struct {
@@ -218,7 +222,7 @@ type WalletInfoDTO struct {
 
 type CreateWalletDTO struct {
 	//Password string `json:"password" validate:"required"`
-	Network string `json:"network" validate:"required"`
+	Network string `json:"network" validate:"required,network"`
 }
 
 type CreatedWalletInfoDTO struct {
@@ -228,7 +232,7 @@ type CreatedWalletInfoDTO struct {
 
 type LoadWalletDTO struct {
 	WalletId string `json:"wallet_id" validate:"required"`
-	Network  string `json:"network" validate:"required"`
+	Network  string `json:"network" validate:"required,network"`
 }
 
 type LoadWalletInfoDTO struct {
@@ -237,7 +241,7 @@ type LoadWalletInfoDTO struct {
 
 type RescanWalletDTO struct {
 	WalletId string `json:"wallet_id" validate:"required"`
-	Network  string `json:"network" validate:"required"`
+	Network  string `json:"network" validate:"required,network"`
 }
 
 type RescanWalletInfoDTO struct {
@@ -246,9 +250,9 @@ type RescanWalletInfoDTO struct {
 }
 
 type ListUnspentDTO struct {
-	Address  string `json:"address" validate:"required"`
+	Address  string `json:"address" validate:"required,btc_address"`
 	WalletId string `json:"wallet_id" validate:"required"`
-	Network  string `json:"network" validate:"required"`
+	Network  string `json:"network" validate:"required,network"`
 }
 
 type UnspentInfoDTO struct {
@@ -267,3 +271,271 @@ type UnspentInfoDTO struct {
 type ListUnspentInfoDTO struct {
 	Result []*UnspentInfoDTO
 }
+
+// CreateMultisigDTO takes hex public keys or account xpubs, xpubs contribute their
+// receive key at Index. Keys are sorted as in BIP67 so their order does not matter.
+type CreateMultisigDTO struct {
+	Required   int      `json:"required" validate:"required,min=1"`
+	Keys       []string `json:"keys" validate:"required,min=1,max=15"`
+	Index      uint32   `json:"index,omitempty"`
+	ScriptType string   `json:"script_type" validate:"required,oneof=p2wsh p2sh-p2wsh"`
+	Network    string   `json:"network" validate:"required,network"`
+}
+
+type MultisigDTO struct {
+	Address       string   `json:"address"`
+	ScriptType    string   `json:"script_type"`
+	Required      int      `json:"required"`
+	Keys          []string `json:"keys"`
+	WitnessScript string   `json:"witness_script"`
+	RedeemScript  string   `json:"redeem_script,omitempty"`
+	ScriptPubKey  string   `json:"script_pub_key"`
+	Descriptor    string   `json:"descriptor"`
+}
+
+// PrevTxDTO describes a spent multisig output to the signers, Amount is in satoshi.
+type PrevTxDTO struct {
+	TxId          string `json:"txid" validate:"required,txid"`
+	Vout          int64  `json:"vout" validate:"gte=0"`
+	ScriptPubKey  string `json:"script_pub_key" validate:"required,hexadecimal"`
+	RedeemScript  string `json:"redeem_script,omitempty" validate:"omitempty,hexadecimal"`
+	WitnessScript string `json:"witness_script" validate:"required,hexadecimal"`
+	Amount        int64  `json:"amount" validate:"required"`
+}
+
+// CreateMultisigTransactionDTO spends outputs of a multisig address, the change goes back
+// to it unless ChangeAddress is given.
+type CreateMultisigTransactionDTO struct {
+	Utxo []struct {
+		TxId   string `json:"txid" validate:"required,txid"`
+		Vout   int64  `json:"vout" validate:"gte=0"`
+		Amount int64  `json:"amount" validate:"required"`
+	} `json:"utxo" validate:"required,min=1,dive"`
+	WitnessScript string `json:"witness_script" validate:"required,hexadecimal"`
+	ScriptType    string `json:"script_type" validate:"required,oneof=p2wsh p2sh-p2wsh"`
+	ToAddress     string `json:"to_address" validate:"required,btc_address"`
+	ChangeAddress string `json:"change_address,omitempty" validate:"omitempty,btc_address"`
+	Amount        int64  `json:"amount" validate:"required"`
+	Network       string `json:"network" validate:"required,network"`
+}
+
+type CreatedMultisigTransactionDTO struct {
+	Tx      string      `json:"tx"`
+	Fee     float64     `json:"fee"`
+	PrevTxs []PrevTxDTO `json:"prev_txs"`
+}
+
+// SignMultisigTransactionDTO adds the signature of one co-signer, a pasted or keystore key.
+type SignMultisigTransactionDTO struct {
+	Tx         string      `json:"tx" validate:"required,hex_tx"`
+	PrivateKey string      `json:"privateKey,omitempty" validate:"omitempty,wif"`
+	KeyId      string      `json:"key_id,omitempty" validate:"required_without=PrivateKey,excluded_with=PrivateKey"`
+	Passphrase string      `json:"passphrase,omitempty"`
+	ApprovalId string      `json:"approval_id,omitempty"`
+	PrevTxs    []PrevTxDTO `json:"prev_txs" validate:"required,min=1,dive"`
+	Network    string      `json:"network" validate:"required,network"`
+}
+
+// SignedMultisigTransactionDTO is complete once enough co-signers signed it.
+type SignedMultisigTransactionDTO struct {
+	Tx       string `json:"tx"`
+	Complete bool   `json:"complete"`
+}
+
+// CombineMultisigTransactionsDTO merges copies of one transaction signed by different co-signers.
+type CombineMultisigTransactionsDTO struct {
+	Txs     []string `json:"txs" validate:"required,min=2,dive,hex_tx"`
+	Network string   `json:"network" validate:"required,network"`
+}
+
+type CombinedMultisigTransactionDTO struct {
+	Tx string `json:"tx"`
+}
+
+type FinalizeMultisigTransactionDTO struct {
+	Tx      string      `json:"tx" validate:"required,hex_tx"`
+	PrevTxs []PrevTxDTO `json:"prev_txs" validate:"required,min=1,dive"`
+	Network string      `json:"network" validate:"required,network"`
+}
+
+type DescriptorDTO struct {
+	Descriptor string `json:"descriptor" validate:"required"`
+	Network    string `json:"network" validate:"required,network"`
+}
+
+// DescriptorInfoDTO carries the descriptor with the checksum the node computed for it.
+type DescriptorInfoDTO struct {
+	Descriptor string `json:"descriptor"`
+	Checksum   string `json:"checksum"`
+	Type       string `json:"type"`
+	IsRange    bool   `json:"is_range"`
+	IsSolvable bool   `json:"is_solvable"`
+}
+
+// ImportDescriptorDTO is one descriptor to import. Range is [end] or [begin, end] and only
+// valid for ranged descriptors, Timestamp is the unix time to rescan from, omitted it only
+// watches new transactions.
+type ImportDescriptorDTO struct {
+	Descriptor string  `json:"descriptor" validate:"required"`
+	Range      []int64 `json:"range,omitempty" validate:"omitempty,min=1,max=2,dive,gte=0"`
+	Timestamp  int64   `json:"timestamp,omitempty" validate:"gte=0"`
+	Active     bool    `json:"active,omitempty"`
+	Internal   bool    `json:"internal,omitempty"`
+	Label      string  `json:"label,omitempty"`
+}
+
+type ImportDescriptorsDTO struct {
+	WalletId    string                `json:"wallet_id" validate:"required"`
+	Descriptors []ImportDescriptorDTO `json:"descriptors" validate:"required,min=1,dive"`
+	Network     string                `json:"network" validate:"required,network"`
+}
+
+type ImportedDescriptorDTO struct {
+	Descriptor string   `json:"descriptor"`
+	Success    bool     `json:"success"`
+	Warnings   []string `json:"warnings,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+type ImportDescriptorsInfoDTO struct {
+	Result []ImportedDescriptorDTO `json:"result"`
+}
+
+type DeriveAddressesDTO struct {
+	Descriptor string  `json:"descriptor" validate:"required"`
+	Range      []int64 `json:"range,omitempty" validate:"omitempty,min=1,max=2,dive,gte=0"`
+	Network    string  `json:"network" validate:"required,network"`
+}
+
+type DerivedAddressesDTO struct {
+	Addresses []string `json:"addresses"`
+}
+
+// BlockDTO selects a block by hash or height, its txids are paged from page 1.
+type BlockDTO struct {
+	Block   string `json:"block" path:"block" validate:"required"`
+	Network string `json:"network" query:"network" validate:"required,network"`
+	Page    int64  `json:"page" query:"page" validate:"gte=0"`
+	PerPage int64  `json:"per_page" query:"per_page" validate:"gte=0,lte=1000"`
+}
+
+type BlockInfoDTO struct {
+	Hash              string   `json:"hash"`
+	Height            int64    `json:"height"`
+	Confirmations     int64    `json:"confirmations"`
+	Version           int64    `json:"version"`
+	MerkleRoot        string   `json:"merkle_root"`
+	Time              int64    `json:"time"`
+	MedianTime        int64    `json:"median_time"`
+	Nonce             int64    `json:"nonce"`
+	Bits              string   `json:"bits"`
+	Difficulty        float64  `json:"difficulty"`
+	Size              int64    `json:"size"`
+	Weight            int64    `json:"weight"`
+	PreviousBlockHash string   `json:"previous_block_hash,omitempty"`
+	NextBlockHash     string   `json:"next_block_hash,omitempty"`
+	TxCount           int64    `json:"tx_count"`
+	Page              int64    `json:"page"`
+	PerPage           int64    `json:"per_page"`
+	TxIds             []string `json:"txids"`
+}
+
+type TransactionDTO struct {
+	TxId    string `json:"txid" path:"txid" validate:"required,txid"`
+	Network string `json:"network" query:"network" validate:"required,network"`
+	Verbose bool   `json:"verbose" query:"verbose"`
+}
+
+// TxInputDTO is a transaction input with the value and address of the output it spends,
+// Value is in BTC.
+type TxInputDTO struct {
+	TxId     string   `json:"txid,omitempty"`
+	Vout     int64    `json:"vout"`
+	Coinbase string   `json:"coinbase,omitempty"`
+	Sequence int64    `json:"sequence"`
+	Witness  []string `json:"witness,omitempty"`
+	Value    float64  `json:"value"`
+	Address  string   `json:"address,omitempty"`
+}
+
+type TxOutputDTO struct {
+	N            int64   `json:"n"`
+	Value        float64 `json:"value"`
+	Address      string  `json:"address,omitempty"`
+	ScriptPubKey string  `json:"script_pub_key"`
+	Type         string  `json:"type"`
+}
+
+// TransactionInfoDTO carries the raw transaction, the other fields are only set for verbose
+// requests. Fee is in BTC and zero for coinbase transactions.
+type TransactionInfoDTO struct {
+	TxId          string        `json:"txid"`
+	Hex           string        `json:"hex"`
+	Hash          string        `json:"hash,omitempty"`
+	Version       int64         `json:"version,omitempty"`
+	Size          int64         `json:"size,omitempty"`
+	Vsize         int64         `json:"vsize,omitempty"`
+	Weight        int64         `json:"weight,omitempty"`
+	Locktime      int64         `json:"locktime,omitempty"`
+	Inputs        []TxInputDTO  `json:"inputs,omitempty"`
+	Outputs       []TxOutputDTO `json:"outputs,omitempty"`
+	Fee           float64       `json:"fee,omitempty"`
+	BlockHash     string        `json:"block_hash,omitempty"`
+	Confirmations int64         `json:"confirmations,omitempty"`
+	BlockTime     int64         `json:"block_time,omitempty"`
+}
+
+type MempoolDTO struct {
+	Network string `json:"network" query:"network" validate:"required,network"`
+}
+
+type MempoolInfoDTO struct {
+	Loaded        bool    `json:"loaded"`
+	Size          int64   `json:"size"`
+	Bytes         int64   `json:"bytes"`
+	Usage         int64   `json:"usage"`
+	MaxMempool    int64   `json:"max_mempool"`
+	MempoolMinFee float64 `json:"mempool_min_fee"`
+	MinRelayTxFee float64 `json:"min_relay_tx_fee"`
+}
+
+type MempoolEntryDTO struct {
+	TxId    string `json:"txid" path:"txid" validate:"required,txid"`
+	Network string `json:"network" query:"network" validate:"required,network"`
+}
+
+// MempoolEntryInfoDTO describes an unconfirmed transaction, fees are in BTC.
+type MempoolEntryInfoDTO struct {
+	TxId            string   `json:"txid"`
+	Vsize           int64    `json:"vsize"`
+	Weight          int64    `json:"weight"`
+	Time            int64    `json:"time"`
+	Height          int64    `json:"height"`
+	Fee             float64  `json:"fee"`
+	ModifiedFee     float64  `json:"modified_fee"`
+	AncestorCount   int64    `json:"ancestor_count"`
+	DescendantCount int64    `json:"descendant_count"`
+	Depends         []string `json:"depends"`
+	SpentBy         []string `json:"spent_by"`
+	Replaceable     bool     `json:"replaceable"`
+}
+
+// TxOutDTO looks up an output in the UTXO set, outputs spent in the mempool count as spent
+// unless ConfirmedOnly is set.
+type TxOutDTO struct {
+	TxId          string `json:"txid" path:"txid" validate:"required,txid"`
+	Vout          int64  `json:"vout" path:"vout" validate:"gte=0"`
+	Network       string `json:"network" query:"network" validate:"required,network"`
+	ConfirmedOnly bool   `json:"confirmed_only" query:"confirmed_only"`
+}
+
+type TxOutInfoDTO struct {
+	Unspent       bool    `json:"unspent"`
+	BestBlock     string  `json:"best_block,omitempty"`
+	Confirmations int64   `json:"confirmations,omitempty"`
+	Value         float64 `json:"value,omitempty"`
+	Address       string  `json:"address,omitempty"`
+	ScriptPubKey  string  `json:"script_pub_key,omitempty"`
+	Type          string  `json:"type,omitempty"`
+	Coinbase      bool    `json:"coinbase,omitempty"`
+}
@@ -13,12 +13,29 @@ const (
 	StatusFailedFundForTx     errors.Status = "failed_fund_for_tx"
 	StatusFailedSignTx        errors.Status = "failed_sign_tx"
 	StatusFailedSendTx        errors.Status = "failed_send_tx"
+	StatusFailedSimulateTx    errors.Status = "failed_simulate_tx"
 	StatusFailedGetWalletInfo errors.Status = "failed_get_wallet_info"
 	StatusFailedCreateWallet  errors.Status = "failed_create_wallet"
 	StatusFailedLoadWallet    errors.Status = "failed_load_wallet"
 	StatusFailedImportAddress errors.Status = "failed_import_address"
 	StatusFailedRescanWallet  errors.Status = "failed_rescan_wallet"
 	StatusFailedGetUnspent    errors.Status = "failed_get_unspent"
+
+	StatusFailedCreateMultisig     errors.Status = "failed_create_multisig"
+	StatusFailedCreateMultisigTx   errors.Status = "failed_create_multisig_tx"
+	StatusFailedSignMultisigTx     errors.Status = "failed_sign_multisig_tx"
+	StatusFailedCombineMultisigTxs errors.Status = "failed_combine_multisig_txs"
+	StatusFailedFinalizeMultisigTx errors.Status = "failed_finalize_multisig_tx"
+
+	StatusFailedGetDescriptorInfo errors.Status = "failed_get_descriptor_info"
+	StatusFailedImportDescriptors errors.Status = "failed_import_descriptors"
+	StatusFailedDeriveAddresses   errors.Status = "failed_derive_addresses"
+
+	StatusFailedGetBlock        errors.Status = "failed_get_block"
+	StatusFailedGetTx           errors.Status = "failed_get_tx"
+	StatusFailedGetMempoolInfo  errors.Status = "failed_get_mempool_info"
+	StatusFailedGetMempoolEntry errors.Status = "failed_get_mempool_entry"
+	StatusFailedGetTxOut        errors.Status = "failed_get_tx_out"
 )
 
 var (
@@ -29,10 +46,27 @@ var (
 	ErrFailedFundForTx     = errors.New(codes.InternalError, StatusFailedFundForTx)
 	ErrFailedSignTx        = errors.New(codes.InternalError, StatusFailedSignTx)
 	ErrFailedSendTx        = errors.New(codes.InternalError, StatusFailedSendTx)
+	ErrFailedSimulateTx    = errors.New(codes.InternalError, StatusFailedSimulateTx)
 	ErrFailedGetWalletInfo = errors.New(codes.InternalError, StatusFailedGetWalletInfo)
 	ErrFailedCreateWallet  = errors.New(codes.InternalError, StatusFailedCreateWallet)
 	ErrFailedLoadWallet    = errors.New(codes.InternalError, StatusFailedLoadWallet)
 	ErrFailedImportAddress = errors.New(codes.InternalError, StatusFailedImportAddress)
 	ErrFailedRescanWallet  = errors.New(codes.InternalError, StatusFailedRescanWallet)
 	ErrFailedGetUnspent    = errors.New(codes.InternalError, StatusFailedGetUnspent)
+
+	ErrFailedCreateMultisig     = errors.New(codes.InternalError, StatusFailedCreateMultisig)
+	ErrFailedCreateMultisigTx   = errors.New(codes.InternalError, StatusFailedCreateMultisigTx)
+	ErrFailedSignMultisigTx     = errors.New(codes.InternalError, StatusFailedSignMultisigTx)
+	ErrFailedCombineMultisigTxs = errors.New(codes.InternalError, StatusFailedCombineMultisigTxs)
+	ErrFailedFinalizeMultisigTx = errors.New(codes.InternalError, StatusFailedFinalizeMultisigTx)
+
+	ErrFailedGetDescriptorInfo = errors.New(codes.InternalError, StatusFailedGetDescriptorInfo)
+	ErrFailedImportDescriptors = errors.New(codes.InternalError, StatusFailedImportDescriptors)
+	ErrFailedDeriveAddresses   = errors.New(codes.InternalError, StatusFailedDeriveAddresses)
+
+	ErrFailedGetBlock        = errors.New(codes.InternalError, StatusFailedGetBlock)
+	ErrFailedGetTx           = errors.New(codes.InternalError, StatusFailedGetTx)
+	ErrFailedGetMempoolInfo  = errors.New(codes.InternalError, StatusFailedGetMempoolInfo)
+	ErrFailedGetMempoolEntry = errors.New(codes.InternalError, StatusFailedGetMempoolEntry)
+	ErrFailedGetTxOut        = errors.New(codes.InternalError, StatusFailedGetTxOut)
 )
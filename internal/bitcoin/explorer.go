@@ -0,0 +1,275 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/hex"
+	"nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"nn-blockchain-api/pkg/tracing"
+	"strconv"
+
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	defaultBlockTxsPerPage = 100
+	// maxPrevTxs bounds the getrawtransaction calls one verbose transaction lookup makes.
+	maxPrevTxs = 100
+)
+
+func (s *service) Block(ctx context.Context, dto *BlockDTO) (*BlockInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/Block")
+	defer span.End()
+
+	block, err := s.block(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed get block: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetBlock, err)
+	}
+
+	page, perPage := dto.Page, dto.PerPage
+	if page == 0 {
+		page = 1
+	}
+	if perPage == 0 {
+		perPage = defaultBlockTxsPerPage
+	}
+	txIds := []string{}
+	if start := (page - 1) * perPage; start < int64(len(block.Tx)) {
+		end := start + perPage
+		if end > int64(len(block.Tx)) {
+			end = int64(len(block.Tx))
+		}
+		txIds = block.Tx[start:end]
+	}
+
+	return &BlockInfoDTO{
+		Hash:              block.Hash,
+		Height:            block.Height,
+		Confirmations:     block.Confirmations,
+		Version:           block.Version,
+		MerkleRoot:        block.MerkleRoot,
+		Time:              block.Time,
+		MedianTime:        block.MedianTime,
+		Nonce:             block.Nonce,
+		Bits:              block.Bits,
+		Difficulty:        block.Difficulty,
+		Size:              block.Size,
+		Weight:            block.Weight,
+		PreviousBlockHash: block.PreviousBlockHash,
+		NextBlockHash:     block.NextBlockHash,
+		TxCount:           int64(len(block.Tx)),
+		Page:              page,
+		PerPage:           perPage,
+		TxIds:             txIds,
+	}, nil
+}
+
+// block resolves dto.Block, a 64 character hex hash or a height, to the block.
+func (s *service) block(ctx context.Context, dto *BlockDTO) (*bitcoin_rpc.Block, error) {
+	hash := dto.Block
+	if _, err := hex.DecodeString(hash); err != nil || len(hash) != 64 {
+		height, err := strconv.ParseInt(dto.Block, 10, 64)
+		if err != nil || height < 0 {
+			return nil, errors.WithMessage(ErrInvalidRequest, "block %v is neither a hash nor a height", dto.Block)
+		}
+		if hash, err = s.btcRpcSvc.GetBlockHash(ctx, height, dto.Network); err != nil {
+			return nil, err
+		}
+	}
+	return s.btcRpcSvc.GetBlock(ctx, hash, dto.Network)
+}
+
+func (s *service) Transaction(ctx context.Context, dto *TransactionDTO) (*TransactionInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/Transaction")
+	defer span.End()
+
+	tx, err := s.transaction(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed get transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetTx, err)
+	}
+
+	return tx, nil
+}
+
+func (s *service) transaction(ctx context.Context, dto *TransactionDTO) (*TransactionInfoDTO, error) {
+	if !dto.Verbose {
+		raw, err := s.btcRpcSvc.GetRawTransaction(ctx, dto.TxId, dto.Network)
+		if err != nil {
+			return nil, err
+		}
+		return &TransactionInfoDTO{TxId: dto.TxId, Hex: raw}, nil
+	}
+
+	tx, err := s.btcRpcSvc.GetTransaction(ctx, dto.TxId, dto.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &TransactionInfoDTO{
+		TxId:          tx.Txid,
+		Hex:           tx.Hex,
+		Hash:          tx.Hash,
+		Version:       tx.Version,
+		Size:          tx.Size,
+		Vsize:         tx.Vsize,
+		Weight:        tx.Weight,
+		Locktime:      tx.Locktime,
+		BlockHash:     tx.BlockHash,
+		Confirmations: tx.Confirmations,
+		BlockTime:     tx.BlockTime,
+	}
+
+	var out btcutil.Amount
+	for _, output := range tx.Vout {
+		value, err := btcutil.NewAmount(output.Value)
+		if err != nil {
+			return nil, err
+		}
+		out += value
+		info.Outputs = append(info.Outputs, TxOutputDTO{
+			N:            output.N,
+			Value:        output.Value,
+			Address:      output.ScriptPubKey.Address,
+			ScriptPubKey: output.ScriptPubKey.Hex,
+			Type:         output.ScriptPubKey.Type,
+		})
+	}
+
+	in, coinbase, err := s.resolveInputs(ctx, tx, info, dto.Network)
+	if err != nil {
+		return nil, err
+	}
+	if !coinbase {
+		info.Fee = (in - out).ToBTC()
+	}
+	return info, nil
+}
+
+// resolveInputs adds the inputs of tx to info with the values and addresses of the outputs
+// they spend, looking each previous transaction up once. It returns the total input value
+// and whether tx is a coinbase transaction, whose input spends nothing. Transactions that
+// spend outputs of more than maxPrevTxs transactions are rejected before any lookup.
+func (s *service) resolveInputs(ctx context.Context, tx *bitcoin_rpc.Transaction, info *TransactionInfoDTO, network string) (btcutil.Amount, bool, error) {
+	prevTxs := make(map[string]*bitcoin_rpc.Transaction)
+	for _, input := range tx.Vin {
+		if input.Coinbase == "" {
+			prevTxs[input.Txid] = nil
+		}
+	}
+	if len(prevTxs) > maxPrevTxs {
+		return 0, false, errors.WithMessage(ErrInvalidRequest, "transaction %v spends outputs of %d transactions, verbose lookups resolve at most %d", tx.Txid, len(prevTxs), maxPrevTxs)
+	}
+
+	var total btcutil.Amount
+	coinbase := false
+
+	for _, input := range tx.Vin {
+		item := TxInputDTO{TxId: input.Txid, Vout: input.Vout, Coinbase: input.Coinbase, Sequence: input.Sequence, Witness: input.TxInWitness}
+		if input.Coinbase != "" {
+			coinbase = true
+			info.Inputs = append(info.Inputs, item)
+			continue
+		}
+
+		prevTx := prevTxs[input.Txid]
+		if prevTx == nil {
+			var err error
+			if prevTx, err = s.btcRpcSvc.GetTransaction(ctx, input.Txid, network); err != nil {
+				return 0, false, err
+			}
+			prevTxs[input.Txid] = prevTx
+		}
+		if input.Vout >= int64(len(prevTx.Vout)) {
+			return 0, false, errors.WithMessage(ErrInvalidRequest, "transaction %v has no output %v", input.Txid, input.Vout)
+		}
+
+		prevOut := prevTx.Vout[input.Vout]
+		value, err := btcutil.NewAmount(prevOut.Value)
+		if err != nil {
+			return 0, false, err
+		}
+		total += value
+		item.Value, item.Address = prevOut.Value, prevOut.ScriptPubKey.Address
+		info.Inputs = append(info.Inputs, item)
+	}
+	return total, coinbase, nil
+}
+
+func (s *service) MempoolInfo(ctx context.Context, dto *MempoolDTO) (*MempoolInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/MempoolInfo")
+	defer span.End()
+
+	info, err := s.btcRpcSvc.MempoolInfo(ctx, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed get mempool info: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetMempoolInfo, err)
+	}
+
+	return &MempoolInfoDTO{
+		Loaded:        info.Loaded,
+		Size:          info.Size,
+		Bytes:         info.Bytes,
+		Usage:         info.Usage,
+		MaxMempool:    info.MaxMempool,
+		MempoolMinFee: info.MempoolMinFee,
+		MinRelayTxFee: info.MinRelayTxFee,
+	}, nil
+}
+
+func (s *service) MempoolEntry(ctx context.Context, dto *MempoolEntryDTO) (*MempoolEntryInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/MempoolEntry")
+	defer span.End()
+
+	entry, err := s.btcRpcSvc.MempoolEntry(ctx, dto.TxId, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed get mempool entry: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetMempoolEntry, err)
+	}
+
+	return &MempoolEntryInfoDTO{
+		TxId:            dto.TxId,
+		Vsize:           entry.Vsize,
+		Weight:          entry.Weight,
+		Time:            entry.Time,
+		Height:          entry.Height,
+		Fee:             entry.Fees.Base,
+		ModifiedFee:     entry.Fees.Modified,
+		AncestorCount:   entry.AncestorCount,
+		DescendantCount: entry.DescendantCount,
+		Depends:         entry.Depends,
+		SpentBy:         entry.SpentBy,
+		Replaceable:     entry.Bip125Replacable,
+	}, nil
+}
+
+func (s *service) TxOut(ctx context.Context, dto *TxOutDTO) (*TxOutInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/TxOut")
+	defer span.End()
+
+	out, err := s.btcRpcSvc.GetTxOut(ctx, dto.TxId, dto.Vout, !dto.ConfirmedOnly, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed get tx out: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetTxOut, err)
+	}
+	if out == nil {
+		return &TxOutInfoDTO{}, nil
+	}
+
+	return &TxOutInfoDTO{
+		Unspent:       true,
+		BestBlock:     out.BestBlock,
+		Confirmations: out.Confirmations,
+		Value:         out.Value,
+		Address:       out.ScriptPubKey.Address,
+		ScriptPubKey:  out.ScriptPubKey.Hex,
+		Type:          out.ScriptPubKey.Type,
+		Coinbase:      out.Coinbase,
+	}, nil
+}
@@ -0,0 +1,223 @@
+package bitcoin_test
+
+import (
+	"context"
+	"fmt"
+	"nn-blockchain-api/internal/bitcoin"
+	"nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	blockHash = "000000000933ea01ad0ee984209779baaec3ced90fa3f408719526f8d77f4943"
+	txId      = "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"
+	prevTxId  = "0e3e2357e806b6cdb1f70b54c3a3a17b6714ee1f0e68bebb44a74b1efd512098"
+)
+
+func TestService_Block(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+	block := &bitcoin_rpc.Block{
+		BlockHeader: bitcoin_rpc.BlockHeader{Hash: blockHash, Height: 7, Confirmations: 2},
+		Tx:          []string{"a", "b", "c"},
+	}
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.BlockDTO
+		setup  func()
+		expect func(t *testing.T, info *bitcoin.BlockInfoDTO, err error)
+	}{
+		{
+			name: "should resolve height and page txids",
+			dto:  &bitcoin.BlockDTO{Block: "7", Network: "test", Page: 2, PerPage: 2},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetBlockHash(gomock.Any(), int64(7), "test").Return(blockHash, nil)
+				btcRpcSvc.EXPECT().GetBlock(gomock.Any(), blockHash, "test").Return(block, nil)
+			},
+			expect: func(t *testing.T, info *bitcoin.BlockInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.BlockInfoDTO{Hash: blockHash, Height: 7, Confirmations: 2, TxCount: 3, Page: 2, PerPage: 2, TxIds: []string{"c"}}, info)
+			},
+		},
+		{
+			name: "should return empty page past the last txid",
+			dto:  &bitcoin.BlockDTO{Block: blockHash, Network: "test", Page: 3},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetBlock(gomock.Any(), blockHash, "test").Return(block, nil)
+			},
+			expect: func(t *testing.T, info *bitcoin.BlockInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, int64(100), info.PerPage)
+				assert.Empty(t, info.TxIds)
+			},
+		},
+		{
+			name:  "should reject block that is neither hash nor height",
+			dto:   &bitcoin.BlockDTO{Block: "tip", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, info *bitcoin.BlockInfoDTO, err error) {
+				assert.Nil(t, info)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "block tip is neither a hash nor a height"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			info, err := service.Block(context.Background(), tc.dto)
+			tc.expect(t, info, err)
+		})
+	}
+}
+
+func TestService_Transaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+	output := func(n int64, value float64, address string) bitcoin_rpc.TxOutput {
+		return bitcoin_rpc.TxOutput{N: n, Value: value, ScriptPubKey: bitcoin_rpc.ScriptPubKey{Hex: "0014ab", Address: address, Type: "witness_v0_keyhash"}}
+	}
+	prevTx := &bitcoin_rpc.Transaction{Txid: prevTxId, Vout: []bitcoin_rpc.TxOutput{output(0, 0.3, "tb1qa"), output(1, 0.2, "tb1qb")}}
+	tx := &bitcoin_rpc.Transaction{
+		Txid:      txId,
+		Hex:       "0200",
+		Vin:       []bitcoin_rpc.TxInput{{Txid: prevTxId, Vout: 0}, {Txid: prevTxId, Vout: 1}},
+		Vout:      []bitcoin_rpc.TxOutput{output(0, 0.4999, "tb1qc")},
+		BlockHash: blockHash,
+	}
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.TransactionDTO
+		setup  func()
+		expect func(t *testing.T, info *bitcoin.TransactionInfoDTO, err error)
+	}{
+		{
+			name: "should return raw transaction",
+			dto:  &bitcoin.TransactionDTO{TxId: txId, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetRawTransaction(gomock.Any(), txId, "test").Return("0200", nil)
+			},
+			expect: func(t *testing.T, info *bitcoin.TransactionInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.TransactionInfoDTO{TxId: txId, Hex: "0200"}, info)
+			},
+		},
+		{
+			name: "should resolve input values and fee",
+			dto:  &bitcoin.TransactionDTO{TxId: txId, Network: "test", Verbose: true},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetTransaction(gomock.Any(), txId, "test").Return(tx, nil)
+				btcRpcSvc.EXPECT().GetTransaction(gomock.Any(), prevTxId, "test").Return(prevTx, nil).Times(1)
+			},
+			expect: func(t *testing.T, info *bitcoin.TransactionInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []bitcoin.TxInputDTO{
+					{TxId: prevTxId, Vout: 0, Value: 0.3, Address: "tb1qa"},
+					{TxId: prevTxId, Vout: 1, Value: 0.2, Address: "tb1qb"},
+				}, info.Inputs)
+				assert.Equal(t, []bitcoin.TxOutputDTO{{N: 0, Value: 0.4999, Address: "tb1qc", ScriptPubKey: "0014ab", Type: "witness_v0_keyhash"}}, info.Outputs)
+				assert.Equal(t, 0.0001, info.Fee)
+				assert.Equal(t, blockHash, info.BlockHash)
+			},
+		},
+		{
+			name: "should not resolve coinbase input",
+			dto:  &bitcoin.TransactionDTO{TxId: txId, Network: "test", Verbose: true},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetTransaction(gomock.Any(), txId, "test").
+					Return(&bitcoin_rpc.Transaction{Txid: txId, Vin: []bitcoin_rpc.TxInput{{Coinbase: "03ab"}}, Vout: []bitcoin_rpc.TxOutput{output(0, 6.25, "tb1qc")}}, nil)
+			},
+			expect: func(t *testing.T, info *bitcoin.TransactionInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []bitcoin.TxInputDTO{{Coinbase: "03ab"}}, info.Inputs)
+				assert.Zero(t, info.Fee)
+			},
+		},
+		{
+			name: "should reject inputs of too many transactions",
+			dto:  &bitcoin.TransactionDTO{TxId: txId, Network: "test", Verbose: true},
+			setup: func() {
+				many := &bitcoin_rpc.Transaction{Txid: txId, Vout: []bitcoin_rpc.TxOutput{output(0, 1, "tb1qc")}}
+				for i := 0; i <= 100; i++ {
+					many.Vin = append(many.Vin, bitcoin_rpc.TxInput{Txid: fmt.Sprintf("%064x", i)})
+				}
+				btcRpcSvc.EXPECT().GetTransaction(gomock.Any(), txId, "test").Return(many, nil)
+			},
+			expect: func(t *testing.T, info *bitcoin.TransactionInfoDTO, err error) {
+				assert.Nil(t, info)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "transaction "+txId+" spends outputs of 101 transactions, verbose lookups resolve at most 100"), err)
+			},
+		},
+		{
+			name: "should keep node error",
+			dto:  &bitcoin.TransactionDTO{TxId: txId, Network: "test", Verbose: true},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetTransaction(gomock.Any(), txId, "test").
+					Return(nil, errors.FromBitcoinRPC(-5, "No such mempool or blockchain transaction"))
+			},
+			expect: func(t *testing.T, info *bitcoin.TransactionInfoDTO, err error) {
+				assert.Nil(t, info)
+				assert.Equal(t, errors.FromBitcoinRPC(-5, "No such mempool or blockchain transaction"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			info, err := service.Transaction(context.Background(), tc.dto)
+			tc.expect(t, info, err)
+		})
+	}
+}
+
+func TestService_TxOut(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.TxOutDTO
+		setup  func()
+		expect *bitcoin.TxOutInfoDTO
+	}{
+		{
+			name: "should return unspent output",
+			dto:  &bitcoin.TxOutDTO{TxId: txId, Vout: 1, Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetTxOut(gomock.Any(), txId, int64(1), true, "test").
+					Return(&bitcoin_rpc.TxOut{BestBlock: blockHash, Confirmations: 3, Value: 0.5, ScriptPubKey: bitcoin_rpc.ScriptPubKey{Hex: "0014ab", Address: "tb1qa", Type: "witness_v0_keyhash"}}, nil)
+			},
+			expect: &bitcoin.TxOutInfoDTO{Unspent: true, BestBlock: blockHash, Confirmations: 3, Value: 0.5, Address: "tb1qa", ScriptPubKey: "0014ab", Type: "witness_v0_keyhash"},
+		},
+		{
+			name: "should report spent output",
+			dto:  &bitcoin.TxOutDTO{TxId: txId, Network: "test", ConfirmedOnly: true},
+			setup: func() {
+				btcRpcSvc.EXPECT().GetTxOut(gomock.Any(), txId, int64(0), false, "test").Return(nil, nil)
+			},
+			expect: &bitcoin.TxOutInfoDTO{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			out, err := service.TxOut(context.Background(), tc.dto)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, out)
+		})
+	}
+}
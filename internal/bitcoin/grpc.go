@@ -0,0 +1,879 @@
+package bitcoin
+
+import (
+	"context"
+	gErrors "errors"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	pb "nn-blockchain-api/pkg/grpc_server/proto/bitcoin"
+
+	"google.golang.org/grpc"
+)
+
+// GRPCServer exposes Service over gRPC with the same validation as the REST handler.
+// Requests pick the UTXO chain by their chain field, btcSvcs is keyed by chain name.
+type GRPCServer struct {
+	pb.UnimplementedBitcoinServiceServer
+	btcSvcs map[string]Service
+}
+
+func NewGRPCServer(btcSvcs map[string]Service) (*GRPCServer, error) {
+	for name, btcSvc := range btcSvcs {
+		if btcSvc == nil {
+			return nil, gErrors.New("invalid " + name + " service")
+		}
+	}
+
+	return &GRPCServer{btcSvcs: btcSvcs}, nil
+}
+
+func (s *GRPCServer) Register(registrar grpc.ServiceRegistrar) {
+	pb.RegisterBitcoinServiceServer(registrar, s)
+}
+
+// Rules mirrors the scopes SetupRoutes requires for the REST endpoints. The interceptor
+// checks the key against the chain of the request when it names one.
+func (s *GRPCServer) Rules() map[string]auth.Rule {
+	method := func(name string) string {
+		return "/" + pb.BitcoinService_ServiceDesc.ServiceName + "/" + name
+	}
+
+	return map[string]auth.Rule{
+		method("StatusNode"):           {Chain: chain, Scope: auth.ScopeRead},
+		method("CreateRawTransaction"): {Chain: chain, Scope: auth.ScopeBuild},
+		method("DecodeRawTransaction"): {Chain: chain, Scope: auth.ScopeRead},
+		method("FundRawTransaction"):   {Chain: chain, Scope: auth.ScopeBuild},
+		method("SignRawTransaction"):   {Chain: chain, Scope: auth.ScopeSign},
+		method("SendRawTransaction"):   {Chain: chain, Scope: auth.ScopeBroadcast},
+		method("SimulateTransaction"):  {Chain: chain, Scope: auth.ScopeRead},
+		method("WalletInfo"):           {Chain: chain, Scope: auth.ScopeRead},
+		method("CreateWallet"):         {Chain: chain, Scope: auth.ScopeBuild},
+		method("LoadWallet"):           {Chain: chain, Scope: auth.ScopeBuild},
+		method("ImportAddress"):        {Chain: chain, Scope: auth.ScopeBuild},
+		method("RescanWallet"):         {Chain: chain, Scope: auth.ScopeBuild},
+		method("ListUnspent"):          {Chain: chain, Scope: auth.ScopeRead},
+
+		method("CreateMultisig"):              {Chain: chain, Scope: auth.ScopeBuild},
+		method("CreateMultisigTransaction"):   {Chain: chain, Scope: auth.ScopeBuild},
+		method("SignMultisigTransaction"):     {Chain: chain, Scope: auth.ScopeSign},
+		method("CombineMultisigTransactions"): {Chain: chain, Scope: auth.ScopeBuild},
+		method("FinalizeMultisigTransaction"): {Chain: chain, Scope: auth.ScopeBuild},
+
+		method("DescriptorInfo"):    {Chain: chain, Scope: auth.ScopeRead},
+		method("ImportDescriptors"): {Chain: chain, Scope: auth.ScopeBuild},
+		method("DeriveAddresses"):   {Chain: chain, Scope: auth.ScopeRead},
+
+		method("Block"):        {Chain: chain, Scope: auth.ScopeRead},
+		method("Transaction"):  {Chain: chain, Scope: auth.ScopeRead},
+		method("MempoolInfo"):  {Chain: chain, Scope: auth.ScopeRead},
+		method("MempoolEntry"): {Chain: chain, Scope: auth.ScopeRead},
+		method("TxOut"):        {Chain: chain, Scope: auth.ScopeRead},
+	}
+}
+
+func (s *GRPCServer) StatusNode(ctx context.Context, req *pb.StatusNodeRequest) (*pb.StatusNodeResponse, error) {
+	dto := StatusNodeDTO{Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := btcSvc.StatusNode(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	softforks := status.Softforks
+	return &pb.StatusNodeResponse{
+		Chain:                status.Chain,
+		Blocks:               toInt64(status.Blocks),
+		Headers:              toInt64(status.Headers),
+		VerificationProgress: toFloat64(status.Verificationprogress),
+		Softforks: []*pb.Softfork{
+			{Name: "bip34", Type: softforks.Bip34.Type, Active: softforks.Bip34.Active, Height: toInt64(softforks.Bip34.Height)},
+			{Name: "bip66", Type: softforks.Bip66.Type, Active: softforks.Bip66.Active, Height: toInt64(softforks.Bip66.Height)},
+			{Name: "bip65", Type: softforks.Bip65.Type, Active: softforks.Bip65.Active, Height: toInt64(softforks.Bip65.Height)},
+			{Name: "csv", Type: softforks.Csv.Type, Active: softforks.Csv.Active, Height: toInt64(softforks.Csv.Height)},
+			{Name: "segwit", Type: softforks.Segwit.Type, Active: softforks.Segwit.Active, Height: toInt64(softforks.Segwit.Height)},
+			{Name: "taproot", Type: softforks.Taproot.Type, Active: softforks.Taproot.Active, Bip9Status: softforks.Taproot.Bip9.Status},
+		},
+		Warnings: status.Warnings,
+	}, nil
+}
+
+func (s *GRPCServer) CreateRawTransaction(ctx context.Context, req *pb.CreateRawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
+	dto := CreateRawTransactionDTO{
+		FromAddress: req.GetFromAddress(),
+		ToAddress:   req.GetToAddress(),
+		Amount:      req.GetAmount(),
+		Network:     req.GetNetwork(),
+		Utxo:        utxoDTOs(req.GetUtxo()),
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := btcSvc.CreateTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateRawTransactionResponse{Tx: tx.Tx, Fee: tx.Fee}, nil
+}
+
+func (s *GRPCServer) DecodeRawTransaction(ctx context.Context, req *pb.DecodeRawTransactionRequest) (*pb.DecodeRawTransactionResponse, error) {
+	dto := DecodeRawTransactionDTO{Tx: req.GetTx(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := btcSvc.DecodeTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.DecodeRawTransactionResponse{
+		Txid:     decoded.Txid,
+		Hash:     decoded.Hash,
+		Version:  int64(decoded.Version),
+		Size:     int64(decoded.Size),
+		Vsize:    int64(decoded.Vsize),
+		Weight:   int64(decoded.Weight),
+		Locktime: int64(decoded.Locktime),
+	}
+	for _, vin := range decoded.Vin {
+		resp.Vin = append(resp.Vin, &pb.Vin{
+			Txid:      vin.Txid,
+			Vout:      int64(vin.Vout),
+			ScriptSig: &pb.ScriptSig{Asm: vin.ScriptSig.Asm, Hex: vin.ScriptSig.Hex},
+			Sequence:  vin.Sequence,
+		})
+	}
+	for _, vout := range decoded.Vout {
+		resp.Vout = append(resp.Vout, &pb.Vout{
+			Value: vout.Value,
+			N:     int64(vout.N),
+			ScriptPubKey: &pb.ScriptPubKey{
+				Asm:     vout.ScriptPubKey.Asm,
+				Hex:     vout.ScriptPubKey.Hex,
+				Address: vout.ScriptPubKey.Address,
+				Type:    vout.ScriptPubKey.Type,
+			},
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCServer) FundRawTransaction(ctx context.Context, req *pb.FundRawTransactionRequest) (*pb.FundRawTransactionResponse, error) {
+	dto := FundForRawTransactionDTO{CreatedTxHex: req.GetCreatedTxHex(), ChangeAddress: req.GetChangeAddress(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	funded, err := btcSvc.FoundForRawTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FundRawTransactionResponse{Tx: funded.Tx, Fee: funded.Fee}, nil
+}
+
+func (s *GRPCServer) SignRawTransaction(ctx context.Context, req *pb.SignRawTransactionRequest) (*pb.SignRawTransactionResponse, error) {
+	dto := SignRawTransactionDTO{Tx: req.GetTx(), PrivateKey: req.GetPrivateKey(), WalletId: req.GetWalletId(), KeyId: req.GetKeyId(), Passphrase: req.GetPassphrase(), ApprovalId: req.GetApprovalId(), Utxo: utxoDTOs(req.GetUtxo()), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := btcSvc.SignTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SignRawTransactionResponse{Hash: signed.Hash}, nil
+}
+
+func (s *GRPCServer) SendRawTransaction(ctx context.Context, req *pb.SendRawTransactionRequest) (*pb.SendRawTransactionResponse, error) {
+	dto := SendRawTransactionDTO{SignedTx: req.GetSignedTx(), Network: req.GetNetwork(), DryRun: req.GetDryRun()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := btcSvc.SendTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SendRawTransactionResponse{TxId: sent.TxId, DryRun: sent.DryRun}
+	if sent.Simulation != nil {
+		resp.Simulation = simulationResponse(sent.Simulation)
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) SimulateTransaction(ctx context.Context, req *pb.SimulateTransactionRequest) (*pb.SimulateTransactionResponse, error) {
+	dto := SimulateTransactionDTO{SignedTx: req.GetSignedTx(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	simulation, err := btcSvc.SimulateTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return simulationResponse(simulation), nil
+}
+
+func simulationResponse(simulation *SimulationDTO) *pb.SimulateTransactionResponse {
+	resp := &pb.SimulateTransactionResponse{
+		Txid:    simulation.TxId,
+		Wtxid:   simulation.WTxId,
+		Passed:  simulation.Passed,
+		Vsize:   simulation.Vsize,
+		Fee:     simulation.Fee,
+		FeeRate: simulation.FeeRate,
+	}
+	for _, c := range simulation.Checks {
+		resp.Checks = append(resp.Checks, &pb.Check{Name: c.Name, Passed: c.Passed, Status: string(c.Status), Message: c.Message})
+	}
+	return resp
+}
+
+func (s *GRPCServer) WalletInfo(ctx context.Context, req *pb.WalletInfoRequest) (*pb.WalletInfoResponse, error) {
+	dto := WalletDTO{WalletId: req.GetWalletId(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := btcSvc.WalletInfo(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.WalletInfoResponse{
+		WalletName:            info.Walletname,
+		WalletVersion:         int64(info.Walletversion),
+		Format:                info.Format,
+		Balance:               info.Balance,
+		UnconfirmedBalance:    info.UnconfirmedBalance,
+		ImmatureBalance:       info.ImmatureBalance,
+		TxCount:               int64(info.Txcount),
+		KeypoolOldest:         int64(info.Keypoololdest),
+		KeypoolSize:           int64(info.Keypoolsize),
+		HdSeedId:              info.Hdseedid,
+		KeypoolSizeHdInternal: int64(info.KeypoolsizeHdInternal),
+		PayTxFee:              info.Paytxfee,
+		PrivateKeysEnabled:    info.PrivateKeysEnabled,
+		AvoidReuse:            info.AvoidReuse,
+		// The node reports false when idle and a progress object while scanning.
+		Scanning:    info.Scanning != nil && info.Scanning != false,
+		Descriptors: info.Descriptors,
+	}, nil
+}
+
+func (s *GRPCServer) CreateWallet(ctx context.Context, req *pb.CreateWalletRequest) (*pb.CreateWalletResponse, error) {
+	dto := CreateWalletDTO{Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := btcSvc.CreateWallet(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateWalletResponse{WalletId: wallet.WalletId, Password: wallet.Password}, nil
+}
+
+func (s *GRPCServer) LoadWallet(ctx context.Context, req *pb.LoadWalletRequest) (*pb.LoadWalletResponse, error) {
+	dto := LoadWalletDTO{WalletId: req.GetWalletId(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := btcSvc.LoadWaller(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoadWalletResponse{Message: info.Message}, nil
+}
+
+func (s *GRPCServer) ImportAddress(ctx context.Context, req *pb.ImportAddressRequest) (*pb.ImportAddressResponse, error) {
+	dto := ImportAddressDTO{Address: req.GetAddress(), WalletId: req.GetWalletId(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := btcSvc.ImportAddress(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ImportAddressResponse{Message: info.Message}, nil
+}
+
+func (s *GRPCServer) RescanWallet(ctx context.Context, req *pb.RescanWalletRequest) (*pb.RescanWalletResponse, error) {
+	dto := RescanWalletDTO{WalletId: req.GetWalletId(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := btcSvc.RescanWallet(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RescanWalletResponse{Status: info.Status, Message: info.Message}, nil
+}
+
+func (s *GRPCServer) ListUnspent(ctx context.Context, req *pb.ListUnspentRequest) (*pb.ListUnspentResponse, error) {
+	dto := ListUnspentDTO{Address: req.GetAddress(), WalletId: req.GetWalletId(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := btcSvc.ListUnspent(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListUnspentResponse{}
+	for _, unspent := range list.Result {
+		resp.Result = append(resp.Result, &pb.Unspent{
+			Txid:          unspent.Txid,
+			Vout:          int64(unspent.Vout),
+			Address:       unspent.Address,
+			Label:         unspent.Label,
+			ScriptPubKey:  unspent.ScriptPubKey,
+			Amount:        unspent.Amount,
+			Confirmations: int64(unspent.Confirmations),
+			Spendable:     unspent.Spendable,
+			Solvable:      unspent.Solvable,
+			Safe:          unspent.Safe,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *GRPCServer) CreateMultisig(ctx context.Context, req *pb.CreateMultisigRequest) (*pb.CreateMultisigResponse, error) {
+	dto := CreateMultisigDTO{Required: int(req.GetRequired()), Keys: req.GetKeys(), Index: req.GetIndex(), ScriptType: req.GetScriptType(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	multisig, err := btcSvc.CreateMultisig(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateMultisigResponse{
+		Address:       multisig.Address,
+		ScriptType:    multisig.ScriptType,
+		Required:      int64(multisig.Required),
+		Keys:          multisig.Keys,
+		WitnessScript: multisig.WitnessScript,
+		RedeemScript:  multisig.RedeemScript,
+		ScriptPubKey:  multisig.ScriptPubKey,
+		Descriptor_:   multisig.Descriptor,
+	}, nil
+}
+
+func (s *GRPCServer) CreateMultisigTransaction(ctx context.Context, req *pb.CreateMultisigTransactionRequest) (*pb.CreateMultisigTransactionResponse, error) {
+	dto := CreateMultisigTransactionDTO{
+		WitnessScript: req.GetWitnessScript(),
+		ScriptType:    req.GetScriptType(),
+		ToAddress:     req.GetToAddress(),
+		ChangeAddress: req.GetChangeAddress(),
+		Amount:        req.GetAmount(),
+		Network:       req.GetNetwork(),
+	}
+	for _, utxo := range req.GetUtxo() {
+		dto.Utxo = append(dto.Utxo, struct {
+			TxId   string `json:"txid" validate:"required,txid"`
+			Vout   int64  `json:"vout" validate:"gte=0"`
+			Amount int64  `json:"amount" validate:"required"`
+		}{TxId: utxo.GetTxid(), Vout: utxo.GetVout(), Amount: utxo.GetAmount()})
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := btcSvc.CreateMultisigTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CreateMultisigTransactionResponse{Tx: created.Tx, Fee: created.Fee}
+	for _, prevTx := range created.PrevTxs {
+		resp.PrevTxs = append(resp.PrevTxs, &pb.PrevTx{
+			Txid:          prevTx.TxId,
+			Vout:          prevTx.Vout,
+			ScriptPubKey:  prevTx.ScriptPubKey,
+			RedeemScript:  prevTx.RedeemScript,
+			WitnessScript: prevTx.WitnessScript,
+			Amount:        prevTx.Amount,
+		})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) SignMultisigTransaction(ctx context.Context, req *pb.SignMultisigTransactionRequest) (*pb.SignMultisigTransactionResponse, error) {
+	dto := SignMultisigTransactionDTO{
+		Tx:         req.GetTx(),
+		PrivateKey: req.GetPrivateKey(),
+		KeyId:      req.GetKeyId(),
+		Passphrase: req.GetPassphrase(),
+		ApprovalId: req.GetApprovalId(),
+		PrevTxs:    prevTxDTOs(req.GetPrevTxs()),
+		Network:    req.GetNetwork(),
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := btcSvc.SignMultisigTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SignMultisigTransactionResponse{Tx: signed.Tx, Complete: signed.Complete}, nil
+}
+
+func (s *GRPCServer) CombineMultisigTransactions(ctx context.Context, req *pb.CombineMultisigTransactionsRequest) (*pb.CombineMultisigTransactionsResponse, error) {
+	dto := CombineMultisigTransactionsDTO{Txs: req.GetTxs(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	combined, err := btcSvc.CombineMultisigTransactions(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CombineMultisigTransactionsResponse{Tx: combined.Tx}, nil
+}
+
+func (s *GRPCServer) FinalizeMultisigTransaction(ctx context.Context, req *pb.FinalizeMultisigTransactionRequest) (*pb.SignMultisigTransactionResponse, error) {
+	dto := FinalizeMultisigTransactionDTO{Tx: req.GetTx(), PrevTxs: prevTxDTOs(req.GetPrevTxs()), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	final, err := btcSvc.FinalizeMultisigTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SignMultisigTransactionResponse{Tx: final.Tx, Complete: final.Complete}, nil
+}
+
+func (s *GRPCServer) DescriptorInfo(ctx context.Context, req *pb.DescriptorInfoRequest) (*pb.DescriptorInfoResponse, error) {
+	dto := DescriptorDTO{Descriptor: req.GetDescriptor_(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := btcSvc.DescriptorInfo(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DescriptorInfoResponse{
+		Descriptor_: info.Descriptor,
+		Checksum:    info.Checksum,
+		Type:        info.Type,
+		IsRange:     info.IsRange,
+		IsSolvable:  info.IsSolvable,
+	}, nil
+}
+
+func (s *GRPCServer) ImportDescriptors(ctx context.Context, req *pb.ImportDescriptorsRequest) (*pb.ImportDescriptorsResponse, error) {
+	dto := ImportDescriptorsDTO{WalletId: req.GetWalletId(), Network: req.GetNetwork()}
+	for _, descriptor := range req.GetDescriptors() {
+		dto.Descriptors = append(dto.Descriptors, ImportDescriptorDTO{
+			Descriptor: descriptor.GetDescriptor_(),
+			Range:      descriptor.GetRange(),
+			Timestamp:  descriptor.GetTimestamp(),
+			Active:     descriptor.GetActive(),
+			Internal:   descriptor.GetInternal(),
+			Label:      descriptor.GetLabel(),
+		})
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	imported, err := btcSvc.ImportDescriptors(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ImportDescriptorsResponse{}
+	for _, result := range imported.Result {
+		resp.Result = append(resp.Result, &pb.ImportedDescriptor{
+			Descriptor_: result.Descriptor,
+			Success:     result.Success,
+			Warnings:    result.Warnings,
+			Error:       result.Error,
+		})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) DeriveAddresses(ctx context.Context, req *pb.DeriveAddressesRequest) (*pb.DeriveAddressesResponse, error) {
+	dto := DeriveAddressesDTO{Descriptor: req.GetDescriptor_(), Range: req.GetRange(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	derived, err := btcSvc.DeriveAddresses(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeriveAddressesResponse{Addresses: derived.Addresses}, nil
+}
+
+// utxoDTO is the element type of the Utxo fields of the transaction DTOs.
+type utxoDTO = struct {
+	TxId     string `json:"txid" validate:"required,txid"`
+	Vout     int64  `json:"vout" validate:"gte=0"`
+	Amount   int64  `json:"amount" validate:"required"`
+	PKScript string `json:"pk_script" validate:"required,hexadecimal"`
+}
+
+func utxoDTOs(utxos []*pb.Utxo) []utxoDTO {
+	dtos := make([]utxoDTO, 0, len(utxos))
+	for _, utxo := range utxos {
+		dtos = append(dtos, utxoDTO{TxId: utxo.GetTxid(), Vout: utxo.GetVout(), Amount: utxo.GetAmount(), PKScript: utxo.GetPkScript()})
+	}
+	return dtos
+}
+
+// toInt64 reads a JSON number the node returned into an untyped field.
+func toInt64(value interface{}) int64 {
+	return int64(toFloat64(value))
+}
+
+func toFloat64(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	}
+	return 0
+}
+
+func prevTxDTOs(prevTxs []*pb.PrevTx) []PrevTxDTO {
+	dtos := make([]PrevTxDTO, 0, len(prevTxs))
+	for _, prevTx := range prevTxs {
+		dtos = append(dtos, PrevTxDTO{
+			TxId:          prevTx.GetTxid(),
+			Vout:          prevTx.GetVout(),
+			ScriptPubKey:  prevTx.GetScriptPubKey(),
+			RedeemScript:  prevTx.GetRedeemScript(),
+			WitnessScript: prevTx.GetWitnessScript(),
+			Amount:        prevTx.GetAmount(),
+		})
+	}
+	return dtos
+}
+
+func (s *GRPCServer) Block(ctx context.Context, req *pb.BlockRequest) (*pb.BlockResponse, error) {
+	dto := BlockDTO{Block: req.GetBlock(), Network: req.GetNetwork(), Page: req.GetPage(), PerPage: req.GetPerPage()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := btcSvc.Block(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BlockResponse{
+		Hash:              block.Hash,
+		Height:            block.Height,
+		Confirmations:     block.Confirmations,
+		Version:           block.Version,
+		MerkleRoot:        block.MerkleRoot,
+		Time:              block.Time,
+		MedianTime:        block.MedianTime,
+		Nonce:             block.Nonce,
+		Bits:              block.Bits,
+		Difficulty:        block.Difficulty,
+		Size:              block.Size,
+		Weight:            block.Weight,
+		PreviousBlockHash: block.PreviousBlockHash,
+		NextBlockHash:     block.NextBlockHash,
+		TxCount:           block.TxCount,
+		Page:              block.Page,
+		PerPage:           block.PerPage,
+		Txids:             block.TxIds,
+	}, nil
+}
+
+func (s *GRPCServer) Transaction(ctx context.Context, req *pb.TransactionRequest) (*pb.TransactionResponse, error) {
+	dto := TransactionDTO{TxId: req.GetTxid(), Network: req.GetNetwork(), Verbose: req.GetVerbose()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := btcSvc.Transaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.TransactionResponse{
+		Txid:          tx.TxId,
+		Hex:           tx.Hex,
+		Hash:          tx.Hash,
+		Version:       tx.Version,
+		Size:          tx.Size,
+		Vsize:         tx.Vsize,
+		Weight:        tx.Weight,
+		Locktime:      tx.Locktime,
+		Fee:           tx.Fee,
+		BlockHash:     tx.BlockHash,
+		Confirmations: tx.Confirmations,
+		BlockTime:     tx.BlockTime,
+	}
+	for _, input := range tx.Inputs {
+		resp.Inputs = append(resp.Inputs, &pb.TxInput{
+			Txid:     input.TxId,
+			Vout:     input.Vout,
+			Coinbase: input.Coinbase,
+			Sequence: input.Sequence,
+			Witness:  input.Witness,
+			Value:    input.Value,
+			Address:  input.Address,
+		})
+	}
+	for _, output := range tx.Outputs {
+		resp.Outputs = append(resp.Outputs, &pb.TxOutput{
+			N:            output.N,
+			Value:        output.Value,
+			Address:      output.Address,
+			ScriptPubKey: output.ScriptPubKey,
+			Type:         output.Type,
+		})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) MempoolInfo(ctx context.Context, req *pb.MempoolInfoRequest) (*pb.MempoolInfoResponse, error) {
+	dto := MempoolDTO{Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := btcSvc.MempoolInfo(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MempoolInfoResponse{
+		Loaded:        info.Loaded,
+		Size:          info.Size,
+		Bytes:         info.Bytes,
+		Usage:         info.Usage,
+		MaxMempool:    info.MaxMempool,
+		MempoolMinFee: info.MempoolMinFee,
+		MinRelayTxFee: info.MinRelayTxFee,
+	}, nil
+}
+
+func (s *GRPCServer) MempoolEntry(ctx context.Context, req *pb.MempoolEntryRequest) (*pb.MempoolEntryResponse, error) {
+	dto := MempoolEntryDTO{TxId: req.GetTxid(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := btcSvc.MempoolEntry(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MempoolEntryResponse{
+		Txid:            entry.TxId,
+		Vsize:           entry.Vsize,
+		Weight:          entry.Weight,
+		Time:            entry.Time,
+		Height:          entry.Height,
+		Fee:             entry.Fee,
+		ModifiedFee:     entry.ModifiedFee,
+		AncestorCount:   entry.AncestorCount,
+		DescendantCount: entry.DescendantCount,
+		Depends:         entry.Depends,
+		SpentBy:         entry.SpentBy,
+		Replaceable:     entry.Replaceable,
+	}, nil
+}
+
+func (s *GRPCServer) TxOut(ctx context.Context, req *pb.TxOutRequest) (*pb.TxOutResponse, error) {
+	dto := TxOutDTO{TxId: req.GetTxid(), Vout: req.GetVout(), Network: req.GetNetwork(), ConfirmedOnly: req.GetConfirmedOnly()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	btcSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := btcSvc.TxOut(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TxOutResponse{
+		Unspent:       out.Unspent,
+		BestBlock:     out.BestBlock,
+		Confirmations: out.Confirmations,
+		Value:         out.Value,
+		Address:       out.Address,
+		ScriptPubKey:  out.ScriptPubKey,
+		Type:          out.Type,
+		Coinbase:      out.Coinbase,
+	}, nil
+}
+
+func (s *GRPCServer) service(name string) (Service, error) {
+	if name == "" {
+		name = chain
+	}
+	btcSvc, ok := s.btcSvcs[name]
+	if !ok {
+		return nil, errors.NewNotFound("unknown chain " + name)
+	}
+	return btcSvc, nil
+}
@@ -0,0 +1,198 @@
+package bitcoin_test
+
+import (
+	"context"
+	"net"
+	"nn-blockchain-api/internal/bitcoin"
+	mock_bitcoin "nn-blockchain-api/internal/bitcoin/mocks"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	pb "nn-blockchain-api/pkg/grpc_server/proto/bitcoin"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestNewGRPCServer(t *testing.T) {
+	srv, err := bitcoin.NewGRPCServer(nil)
+	assert.NotNil(t, srv)
+	assert.Nil(t, err)
+
+	srv, err = bitcoin.NewGRPCServer(map[string]bitcoin.Service{"bitcoin": nil})
+	assert.Nil(t, srv)
+	assert.EqualError(t, err, "invalid bitcoin service")
+}
+
+func TestGRPCServer_Rules(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	srv, err := bitcoin.NewGRPCServer(map[string]bitcoin.Service{"bitcoin": mock_bitcoin.NewMockService(controller)})
+	assert.Nil(t, err)
+
+	rules := srv.Rules()
+	assert.Len(t, rules, len(pb.BitcoinService_ServiceDesc.Methods))
+	for _, method := range pb.BitcoinService_ServiceDesc.Methods {
+		rule, ok := rules["/"+pb.BitcoinService_ServiceDesc.ServiceName+"/"+method.MethodName]
+		assert.True(t, ok, method.MethodName)
+		assert.Equal(t, "bitcoin", rule.Chain)
+	}
+	assert.Equal(t, auth.ScopeBroadcast, rules["/api.bitcoin.v1.BitcoinService/SendRawTransaction"].Scope)
+}
+
+func TestGRPCServer(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	btcSvc := mock_bitcoin.NewMockService(controller)
+	client := newBitcoinClient(t, btcSvc)
+	ctx := context.Background()
+
+	t.Run("create raw transaction", func(t *testing.T) {
+		btcSvc.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, dto *bitcoin.CreateRawTransactionDTO) (*bitcoin.CreatedRawTransactionDTO, error) {
+				assert.Equal(t, "mkHS9ne12qx9pS9VojpwU5xtRd4T7X7ZUt", dto.FromAddress)
+				assert.Len(t, dto.Utxo, 1)
+				assert.Equal(t, int64(1), dto.Utxo[0].Vout)
+				assert.Equal(t, "76a914", dto.Utxo[0].PKScript)
+				return &bitcoin.CreatedRawTransactionDTO{Tx: "0200", Fee: 0.0001}, nil
+			})
+
+		resp, err := client.CreateRawTransaction(ctx, &pb.CreateRawTransactionRequest{
+			Utxo: []*pb.Utxo{{
+				Txid:     "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b",
+				Vout:     1,
+				Amount:   5000,
+				PkScript: "76a914",
+			}},
+			FromAddress: "mkHS9ne12qx9pS9VojpwU5xtRd4T7X7ZUt",
+			ToAddress:   "mkHS9ne12qx9pS9VojpwU5xtRd4T7X7ZUt",
+			Amount:      1000,
+			Network:     "test",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "0200", resp.Tx)
+		assert.Equal(t, 0.0001, resp.Fee)
+	})
+
+	t.Run("sign multisig transaction", func(t *testing.T) {
+		btcSvc.EXPECT().SignMultisigTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, dto *bitcoin.SignMultisigTransactionDTO) (*bitcoin.SignedMultisigTransactionDTO, error) {
+				assert.Equal(t, "key", dto.KeyId)
+				assert.Len(t, dto.PrevTxs, 1)
+				assert.Equal(t, "5221", dto.PrevTxs[0].WitnessScript)
+				assert.Equal(t, int64(5000), dto.PrevTxs[0].Amount)
+				return &bitcoin.SignedMultisigTransactionDTO{Tx: "0201"}, nil
+			})
+
+		resp, err := client.SignMultisigTransaction(ctx, &pb.SignMultisigTransactionRequest{
+			Tx:    "0200",
+			KeyId: "key",
+			PrevTxs: []*pb.PrevTx{{
+				Txid:          "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b",
+				Vout:          1,
+				ScriptPubKey:  "0020",
+				WitnessScript: "5221",
+				Amount:        5000,
+			}},
+			Network: "test",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "0201", resp.Tx)
+		assert.False(t, resp.Complete)
+	})
+
+	t.Run("verbose transaction", func(t *testing.T) {
+		btcSvc.EXPECT().Transaction(gomock.Any(), &bitcoin.TransactionDTO{TxId: txId, Network: "test", Verbose: true}).
+			Return(&bitcoin.TransactionInfoDTO{
+				TxId:    txId,
+				Hex:     "0200",
+				Inputs:  []bitcoin.TxInputDTO{{TxId: prevTxId, Vout: 1, Value: 0.2, Address: "tb1qb"}},
+				Outputs: []bitcoin.TxOutputDTO{{N: 0, Value: 0.1999, ScriptPubKey: "0014ab"}},
+				Fee:     0.0001,
+			}, nil)
+
+		resp, err := client.Transaction(ctx, &pb.TransactionRequest{Txid: txId, Network: "test", Verbose: true})
+		assert.Nil(t, err)
+		assert.Equal(t, 0.2, resp.Inputs[0].Value)
+		assert.Equal(t, "tb1qb", resp.Inputs[0].Address)
+		assert.Equal(t, "0014ab", resp.Outputs[0].ScriptPubKey)
+		assert.Equal(t, 0.0001, resp.Fee)
+	})
+
+	t.Run("status node", func(t *testing.T) {
+		status := &bitcoin.StatusNodeInfoDTO{Chain: "test", Blocks: float64(2100000), Headers: float64(2100001), Verificationprogress: 0.99}
+		status.Softforks.Segwit.Active = true
+		status.Softforks.Segwit.Height = float64(834624)
+		btcSvc.EXPECT().StatusNode(gomock.Any(), &bitcoin.StatusNodeDTO{Network: "test"}).Return(status, nil)
+
+		resp, err := client.StatusNode(ctx, &pb.StatusNodeRequest{Network: "test"})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2100000), resp.Blocks)
+		assert.Equal(t, int64(2100001), resp.Headers)
+		assert.Equal(t, 0.99, resp.VerificationProgress)
+		assert.Equal(t, "segwit", resp.Softforks[4].Name)
+		assert.True(t, resp.Softforks[4].Active)
+		assert.Equal(t, int64(834624), resp.Softforks[4].Height)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		_, err := client.SendRawTransaction(ctx, &pb.SendRawTransactionRequest{SignedTx: "0xzz", Network: "Moon"})
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+		assert.True(t, ok)
+		assert.Len(t, badRequest.FieldViolations, 2)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		_, err := client.StatusNode(ctx, &pb.StatusNodeRequest{Network: "main", Chain: "dogecoin"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("node rejection", func(t *testing.T) {
+		btcSvc.EXPECT().SendTransaction(gomock.Any(), gomock.Any()).
+			Return(nil, errors.FromBitcoinRPC(-26, "min relay fee not met"))
+
+		_, err := client.SendRawTransaction(ctx, &pb.SendRawTransactionRequest{SignedTx: "0200", Network: "test"})
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		assert.True(t, ok)
+		assert.Equal(t, string(errors.StatusFeeTooLow), info.Reason)
+	})
+}
+
+func newBitcoinClient(t *testing.T, btcSvc bitcoin.Service) pb.BitcoinServiceClient {
+	srv, err := bitcoin.NewGRPCServer(map[string]bitcoin.Service{"bitcoin": btcSvc})
+	assert.Nil(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	srv.Register(grpcServer)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewBitcoinServiceClient(conn)
+}
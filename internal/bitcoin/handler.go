@@ -1,46 +1,115 @@
 package bitcoin
 
 import (
+	"context"
 	"encoding/json"
 	gErrors "errors"
 	"net/http"
+	"nn-blockchain-api/pkg/auth"
 	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/params"
 	"nn-blockchain-api/pkg/respond"
 
 	"github.com/go-chi/chi/v5"
 )
 
+// Handler serves every UTXO chain, btcSvcs is keyed by chain name and may lack any of them.
 type Handler struct {
-	btcSvc Service
+	btcSvcs map[string]Service
+	guard   auth.Guard
 }
 
-func NewHandler(btcSvc Service) (*Handler, error) {
-	if btcSvc == nil {
-		return nil, gErrors.New("invalid bitcoin service")
+func NewHandler(btcSvcs map[string]Service, guard auth.Guard) (*Handler, error) {
+	for name, btcSvc := range btcSvcs {
+		if btcSvc == nil {
+			return nil, gErrors.New("invalid " + name + " service")
+		}
+	}
+	if guard == nil {
+		return nil, gErrors.New("invalid guard")
 	}
 
 	return &Handler{
-		btcSvc: btcSvc,
+		btcSvcs: btcSvcs,
+		guard:   guard,
 	}, nil
 }
 
 func (h *Handler) SetupRoutes(router chi.Router) {
-	router.Post("/status", h.StatusNode)
+	h.SetupChainRoutes(chain, router)
+}
+
+// SetupChainRoutes registers the routes of one chain, they answer 404 while the chain
+// has no service.
+func (h *Handler) SetupChainRoutes(name string, router chi.Router) {
+	router = router.With(withChain(name))
+
+	router.With(h.require(name, auth.ScopeRead)).Post("/status", h.StatusNode)
 
 	// Transaction
-	router.Post("/create-raw-tx", h.CreateRawTransaction)
-	router.Post("/decode-raw-tx", h.DecodeRawTransaction)
-	router.Post("/fund-for-raw-tx", h.FundForRawTransaction)
-	router.Post("/sign-raw-tx", h.SignRawTransaction)
-	router.Post("/send-raw-tx", h.SendRawTransaction)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/create-raw-tx", h.CreateRawTransaction)
+	router.With(h.require(name, auth.ScopeRead)).Post("/decode-raw-tx", h.DecodeRawTransaction)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/fund-for-raw-tx", h.FundForRawTransaction)
+	router.With(h.require(name, auth.ScopeSign)).Post("/sign-raw-tx", h.SignRawTransaction)
+	router.With(h.require(name, auth.ScopeBroadcast)).Post("/send-raw-tx", h.SendRawTransaction)
+	router.With(h.require(name, auth.ScopeRead)).Post("/simulate", h.SimulateTransaction)
 
 	// Wallet/Unspent transaction list
-	router.Post("/wallet-info", h.WalletInfo)
-	router.Post("/create-wallet", h.CreateWallet)
-	router.Post("/load-wallet", h.LoadWallet)
-	router.Post("/import-address", h.ImportAddress)
-	router.Post("/rescan-wallet", h.RescanWallet)
-	router.Post("/list-utx", h.ListUnspent)
+	router.With(h.require(name, auth.ScopeRead)).Post("/wallet-info", h.WalletInfo)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/create-wallet", h.CreateWallet)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/load-wallet", h.LoadWallet)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/import-address", h.ImportAddress)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/rescan-wallet", h.RescanWallet)
+	router.With(h.require(name, auth.ScopeRead)).Post("/list-utx", h.ListUnspent)
+
+	// Multisig
+	router.With(h.require(name, auth.ScopeBuild)).Post("/multisig/create", h.CreateMultisig)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/multisig/create-tx", h.CreateMultisigTransaction)
+	router.With(h.require(name, auth.ScopeSign)).Post("/multisig/sign", h.SignMultisigTransaction)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/multisig/combine", h.CombineMultisigTransactions)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/multisig/finalize", h.FinalizeMultisigTransaction)
+
+	// Descriptors
+	router.With(h.require(name, auth.ScopeRead)).Post("/descriptor/info", h.DescriptorInfo)
+	router.With(h.require(name, auth.ScopeBuild)).Post("/descriptor/import", h.ImportDescriptors)
+	router.With(h.require(name, auth.ScopeRead)).Post("/descriptor/derive-addresses", h.DeriveAddresses)
+
+	// Explorer
+	router.With(h.require(name, auth.ScopeRead)).Get("/blocks/{block}", h.Block)
+	router.With(h.require(name, auth.ScopeRead)).Get("/tx/{txid}", h.Transaction)
+	router.With(h.require(name, auth.ScopeRead)).Get("/tx/{txid}/mempool", h.MempoolEntry)
+	router.With(h.require(name, auth.ScopeRead)).Get("/tx/{txid}/out/{vout}", h.TxOut)
+	router.With(h.require(name, auth.ScopeRead)).Get("/mempool", h.MempoolInfo)
+}
+
+// require lets the guard check the key for the chain.
+func (h *Handler) require(name string, scope auth.Scope) func(http.Handler) http.Handler {
+	if h.btcSvcs[name] == nil {
+		return func(http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				respond.Respond(w, http.StatusNotFound, errors.NewNotFound("unknown chain "+name))
+			})
+		}
+	}
+	return h.guard.Require(name, scope)
+}
+
+type chainKey struct{}
+
+// withChain tells the handlers which chain the routes were registered for.
+func withChain(name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), chainKey{}, name)))
+		})
+	}
+}
+
+func (h *Handler) service(r *http.Request) Service {
+	if name, ok := r.Context().Value(chainKey{}).(string); ok {
+		return h.btcSvcs[name]
+	}
+	return h.btcSvcs[chain]
 }
 
 func (h *Handler) StatusNode(w http.ResponseWriter, r *http.Request) {
@@ -57,7 +126,7 @@ func (h *Handler) StatusNode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	status, err := h.btcSvc.StatusNode(r.Context(), &dto)
+	status, err := h.service(r).StatusNode(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -80,7 +149,7 @@ func (h *Handler) CreateRawTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	transaction, err := h.btcSvc.CreateTransaction(r.Context(), &dto)
+	transaction, err := h.service(r).CreateTransaction(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -102,7 +171,7 @@ func (h *Handler) DecodeRawTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	decodedTx, err := h.btcSvc.DecodeTransaction(r.Context(), &dto)
+	decodedTx, err := h.service(r).DecodeTransaction(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -125,7 +194,7 @@ func (h *Handler) FundForRawTransaction(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	foundedTx, err := h.btcSvc.FoundForRawTransaction(r.Context(), &dto)
+	foundedTx, err := h.service(r).FoundForRawTransaction(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -148,7 +217,7 @@ func (h *Handler) SignRawTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	signedTx, err := h.btcSvc.SignTransaction(r.Context(), &dto)
+	signedTx, err := h.service(r).SignTransaction(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -171,7 +240,7 @@ func (h *Handler) SendRawTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	transactionId, err := h.btcSvc.SendTransaction(r.Context(), &dto)
+	transactionId, err := h.service(r).SendTransaction(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -180,6 +249,29 @@ func (h *Handler) SendRawTransaction(w http.ResponseWriter, r *http.Request) {
 	respond.Respond(w, http.StatusOK, transactionId)
 }
 
+func (h *Handler) SimulateTransaction(w http.ResponseWriter, r *http.Request) {
+	var dto SimulateTransactionDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	simulation, err := h.service(r).SimulateTransaction(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, simulation)
+}
+
 func (h *Handler) WalletInfo(w http.ResponseWriter, r *http.Request) {
 	var dto WalletDTO
 
@@ -194,7 +286,7 @@ func (h *Handler) WalletInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info, err := h.btcSvc.WalletInfo(r.Context(), &dto)
+	info, err := h.service(r).WalletInfo(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -217,7 +309,7 @@ func (h *Handler) CreateWallet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	walletId, err := h.btcSvc.CreateWallet(r.Context(), &dto)
+	walletId, err := h.service(r).CreateWallet(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -240,7 +332,7 @@ func (h *Handler) LoadWallet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info, err := h.btcSvc.LoadWaller(r.Context(), &dto)
+	info, err := h.service(r).LoadWaller(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -263,7 +355,7 @@ func (h *Handler) ImportAddress(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info, err := h.btcSvc.ImportAddress(r.Context(), &dto)
+	info, err := h.service(r).ImportAddress(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -286,7 +378,7 @@ func (h *Handler) RescanWallet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	info, err := h.btcSvc.RescanWallet(r.Context(), &dto)
+	info, err := h.service(r).RescanWallet(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -309,7 +401,7 @@ func (h *Handler) ListUnspent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	list, err := h.btcSvc.ListUnspent(r.Context(), &dto)
+	list, err := h.service(r).ListUnspent(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -317,3 +409,297 @@ func (h *Handler) ListUnspent(w http.ResponseWriter, r *http.Request) {
 
 	respond.Respond(w, http.StatusOK, list)
 }
+
+func (h *Handler) CreateMultisig(w http.ResponseWriter, r *http.Request) {
+	var dto CreateMultisigDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	multisig, err := h.service(r).CreateMultisig(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, multisig)
+}
+
+func (h *Handler) CreateMultisigTransaction(w http.ResponseWriter, r *http.Request) {
+	var dto CreateMultisigTransactionDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	transaction, err := h.service(r).CreateMultisigTransaction(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, transaction)
+}
+
+func (h *Handler) SignMultisigTransaction(w http.ResponseWriter, r *http.Request) {
+	var dto SignMultisigTransactionDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	signedTx, err := h.service(r).SignMultisigTransaction(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, signedTx)
+}
+
+func (h *Handler) CombineMultisigTransactions(w http.ResponseWriter, r *http.Request) {
+	var dto CombineMultisigTransactionsDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	combinedTx, err := h.service(r).CombineMultisigTransactions(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, combinedTx)
+}
+
+func (h *Handler) FinalizeMultisigTransaction(w http.ResponseWriter, r *http.Request) {
+	var dto FinalizeMultisigTransactionDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	finalTx, err := h.service(r).FinalizeMultisigTransaction(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, finalTx)
+}
+
+func (h *Handler) DescriptorInfo(w http.ResponseWriter, r *http.Request) {
+	var dto DescriptorDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	info, err := h.service(r).DescriptorInfo(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, info)
+}
+
+func (h *Handler) ImportDescriptors(w http.ResponseWriter, r *http.Request) {
+	var dto ImportDescriptorsDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	imported, err := h.service(r).ImportDescriptors(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, imported)
+}
+
+func (h *Handler) DeriveAddresses(w http.ResponseWriter, r *http.Request) {
+	var dto DeriveAddressesDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	addresses, err := h.service(r).DeriveAddresses(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, addresses)
+}
+
+func (h *Handler) Block(w http.ResponseWriter, r *http.Request) {
+	var dto BlockDTO
+
+	if err := params.Decode(r, &dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	block, err := h.service(r).Block(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, block)
+}
+
+func (h *Handler) Transaction(w http.ResponseWriter, r *http.Request) {
+	var dto TransactionDTO
+
+	if err := params.Decode(r, &dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	tx, err := h.service(r).Transaction(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, tx)
+}
+
+func (h *Handler) MempoolInfo(w http.ResponseWriter, r *http.Request) {
+	var dto MempoolDTO
+
+	if err := params.Decode(r, &dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	info, err := h.service(r).MempoolInfo(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, info)
+}
+
+func (h *Handler) MempoolEntry(w http.ResponseWriter, r *http.Request) {
+	var dto MempoolEntryDTO
+
+	if err := params.Decode(r, &dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	entry, err := h.service(r).MempoolEntry(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, entry)
+}
+
+func (h *Handler) TxOut(w http.ResponseWriter, r *http.Request) {
+	var dto TxOutDTO
+
+	if err := params.Decode(r, &dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	out, err := h.service(r).TxOut(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, out)
+}
@@ -1,10 +1,16 @@
 package bitcoin_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"nn-blockchain-api/internal/bitcoin"
 	mock_bitcoin "nn-blockchain-api/internal/bitcoin/mocks"
+	"nn-blockchain-api/pkg/auth"
+	mock_auth "nn-blockchain-api/pkg/auth/mocks"
+	"strings"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -14,33 +20,116 @@ func TestNewHandler(t *testing.T) {
 	defer controller.Finish()
 
 	tests := []struct {
-		name   string
-		btcSvc bitcoin.Service
-		expect func(*testing.T, *bitcoin.Handler, error)
+		name    string
+		btcSvcs map[string]bitcoin.Service
+		guard   auth.Guard
+		expect  func(*testing.T, *bitcoin.Handler, error)
 	}{
 		{
-			name:   "should return service",
-			btcSvc: mock_bitcoin.NewMockService(controller),
+			name:    "should return service",
+			btcSvcs: map[string]bitcoin.Service{"bitcoin": mock_bitcoin.NewMockService(controller)},
+			guard:   mock_auth.NewMockGuard(controller),
 			expect: func(t *testing.T, s *bitcoin.Handler, err error) {
 				assert.NotNil(t, s)
 				assert.Nil(t, err)
 			},
 		},
 		{
-			name:   "should return invalid bitcoin service",
-			btcSvc: nil,
+			name:    "should return service without bitcoin",
+			btcSvcs: map[string]bitcoin.Service{"litecoin": mock_bitcoin.NewMockService(controller)},
+			guard:   mock_auth.NewMockGuard(controller),
+			expect: func(t *testing.T, s *bitcoin.Handler, err error) {
+				assert.NotNil(t, s)
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name:    "should return invalid chain service",
+			btcSvcs: map[string]bitcoin.Service{"bitcoin": mock_bitcoin.NewMockService(controller), "dogecoin": nil},
+			guard:   mock_auth.NewMockGuard(controller),
+			expect: func(t *testing.T, s *bitcoin.Handler, err error) {
+				assert.Nil(t, s)
+				assert.NotNil(t, err)
+				assert.EqualError(t, err, "invalid dogecoin service")
+			},
+		},
+		{
+			name:    "should return invalid guard",
+			btcSvcs: map[string]bitcoin.Service{"bitcoin": mock_bitcoin.NewMockService(controller)},
+			guard:   nil,
 			expect: func(t *testing.T, s *bitcoin.Handler, err error) {
 				assert.Nil(t, s)
 				assert.NotNil(t, err)
-				assert.EqualError(t, err, "invalid bitcoin service")
+				assert.EqualError(t, err, "invalid guard")
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := bitcoin.NewHandler(tc.btcSvc)
+			svc, err := bitcoin.NewHandler(tc.btcSvcs, tc.guard)
 			tc.expect(t, svc, err)
 		})
 	}
 }
+
+func TestHandler_Chain(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	btcSvc := mock_bitcoin.NewMockService(controller)
+	ltcSvc := mock_bitcoin.NewMockService(controller)
+
+	guard, err := auth.NewGuard(auth.NewMultiKeyStore(), false)
+	assert.Nil(t, err)
+	handler, err := bitcoin.NewHandler(map[string]bitcoin.Service{"bitcoin": btcSvc, "litecoin": ltcSvc}, guard)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	router.Route("/bitcoin", handler.SetupRoutes)
+	router.Route("/litecoin", func(r chi.Router) {
+		handler.SetupChainRoutes("litecoin", r)
+	})
+	router.Route("/dogecoin", func(r chi.Router) {
+		handler.SetupChainRoutes("dogecoin", r)
+	})
+
+	tests := []struct {
+		name   string
+		path   string
+		expect func()
+		code   int
+	}{
+		{
+			name: "bitcoin route",
+			path: "/bitcoin/status",
+			expect: func() {
+				btcSvc.EXPECT().StatusNode(gomock.Any(), &bitcoin.StatusNodeDTO{Network: "main"}).Return(&bitcoin.StatusNodeInfoDTO{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "litecoin route",
+			path: "/litecoin/status",
+			expect: func() {
+				ltcSvc.EXPECT().StatusNode(gomock.Any(), &bitcoin.StatusNodeDTO{Network: "main"}).Return(&bitcoin.StatusNodeInfoDTO{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "unconfigured chain",
+			path:   "/dogecoin/status",
+			expect: func() {},
+			code:   http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.expect()
+
+			res := httptest.NewRecorder()
+			router.ServeHTTP(res, httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(`{"network":"main"}`)))
+			assert.Equal(t, tt.code, res.Code)
+		})
+	}
+}
@@ -35,6 +35,66 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Block mocks base method.
+func (m *MockService) Block(ctx context.Context, dto *bitcoin.BlockDTO) (*bitcoin.BlockInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.BlockInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockServiceMockRecorder) Block(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockService)(nil).Block), ctx, dto)
+}
+
+// CombineMultisigTransactions mocks base method.
+func (m *MockService) CombineMultisigTransactions(ctx context.Context, dto *bitcoin.CombineMultisigTransactionsDTO) (*bitcoin.CombinedMultisigTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CombineMultisigTransactions", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.CombinedMultisigTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CombineMultisigTransactions indicates an expected call of CombineMultisigTransactions.
+func (mr *MockServiceMockRecorder) CombineMultisigTransactions(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CombineMultisigTransactions", reflect.TypeOf((*MockService)(nil).CombineMultisigTransactions), ctx, dto)
+}
+
+// CreateMultisig mocks base method.
+func (m *MockService) CreateMultisig(ctx context.Context, dto *bitcoin.CreateMultisigDTO) (*bitcoin.MultisigDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultisig", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.MultisigDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMultisig indicates an expected call of CreateMultisig.
+func (mr *MockServiceMockRecorder) CreateMultisig(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultisig", reflect.TypeOf((*MockService)(nil).CreateMultisig), ctx, dto)
+}
+
+// CreateMultisigTransaction mocks base method.
+func (m *MockService) CreateMultisigTransaction(ctx context.Context, dto *bitcoin.CreateMultisigTransactionDTO) (*bitcoin.CreatedMultisigTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultisigTransaction", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.CreatedMultisigTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMultisigTransaction indicates an expected call of CreateMultisigTransaction.
+func (mr *MockServiceMockRecorder) CreateMultisigTransaction(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultisigTransaction", reflect.TypeOf((*MockService)(nil).CreateMultisigTransaction), ctx, dto)
+}
+
 // CreateTransaction mocks base method.
 func (m *MockService) CreateTransaction(ctx context.Context, dto *bitcoin.CreateRawTransactionDTO) (*bitcoin.CreatedRawTransactionDTO, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +140,51 @@ func (mr *MockServiceMockRecorder) DecodeTransaction(ctx, dto interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeTransaction", reflect.TypeOf((*MockService)(nil).DecodeTransaction), ctx, dto)
 }
 
+// DeriveAddresses mocks base method.
+func (m *MockService) DeriveAddresses(ctx context.Context, dto *bitcoin.DeriveAddressesDTO) (*bitcoin.DerivedAddressesDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeriveAddresses", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.DerivedAddressesDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveAddresses indicates an expected call of DeriveAddresses.
+func (mr *MockServiceMockRecorder) DeriveAddresses(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveAddresses", reflect.TypeOf((*MockService)(nil).DeriveAddresses), ctx, dto)
+}
+
+// DescriptorInfo mocks base method.
+func (m *MockService) DescriptorInfo(ctx context.Context, dto *bitcoin.DescriptorDTO) (*bitcoin.DescriptorInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescriptorInfo", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.DescriptorInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescriptorInfo indicates an expected call of DescriptorInfo.
+func (mr *MockServiceMockRecorder) DescriptorInfo(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescriptorInfo", reflect.TypeOf((*MockService)(nil).DescriptorInfo), ctx, dto)
+}
+
+// FinalizeMultisigTransaction mocks base method.
+func (m *MockService) FinalizeMultisigTransaction(ctx context.Context, dto *bitcoin.FinalizeMultisigTransactionDTO) (*bitcoin.SignedMultisigTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalizeMultisigTransaction", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.SignedMultisigTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinalizeMultisigTransaction indicates an expected call of FinalizeMultisigTransaction.
+func (mr *MockServiceMockRecorder) FinalizeMultisigTransaction(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizeMultisigTransaction", reflect.TypeOf((*MockService)(nil).FinalizeMultisigTransaction), ctx, dto)
+}
+
 // FoundForRawTransaction mocks base method.
 func (m *MockService) FoundForRawTransaction(ctx context.Context, dto *bitcoin.FundForRawTransactionDTO) (*bitcoin.FundedRawTransactionDTO, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +215,21 @@ func (mr *MockServiceMockRecorder) ImportAddress(ctx, dto interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAddress", reflect.TypeOf((*MockService)(nil).ImportAddress), ctx, dto)
 }
 
+// ImportDescriptors mocks base method.
+func (m *MockService) ImportDescriptors(ctx context.Context, dto *bitcoin.ImportDescriptorsDTO) (*bitcoin.ImportDescriptorsInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportDescriptors", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.ImportDescriptorsInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportDescriptors indicates an expected call of ImportDescriptors.
+func (mr *MockServiceMockRecorder) ImportDescriptors(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportDescriptors", reflect.TypeOf((*MockService)(nil).ImportDescriptors), ctx, dto)
+}
+
 // ListUnspent mocks base method.
 func (m *MockService) ListUnspent(ctx context.Context, dto *bitcoin.ListUnspentDTO) (*bitcoin.ListUnspentInfoDTO, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +260,36 @@ func (mr *MockServiceMockRecorder) LoadWaller(ctx, dto interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadWaller", reflect.TypeOf((*MockService)(nil).LoadWaller), ctx, dto)
 }
 
+// MempoolEntry mocks base method.
+func (m *MockService) MempoolEntry(ctx context.Context, dto *bitcoin.MempoolEntryDTO) (*bitcoin.MempoolEntryInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MempoolEntry", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.MempoolEntryInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MempoolEntry indicates an expected call of MempoolEntry.
+func (mr *MockServiceMockRecorder) MempoolEntry(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MempoolEntry", reflect.TypeOf((*MockService)(nil).MempoolEntry), ctx, dto)
+}
+
+// MempoolInfo mocks base method.
+func (m *MockService) MempoolInfo(ctx context.Context, dto *bitcoin.MempoolDTO) (*bitcoin.MempoolInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MempoolInfo", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.MempoolInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MempoolInfo indicates an expected call of MempoolInfo.
+func (mr *MockServiceMockRecorder) MempoolInfo(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MempoolInfo", reflect.TypeOf((*MockService)(nil).MempoolInfo), ctx, dto)
+}
+
 // RescanWallet mocks base method.
 func (m *MockService) RescanWallet(ctx context.Context, dto *bitcoin.RescanWalletDTO) (*bitcoin.RescanWalletInfoDTO, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +320,21 @@ func (mr *MockServiceMockRecorder) SendTransaction(ctx, dto interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransaction", reflect.TypeOf((*MockService)(nil).SendTransaction), ctx, dto)
 }
 
+// SignMultisigTransaction mocks base method.
+func (m *MockService) SignMultisigTransaction(ctx context.Context, dto *bitcoin.SignMultisigTransactionDTO) (*bitcoin.SignedMultisigTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignMultisigTransaction", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.SignedMultisigTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignMultisigTransaction indicates an expected call of SignMultisigTransaction.
+func (mr *MockServiceMockRecorder) SignMultisigTransaction(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMultisigTransaction", reflect.TypeOf((*MockService)(nil).SignMultisigTransaction), ctx, dto)
+}
+
 // SignTransaction mocks base method.
 func (m *MockService) SignTransaction(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) (*bitcoin.SignedRawTransactionDTO, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +350,21 @@ func (mr *MockServiceMockRecorder) SignTransaction(ctx, dto interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockService)(nil).SignTransaction), ctx, dto)
 }
 
+// SimulateTransaction mocks base method.
+func (m *MockService) SimulateTransaction(ctx context.Context, dto *bitcoin.SimulateTransactionDTO) (*bitcoin.SimulationDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTransaction", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.SimulationDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTransaction indicates an expected call of SimulateTransaction.
+func (mr *MockServiceMockRecorder) SimulateTransaction(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTransaction", reflect.TypeOf((*MockService)(nil).SimulateTransaction), ctx, dto)
+}
+
 // StatusNode mocks base method.
 func (m *MockService) StatusNode(ctx context.Context, dto *bitcoin.StatusNodeDTO) (*bitcoin.StatusNodeInfoDTO, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +380,36 @@ func (mr *MockServiceMockRecorder) StatusNode(ctx, dto interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatusNode", reflect.TypeOf((*MockService)(nil).StatusNode), ctx, dto)
 }
 
+// Transaction mocks base method.
+func (m *MockService) Transaction(ctx context.Context, dto *bitcoin.TransactionDTO) (*bitcoin.TransactionInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.TransactionInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockServiceMockRecorder) Transaction(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockService)(nil).Transaction), ctx, dto)
+}
+
+// TxOut mocks base method.
+func (m *MockService) TxOut(ctx context.Context, dto *bitcoin.TxOutDTO) (*bitcoin.TxOutInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxOut", ctx, dto)
+	ret0, _ := ret[0].(*bitcoin.TxOutInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxOut indicates an expected call of TxOut.
+func (mr *MockServiceMockRecorder) TxOut(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxOut", reflect.TypeOf((*MockService)(nil).TxOut), ctx, dto)
+}
+
 // WalletInfo mocks base method.
 func (m *MockService) WalletInfo(ctx context.Context, dto *bitcoin.WalletDTO) (*bitcoin.WalletInfoDTO, error) {
 	m.ctrl.T.Helper()
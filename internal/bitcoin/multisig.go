@@ -0,0 +1,274 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/hex"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/hd"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"nn-blockchain-api/pkg/storage"
+	"nn-blockchain-api/pkg/tracing"
+	"sort"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+)
+
+func (s *service) CreateMultisig(ctx context.Context, dto *CreateMultisigDTO) (*MultisigDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/CreateMultisig")
+	defer span.End()
+
+	keys, err := multisigKeys(dto.Keys, dto.Index)
+	if err != nil {
+		return nil, err
+	}
+	if dto.Required > len(keys) {
+		return nil, errors.WithMessage(ErrInvalidRequest, "%d signatures required of %d keys", dto.Required, len(keys))
+	}
+
+	multisig, err := s.btcRpcSvc.CreateMultisig(ctx, dto.Required, keys, dto.ScriptType, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed create multisig: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedCreateMultisig, err)
+	}
+
+	script := bitcoin_rpc.MultisigScript{WitnessScript: multisig.RedeemScript, ScriptType: dto.ScriptType}
+	redeemScript, err := script.RedeemScript()
+	if err != nil {
+		return nil, errors.Wrap(ErrFailedCreateMultisig, err)
+	}
+	scriptPubKey, err := script.ScriptPubKey()
+	if err != nil {
+		return nil, errors.Wrap(ErrFailedCreateMultisig, err)
+	}
+
+	return &MultisigDTO{
+		Address:       multisig.Address,
+		ScriptType:    dto.ScriptType,
+		Required:      dto.Required,
+		Keys:          keys,
+		WitnessScript: multisig.RedeemScript,
+		RedeemScript:  redeemScript,
+		ScriptPubKey:  scriptPubKey,
+		Descriptor:    multisig.Descriptor,
+	}, nil
+}
+
+func (s *service) CreateMultisigTransaction(ctx context.Context, dto *CreateMultisigTransactionDTO) (*CreatedMultisigTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/CreateMultisigTransaction")
+	defer span.End()
+
+	script := bitcoin_rpc.MultisigScript{WitnessScript: dto.WitnessScript, ScriptType: dto.ScriptType}
+	changeAddress := dto.ChangeAddress
+	if changeAddress == "" {
+		address, err := script.Address(s.coin, dto.Network)
+		if err != nil {
+			return nil, errors.WithMessage(ErrInvalidRequest, err.Error())
+		}
+		changeAddress = address
+	}
+
+	utxos := make(bitcoin_rpc.UTXO, len(dto.Utxo))
+	for i, utxo := range dto.Utxo {
+		utxos[i].TxId, utxos[i].Vout, utxos[i].Amount = utxo.TxId, utxo.Vout, utxo.Amount
+	}
+
+	tx, fee, err := s.btcRpcSvc.CreateMultisigTransaction(ctx, utxos, script, dto.ToAddress, changeAddress, dto.Amount, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed create multisig transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedCreateMultisigTx, err)
+	}
+
+	prevTxs, err := script.PrevTxs(utxos)
+	if err != nil {
+		return nil, errors.Wrap(ErrFailedCreateMultisigTx, err)
+	}
+
+	if _, err := storage.RecordCreatedTx(ctx, s.store, s.coin.Name, dto.Network, *tx, *fee); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record created transaction: %v", err)
+	}
+
+	created := &CreatedMultisigTransactionDTO{Tx: *tx, Fee: *fee}
+	for i, prevTx := range prevTxs {
+		created.PrevTxs = append(created.PrevTxs, PrevTxDTO{
+			TxId:          prevTx.TxId,
+			Vout:          prevTx.Vout,
+			ScriptPubKey:  prevTx.ScriptPubKey,
+			RedeemScript:  prevTx.RedeemScript,
+			WitnessScript: prevTx.WitnessScript,
+			Amount:        utxos[i].Amount,
+		})
+	}
+	return created, nil
+}
+
+// SignMultisigTransaction adds one co-signer's signatures. The multisig address is treated
+// as the signer's own, so change going back to it is not counted as spend by the policies.
+func (s *service) SignMultisigTransaction(ctx context.Context, dto *SignMultisigTransactionDTO) (*SignedMultisigTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/SignMultisigTransaction")
+	defer span.End()
+
+	signed, complete, err := s.signMultisig(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed sign multisig transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSignMultisigTx, err)
+	}
+
+	if complete {
+		if _, err := storage.RecordSignedTx(ctx, s.store, s.coin.Name, dto.Network, dto.Tx, signed); err != nil {
+			tracing.Logger(ctx, s.logger).Warnf("failed record signed transaction: %v", err)
+		}
+	}
+
+	return &SignedMultisigTransactionDTO{Tx: signed, Complete: complete}, nil
+}
+
+func (s *service) signMultisig(ctx context.Context, dto *SignMultisigTransactionDTO) (string, bool, error) {
+	prevTxs, addresses, err := multisigPrevTxs(s.coin, dto.PrevTxs, dto.Network)
+	if err != nil {
+		return "", false, err
+	}
+
+	tx, err := s.decode(dto.Tx, dto.Network, s.prevout(ctx, dto.Network))
+	if err != nil {
+		return "", false, err
+	}
+
+	key := signer{KeyId: dto.KeyId, Passphrase: dto.Passphrase, PrivateKey: dto.PrivateKey, Network: dto.Network}
+	subject, err := s.subject(ctx, key)
+	if err != nil {
+		return "", false, err
+	}
+	subject.Addresses = append(subject.Addresses, addresses...)
+
+	reservation, err := s.policies.CheckSign(ctx, tx, subject, dto.ApprovalId, auth.NameFromContext(ctx))
+	if err != nil {
+		return "", false, err
+	}
+
+	privateKey, err := s.privateKey(key)
+	if err != nil {
+		if err := s.policies.Release(ctx, reservation); err != nil {
+			tracing.Logger(ctx, s.logger).Warnf("failed release policy reservation: %v", err)
+		}
+		return "", false, err
+	}
+	signed, complete, err := s.btcRpcSvc.SignMultisigTransaction(ctx, dto.Tx, []string{privateKey}, prevTxs, dto.Network)
+	if err != nil {
+		if err := s.policies.Release(ctx, reservation); err != nil {
+			tracing.Logger(ctx, s.logger).Warnf("failed release policy reservation: %v", err)
+		}
+		return "", false, err
+	}
+	return signed, complete, nil
+}
+
+func (s *service) CombineMultisigTransactions(ctx context.Context, dto *CombineMultisigTransactionsDTO) (*CombinedMultisigTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/CombineMultisigTransactions")
+	defer span.End()
+
+	tx, err := s.btcRpcSvc.CombineTransactions(ctx, dto.Txs, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed combine multisig transactions: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedCombineMultisigTxs, err)
+	}
+
+	return &CombinedMultisigTransactionDTO{Tx: tx}, nil
+}
+
+// FinalizeMultisigTransaction checks that the collected signatures complete the transaction,
+// it signs nothing itself so no policy applies.
+func (s *service) FinalizeMultisigTransaction(ctx context.Context, dto *FinalizeMultisigTransactionDTO) (*SignedMultisigTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/FinalizeMultisigTransaction")
+	defer span.End()
+
+	prevTxs, _, err := multisigPrevTxs(s.coin, dto.PrevTxs, dto.Network)
+	if err != nil {
+		return nil, errors.Wrap(ErrFailedFinalizeMultisigTx, err)
+	}
+
+	tx, complete, err := s.btcRpcSvc.SignMultisigTransaction(ctx, dto.Tx, nil, prevTxs, dto.Network)
+	if err == nil && !complete {
+		err = errors.NewRejected(errors.StatusSigningIncomplete, "transaction lacks signatures of the required co-signers")
+	}
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed finalize multisig transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedFinalizeMultisigTx, err)
+	}
+
+	if _, err := storage.RecordSignedTx(ctx, s.store, s.coin.Name, dto.Network, dto.Tx, tx); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record signed transaction: %v", err)
+	}
+
+	return &SignedMultisigTransactionDTO{Tx: tx, Complete: true}, nil
+}
+
+// multisigKeys resolves hex public keys and xpubs to sorted compressed public keys.
+func multisigKeys(keys []string, index uint32) ([]string, error) {
+	resolved := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if data, err := hex.DecodeString(key); err == nil {
+			pubKey, err := btcec.ParsePubKey(data, btcec.S256())
+			if err != nil {
+				return nil, errors.WithMessage(ErrInvalidRequest, "invalid public key %s", key)
+			}
+			resolved = append(resolved, hex.EncodeToString(pubKey.SerializeCompressed()))
+			continue
+		}
+
+		pubKey, err := hd.ChildPublicKey(key, hd.ChainReceive, index)
+		if err != nil {
+			return nil, errors.WithMessage(ErrInvalidRequest, "invalid key %s: %v", key, err)
+		}
+		resolved = append(resolved, pubKey)
+	}
+
+	sort.Strings(resolved)
+	for i := 1; i < len(resolved); i++ {
+		if strings.EqualFold(resolved[i], resolved[i-1]) {
+			return nil, errors.WithMessage(ErrInvalidRequest, "duplicate public key %s", resolved[i])
+		}
+	}
+	return resolved, nil
+}
+
+// multisigPrevTxs checks each output script against its witness script and returns the
+// multisig addresses being spent.
+func multisigPrevTxs(coin bitcoin_rpc.Chain, dtos []PrevTxDTO, network string) ([]bitcoin_rpc.PrevTx, []string, error) {
+	prevTxs := make([]bitcoin_rpc.PrevTx, 0, len(dtos))
+	var addresses []string
+	for _, dto := range dtos {
+		script := bitcoin_rpc.MultisigScript{WitnessScript: dto.WitnessScript, ScriptType: bitcoin_rpc.ScriptTypeP2WSH}
+		if dto.RedeemScript != "" {
+			script.ScriptType = bitcoin_rpc.ScriptTypeP2SHP2WSH
+		}
+
+		scriptPubKey, err := script.ScriptPubKey()
+		if err != nil {
+			return nil, nil, errors.WithMessage(ErrInvalidRequest, "prev tx %s:%d: %v", dto.TxId, dto.Vout, err)
+		}
+		redeemScript, _ := script.RedeemScript()
+		if !strings.EqualFold(scriptPubKey, dto.ScriptPubKey) || !strings.EqualFold(redeemScript, dto.RedeemScript) {
+			return nil, nil, errors.WithMessage(ErrInvalidRequest, "prev tx %s:%d does not pay to its witness script", dto.TxId, dto.Vout)
+		}
+		address, _ := script.Address(coin, network)
+		addresses = append(addresses, address)
+
+		prevTxs = append(prevTxs, bitcoin_rpc.PrevTx{
+			TxId:          dto.TxId,
+			Vout:          dto.Vout,
+			ScriptPubKey:  dto.ScriptPubKey,
+			RedeemScript:  dto.RedeemScript,
+			WitnessScript: dto.WitnessScript,
+			Amount:        btcutil.Amount(dto.Amount).ToBTC(),
+		})
+	}
+	return prevTxs, addresses, nil
+}
@@ -0,0 +1,377 @@
+package bitcoin_test
+
+import (
+	"context"
+	"nn-blockchain-api/internal/bitcoin"
+	mock_wallet "nn-blockchain-api/internal/wallet/mocks"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/keystore"
+	mock_keystore "nn-blockchain-api/pkg/keystore/mocks"
+	"nn-blockchain-api/pkg/logger"
+	"nn-blockchain-api/pkg/policy"
+	mock_policy "nn-blockchain-api/pkg/policy/mocks"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	mock_bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// witnessScript is 2-of-2 over the key of the test WIF and the first BIP84 test vector key.
+	witnessScript   = "522102d0de0aaeaefad02b8bdc8a01a1b8b11c696bd3d66a2c5f10780d95b7df42645c210330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c52ae"
+	multisigAddress = "tb1qx804twjj7e8rkrp4h9yhd0f32h5a7x7c5ltk29mjaam4d09pkaeq44uhda"
+	multisigOutput  = "002031df55ba52f64e3b0c35b94976bd3155e9df1bd8a7d7651772ef7756bca1b772"
+	nestedAddress   = "2N9cci8JcikgVXnJ1Lsd1EQABJrf5JsnKaz"
+	nestedOutput    = "a914b38db6f205a83fb64671c5e6ad4096a8c8c664d287"
+	zpub            = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+)
+
+func newMockedService(t *testing.T, controller *gomock.Controller) (bitcoin.Service, *mock_bitcoin_rpc.MockService, *mock_keystore.MockKeystore, *mock_policy.MockEngine) {
+	btcRpcSvc := mock_bitcoin_rpc.NewMockService(controller)
+	keys := mock_keystore.NewMockKeystore(controller)
+	policies := mock_policy.NewMockEngine(controller)
+
+	newLogger, _ := logger.NewLogger("development")
+	zapLogger, _ := newLogger.SetupZapLogger()
+	service, err := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), keys, policies, newStorage(t), zapLogger)
+	assert.Nil(t, err)
+	return service, btcRpcSvc, keys, policies
+}
+
+func TestService_CreateMultisig(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+	keys := []string{"02d0de0aaeaefad02b8bdc8a01a1b8b11c696bd3d66a2c5f10780d95b7df42645c", "0330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c"}
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.CreateMultisigDTO
+		setup  func()
+		expect func(t *testing.T, multisig *bitcoin.MultisigDTO, err error)
+	}{
+		{
+			name: "should sort keys and resolve xpubs",
+			dto:  &bitcoin.CreateMultisigDTO{Required: 2, Keys: []string{zpub, keys[0]}, ScriptType: "p2sh-p2wsh", Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().CreateMultisig(gomock.Any(), 2, keys, "p2sh-p2wsh", "test").
+					Return(&bitcoin_rpc.Multisig{Address: nestedAddress, RedeemScript: witnessScript, Descriptor: "sh(wsh(multi(...)))"}, nil)
+			},
+			expect: func(t *testing.T, multisig *bitcoin.MultisigDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.MultisigDTO{
+					Address:       nestedAddress,
+					ScriptType:    "p2sh-p2wsh",
+					Required:      2,
+					Keys:          keys,
+					WitnessScript: witnessScript,
+					RedeemScript:  multisigOutput,
+					ScriptPubKey:  nestedOutput,
+					Descriptor:    "sh(wsh(multi(...)))",
+				}, multisig)
+			},
+		},
+		{
+			name:  "should reject more signatures than keys",
+			dto:   &bitcoin.CreateMultisigDTO{Required: 3, Keys: keys, ScriptType: "p2wsh", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, multisig *bitcoin.MultisigDTO, err error) {
+				assert.Nil(t, multisig)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "3 signatures required of 2 keys"), err)
+			},
+		},
+		{
+			name:  "should reject duplicate keys",
+			dto:   &bitcoin.CreateMultisigDTO{Required: 1, Keys: []string{keys[1], zpub}, ScriptType: "p2wsh", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, multisig *bitcoin.MultisigDTO, err error) {
+				assert.Nil(t, multisig)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "duplicate public key %s", keys[1]), err)
+			},
+		},
+		{
+			name:  "should reject invalid public key",
+			dto:   &bitcoin.CreateMultisigDTO{Required: 1, Keys: []string{"02d0de"}, ScriptType: "p2wsh", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, multisig *bitcoin.MultisigDTO, err error) {
+				assert.Nil(t, multisig)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "invalid public key 02d0de"), err)
+			},
+		},
+		{
+			name: "should return error",
+			dto:  &bitcoin.CreateMultisigDTO{Required: 2, Keys: keys, ScriptType: "p2wsh", Network: "test"},
+			setup: func() {
+				btcRpcSvc.EXPECT().CreateMultisig(gomock.Any(), 2, keys, "p2wsh", "test").Return(nil, bitcoin.ErrFailedCreateMultisig)
+			},
+			expect: func(t *testing.T, multisig *bitcoin.MultisigDTO, err error) {
+				assert.Nil(t, multisig)
+				assert.Equal(t, bitcoin.ErrFailedCreateMultisig, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			multisig, err := service.CreateMultisig(context.Background(), tc.dto)
+			tc.expect(t, multisig, err)
+		})
+	}
+}
+
+func TestService_CreateMultisigTransaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+
+	dto := &bitcoin.CreateMultisigTransactionDTO{
+		WitnessScript: witnessScript,
+		ScriptType:    "p2wsh",
+		ToAddress:     "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		Amount:        100000,
+		Network:       "test",
+	}
+	dto.Utxo = append(dto.Utxo, struct {
+		TxId   string `json:"txid" validate:"required,txid"`
+		Vout   int64  `json:"vout" validate:"gte=0"`
+		Amount int64  `json:"amount" validate:"required"`
+	}{TxId: "989d301c546841d0ac5c8354c7d78079e3603b089682d1639b2ee1c1a8010c6a", Vout: 1, Amount: 1045428})
+
+	utxos := make(bitcoin_rpc.UTXO, 1)
+	utxos[0].TxId, utxos[0].Vout, utxos[0].Amount = dto.Utxo[0].TxId, dto.Utxo[0].Vout, dto.Utxo[0].Amount
+	script := bitcoin_rpc.MultisigScript{WitnessScript: witnessScript, ScriptType: "p2wsh"}
+
+	invalid := *dto
+	invalid.WitnessScript = "76a914"
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.CreateMultisigTransactionDTO
+		setup  func()
+		expect func(t *testing.T, created *bitcoin.CreatedMultisigTransactionDTO, err error)
+	}{
+		{
+			name: "should return change to the multisig address",
+			dto:  dto,
+			setup: func() {
+				tx, fee := "tx", 0.00000500
+				btcRpcSvc.EXPECT().CreateMultisigTransaction(gomock.Any(), utxos, script, dto.ToAddress, multisigAddress, int64(100000), "test").Return(&tx, &fee, nil)
+			},
+			expect: func(t *testing.T, created *bitcoin.CreatedMultisigTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.CreatedMultisigTransactionDTO{
+					Tx:  "tx",
+					Fee: 0.00000500,
+					PrevTxs: []bitcoin.PrevTxDTO{{
+						TxId:          dto.Utxo[0].TxId,
+						Vout:          1,
+						ScriptPubKey:  multisigOutput,
+						WitnessScript: witnessScript,
+						Amount:        1045428,
+					}},
+				}, created)
+			},
+		},
+		{
+			name:  "should reject script that is not multisig",
+			dto:   &invalid,
+			setup: func() {},
+			expect: func(t *testing.T, created *bitcoin.CreatedMultisigTransactionDTO, err error) {
+				assert.Nil(t, created)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "witness script is not a multisig script"), err)
+			},
+		},
+		{
+			name: "should keep insufficient funds",
+			dto:  dto,
+			setup: func() {
+				btcRpcSvc.EXPECT().CreateMultisigTransaction(gomock.Any(), utxos, script, dto.ToAddress, multisigAddress, int64(100000), "test").
+					Return(nil, nil, errors.NewRejected(errors.StatusInsufficientFunds, "your balance too low for this transaction"))
+			},
+			expect: func(t *testing.T, created *bitcoin.CreatedMultisigTransactionDTO, err error) {
+				assert.Nil(t, created)
+				assert.Equal(t, errors.NewRejected(errors.StatusInsufficientFunds, "your balance too low for this transaction"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			created, err := service.CreateMultisigTransaction(context.Background(), tc.dto)
+			tc.expect(t, created, err)
+		})
+	}
+}
+
+func TestService_SignMultisigTransaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, keys, policies := newMockedService(t, controller)
+
+	prevTx := bitcoin.PrevTxDTO{
+		TxId:          "989d301c546841d0ac5c8354c7d78079e3603b089682d1639b2ee1c1a8010c6a",
+		Vout:          1,
+		ScriptPubKey:  multisigOutput,
+		WitnessScript: witnessScript,
+		Amount:        1045428,
+	}
+	prevTxs := []bitcoin_rpc.PrevTx{{TxId: prevTx.TxId, Vout: 1, ScriptPubKey: multisigOutput, WitnessScript: witnessScript, Amount: 0.01045428}}
+
+	dto := &bitcoin.SignMultisigTransactionDTO{
+		Tx:         unsignedTx,
+		PrivateKey: "cMzLdeGd5vEqxB8B6VFQoRopQ3sLAAvEzDAoQgvX54xwofSWj1fx",
+		PrevTxs:    []bitcoin.PrevTxDTO{prevTx},
+		Network:    "test",
+	}
+
+	keyDto := *dto
+	keyDto.PrivateKey, keyDto.KeyId, keyDto.Passphrase = "", "key", "secret"
+
+	mismatched := *dto
+	mismatched.PrevTxs = []bitcoin.PrevTxDTO{prevTx}
+	mismatched.PrevTxs[0].ScriptPubKey = nestedOutput
+
+	reservation := &policy.Reservation{}
+	// The policies see the amount the node has for the spent output, not the one of the request.
+	btcRpcSvc.EXPECT().GetTxOut(gomock.Any(), unsignedPrevTxId, int64(1), true, "test").Return(&bitcoin_rpc.TxOut{Value: 0.0101}, nil).AnyTimes()
+	decoded, err := decodeTestnet(unsignedTx, func(txid string, vout uint32) (int64, bool, error) {
+		return 1010000, txid == unsignedPrevTxId && vout == 1, nil
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		dto    *bitcoin.SignMultisigTransactionDTO
+		setup  func()
+		expect func(t *testing.T, signed *bitcoin.SignedMultisigTransactionDTO, err error)
+	}{
+		{
+			name: "should return partially signed transaction",
+			dto:  dto,
+			setup: func() {
+				keys.EXPECT().AllowsRawKeys().Return(true)
+				subject := policy.Subject{Addresses: []string{"n1KSZGmQgB8iSZqv6UVhGkCGUbEdw8Lm3Q", "tb1qmy63mjadtw8nhzl69ukdepwzsyvv4yex7xygd7", multisigAddress}}
+				policies.EXPECT().CheckSign(gomock.Any(), decoded, subject, "", "").Return(reservation, nil)
+				btcRpcSvc.EXPECT().SignMultisigTransaction(gomock.Any(), unsignedTx, []string{dto.PrivateKey}, prevTxs, "test").Return("partial", false, nil)
+			},
+			expect: func(t *testing.T, signed *bitcoin.SignedMultisigTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.SignedMultisigTransactionDTO{Tx: "partial"}, signed)
+			},
+		},
+		{
+			name: "should sign with keystore key",
+			dto:  &keyDto,
+			setup: func() {
+				keys.EXPECT().Get("key").Return(&keystore.Key{Id: "key", Chain: "bitcoin", Network: "test", Address: "tb1qmy63mjadtw8nhzl69ukdepwzsyvv4yex7xygd7"}, nil)
+				subject := policy.Subject{KeyId: "key", Addresses: []string{"tb1qmy63mjadtw8nhzl69ukdepwzsyvv4yex7xygd7", multisigAddress}}
+				policies.EXPECT().CheckSign(gomock.Any(), decoded, subject, "", "").Return(reservation, nil)
+				keys.EXPECT().Unlock("key", "secret").Return("wif", nil)
+				btcRpcSvc.EXPECT().SignMultisigTransaction(gomock.Any(), unsignedTx, []string{"wif"}, prevTxs, "test").Return("signed", true, nil)
+			},
+			expect: func(t *testing.T, signed *bitcoin.SignedMultisigTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.SignedMultisigTransactionDTO{Tx: "signed", Complete: true}, signed)
+			},
+		},
+		{
+			name:  "should reject output not paying to the witness script",
+			dto:   &mismatched,
+			setup: func() {},
+			expect: func(t *testing.T, signed *bitcoin.SignedMultisigTransactionDTO, err error) {
+				assert.Nil(t, signed)
+				assert.Equal(t, errors.WithMessage(bitcoin.ErrInvalidRequest, "prev tx %s:1 does not pay to its witness script", prevTx.TxId), err)
+			},
+		},
+		{
+			name: "should return policy denial",
+			dto:  dto,
+			setup: func() {
+				keys.EXPECT().AllowsRawKeys().Return(true)
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "", "").Return(nil, policy.ErrPolicyDenied)
+			},
+			expect: func(t *testing.T, signed *bitcoin.SignedMultisigTransactionDTO, err error) {
+				assert.Nil(t, signed)
+				assert.Equal(t, policy.ErrPolicyDenied, err)
+			},
+		},
+		{
+			name: "should release reservation on error",
+			dto:  dto,
+			setup: func() {
+				keys.EXPECT().AllowsRawKeys().Return(true)
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "", "").Return(reservation, nil)
+				btcRpcSvc.EXPECT().SignMultisigTransaction(gomock.Any(), unsignedTx, []string{dto.PrivateKey}, prevTxs, "test").Return("", false, bitcoin.ErrFailedSignMultisigTx)
+				policies.EXPECT().Release(gomock.Any(), reservation)
+			},
+			expect: func(t *testing.T, signed *bitcoin.SignedMultisigTransactionDTO, err error) {
+				assert.Nil(t, signed)
+				assert.Equal(t, bitcoin.ErrFailedSignMultisigTx, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			signed, err := service.SignMultisigTransaction(context.Background(), tc.dto)
+			tc.expect(t, signed, err)
+		})
+	}
+}
+
+func TestService_CombineMultisigTransactions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+	dto := &bitcoin.CombineMultisigTransactionsDTO{Txs: []string{"aa", "bb"}, Network: "test"}
+
+	btcRpcSvc.EXPECT().CombineTransactions(gomock.Any(), dto.Txs, "test").Return("combined", nil)
+	combined, err := service.CombineMultisigTransactions(context.Background(), dto)
+	assert.Nil(t, err)
+	assert.Equal(t, &bitcoin.CombinedMultisigTransactionDTO{Tx: "combined"}, combined)
+
+	btcRpcSvc.EXPECT().CombineTransactions(gomock.Any(), dto.Txs, "test").Return("", bitcoin.ErrFailedCombineMultisigTxs)
+	combined, err = service.CombineMultisigTransactions(context.Background(), dto)
+	assert.Nil(t, combined)
+	assert.Equal(t, bitcoin.ErrFailedCombineMultisigTxs, err)
+}
+
+func TestService_FinalizeMultisigTransaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+
+	dto := &bitcoin.FinalizeMultisigTransactionDTO{
+		Tx: "partial",
+		PrevTxs: []bitcoin.PrevTxDTO{{
+			TxId:          "989d301c546841d0ac5c8354c7d78079e3603b089682d1639b2ee1c1a8010c6a",
+			Vout:          1,
+			ScriptPubKey:  nestedOutput,
+			RedeemScript:  multisigOutput,
+			WitnessScript: witnessScript,
+			Amount:        1045428,
+		}},
+		Network: "test",
+	}
+	prevTxs := []bitcoin_rpc.PrevTx{{TxId: dto.PrevTxs[0].TxId, Vout: 1, ScriptPubKey: nestedOutput, RedeemScript: multisigOutput, WitnessScript: witnessScript, Amount: 0.01045428}}
+
+	btcRpcSvc.EXPECT().SignMultisigTransaction(gomock.Any(), "partial", nil, prevTxs, "test").Return("final", true, nil)
+	final, err := service.FinalizeMultisigTransaction(context.Background(), dto)
+	assert.Nil(t, err)
+	assert.Equal(t, &bitcoin.SignedMultisigTransactionDTO{Tx: "final", Complete: true}, final)
+
+	btcRpcSvc.EXPECT().SignMultisigTransaction(gomock.Any(), "partial", nil, prevTxs, "test").Return("partial", false, nil)
+	final, err = service.FinalizeMultisigTransaction(context.Background(), dto)
+	assert.Nil(t, final)
+	assert.Equal(t, errors.NewRejected(errors.StatusSigningIncomplete, "transaction lacks signatures of the required co-signers"), err)
+}
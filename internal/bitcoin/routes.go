@@ -0,0 +1,44 @@
+package bitcoin
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/openapi"
+)
+
+// Routes documents the endpoints registered by SetupRoutes.
+func Routes() []openapi.Route {
+	return []openapi.Route{
+		{Method: http.MethodPost, Path: "/status", Name: "StatusNode", Summary: "Blockchain info of the node.", Scope: string(auth.ScopeRead), Request: StatusNodeDTO{}, Response: StatusNodeInfoDTO{}},
+
+		{Method: http.MethodPost, Path: "/create-raw-tx", Name: "CreateRawTransaction", Summary: "Build an unsigned transaction from the given UTXOs.", Scope: string(auth.ScopeBuild), Request: CreateRawTransactionDTO{}, Response: CreatedRawTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/decode-raw-tx", Name: "DecodeRawTransaction", Summary: "Decode a raw transaction.", Scope: string(auth.ScopeRead), Request: DecodeRawTransactionDTO{}, Response: DecodedRawTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/fund-for-raw-tx", Name: "FundForRawTransaction", Summary: "Add inputs and change to a raw transaction.", Scope: string(auth.ScopeBuild), Request: FundForRawTransactionDTO{}, Response: FundedRawTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/sign-raw-tx", Name: "SignRawTransaction", Summary: "Sign a raw transaction with a WIF key or the key of a wallet held by the wallet service. Signing policies may deny it or hold it for approval with a 202, sign again with the approval_id once approved.", Scope: string(auth.ScopeSign), Request: SignRawTransactionDTO{}, Response: SignedRawTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/send-raw-tx", Name: "SendRawTransaction", Summary: "Broadcast a signed transaction once testmempoolaccept accepts it. A dry run stops after the check.", Scope: string(auth.ScopeBroadcast), Request: SendRawTransactionDTO{}, Response: SentRawTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/simulate", Name: "SimulateTransaction", Summary: "Check a signed transaction with testmempoolaccept without broadcasting it.", Scope: string(auth.ScopeRead), Request: SimulateTransactionDTO{}, Response: SimulationDTO{}},
+
+		{Method: http.MethodPost, Path: "/wallet-info", Name: "WalletInfo", Summary: "State of a node wallet.", Scope: string(auth.ScopeRead), Request: WalletDTO{}, Response: WalletInfoDTO{}},
+		{Method: http.MethodPost, Path: "/create-wallet", Name: "CreateWallet", Summary: "Create a watch-only node wallet.", Scope: string(auth.ScopeBuild), Request: CreateWalletDTO{}, Response: CreatedWalletInfoDTO{}},
+		{Method: http.MethodPost, Path: "/load-wallet", Name: "LoadWallet", Summary: "Load a node wallet.", Scope: string(auth.ScopeBuild), Request: LoadWalletDTO{}, Response: LoadWalletInfoDTO{}},
+		{Method: http.MethodPost, Path: "/import-address", Name: "ImportAddress", Summary: "Watch an address in a legacy node wallet, descriptor wallets import addr() descriptors instead.", Scope: string(auth.ScopeBuild), Request: ImportAddressDTO{}, Response: ImportAddressInfoDTO{}},
+		{Method: http.MethodPost, Path: "/rescan-wallet", Name: "RescanWallet", Summary: "Rescan the chain for wallet transactions.", Scope: string(auth.ScopeBuild), Request: RescanWalletDTO{}, Response: RescanWalletInfoDTO{}},
+		{Method: http.MethodPost, Path: "/list-utx", Name: "ListUnspent", Summary: "Unspent outputs of an address.", Scope: string(auth.ScopeRead), Request: ListUnspentDTO{}, Response: ListUnspentInfoDTO{}},
+
+		{Method: http.MethodPost, Path: "/multisig/create", Name: "CreateMultisig", Summary: "Create an m-of-n P2WSH or P2SH-P2WSH multisig address from public keys or xpubs.", Scope: string(auth.ScopeBuild), Request: CreateMultisigDTO{}, Response: MultisigDTO{}},
+		{Method: http.MethodPost, Path: "/multisig/create-tx", Name: "CreateMultisigTransaction", Summary: "Build an unsigned transaction spending multisig outputs, along with the prev_txs co-signers sign with.", Scope: string(auth.ScopeBuild), Request: CreateMultisigTransactionDTO{}, Response: CreatedMultisigTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/multisig/sign", Name: "SignMultisigTransaction", Summary: "Add the signatures of one co-signer to a multisig transaction. Signing policies apply as for sign-raw-tx.", Scope: string(auth.ScopeSign), Request: SignMultisigTransactionDTO{}, Response: SignedMultisigTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/multisig/combine", Name: "CombineMultisigTransactions", Summary: "Merge copies of a multisig transaction signed by different co-signers.", Scope: string(auth.ScopeBuild), Request: CombineMultisigTransactionsDTO{}, Response: CombinedMultisigTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/multisig/finalize", Name: "FinalizeMultisigTransaction", Summary: "Check that a multisig transaction carries enough signatures to broadcast.", Scope: string(auth.ScopeBuild), Request: FinalizeMultisigTransactionDTO{}, Response: SignedMultisigTransactionDTO{}},
+
+		{Method: http.MethodPost, Path: "/descriptor/info", Name: "DescriptorInfo", Summary: "Validate an output descriptor and compute its checksum.", Scope: string(auth.ScopeRead), Request: DescriptorDTO{}, Response: DescriptorInfoDTO{}},
+		{Method: http.MethodPost, Path: "/descriptor/import", Name: "ImportDescriptors", Summary: "Import wpkh, tr, sh(wsh(multi)) or addr descriptors into a descriptor node wallet, ranged descriptors need a range.", Scope: string(auth.ScopeBuild), Request: ImportDescriptorsDTO{}, Response: ImportDescriptorsInfoDTO{}},
+		{Method: http.MethodPost, Path: "/descriptor/derive-addresses", Name: "DeriveAddresses", Summary: "Addresses of an output descriptor, ranged descriptors need a range.", Scope: string(auth.ScopeRead), Request: DeriveAddressesDTO{}, Response: DerivedAddressesDTO{}},
+
+		{Method: http.MethodGet, Path: "/blocks/{block}", Name: "Block", Summary: "Block by hash or height with a page of its txids.", Scope: string(auth.ScopeRead), Request: BlockDTO{}, Response: BlockInfoDTO{}},
+		{Method: http.MethodGet, Path: "/tx/{txid}", Name: "Transaction", Summary: "Raw transaction, verbose adds inputs with the values of the outputs they spend and the fee, for transactions spending outputs of at most 100 transactions. Confirmed transactions outside node wallets need a node with -txindex.", Scope: string(auth.ScopeRead), Request: TransactionDTO{}, Response: TransactionInfoDTO{}},
+		{Method: http.MethodGet, Path: "/tx/{txid}/mempool", Name: "MempoolEntry", Summary: "Mempool entry of an unconfirmed transaction.", Scope: string(auth.ScopeRead), Request: MempoolEntryDTO{}, Response: MempoolEntryInfoDTO{}},
+		{Method: http.MethodGet, Path: "/tx/{txid}/out/{vout}", Name: "TxOut", Summary: "Whether a transaction output is unspent, and its value if so.", Scope: string(auth.ScopeRead), Request: TxOutDTO{}, Response: TxOutInfoDTO{}},
+		{Method: http.MethodGet, Path: "/mempool", Name: "MempoolInfo", Summary: "Size and fee floor of the node mempool.", Scope: string(auth.ScopeRead), Request: MempoolDTO{}, Response: MempoolInfoDTO{}},
+	}
+}
@@ -4,10 +4,22 @@ import (
 	"context"
 	gErrors "errors"
 	"go.uber.org/zap"
+	"nn-blockchain-api/internal/wallet"
+	"nn-blockchain-api/pkg/auth"
 	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/keystore"
+	"nn-blockchain-api/pkg/metrics"
+	"nn-blockchain-api/pkg/policy"
 	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"nn-blockchain-api/pkg/storage"
+	"nn-blockchain-api/pkg/tracing"
+
+	"github.com/btcsuite/btcutil"
 )
 
+// chain is the default route chain and the chain of gRPC requests naming none.
+const chain = bitcoin_rpc.DefaultChain
+
 //go:generate mockgen -source=service.go -destination=mocks/service_mock.go
 
 type UnspentList struct {
@@ -24,6 +36,7 @@ type Service interface {
 	FoundForRawTransaction(ctx context.Context, dto *FundForRawTransactionDTO) (*FundedRawTransactionDTO, error)
 	SignTransaction(ctx context.Context, dto *SignRawTransactionDTO) (*SignedRawTransactionDTO, error)
 	SendTransaction(ctx context.Context, dto *SendRawTransactionDTO) (*SentRawTransactionDTO, error)
+	SimulateTransaction(ctx context.Context, dto *SimulateTransactionDTO) (*SimulationDTO, error)
 
 	WalletInfo(ctx context.Context, dto *WalletDTO) (*WalletInfoDTO, error)
 	CreateWallet(ctx context.Context, dto *CreateWalletDTO) (*CreatedWalletInfoDTO, error)
@@ -31,28 +44,68 @@ type Service interface {
 	ImportAddress(ctx context.Context, dto *ImportAddressDTO) (*ImportAddressInfoDTO, error)
 	RescanWallet(ctx context.Context, dto *RescanWalletDTO) (*RescanWalletInfoDTO, error)
 	ListUnspent(ctx context.Context, dto *ListUnspentDTO) (*ListUnspentInfoDTO, error)
+
+	CreateMultisig(ctx context.Context, dto *CreateMultisigDTO) (*MultisigDTO, error)
+	CreateMultisigTransaction(ctx context.Context, dto *CreateMultisigTransactionDTO) (*CreatedMultisigTransactionDTO, error)
+	SignMultisigTransaction(ctx context.Context, dto *SignMultisigTransactionDTO) (*SignedMultisigTransactionDTO, error)
+	CombineMultisigTransactions(ctx context.Context, dto *CombineMultisigTransactionsDTO) (*CombinedMultisigTransactionDTO, error)
+	FinalizeMultisigTransaction(ctx context.Context, dto *FinalizeMultisigTransactionDTO) (*SignedMultisigTransactionDTO, error)
+
+	DescriptorInfo(ctx context.Context, dto *DescriptorDTO) (*DescriptorInfoDTO, error)
+	ImportDescriptors(ctx context.Context, dto *ImportDescriptorsDTO) (*ImportDescriptorsInfoDTO, error)
+	DeriveAddresses(ctx context.Context, dto *DeriveAddressesDTO) (*DerivedAddressesDTO, error)
+
+	Block(ctx context.Context, dto *BlockDTO) (*BlockInfoDTO, error)
+	Transaction(ctx context.Context, dto *TransactionDTO) (*TransactionInfoDTO, error)
+	MempoolInfo(ctx context.Context, dto *MempoolDTO) (*MempoolInfoDTO, error)
+	MempoolEntry(ctx context.Context, dto *MempoolEntryDTO) (*MempoolEntryInfoDTO, error)
+	TxOut(ctx context.Context, dto *TxOutDTO) (*TxOutInfoDTO, error)
 }
 
 type service struct {
+	coin      bitcoin_rpc.Chain
 	btcRpcSvc bitcoin_rpc.Service
+	walletSvc wallet.Service
+	keys      keystore.Keystore
+	policies  policy.Engine
+	store     storage.Storage
 	logger    *zap.SugaredLogger
 }
 
-func NewService(btcRpcSvc bitcoin_rpc.Service, logger *zap.SugaredLogger) (Service, error) {
+func NewService(coin bitcoin_rpc.Chain, btcRpcSvc bitcoin_rpc.Service, walletSvc wallet.Service, keys keystore.Keystore, policies policy.Engine, store storage.Storage, logger *zap.SugaredLogger) (Service, error) {
+	if coin.Name == "" {
+		return nil, gErrors.New("invalid chain")
+	}
 	if btcRpcSvc == nil {
 		return nil, gErrors.New("invalid btc rpc service")
 	}
+	if walletSvc == nil {
+		return nil, gErrors.New("invalid wallet service")
+	}
+	if keys == nil {
+		return nil, gErrors.New("invalid keystore")
+	}
+	if policies == nil {
+		return nil, gErrors.New("invalid policy engine")
+	}
+	if store == nil {
+		return nil, gErrors.New("invalid storage")
+	}
 	if logger == nil {
 		return nil, gErrors.New("invalid logger")
 	}
-	return &service{btcRpcSvc: btcRpcSvc, logger: logger}, nil
+	return &service{coin: coin, btcRpcSvc: btcRpcSvc, walletSvc: walletSvc, keys: keys, policies: policies, store: store, logger: logger}, nil
 }
 
 func (s *service) StatusNode(ctx context.Context, dto *StatusNodeDTO) (*StatusNodeInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/StatusNode")
+	defer span.End()
+
 	status, err := s.btcRpcSvc.Status(ctx, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed check node status: %v", err)
-		return nil, errors.WithMessage(ErrFailedGetStatusNode, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed check node status: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetStatusNode, err)
 		//return nil, ErrFailedGetStatusNode
 	}
 
@@ -67,13 +120,21 @@ func (s *service) StatusNode(ctx context.Context, dto *StatusNodeDTO) (*StatusNo
 }
 
 func (s *service) CreateTransaction(ctx context.Context, dto *CreateRawTransactionDTO) (*CreatedRawTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/CreateTransaction")
+	defer span.End()
+
 	tx, fee, err := s.btcRpcSvc.CreateTransaction(ctx, bitcoin_rpc.UTXO(dto.Utxo), dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed create transaction: %v", err)
-		return nil, errors.WithMessage(ErrFailedCreateTx, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed create transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedCreateTx, err)
 		//return nil, ErrFailedCreateTx
 	}
 
+	if _, err := storage.RecordCreatedTx(ctx, s.store, s.coin.Name, dto.Network, *tx, *fee); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record created transaction: %v", err)
+	}
+
 	return &CreatedRawTransactionDTO{
 		Tx:  *tx,
 		Fee: *fee,
@@ -81,10 +142,14 @@ func (s *service) CreateTransaction(ctx context.Context, dto *CreateRawTransacti
 }
 
 func (s *service) DecodeTransaction(ctx context.Context, dto *DecodeRawTransactionDTO) (*DecodedRawTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/DecodeTransaction")
+	defer span.End()
+
 	decodedTx, err := s.btcRpcSvc.DecodeTransaction(ctx, dto.Tx, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed decode transaction: %v", err)
-		return nil, errors.WithMessage(ErrFailedDecodeTx, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed decode transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedDecodeTx, err)
 		//return nil, ErrFailedDecodeTx
 	}
 
@@ -102,13 +167,21 @@ func (s *service) DecodeTransaction(ctx context.Context, dto *DecodeRawTransacti
 }
 
 func (s *service) FoundForRawTransaction(ctx context.Context, dto *FundForRawTransactionDTO) (*FundedRawTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/FoundForRawTransaction")
+	defer span.End()
+
 	tx, fee, err := s.btcRpcSvc.FundForTransaction(ctx, dto.CreatedTxHex, dto.ChangeAddress, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed found for transaction: %v", err)
-		return nil, errors.WithMessage(ErrFailedFundForTx, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed found for transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedFundForTx, err)
 		//return nil, ErrFailedFundForTx
 	}
 
+	if _, err := storage.RecordCreatedTx(ctx, s.store, s.coin.Name, dto.Network, tx, *fee); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record funded transaction: %v", err)
+	}
+
 	return &FundedRawTransactionDTO{
 		Tx:  tx,
 		Fee: *fee,
@@ -116,28 +189,107 @@ func (s *service) FoundForRawTransaction(ctx context.Context, dto *FundForRawTra
 }
 
 func (s *service) SignTransaction(ctx context.Context, dto *SignRawTransactionDTO) (*SignedRawTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/SignTransaction")
+	defer span.End()
+
 	//var utxos []map[string]interface{}
 	//for _, s := range dto.Utxo {
 	//	utxos = append(utxos, map[string]interface{}{"txid": s.TxId, "vout": s.Vout, "scriptPubKey": s.PKScript, "amount": s.Amount})
 	//}
 
-	tx, err := s.btcRpcSvc.SignTransaction(ctx, dto.Tx, dto.PrivateKey, bitcoin_rpc.UTXO(dto.Utxo), dto.Network)
+	tx, err := s.sign(ctx, dto)
 	if err != nil {
-		s.logger.Errorf("failed sign transaction: %v", err)
-		return nil, errors.WithMessage(ErrFailedSignTx, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed sign transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSignTx, err)
 		//return nil, ErrFailedSignTx
 	}
 
+	if _, err := storage.RecordSignedTx(ctx, s.store, s.coin.Name, dto.Network, dto.Tx, tx); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record signed transaction: %v", err)
+	}
+
 	return &SignedRawTransactionDTO{
 		Hash: tx,
 	}, nil
 }
 
+// sign checks the signing policies, then uses the key held by the wallet service when the
+// request names a wallet, otherwise a keystore or pasted key.
+func (s *service) sign(ctx context.Context, dto *SignRawTransactionDTO) (string, error) {
+	tx, err := s.decode(dto.Tx, dto.Network, s.prevout(ctx, dto.Network))
+	if err != nil {
+		return "", err
+	}
+	subject, err := s.subject(ctx, dto.signer())
+	if err != nil {
+		return "", err
+	}
+	reservation, err := s.policies.CheckSign(ctx, tx, subject, dto.ApprovalId, auth.NameFromContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	signed, err := s.signWithKey(ctx, dto)
+	if err != nil {
+		if err := s.policies.Release(ctx, reservation); err != nil {
+			tracing.Logger(ctx, s.logger).Warnf("failed release policy reservation: %v", err)
+		}
+		return "", err
+	}
+	return signed, nil
+}
+
+func (s *service) signWithKey(ctx context.Context, dto *SignRawTransactionDTO) (string, error) {
+	if dto.WalletId == "" {
+		privateKey, err := s.privateKey(dto.signer())
+		if err != nil {
+			return "", err
+		}
+		return s.btcRpcSvc.SignTransaction(ctx, dto.Tx, privateKey, bitcoin_rpc.UTXO(dto.Utxo), dto.Network)
+	}
+
+	request := &wallet.SignTransactionDTO{WalletId: dto.WalletId, Chain: s.coin.Name, Network: dto.Network, Tx: dto.Tx}
+	for _, utxo := range dto.Utxo {
+		request.Utxo = append(request.Utxo, wallet.UtxoDTO{TxId: utxo.TxId, Vout: utxo.Vout, Amount: utxo.Amount, PKScript: utxo.PKScript})
+	}
+
+	signed, err := s.walletSvc.SignTransaction(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	return signed.SignedTx, nil
+}
+
 func (s *service) SendTransaction(ctx context.Context, dto *SendRawTransactionDTO) (*SentRawTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/SendTransaction")
+	defer span.End()
+
+	if err := s.checkBroadcast(ctx, dto); err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed send transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSendTx, err)
+	}
+
+	simulation, err := s.preflight(ctx, dto.SignedTx, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed send transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSendTx, err)
+	}
+	if dto.DryRun {
+		return &SentRawTransactionDTO{TxId: simulation.TxId, DryRun: true, Simulation: simulation}, nil
+	}
+
 	txId, err := s.btcRpcSvc.SendTransaction(ctx, dto.SignedTx, dto.Network)
+	metrics.ObserveBroadcast(s.coin.Name, dto.Network, err)
+	if _, recordErr := storage.RecordSentTx(ctx, s.store, s.coin.Name, dto.Network, dto.SignedTx, txId, err); recordErr != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record sent transaction: %v", recordErr)
+	}
 	if err != nil {
-		s.logger.Errorf("failed send transaction: %v", err)
-		return nil, errors.WithMessage(ErrFailedSendTx, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed send transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSendTx, err)
 		//return nil, ErrFailedSendTx
 	}
 
@@ -147,10 +299,14 @@ func (s *service) SendTransaction(ctx context.Context, dto *SendRawTransactionDT
 }
 
 func (s *service) WalletInfo(ctx context.Context, dto *WalletDTO) (*WalletInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/WalletInfo")
+	defer span.End()
+
 	info, err := s.btcRpcSvc.WalletInfo(ctx, dto.WalletId, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed get wallet info: %v", err)
-		return nil, errors.WithMessage(ErrFailedGetWalletInfo, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed get wallet info: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetWalletInfo, err)
 		//return nil, ErrFailedGetWalletInfo
 	}
 
@@ -175,21 +331,33 @@ func (s *service) WalletInfo(ctx context.Context, dto *WalletDTO) (*WalletInfoDT
 }
 
 func (s *service) CreateWallet(ctx context.Context, dto *CreateWalletDTO) (*CreatedWalletInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/CreateWallet")
+	defer span.End()
+
 	walletId, err := s.btcRpcSvc.CreateWallet(ctx, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed create wallet: %v", err)
-		return nil, errors.WithMessage(ErrFailedCreateWallet, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed create wallet: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedCreateWallet, err)
 		//return nil, ErrFailedCreateWallet
 	}
 
+	if err := storage.RecordWallet(ctx, s.store, &storage.Wallet{Id: walletId, Chain: s.coin.Name, Network: dto.Network}); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record wallet: %v", err)
+	}
+
 	return &CreatedWalletInfoDTO{WalletId: walletId}, nil
 }
 
 func (s *service) LoadWaller(ctx context.Context, dto *LoadWalletDTO) (*LoadWalletInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/LoadWaller")
+	defer span.End()
+
 	err := s.btcRpcSvc.LoadWallet(ctx, dto.WalletId, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed load wallet: %v", err)
-		return nil, errors.WithMessage(ErrFailedLoadWallet, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed load wallet: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedLoadWallet, err)
 		//return nil, ErrFailedLoadWallet
 	}
 
@@ -199,23 +367,36 @@ func (s *service) LoadWaller(ctx context.Context, dto *LoadWalletDTO) (*LoadWall
 }
 
 func (s *service) ImportAddress(ctx context.Context, dto *ImportAddressDTO) (*ImportAddressInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/ImportAddress")
+	defer span.End()
+
 	err := s.btcRpcSvc.ImportAddress(ctx, dto.Address, dto.WalletId, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed import wallet: %v", err)
-		return nil, errors.WithMessage(ErrFailedImportAddress, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed import wallet: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedImportAddress, err)
 		//return nil, ErrFailedImportAddress
 	}
 
+	address := &storage.Address{Address: dto.Address, WalletId: dto.WalletId, Chain: s.coin.Name, Network: dto.Network}
+	if err := storage.RecordAddress(ctx, s.store, address); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record address: %v", err)
+	}
+
 	return &ImportAddressInfoDTO{
 		Message: "successful",
 	}, nil
 }
 
 func (s *service) RescanWallet(ctx context.Context, dto *RescanWalletDTO) (*RescanWalletInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/RescanWallet")
+	defer span.End()
+
 	err := s.btcRpcSvc.RescanWallet(ctx, dto.WalletId, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed rescan wallet: %v", err)
-		return nil, errors.WithMessage(ErrFailedRescanWallet, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed rescan wallet: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedRescanWallet, err)
 		//return nil, ErrFailedRescanWallet
 	}
 
@@ -226,10 +407,14 @@ func (s *service) RescanWallet(ctx context.Context, dto *RescanWalletDTO) (*Resc
 }
 
 func (s *service) ListUnspent(ctx context.Context, dto *ListUnspentDTO) (*ListUnspentInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/ListUnspent")
+	defer span.End()
+
 	list, err := s.btcRpcSvc.ListUnspent(ctx, dto.Address, dto.WalletId, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed get unspend list: %v", err)
-		return nil, errors.WithMessage(ErrFailedGetUnspent, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed get unspend list: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetUnspent, err)
 		//return nil, ErrFailedGetUnspent
 	}
 
@@ -251,3 +436,91 @@ func (s *service) ListUnspent(ctx context.Context, dto *ListUnspentDTO) (*ListUn
 
 	return &ListUnspentInfoDTO{Result: result}, err
 }
+
+// signer is the key a request signs with, one of a wallet, a keystore key or a pasted key.
+type signer struct {
+	WalletId   string
+	KeyId      string
+	Passphrase string
+	PrivateKey string
+	Network    string
+}
+
+func (dto *SignRawTransactionDTO) signer() signer {
+	return signer{WalletId: dto.WalletId, KeyId: dto.KeyId, Passphrase: dto.Passphrase, PrivateKey: dto.PrivateKey, Network: dto.Network}
+}
+
+// subject identifies the signer for the policies along with the addresses its change goes
+// back to. Keys that may not sign the request are refused here, before any policy is checked.
+func (s *service) subject(ctx context.Context, dto signer) (policy.Subject, error) {
+	switch {
+	case dto.WalletId != "":
+		addresses, err := s.store.Addresses().ListByWallet(ctx, dto.WalletId)
+		if err != nil {
+			return policy.Subject{}, err
+		}
+		subject := policy.Subject{WalletId: dto.WalletId}
+		for _, address := range addresses {
+			subject.Addresses = append(subject.Addresses, address.Address)
+		}
+		return subject, nil
+	case dto.KeyId != "":
+		key, err := s.keys.Get(dto.KeyId)
+		if err != nil {
+			return policy.Subject{}, err
+		}
+		if err := key.Allows(s.coin.Name, dto.Network); err != nil {
+			return policy.Subject{}, err
+		}
+		return policy.Subject{KeyId: key.Id, Addresses: []string{key.Address}}, nil
+	}
+
+	if !s.keys.AllowsRawKeys() {
+		return policy.Subject{}, keystore.ErrRawKeysDisabled
+	}
+	addresses, err := s.coin.KeyAddresses(dto.PrivateKey, dto.Network)
+	if err != nil {
+		return policy.Subject{}, errors.NewInvalid(errors.StatusInvalidPrivateKey, err.Error())
+	}
+	return policy.Subject{Addresses: addresses}, nil
+}
+
+// privateKey unlocks the keystore key named by the request, plaintext keys were already
+// checked by subject.
+func (s *service) privateKey(dto signer) (string, error) {
+	if dto.KeyId == "" {
+		return dto.PrivateKey, nil
+	}
+	return s.keys.Unlock(dto.KeyId, dto.Passphrase)
+}
+
+func (s *service) checkBroadcast(ctx context.Context, dto *SendRawTransactionDTO) error {
+	tx, err := s.decode(dto.SignedTx, dto.Network, nil)
+	if err != nil {
+		return err
+	}
+	return s.policies.CheckBroadcast(ctx, tx)
+}
+
+// decode reads a raw transaction for the policy engine with the addresses of the chain.
+func (s *service) decode(raw, network string, prevout policy.Prevout) (*policy.Transaction, error) {
+	return policy.DecodeUTXO(s.coin.Name, raw, network, prevout, func(pkScript []byte) string {
+		return s.coin.ScriptAddress(pkScript, network)
+	})
+}
+
+// prevout looks the spent outputs up on the node, the policies never trust the amounts
+// a request claims its inputs have.
+func (s *service) prevout(ctx context.Context, network string) policy.Prevout {
+	return func(txid string, vout uint32) (int64, bool, error) {
+		out, err := s.btcRpcSvc.GetTxOut(ctx, txid, int64(vout), true, network)
+		if err != nil || out == nil {
+			return 0, false, err
+		}
+		amount, err := btcutil.NewAmount(out.Value)
+		if err != nil {
+			return 0, false, err
+		}
+		return int64(amount), true, nil
+	}
+}
@@ -2,14 +2,27 @@ package bitcoin_test
 
 import (
 	"context"
+	gErrors "errors"
 	"go.uber.org/zap"
+	"math/big"
 	"nn-blockchain-api/internal/bitcoin"
+	"nn-blockchain-api/internal/wallet"
+	mock_wallet "nn-blockchain-api/internal/wallet/mocks"
+	"nn-blockchain-api/pkg/auth"
 	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/keystore"
+	mock_keystore "nn-blockchain-api/pkg/keystore/mocks"
 	"nn-blockchain-api/pkg/logger"
+	"nn-blockchain-api/pkg/policy"
+	mock_policy "nn-blockchain-api/pkg/policy/mocks"
 	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
 
 	mock_bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin/mocks"
 
+	"nn-blockchain-api/pkg/storage"
+	bolt_storage "nn-blockchain-api/pkg/storage/bolt"
+	mock_storage "nn-blockchain-api/pkg/storage/mocks"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -23,21 +36,50 @@ func TestNewService(t *testing.T) {
 	tests := []struct {
 		name      string
 		logger    *zap.SugaredLogger
+		coin      bitcoin_rpc.Chain
 		btcRpcSvc bitcoin_rpc.Service
+		walletSvc wallet.Service
+		keys      keystore.Keystore
+		policies  policy.Engine
+		store     storage.Storage
 		expect    func(*testing.T, bitcoin.Service, error)
 	}{
 		{
 			name:      "should return bitcoin service",
+			coin:      bitcoin_rpc.KnownChains["bitcoin"],
 			logger:    &zap.SugaredLogger{},
 			btcRpcSvc: mock_bitcoin_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
 			expect: func(t *testing.T, s bitcoin.Service, err error) {
 				assert.NotNil(t, s)
 				assert.Nil(t, err)
 			},
 		},
+		{
+			name:      "should return invalid chain",
+			btcRpcSvc: mock_bitcoin_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s bitcoin.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid chain")
+			},
+		},
 		{
 			name:      "should return invalid btc rpc service",
+			coin:      bitcoin_rpc.KnownChains["bitcoin"],
 			btcRpcSvc: nil,
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
 			logger:    &zap.SugaredLogger{},
 			expect: func(t *testing.T, s bitcoin.Service, err error) {
 				assert.NotNil(t, err)
@@ -45,9 +87,74 @@ func TestNewService(t *testing.T) {
 				assert.EqualError(t, err, "invalid btc rpc service")
 			},
 		},
+		{
+			name:      "should return invalid wallet service",
+			coin:      bitcoin_rpc.KnownChains["bitcoin"],
+			btcRpcSvc: mock_bitcoin_rpc.NewMockService(controller),
+			walletSvc: nil,
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s bitcoin.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid wallet service")
+			},
+		},
+		{
+			name:      "should return invalid keystore",
+			coin:      bitcoin_rpc.KnownChains["bitcoin"],
+			btcRpcSvc: mock_bitcoin_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      nil,
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s bitcoin.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid keystore")
+			},
+		},
+		{
+			name:      "should return invalid policy engine",
+			coin:      bitcoin_rpc.KnownChains["bitcoin"],
+			btcRpcSvc: mock_bitcoin_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  nil,
+			store:     mock_storage.NewMockStorage(controller),
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s bitcoin.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid policy engine")
+			},
+		},
+		{
+			name:      "should return invalid storage",
+			coin:      bitcoin_rpc.KnownChains["bitcoin"],
+			btcRpcSvc: mock_bitcoin_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     nil,
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s bitcoin.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid storage")
+			},
+		},
 		{
 			name:      "should return invalid logger",
+			coin:      bitcoin_rpc.KnownChains["bitcoin"],
 			btcRpcSvc: mock_bitcoin_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
 			logger:    nil,
 			expect: func(t *testing.T, s bitcoin.Service, err error) {
 				assert.NotNil(t, err)
@@ -59,7 +166,7 @@ func TestNewService(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := bitcoin.NewService(tc.btcRpcSvc, tc.logger)
+			svc, err := bitcoin.NewService(tc.coin, tc.btcRpcSvc, tc.walletSvc, tc.keys, tc.policies, tc.store, tc.logger)
 			tc.expect(t, svc, err)
 		})
 	}
@@ -74,7 +181,7 @@ func TestService_StatusNode(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	status := bitcoin_rpc.StatusNode{
 		Chain:                "test",
@@ -209,7 +316,7 @@ func TestService_StatusNode(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.StatusNodeDTO) {
-				btcRpcSvc.EXPECT().Status(ctx, dto.Network).Return(&status, nil)
+				btcRpcSvc.EXPECT().Status(gomock.Any(), dto.Network).Return(&status, nil)
 			},
 			expect: func(t *testing.T, status *bitcoin.StatusNodeInfoDTO, err error) {
 				assert.Nil(t, err)
@@ -221,11 +328,11 @@ func TestService_StatusNode(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.StatusNodeDTO) {
-				btcRpcSvc.EXPECT().Status(ctx, dto.Network).Return(nil, bitcoin.ErrFailedGetStatusNode)
+				btcRpcSvc.EXPECT().Status(gomock.Any(), dto.Network).Return(nil, bitcoin.ErrFailedGetStatusNode)
 			},
 			expect: func(t *testing.T, status *bitcoin.StatusNodeInfoDTO, err error) {
 				assert.NotNil(t, err)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedGetStatusNode, bitcoin.ErrFailedGetStatusNode.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedGetStatusNode)
 			},
 		},
 	}
@@ -247,17 +354,17 @@ func TestService_CreateTransaction(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	tx := "transaction"
 	fee := 0.0000259
 
 	dto := &bitcoin.CreateRawTransactionDTO{
 		Utxo: []struct {
-			TxId     string `json:"txid" validate:"required"`
-			Vout     int64  `json:"vout" validate:"required"`
+			TxId     string `json:"txid" validate:"required,txid"`
+			Vout     int64  `json:"vout" validate:"gte=0"`
 			Amount   int64  `json:"amount" validate:"required"`
-			PKScript string `json:"pk_script" validate:"required"`
+			PKScript string `json:"pk_script" validate:"required,hexadecimal"`
 		}{
 			{
 				TxId:     "989d301c546841d0ac5c8354c7d78079e3603b089682d1639b2ee1c1a8010c6a",
@@ -284,7 +391,7 @@ func TestService_CreateTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.CreateRawTransactionDTO) {
-				btcRpcSvc.EXPECT().CreateTransaction(ctx, bitcoin_rpc.UTXO(dto.Utxo), dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network).Return(&tx, &fee, nil)
+				btcRpcSvc.EXPECT().CreateTransaction(gomock.Any(), bitcoin_rpc.UTXO(dto.Utxo), dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network).Return(&tx, &fee, nil)
 			},
 			expect: func(t *testing.T, createdTx *bitcoin.CreatedRawTransactionDTO, err error) {
 				assert.Nil(t, err)
@@ -297,11 +404,11 @@ func TestService_CreateTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.CreateRawTransactionDTO) {
-				btcRpcSvc.EXPECT().CreateTransaction(ctx, bitcoin_rpc.UTXO(dto.Utxo), dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network).Return(nil, nil, bitcoin.ErrFailedCreateTx)
+				btcRpcSvc.EXPECT().CreateTransaction(gomock.Any(), bitcoin_rpc.UTXO(dto.Utxo), dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network).Return(nil, nil, bitcoin.ErrFailedCreateTx)
 			},
 			expect: func(t *testing.T, createdTx *bitcoin.CreatedRawTransactionDTO, err error) {
 				assert.Nil(t, createdTx)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedCreateTx, bitcoin.ErrFailedCreateTx.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedCreateTx)
 			},
 		},
 	}
@@ -323,7 +430,7 @@ func TestService_DecodeTransaction(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	dto := &bitcoin.DecodeRawTransactionDTO{
 		Tx:      "transaction",
@@ -400,7 +507,7 @@ func TestService_DecodeTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.DecodeRawTransactionDTO) {
-				btcRpcSvc.EXPECT().DecodeTransaction(ctx, dto.Tx, dto.Network).Return(decodeTx, nil)
+				btcRpcSvc.EXPECT().DecodeTransaction(gomock.Any(), dto.Tx, dto.Network).Return(decodeTx, nil)
 			},
 			expect: func(t *testing.T, decodeTx *bitcoin.DecodedRawTransactionDTO, err error) {
 				assert.Nil(t, err)
@@ -412,11 +519,11 @@ func TestService_DecodeTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.DecodeRawTransactionDTO) {
-				btcRpcSvc.EXPECT().DecodeTransaction(ctx, dto.Tx, dto.Network).Return(nil, bitcoin.ErrFailedDecodeTx)
+				btcRpcSvc.EXPECT().DecodeTransaction(gomock.Any(), dto.Tx, dto.Network).Return(nil, bitcoin.ErrFailedDecodeTx)
 			},
 			expect: func(t *testing.T, decodeTx *bitcoin.DecodedRawTransactionDTO, err error) {
 				assert.Nil(t, decodeTx)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedDecodeTx, bitcoin.ErrFailedDecodeTx.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedDecodeTx)
 			},
 		},
 	}
@@ -438,7 +545,7 @@ func TestService_FoundForRawTransaction(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	dto := &bitcoin.FundForRawTransactionDTO{
 		CreatedTxHex:  "tx",
@@ -461,7 +568,7 @@ func TestService_FoundForRawTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.FundForRawTransactionDTO) {
-				btcRpcSvc.EXPECT().FundForTransaction(ctx, dto.CreatedTxHex, dto.ChangeAddress, dto.Network).Return(tx, &fee, nil)
+				btcRpcSvc.EXPECT().FundForTransaction(gomock.Any(), dto.CreatedTxHex, dto.ChangeAddress, dto.Network).Return(tx, &fee, nil)
 			},
 			expect: func(t *testing.T, fundedTx *bitcoin.FundedRawTransactionDTO, err error) {
 				assert.Nil(t, err)
@@ -474,11 +581,11 @@ func TestService_FoundForRawTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.FundForRawTransactionDTO) {
-				btcRpcSvc.EXPECT().FundForTransaction(ctx, dto.CreatedTxHex, dto.ChangeAddress, dto.Network).Return("", nil, bitcoin.ErrFailedFundForTx)
+				btcRpcSvc.EXPECT().FundForTransaction(gomock.Any(), dto.CreatedTxHex, dto.ChangeAddress, dto.Network).Return("", nil, bitcoin.ErrFailedFundForTx)
 			},
 			expect: func(t *testing.T, fundedTx *bitcoin.FundedRawTransactionDTO, err error) {
 				assert.Nil(t, fundedTx)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedFundForTx, bitcoin.ErrFailedFundForTx.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedFundForTx)
 			},
 		},
 	}
@@ -492,24 +599,33 @@ func TestService_FoundForRawTransaction(t *testing.T) {
 	}
 }
 
+// unsignedTx spends the utxo of the sign tests to tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx.
+const (
+	unsignedTx       = "01000000016a0c01a8c1e12e9b63d18296083b60e37980d7c754835cacd04168541c309d980100000000ffffffff0140420f0000000000160014751e76e8199196d454941c45d1b3a323f1433bd600000000"
+	unsignedPrevTxId = "989d301c546841d0ac5c8354c7d78079e3603b089682d1639b2ee1c1a8010c6a"
+)
+
 func TestService_SignTransaction(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 
 	btcRpcSvc := mock_bitcoin_rpc.NewMockService(controller)
+	walletSvc := mock_wallet.NewMockService(controller)
+	keys := mock_keystore.NewMockKeystore(controller)
+	policies := mock_policy.NewMockEngine(controller)
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, walletSvc, keys, policies, newStorage(t), zapLogger)
 
 	dto := &bitcoin.SignRawTransactionDTO{
-		Tx:         "tx",
-		PrivateKey: "private",
+		Tx:         unsignedTx,
+		PrivateKey: "cMzLdeGd5vEqxB8B6VFQoRopQ3sLAAvEzDAoQgvX54xwofSWj1fx",
 		Utxo: []struct {
-			TxId     string `json:"txid" validate:"required"`
-			Vout     int64  `json:"vout" validate:"required"`
+			TxId     string `json:"txid" validate:"required,txid"`
+			Vout     int64  `json:"vout" validate:"gte=0"`
 			Amount   int64  `json:"amount" validate:"required"`
-			PKScript string `json:"pk_script" validate:"required"`
+			PKScript string `json:"pk_script" validate:"required,hexadecimal"`
 		}{
 			{
 				TxId:     "989d301c546841d0ac5c8354c7d78079e3603b089682d1639b2ee1c1a8010c6a",
@@ -521,6 +637,26 @@ func TestService_SignTransaction(t *testing.T) {
 		Network: "test",
 	}
 
+	walletDto := *dto
+	walletDto.PrivateKey, walletDto.WalletId = "", "wallet"
+
+	keyDto := *dto
+	keyDto.PrivateKey, keyDto.KeyId, keyDto.Passphrase = "", "key", "secret"
+
+	approvalDto := keyDto
+	approvalDto.ApprovalId = "approval"
+
+	key := &keystore.Key{Id: "key", Chain: "bitcoin", Network: "test", Address: "tb1qmy63mjadtw8nhzl69ukdepwzsyvv4yex7xygd7"}
+	reservation := &policy.Reservation{}
+
+	// The policies see the amount the node has for the spent output, not the one of the request.
+	btcRpcSvc.EXPECT().GetTxOut(gomock.Any(), unsignedPrevTxId, int64(1), true, "test").Return(&bitcoin_rpc.TxOut{Value: 0.0101}, nil).AnyTimes()
+	decoded, err := decodeTestnet(unsignedTx, func(txid string, vout uint32) (int64, bool, error) {
+		return 1010000, txid == unsignedPrevTxId && vout == 1, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10000), decoded.Fee)
+
 	tests := []struct {
 		name   string
 		ctx    context.Context
@@ -533,7 +669,9 @@ func TestService_SignTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
-				btcRpcSvc.EXPECT().SignTransaction(ctx, dto.Tx, dto.PrivateKey, bitcoin_rpc.UTXO(dto.Utxo), dto.Network).Return("hash", nil)
+				keys.EXPECT().AllowsRawKeys().Return(true)
+				policies.EXPECT().CheckSign(gomock.Any(), decoded, policy.Subject{Addresses: []string{"n1KSZGmQgB8iSZqv6UVhGkCGUbEdw8Lm3Q", "tb1qmy63mjadtw8nhzl69ukdepwzsyvv4yex7xygd7"}}, "", "").Return(reservation, nil)
+				btcRpcSvc.EXPECT().SignTransaction(gomock.Any(), dto.Tx, dto.PrivateKey, bitcoin_rpc.UTXO(dto.Utxo), dto.Network).Return("hash", nil)
 			},
 			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
 				assert.Nil(t, err)
@@ -545,11 +683,134 @@ func TestService_SignTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
-				btcRpcSvc.EXPECT().SignTransaction(ctx, dto.Tx, dto.PrivateKey, bitcoin_rpc.UTXO(dto.Utxo), dto.Network).Return("", bitcoin.ErrFailedSignTx)
+				keys.EXPECT().AllowsRawKeys().Return(true)
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "", "").Return(reservation, nil)
+				btcRpcSvc.EXPECT().SignTransaction(gomock.Any(), dto.Tx, dto.PrivateKey, bitcoin_rpc.UTXO(dto.Utxo), dto.Network).Return("", bitcoin.ErrFailedSignTx)
+				policies.EXPECT().Release(gomock.Any(), reservation)
 			},
 			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
 				assert.Nil(t, signedTx)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedSignTx, bitcoin.ErrFailedSignTx.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedSignTx)
+			},
+		},
+		{
+			name: "should reject raw key when disabled",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
+				keys.EXPECT().AllowsRawKeys().Return(false)
+			},
+			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTx)
+				assert.Equal(t, keystore.ErrRawKeysDisabled, err)
+			},
+		},
+		{
+			name: "should sign with keystore key",
+			ctx:  context.Background(),
+			dto:  &keyDto,
+			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(key, nil)
+				policies.EXPECT().CheckSign(gomock.Any(), decoded, policy.Subject{KeyId: "key", Addresses: []string{key.Address}}, "", "").Return(reservation, nil)
+				keys.EXPECT().Unlock("key", "secret").Return("wif", nil)
+				btcRpcSvc.EXPECT().SignTransaction(gomock.Any(), dto.Tx, "wif", bitcoin_rpc.UTXO(dto.Utxo), dto.Network).Return("hash", nil)
+			},
+			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "hash", signedTx.Hash)
+			},
+		},
+		{
+			name: "should reject keystore key of another network",
+			ctx:  context.Background(),
+			dto:  &keyDto,
+			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(&keystore.Key{Id: "key", Chain: "bitcoin", Network: "main"}, nil)
+			},
+			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTx)
+				assert.Equal(t, errors.WithMessage(keystore.ErrKeyMismatch, "key key belongs to the main network"), err)
+			},
+		},
+		{
+			name: "should keep keystore error",
+			ctx:  context.Background(),
+			dto:  &keyDto,
+			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(key, nil)
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "", "").Return(reservation, nil)
+				keys.EXPECT().Unlock("key", "secret").Return("", keystore.ErrInvalidPassphrase)
+				policies.EXPECT().Release(gomock.Any(), reservation)
+			},
+			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTx)
+				assert.Equal(t, keystore.ErrInvalidPassphrase, err)
+			},
+		},
+		{
+			name: "should return policy denial",
+			ctx:  context.Background(),
+			dto:  &keyDto,
+			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(key, nil)
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "", "").Return(nil, policy.ErrPolicyDenied)
+			},
+			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTx)
+				assert.Equal(t, policy.ErrPolicyDenied, err)
+			},
+		},
+		{
+			name: "should redeem approval as the calling api key",
+			ctx:  auth.WithKey(context.Background(), &auth.Key{Name: "signer"}),
+			dto:  &approvalDto,
+			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(key, nil)
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "approval", "signer").Return(reservation, nil)
+				keys.EXPECT().Unlock("key", "secret").Return("wif", nil)
+				btcRpcSvc.EXPECT().SignTransaction(gomock.Any(), dto.Tx, "wif", bitcoin_rpc.UTXO(dto.Utxo), dto.Network).Return("hash", nil)
+			},
+			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "hash", signedTx.Hash)
+			},
+		},
+		{
+			name: "should sign with wallet service",
+			ctx:  context.Background(),
+			dto:  &walletDto,
+			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
+				policies.EXPECT().CheckSign(gomock.Any(), decoded, policy.Subject{WalletId: "wallet"}, "", "").Return(reservation, nil)
+				walletSvc.EXPECT().SignTransaction(gomock.Any(), &wallet.SignTransactionDTO{
+					WalletId: "wallet",
+					Chain:    "bitcoin",
+					Network:  dto.Network,
+					Tx:       dto.Tx,
+					Utxo: []wallet.UtxoDTO{{
+						TxId:     dto.Utxo[0].TxId,
+						Vout:     dto.Utxo[0].Vout,
+						Amount:   dto.Utxo[0].Amount,
+						PKScript: dto.Utxo[0].PKScript,
+					}},
+				}).Return(&wallet.SignedTransactionDTO{SignedTx: "signed"}, nil)
+			},
+			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "signed", signedTx.Hash)
+			},
+		},
+		{
+			name: "should keep wallet service error",
+			ctx:  context.Background(),
+			dto:  &walletDto,
+			setup: func(ctx context.Context, dto *bitcoin.SignRawTransactionDTO) {
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "", "").Return(reservation, nil)
+				walletSvc.EXPECT().SignTransaction(gomock.Any(), gomock.Any()).Return(nil, wallet.ErrWalletNotFound)
+				policies.EXPECT().Release(gomock.Any(), reservation)
+			},
+			expect: func(t *testing.T, signedTx *bitcoin.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTx)
+				assert.Equal(t, wallet.ErrWalletNotFound, err)
 			},
 		},
 	}
@@ -568,15 +829,18 @@ func TestService_SendTransaction(t *testing.T) {
 	defer controller.Finish()
 
 	btcRpcSvc := mock_bitcoin_rpc.NewMockService(controller)
+	policies := mock_policy.NewMockEngine(controller)
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	store := newStorage(t)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), policies, store, zapLogger)
 
 	dto := &bitcoin.SendRawTransactionDTO{
-		SignedTx: "hash",
+		SignedTx: unsignedTx,
 		Network:  "test",
 	}
+	accepted := &bitcoin_rpc.MempoolAcceptResult{TxId: "tx_id", WTxId: "wtx_id", Allowed: true, Vsize: 110, Fees: bitcoin_rpc.TxFees{Base: 0.0000022}}
 
 	tests := []struct {
 		name   string
@@ -590,11 +854,18 @@ func TestService_SendTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.SendRawTransactionDTO) {
-				btcRpcSvc.EXPECT().SendTransaction(ctx, dto.SignedTx, dto.Network).Return("tx_id", nil)
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), dto.SignedTx, dto.Network).Return(accepted, nil)
+				btcRpcSvc.EXPECT().SendTransaction(gomock.Any(), dto.SignedTx, dto.Network).Return("tx_id", nil)
 			},
 			expect: func(t *testing.T, sentTx *bitcoin.SentRawTransactionDTO, err error) {
 				assert.Nil(t, err)
 				assert.Equal(t, sentTx.TxId, "tx_id")
+
+				recorded, err := store.Transactions().FindByRaw(context.Background(), unsignedTx)
+				assert.Nil(t, err)
+				assert.Equal(t, recorded.Status, storage.TxStatusSent)
+				assert.Equal(t, recorded.TxId, "tx_id")
 			},
 		},
 		{
@@ -602,11 +873,88 @@ func TestService_SendTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.SendRawTransactionDTO) {
-				btcRpcSvc.EXPECT().SendTransaction(ctx, dto.SignedTx, dto.Network).Return("", bitcoin.ErrFailedSendTx)
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), dto.SignedTx, dto.Network).Return(accepted, nil)
+				btcRpcSvc.EXPECT().SendTransaction(gomock.Any(), dto.SignedTx, dto.Network).Return("", bitcoin.ErrFailedSendTx)
+			},
+			expect: func(t *testing.T, sentTx *bitcoin.SentRawTransactionDTO, err error) {
+				assert.Nil(t, sentTx)
+				assert.Equal(t, err, bitcoin.ErrFailedSendTx)
+
+				recorded, err := store.Transactions().FindByRaw(context.Background(), unsignedTx)
+				assert.Nil(t, err)
+				assert.Equal(t, recorded.Status, storage.TxStatusFailed)
+			},
+		},
+		{
+			name: "should return node rejection",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *bitcoin.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), dto.SignedTx, dto.Network).Return(accepted, nil)
+				btcRpcSvc.EXPECT().SendTransaction(gomock.Any(), dto.SignedTx, dto.Network).Return("", errors.FromBitcoinRPC(-25, "bad-txns-inputs-missingorspent"))
 			},
 			expect: func(t *testing.T, sentTx *bitcoin.SentRawTransactionDTO, err error) {
 				assert.Nil(t, sentTx)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedSendTx, bitcoin.ErrFailedSendTx.Error()))
+				assert.Equal(t, 409, errors.HTTPCode(err))
+				assert.Equal(t, err, errors.FromBitcoinRPC(-25, "bad-txns-inputs-missingorspent"))
+			},
+		},
+		{
+			name: "should wrap untyped error",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *bitcoin.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), dto.SignedTx, dto.Network).Return(accepted, nil)
+				btcRpcSvc.EXPECT().SendTransaction(gomock.Any(), dto.SignedTx, dto.Network).Return("", gErrors.New("unexpected EOF"))
+			},
+			expect: func(t *testing.T, sentTx *bitcoin.SentRawTransactionDTO, err error) {
+				assert.Nil(t, sentTx)
+				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedSendTx, "unexpected EOF"))
+			},
+		},
+		{
+			name: "should refuse transaction the mempool rejects",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *bitcoin.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), dto.SignedTx, dto.Network).
+					Return(&bitcoin_rpc.MempoolAcceptResult{TxId: "tx_id", RejectReason: "min relay fee not met"}, nil)
+			},
+			expect: func(t *testing.T, sentTx *bitcoin.SentRawTransactionDTO, err error) {
+				assert.Nil(t, sentTx)
+				assert.Equal(t, errors.FromBitcoinRPC(-26, "min relay fee not met"), err)
+			},
+		},
+		{
+			name: "should not broadcast dry run",
+			ctx:  context.Background(),
+			dto:  &bitcoin.SendRawTransactionDTO{SignedTx: unsignedTx, Network: "test", DryRun: true},
+			setup: func(ctx context.Context, dto *bitcoin.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), dto.SignedTx, dto.Network).Return(accepted, nil)
+			},
+			expect: func(t *testing.T, sentTx *bitcoin.SentRawTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.True(t, sentTx.DryRun)
+				assert.Equal(t, "tx_id", sentTx.TxId)
+				assert.True(t, sentTx.Simulation.Passed)
+				assert.Equal(t, 2.0, sentTx.Simulation.FeeRate)
+			},
+		},
+		{
+			name: "should return policy denial",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *bitcoin.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(policy.ErrPolicyDenied)
+			},
+			expect: func(t *testing.T, sentTx *bitcoin.SentRawTransactionDTO, err error) {
+				assert.Nil(t, sentTx)
+				assert.Equal(t, policy.ErrPolicyDenied, err)
 			},
 		},
 	}
@@ -628,7 +976,7 @@ func TestService_WalletInfo(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	dto := &bitcoin.WalletDTO{
 		WalletId: "wallet_id",
@@ -666,7 +1014,7 @@ func TestService_WalletInfo(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.WalletDTO) {
-				btcRpcSvc.EXPECT().WalletInfo(ctx, dto.WalletId, dto.Network).Return(info, nil)
+				btcRpcSvc.EXPECT().WalletInfo(gomock.Any(), dto.WalletId, dto.Network).Return(info, nil)
 			},
 			expect: func(t *testing.T, walletInfo *bitcoin.WalletInfoDTO, err error) {
 				assert.Nil(t, err)
@@ -678,11 +1026,11 @@ func TestService_WalletInfo(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.WalletDTO) {
-				btcRpcSvc.EXPECT().WalletInfo(ctx, dto.WalletId, dto.Network).Return(nil, bitcoin.ErrFailedGetWalletInfo)
+				btcRpcSvc.EXPECT().WalletInfo(gomock.Any(), dto.WalletId, dto.Network).Return(nil, bitcoin.ErrFailedGetWalletInfo)
 			},
 			expect: func(t *testing.T, walletInfo *bitcoin.WalletInfoDTO, err error) {
 				assert.Nil(t, walletInfo)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedGetWalletInfo, bitcoin.ErrFailedGetWalletInfo.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedGetWalletInfo)
 			},
 		},
 	}
@@ -704,7 +1052,7 @@ func TestService_CreateWallet(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	dto := &bitcoin.CreateWalletDTO{
 		Network: "test",
@@ -722,7 +1070,7 @@ func TestService_CreateWallet(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.CreateWalletDTO) {
-				btcRpcSvc.EXPECT().CreateWallet(ctx, dto.Network).Return("wallet_id", nil)
+				btcRpcSvc.EXPECT().CreateWallet(gomock.Any(), dto.Network).Return("wallet_id", nil)
 			},
 			expect: func(t *testing.T, createdWallet *bitcoin.CreatedWalletInfoDTO, err error) {
 				assert.Nil(t, err)
@@ -734,11 +1082,11 @@ func TestService_CreateWallet(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.CreateWalletDTO) {
-				btcRpcSvc.EXPECT().CreateWallet(ctx, dto.Network).Return("", bitcoin.ErrFailedCreateWallet)
+				btcRpcSvc.EXPECT().CreateWallet(gomock.Any(), dto.Network).Return("", bitcoin.ErrFailedCreateWallet)
 			},
 			expect: func(t *testing.T, createdWallet *bitcoin.CreatedWalletInfoDTO, err error) {
 				assert.Nil(t, createdWallet)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedCreateWallet, bitcoin.ErrFailedCreateWallet.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedCreateWallet)
 			},
 		},
 	}
@@ -760,7 +1108,7 @@ func TestService_LoadWaller(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	dto := &bitcoin.LoadWalletDTO{
 		WalletId: "wallet_id",
@@ -779,7 +1127,7 @@ func TestService_LoadWaller(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.LoadWalletDTO) {
-				btcRpcSvc.EXPECT().LoadWallet(ctx, dto.WalletId, dto.Network).Return(nil)
+				btcRpcSvc.EXPECT().LoadWallet(gomock.Any(), dto.WalletId, dto.Network).Return(nil)
 			},
 			expect: func(t *testing.T, loadedWallet *bitcoin.LoadWalletInfoDTO, err error) {
 				assert.Nil(t, err)
@@ -790,11 +1138,11 @@ func TestService_LoadWaller(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.LoadWalletDTO) {
-				btcRpcSvc.EXPECT().LoadWallet(ctx, dto.WalletId, dto.Network).Return(bitcoin.ErrFailedLoadWallet)
+				btcRpcSvc.EXPECT().LoadWallet(gomock.Any(), dto.WalletId, dto.Network).Return(bitcoin.ErrFailedLoadWallet)
 			},
 			expect: func(t *testing.T, loadedWallet *bitcoin.LoadWalletInfoDTO, err error) {
 				assert.Nil(t, loadedWallet)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedLoadWallet, bitcoin.ErrFailedLoadWallet.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedLoadWallet)
 			},
 		},
 	}
@@ -816,7 +1164,7 @@ func TestService_ImportAddress(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	dto := &bitcoin.ImportAddressDTO{
 		Address:  "address",
@@ -836,7 +1184,7 @@ func TestService_ImportAddress(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.ImportAddressDTO) {
-				btcRpcSvc.EXPECT().ImportAddress(ctx, dto.Address, dto.WalletId, dto.Network).Return(nil)
+				btcRpcSvc.EXPECT().ImportAddress(gomock.Any(), dto.Address, dto.WalletId, dto.Network).Return(nil)
 			},
 			expect: func(t *testing.T, importedAddress *bitcoin.ImportAddressInfoDTO, err error) {
 				assert.Nil(t, err)
@@ -847,11 +1195,11 @@ func TestService_ImportAddress(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.ImportAddressDTO) {
-				btcRpcSvc.EXPECT().ImportAddress(ctx, dto.Address, dto.WalletId, dto.Network).Return(bitcoin.ErrFailedImportAddress)
+				btcRpcSvc.EXPECT().ImportAddress(gomock.Any(), dto.Address, dto.WalletId, dto.Network).Return(bitcoin.ErrFailedImportAddress)
 			},
 			expect: func(t *testing.T, importedAddress *bitcoin.ImportAddressInfoDTO, err error) {
 				assert.Nil(t, importedAddress)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedImportAddress, bitcoin.ErrFailedImportAddress.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedImportAddress)
 			},
 		},
 	}
@@ -873,7 +1221,7 @@ func TestService_RescanWallet(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	dto := &bitcoin.RescanWalletDTO{
 		WalletId: "wallet_id",
@@ -892,7 +1240,7 @@ func TestService_RescanWallet(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.RescanWalletDTO) {
-				btcRpcSvc.EXPECT().RescanWallet(ctx, dto.WalletId, dto.Network).Return(nil)
+				btcRpcSvc.EXPECT().RescanWallet(gomock.Any(), dto.WalletId, dto.Network).Return(nil)
 			},
 			expect: func(t *testing.T, rescanInfo *bitcoin.RescanWalletInfoDTO, err error) {
 				assert.Nil(t, err)
@@ -903,11 +1251,11 @@ func TestService_RescanWallet(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.RescanWalletDTO) {
-				btcRpcSvc.EXPECT().RescanWallet(ctx, dto.WalletId, dto.Network).Return(bitcoin.ErrFailedRescanWallet)
+				btcRpcSvc.EXPECT().RescanWallet(gomock.Any(), dto.WalletId, dto.Network).Return(bitcoin.ErrFailedRescanWallet)
 			},
 			expect: func(t *testing.T, rescanInfo *bitcoin.RescanWalletInfoDTO, err error) {
 				assert.Nil(t, rescanInfo)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedRescanWallet, bitcoin.ErrFailedRescanWallet.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedRescanWallet)
 			},
 		},
 	}
@@ -929,7 +1277,7 @@ func TestService_ListUnspent(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := bitcoin.NewService(btcRpcSvc, zapLogger)
+	service, _ := bitcoin.NewService(bitcoin_rpc.KnownChains["bitcoin"], btcRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	dto := &bitcoin.ListUnspentDTO{
 		Address:  "address",
@@ -964,7 +1312,7 @@ func TestService_ListUnspent(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.ListUnspentDTO) {
-				btcRpcSvc.EXPECT().ListUnspent(ctx, dto.Address, dto.WalletId, dto.Network).Return(listUTXO, nil)
+				btcRpcSvc.EXPECT().ListUnspent(gomock.Any(), dto.Address, dto.WalletId, dto.Network).Return(listUTXO, nil)
 			},
 			expect: func(t *testing.T, utxoInfo *bitcoin.ListUnspentInfoDTO, err error) {
 				assert.Nil(t, err)
@@ -975,11 +1323,11 @@ func TestService_ListUnspent(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *bitcoin.ListUnspentDTO) {
-				btcRpcSvc.EXPECT().ListUnspent(ctx, dto.Address, dto.WalletId, dto.Network).Return(nil, bitcoin.ErrFailedGetUnspent)
+				btcRpcSvc.EXPECT().ListUnspent(gomock.Any(), dto.Address, dto.WalletId, dto.Network).Return(nil, bitcoin.ErrFailedGetUnspent)
 			},
 			expect: func(t *testing.T, utxoInfo *bitcoin.ListUnspentInfoDTO, err error) {
 				assert.Nil(t, utxoInfo)
-				assert.Equal(t, err, errors.WithMessage(bitcoin.ErrFailedGetUnspent, bitcoin.ErrFailedGetUnspent.Error()))
+				assert.Equal(t, err, bitcoin.ErrFailedGetUnspent)
 			},
 		},
 	}
@@ -992,3 +1340,21 @@ func TestService_ListUnspent(t *testing.T) {
 		})
 	}
 }
+
+// decodeTestnet decodes a bitcoin testnet transaction the way the service does.
+func decodeTestnet(raw string, prevout policy.Prevout) (*policy.Transaction, error) {
+	coin := bitcoin_rpc.KnownChains[bitcoin_rpc.DefaultChain]
+	return policy.DecodeUTXO(coin.Name, raw, "test", prevout, func(pkScript []byte) string {
+		return coin.ScriptAddress(pkScript, "test")
+	})
+}
+
+func newStorage(t *testing.T) storage.Storage {
+	store, err := bolt_storage.NewStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
@@ -0,0 +1,68 @@
+package bitcoin
+
+import (
+	"context"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/tracing"
+
+	"github.com/btcsuite/btcutil"
+)
+
+const CheckMempoolAccept = "mempool_accept"
+
+// rpcVerifyRejected is the code Bitcoin Core rejects a transaction with on broadcast.
+const rpcVerifyRejected = -26
+
+func (s *service) SimulateTransaction(ctx context.Context, dto *SimulateTransactionDTO) (*SimulationDTO, error) {
+	ctx, span := tracing.Start(ctx, "bitcoin.Service/SimulateTransaction")
+	defer span.End()
+
+	simulation, err := s.simulate(ctx, dto.SignedTx, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed simulate transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSimulateTx, err)
+	}
+
+	return simulation, nil
+}
+
+// preflight refuses a transaction the node would not accept to its mempool with the
+// error sendrawtransaction would have answered.
+func (s *service) preflight(ctx context.Context, signedTx, network string) (*SimulationDTO, error) {
+	simulation, err := s.simulate(ctx, signedTx, network)
+	if err != nil {
+		return nil, err
+	}
+	if !simulation.Passed {
+		return nil, errors.FromBitcoinRPC(rpcVerifyRejected, simulation.Checks[0].Message)
+	}
+	return simulation, nil
+}
+
+// simulate runs testmempoolaccept, which checks consensus rules, standardness and fees
+// without broadcasting.
+func (s *service) simulate(ctx context.Context, signedTx, network string) (*SimulationDTO, error) {
+	result, err := s.btcRpcSvc.TestMempoolAccept(ctx, signedTx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := &SimulationDTO{TxId: result.TxId, WTxId: result.WTxId, Passed: result.Allowed}
+	if !result.Allowed {
+		check := CheckDTO{Name: CheckMempoolAccept, Message: result.RejectReason}
+		if typed, ok := errors.FromBitcoinRPC(rpcVerifyRejected, result.RejectReason).(*errors.Error); ok {
+			check.Status = typed.Status
+		}
+		simulation.Checks = []CheckDTO{check}
+		return simulation, nil
+	}
+
+	simulation.Checks = []CheckDTO{{Name: CheckMempoolAccept, Passed: true}}
+	simulation.Vsize = result.Vsize
+	simulation.Fee = result.Fees.Base
+	if fee, err := btcutil.NewAmount(result.Fees.Base); err == nil && result.Vsize > 0 {
+		simulation.FeeRate = float64(fee) / float64(result.Vsize)
+	}
+	return simulation, nil
+}
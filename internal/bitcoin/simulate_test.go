@@ -0,0 +1,78 @@
+package bitcoin_test
+
+import (
+	"context"
+	"nn-blockchain-api/internal/bitcoin"
+	"nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_SimulateTransaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, btcRpcSvc, _, _ := newMockedService(t, controller)
+	dto := &bitcoin.SimulateTransactionDTO{SignedTx: unsignedTx, Network: "test"}
+
+	tests := []struct {
+		name   string
+		setup  func()
+		expect func(t *testing.T, simulation *bitcoin.SimulationDTO, err error)
+	}{
+		{
+			name: "should report fee rate of accepted transaction",
+			setup: func() {
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), unsignedTx, "test").
+					Return(&bitcoin_rpc.MempoolAcceptResult{TxId: txId, WTxId: txId, Allowed: true, Vsize: 141, Fees: bitcoin_rpc.TxFees{Base: 0.00001410}}, nil)
+			},
+			expect: func(t *testing.T, simulation *bitcoin.SimulationDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &bitcoin.SimulationDTO{
+					TxId:    txId,
+					WTxId:   txId,
+					Passed:  true,
+					Checks:  []bitcoin.CheckDTO{{Name: bitcoin.CheckMempoolAccept, Passed: true}},
+					Vsize:   141,
+					Fee:     0.00001410,
+					FeeRate: 10,
+				}, simulation)
+			},
+		},
+		{
+			name: "should report reject reason",
+			setup: func() {
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), unsignedTx, "test").
+					Return(&bitcoin_rpc.MempoolAcceptResult{TxId: txId, WTxId: txId, RejectReason: "min relay fee not met"}, nil)
+			},
+			expect: func(t *testing.T, simulation *bitcoin.SimulationDTO, err error) {
+				assert.Nil(t, err)
+				assert.False(t, simulation.Passed)
+				assert.Equal(t, []bitcoin.CheckDTO{
+					{Name: bitcoin.CheckMempoolAccept, Status: errors.StatusFeeTooLow, Message: "min relay fee not met"},
+				}, simulation.Checks)
+			},
+		},
+		{
+			name: "should fail when the node is unavailable",
+			setup: func() {
+				btcRpcSvc.EXPECT().TestMempoolAccept(gomock.Any(), unsignedTx, "test").Return(nil, errors.NewNodeUnavailable("connection refused"))
+			},
+			expect: func(t *testing.T, simulation *bitcoin.SimulationDTO, err error) {
+				assert.Nil(t, simulation)
+				assert.Equal(t, errors.NewNodeUnavailable("connection refused"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			simulation, err := service.SimulateTransaction(context.Background(), dto)
+			tc.expect(t, simulation, err)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package ethereum
+
+import (
+	"context"
+	"nn-blockchain-api/pkg/errors"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"nn-blockchain-api/pkg/storage"
+	"nn-blockchain-api/pkg/tracing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func (s *service) CallContract(ctx context.Context, dto *ContractCallDTO) (*ContractCallResultDTO, error) {
+	ctx, span := tracing.Start(ctx, "ethereum.Service/CallContract")
+	defer span.End()
+
+	result, err := s.callContract(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed call contract: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedCallContract, err)
+	}
+
+	return result, nil
+}
+
+func (s *service) callContract(ctx context.Context, dto *ContractCallDTO) (*ContractCallResultDTO, error) {
+	contract, method, data, err := packCall(dto.ABI, dto.Method, dto.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	block := dto.Block
+	if block == "" {
+		block = "latest"
+	}
+	if block, err = blockNumber(block); err != nil {
+		return nil, err
+	}
+
+	call, err := s.ethRpcSvc.Call(ctx, ethereum_rpc.CallMsg{From: dto.From, To: dto.Contract, Data: data}, block, dto.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	if call.Reverted {
+		result := &ContractCallResultDTO{
+			Outputs:      []ContractOutputDTO{},
+			Reverted:     true,
+			RevertReason: ethereum_rpc.RevertReason(contract, call.RevertData),
+		}
+		if result.RevertReason == "" {
+			result.RevertReason = call.Message
+		}
+		if len(call.RevertData) > 0 {
+			result.RevertData = hexutil.Encode(call.RevertData)
+		}
+		return result, nil
+	}
+
+	// A call to an address without code succeeds with empty output.
+	if len(call.Output) == 0 && len(method.Outputs) > 0 {
+		return nil, errors.NewRejected(errors.StatusExecutionReverted, "call returned no data, "+dto.Contract+" may not be a contract")
+	}
+	outputs, err := ethereum_rpc.UnpackOutputs(method, call.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ContractCallResultDTO{Outputs: make([]ContractOutputDTO, len(outputs))}
+	for i, output := range outputs {
+		result.Outputs[i] = ContractOutputDTO{Name: output.Name, Type: output.Type, Value: output.Value}
+	}
+	return result, nil
+}
+
+func (s *service) CreateContractTransaction(ctx context.Context, dto *CreateContractTransactionDTO) (*CreatedContractTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "ethereum.Service/CreateContractTransaction")
+	defer span.End()
+
+	tx, err := s.createContractTransaction(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed create contract transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedCreateTx, err)
+	}
+
+	if _, err := storage.RecordCreatedTx(ctx, s.store, s.evm.Name, dto.Network, tx.Tx, tx.Fee); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record created transaction: %v", err)
+	}
+
+	return tx, nil
+}
+
+func (s *service) createContractTransaction(ctx context.Context, dto *CreateContractTransactionDTO) (*CreatedContractTransactionDTO, error) {
+	_, method, data, err := packCall(dto.ABI, dto.Method, dto.Args)
+	if err != nil {
+		return nil, err
+	}
+	if dto.Amount > 0 && !method.IsPayable() {
+		return nil, errors.WithMessage(ErrInvalidRequest, "method %s is not payable", method.Sig)
+	}
+
+	value := ethereum_rpc.ToWei(dto.Amount, s.evm.Decimals)
+	tx, err := s.ethRpcSvc.BuildTransaction(ctx, dto.FromAddress, dto.Contract, data, value, dto.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &CreatedContractTransactionDTO{
+		Tx:       tx.Tx,
+		Fee:      tx.Fee,
+		Data:     hexutil.Encode(data),
+		Nonce:    tx.Nonce,
+		Gas:      tx.Gas,
+		GasPrice: tx.GasPrice.String(),
+	}
+	if tx.L1Fee != nil {
+		created.L1Fee = tx.L1Fee.String()
+	}
+	return created, nil
+}
+
+// packCall parses the ABI and encodes the calldata, rejecting inputs that do not match it.
+func packCall(fragment, name string, args []interface{}) (*abi.ABI, *abi.Method, []byte, error) {
+	contract, err := ethereum_rpc.ParseABI(fragment)
+	if err != nil {
+		return nil, nil, nil, errors.WithMessage(ErrInvalidRequest, "%v", err)
+	}
+	method, err := ethereum_rpc.Method(contract, name)
+	if err != nil {
+		return nil, nil, nil, errors.WithMessage(ErrInvalidRequest, "%v", err)
+	}
+	data, err := ethereum_rpc.PackCall(method, args)
+	if err != nil {
+		return nil, nil, nil, errors.WithMessage(ErrInvalidRequest, "%v", err)
+	}
+	return contract, method, data, nil
+}
@@ -0,0 +1,160 @@
+package ethereum_test
+
+import (
+	"context"
+	"math/big"
+	"nn-blockchain-api/internal/ethereum"
+	"nn-blockchain-api/pkg/errors"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	balanceOfABI  = `{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"}`
+	depositABI    = `[{"inputs":[{"name":"to","type":"address"}],"name":"deposit","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"name":"to","type":"address"}],"name":"withdraw","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	holder        = "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+	balanceOfCall = "0x70a08231000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d359"
+)
+
+func TestService_CallContract(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, ethRpcSvc := newExplorerService(t, controller)
+	msg := ethereum_rpc.CallMsg{To: token, Data: hexutil.MustDecode(balanceOfCall)}
+
+	tests := []struct {
+		name   string
+		dto    *ethereum.ContractCallDTO
+		setup  func()
+		expect func(t *testing.T, result *ethereum.ContractCallResultDTO, err error)
+	}{
+		{
+			name: "should decode outputs",
+			dto:  &ethereum.ContractCallDTO{Contract: token, ABI: balanceOfABI, Method: "balanceOf", Args: []interface{}{holder}, Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().Call(gomock.Any(), msg, "latest", "test").
+					Return(&ethereum_rpc.CallResult{Output: hexutil.MustDecode("0x00000000000000000000000000000000000000000000003635c9adc5dea00000")}, nil)
+			},
+			expect: func(t *testing.T, result *ethereum.ContractCallResultDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &ethereum.ContractCallResultDTO{
+					Outputs: []ethereum.ContractOutputDTO{{Name: "balance", Type: "uint256", Value: "1000000000000000000000"}},
+				}, result)
+			},
+		},
+		{
+			name: "should decode revert reason",
+			dto:  &ethereum.ContractCallDTO{Contract: token, ABI: balanceOfABI, Method: "balanceOf", Args: []interface{}{holder}, Block: "16", Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().Call(gomock.Any(), msg, "0x10", "test").
+					Return(&ethereum_rpc.CallResult{Reverted: true, Message: "execution reverted: paused", RevertData: hexutil.MustDecode("0x08c379a0" +
+						"0000000000000000000000000000000000000000000000000000000000000020" +
+						"0000000000000000000000000000000000000000000000000000000000000006" +
+						"7061757365640000000000000000000000000000000000000000000000000000")}, nil)
+			},
+			expect: func(t *testing.T, result *ethereum.ContractCallResultDTO, err error) {
+				assert.Nil(t, err)
+				assert.True(t, result.Reverted)
+				assert.Equal(t, "paused", result.RevertReason)
+				assert.Empty(t, result.Outputs)
+			},
+		},
+		{
+			name: "should report call to an account without code",
+			dto:  &ethereum.ContractCallDTO{Contract: token, ABI: balanceOfABI, Method: "balanceOf", Args: []interface{}{holder}, Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().Call(gomock.Any(), msg, "latest", "test").Return(&ethereum_rpc.CallResult{}, nil)
+			},
+			expect: func(t *testing.T, result *ethereum.ContractCallResultDTO, err error) {
+				assert.Nil(t, result)
+				assert.Equal(t, errors.NewRejected(errors.StatusExecutionReverted, "call returned no data, "+token+" may not be a contract"), err)
+			},
+		},
+		{
+			name:  "should reject arguments the abi does not accept",
+			dto:   &ethereum.ContractCallDTO{Contract: token, ABI: balanceOfABI, Method: "balanceOf", Args: []interface{}{true}, Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, result *ethereum.ContractCallResultDTO, err error) {
+				assert.Nil(t, result)
+				assert.Equal(t, errors.WithMessage(ethereum.ErrInvalidRequest, "invalid argument account: expected address, got true"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			result, err := service.CallContract(context.Background(), tc.dto)
+			tc.expect(t, result, err)
+		})
+	}
+}
+
+func TestService_CreateContractTransaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, ethRpcSvc := newExplorerService(t, controller)
+	calldata := hexutil.MustDecode("0xf340fa01000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d359")
+
+	tests := []struct {
+		name   string
+		dto    *ethereum.CreateContractTransactionDTO
+		setup  func()
+		expect func(t *testing.T, tx *ethereum.CreatedContractTransactionDTO, err error)
+	}{
+		{
+			name: "should build payable call",
+			dto:  &ethereum.CreateContractTransactionDTO{FromAddress: holder, Contract: token, ABI: depositABI, Method: "deposit", Args: []interface{}{holder}, Amount: 0.5, Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().BuildTransaction(gomock.Any(), holder, token, calldata, big.NewInt(500000000000000000), "test").
+					Return(&ethereum_rpc.UnsignedTransaction{Tx: "f8", Fee: 0.00005, Nonce: 3, Gas: 50000, GasPrice: big.NewInt(1000000000)}, nil)
+			},
+			expect: func(t *testing.T, tx *ethereum.CreatedContractTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &ethereum.CreatedContractTransactionDTO{
+					Tx:       "f8",
+					Fee:      0.00005,
+					Data:     hexutil.Encode(calldata),
+					Nonce:    3,
+					Gas:      50000,
+					GasPrice: "1000000000",
+				}, tx)
+			},
+		},
+		{
+			name:  "should reject value for non payable method",
+			dto:   &ethereum.CreateContractTransactionDTO{FromAddress: holder, Contract: token, ABI: depositABI, Method: "withdraw", Args: []interface{}{holder}, Amount: 1, Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, tx *ethereum.CreatedContractTransactionDTO, err error) {
+				assert.Nil(t, tx)
+				assert.Equal(t, errors.WithMessage(ethereum.ErrInvalidRequest, "method withdraw(address) is not payable"), err)
+			},
+		},
+		{
+			name: "should keep revert of gas estimation",
+			dto:  &ethereum.CreateContractTransactionDTO{FromAddress: holder, Contract: token, ABI: depositABI, Method: "withdraw", Args: []interface{}{holder}, Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().BuildTransaction(gomock.Any(), holder, token, gomock.Any(), big.NewInt(0), "test").
+					Return(nil, errors.FromEthereumRPC(3, "execution reverted: nothing to withdraw"))
+			},
+			expect: func(t *testing.T, tx *ethereum.CreatedContractTransactionDTO, err error) {
+				assert.Nil(t, tx)
+				assert.Equal(t, errors.NewRejected(errors.StatusExecutionReverted, "execution reverted: nothing to withdraw"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			tx, err := service.CreateContractTransaction(context.Background(), tc.dto)
+			tc.expect(t, tx, err)
+		})
+	}
+}
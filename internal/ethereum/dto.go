@@ -1,49 +1,16 @@
 package ethereum
 
 import (
-	"fmt"
 	"nn-blockchain-api/pkg/errors"
-	"strings"
-
-	"github.com/go-playground/validator/v10"
+	"nn-blockchain-api/pkg/validation"
 )
 
-type ApiError struct {
-	Field string
-	Msg   string
-}
-
-func msgForTag(tag string) string {
-	switch tag {
-	case "required":
-		return "is required"
-	}
-	return ""
-}
-
 func Validate(dto interface{}) error {
-	validate := validator.New()
-
-	if err := validate.Struct(dto); err != nil {
-		if _, ok := err.(*validator.InvalidValidationError); ok {
-			return errors.WithMessage(ErrInvalidRequest, err.Error())
-		}
-
-		var out []string
-		for _, err := range err.(validator.ValidationErrors) {
-			//out = append(out, ApiError{
-			//	Field: err.Field(),
-			//	Msg:   msgForTag(err.Tag()),
-			//})
-			out = append(out, fmt.Sprintf("%v - %v", err.Field(), msgForTag(err.Tag())))
-		}
-		return errors.WithMessage(ErrInvalidRequest, strings.Join(out, ", "))
-	}
-	return nil
+	return validation.Validate(ErrInvalidRequest, dto)
 }
 
 type StatusNodeDTO struct {
-	Network string `json:"network" validate:"required"`
+	Network string `json:"network" validate:"required,network"`
 }
 
 type NodeInfoDTO struct {
@@ -66,10 +33,10 @@ type NodeInfoDTO struct {
 }
 
 type CreateRawTransactionDTO struct {
-	FromAddress string  `json:"from_address" validate:"required"`
-	ToAddress   string  `json:"to_address" validate:"required"`
-	Amount      float64 `json:"amount" validate:"required"`
-	Network     string  `json:"network" validate:"required"`
+	FromAddress string  `json:"from_address" validate:"required,eth_address"`
+	ToAddress   string  `json:"to_address" validate:"required,eth_address"`
+	Amount      float64 `json:"amount" validate:"required,gt=0"`
+	Network     string  `json:"network" validate:"required,network"`
 }
 
 type CreatedRawTransactionDTO struct {
@@ -77,21 +44,186 @@ type CreatedRawTransactionDTO struct {
 	Fee float64 `json:"fee"`
 }
 
+// SignRawTransactionDTO signs with a pasted key, the key of a wallet held by the wallet service or a keystore key.
 type SignRawTransactionDTO struct {
-	Tx         string `json:"tx" validate:"required"`
-	PrivateKey string `json:"privateKey" validate:"required"`
-	Network    string `json:"network" validate:"required"`
+	Tx         string `json:"tx" validate:"required,hex_tx"`
+	PrivateKey string `json:"privateKey,omitempty" validate:"omitempty,hex_privkey"`
+	WalletId   string `json:"wallet_id,omitempty" validate:"excluded_with=PrivateKey"`
+	KeyId      string `json:"key_id,omitempty" validate:"required_without_all=PrivateKey WalletId,excluded_with=PrivateKey WalletId"`
+	Passphrase string `json:"passphrase,omitempty"`
+	ApprovalId string `json:"approval_id,omitempty"`
+	Network    string `json:"network" validate:"required,network"`
 }
 
 type SignedRawTransactionDTO struct {
 	SignedTx string `json:"signed_tx"`
 }
 
+// SendRawTransactionDTO broadcasts a transaction once it passes the pre-flight checks. A
+// dry run stops after the checks.
 type SendRawTransactionDTO struct {
-	SignedTx string `json:"signed_tx" validate:"required"`
-	Network  string `json:"network" validate:"required"`
+	SignedTx string `json:"signed_tx" validate:"required,hex_tx"`
+	Network  string `json:"network" validate:"required,network"`
+	DryRun   bool   `json:"dry_run,omitempty"`
 }
 
+// SentRawTransactionDTO carries the checks of a dry run, TxId is the hash the transaction
+// would have.
 type SentRawTransactionDTO struct {
-	TxId string `json:"tx_id"`
+	TxId       string         `json:"tx_id"`
+	DryRun     bool           `json:"dry_run,omitempty"`
+	Simulation *SimulationDTO `json:"simulation,omitempty"`
+}
+
+type SimulateTransactionDTO struct {
+	SignedTx string `json:"signed_tx" validate:"required,hex_tx"`
+	Network  string `json:"network" validate:"required,network"`
+}
+
+// CheckDTO is the outcome of a pre-flight check, Status is the error status a failure is
+// reported with on send. A passed check may carry a warning, such as a queued nonce.
+type CheckDTO struct {
+	Name    string        `json:"name"`
+	Passed  bool          `json:"passed"`
+	Status  errors.Status `json:"status,omitempty"`
+	Message string        `json:"message,omitempty"`
+	Warning string        `json:"warning,omitempty"`
+}
+
+// SimulationDTO reports the pre-flight checks of a signed transaction: chain id, sender,
+// nonce, balance and a replay of the transaction as eth_call.
+type SimulationDTO struct {
+	TxId   string     `json:"tx_id"`
+	From   string     `json:"from,omitempty"`
+	Passed bool       `json:"passed"`
+	Checks []CheckDTO `json:"checks"`
+}
+
+// BlockDTO selects a block by decimal or hex number, hash or a tag such as latest.
+type BlockDTO struct {
+	Block   string `json:"block" path:"block" validate:"required"`
+	Network string `json:"network" query:"network" validate:"required,network"`
+	Full    bool   `json:"full" query:"full"`
+}
+
+// BlockTransactionDTO is a transaction of a block, amounts are in wei.
+type BlockTransactionDTO struct {
+	Hash     string `json:"hash"`
+	From     string `json:"from"`
+	To       string `json:"to,omitempty"`
+	Nonce    uint64 `json:"nonce"`
+	Value    string `json:"value"`
+	Gas      uint64 `json:"gas"`
+	GasPrice string `json:"gas_price"`
+	Input    string `json:"input"`
+	Index    uint64 `json:"index"`
+}
+
+// BlockInfoDTO lists the transaction hashes of the block, or the transactions for full requests.
+type BlockInfoDTO struct {
+	Number        uint64                `json:"number"`
+	Hash          string                `json:"hash"`
+	ParentHash    string                `json:"parent_hash"`
+	Timestamp     uint64                `json:"timestamp"`
+	Miner         string                `json:"miner"`
+	GasLimit      uint64                `json:"gas_limit"`
+	GasUsed       uint64                `json:"gas_used"`
+	BaseFeePerGas string                `json:"base_fee_per_gas,omitempty"`
+	Size          uint64                `json:"size"`
+	TxCount       int64                 `json:"tx_count"`
+	TxHashes      []string              `json:"tx_hashes,omitempty"`
+	Transactions  []BlockTransactionDTO `json:"transactions,omitempty"`
+}
+
+// GetLogsDTO filters logs by emitting contracts and topics over a block range. Each entry
+// of Topics lists the accepted values at its position, null accepts any. Logs are decoded
+// when ABI holds the JSON ABI of their events.
+type GetLogsDTO struct {
+	Addresses []string   `json:"addresses,omitempty" validate:"max=100,dive,eth_address"`
+	Topics    [][]string `json:"topics,omitempty" validate:"max=4,dive,max=100,dive,len=66,hexadecimal"`
+	FromBlock string     `json:"from_block" validate:"required"`
+	ToBlock   string     `json:"to_block,omitempty"`
+	ABI       string     `json:"abi,omitempty"`
+	Network   string     `json:"network" validate:"required,network"`
+}
+
+type EventDTO struct {
+	Name      string                 `json:"name"`
+	Signature string                 `json:"signature"`
+	Args      map[string]interface{} `json:"args"`
+}
+
+type LogDTO struct {
+	Address     string    `json:"address"`
+	Topics      []string  `json:"topics"`
+	Data        string    `json:"data"`
+	BlockNumber uint64    `json:"block_number"`
+	BlockHash   string    `json:"block_hash"`
+	TxHash      string    `json:"tx_hash"`
+	TxIndex     uint64    `json:"tx_index"`
+	LogIndex    uint64    `json:"log_index"`
+	Removed     bool      `json:"removed,omitempty"`
+	Event       *EventDTO `json:"event,omitempty"`
+	DecodeError string    `json:"decode_error,omitempty"`
+}
+
+// LogsDTO carries the logs of the resolved block range in chain order.
+type LogsDTO struct {
+	FromBlock uint64   `json:"from_block"`
+	ToBlock   uint64   `json:"to_block"`
+	Logs      []LogDTO `json:"logs"`
+}
+
+// ContractCallDTO runs a read-only call of Method on Contract. ABI is the JSON ABI of the
+// contract or a fragment declaring the method, and Method a name or, for overloads, a
+// signature. Args are JSON values in input order: integers as numbers or decimal or hex
+// strings, addresses and byte strings as hex, arrays as arrays and tuples as objects.
+type ContractCallDTO struct {
+	Contract string        `json:"contract" validate:"required,eth_address"`
+	ABI      string        `json:"abi" validate:"required"`
+	Method   string        `json:"method" validate:"required"`
+	Args     []interface{} `json:"args,omitempty"`
+	From     string        `json:"from,omitempty" validate:"omitempty,eth_address"`
+	Block    string        `json:"block,omitempty"`
+	Network  string        `json:"network" validate:"required,network"`
+}
+
+type ContractOutputDTO struct {
+	Name  string      `json:"name,omitempty"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// ContractCallResultDTO holds the decoded outputs of the call, or why it reverted.
+type ContractCallResultDTO struct {
+	Outputs      []ContractOutputDTO `json:"outputs"`
+	Reverted     bool                `json:"reverted,omitempty"`
+	RevertReason string              `json:"revert_reason,omitempty"`
+	RevertData   string              `json:"revert_data,omitempty"`
+}
+
+// CreateContractTransactionDTO builds a call of Method on Contract as CreateRawTransactionDTO
+// builds a transfer. ABI, Method and Args are given as for ContractCallDTO, Amount is the
+// native currency sent to a payable method.
+type CreateContractTransactionDTO struct {
+	FromAddress string        `json:"from_address" validate:"required,eth_address"`
+	Contract    string        `json:"contract" validate:"required,eth_address"`
+	ABI         string        `json:"abi" validate:"required"`
+	Method      string        `json:"method" validate:"required"`
+	Args        []interface{} `json:"args,omitempty"`
+	Amount      float64       `json:"amount,omitempty" validate:"gte=0"`
+	Network     string        `json:"network" validate:"required,network"`
+}
+
+// CreatedContractTransactionDTO is an unsigned transaction for /sign-raw-tx, amounts in wei.
+// GasPrice is the fee cap on EIP-1559 chains, the fee includes the L1 data fee of OP-stack
+// chains.
+type CreatedContractTransactionDTO struct {
+	Tx       string  `json:"tx"`
+	Fee      float64 `json:"fee"`
+	Data     string  `json:"data"`
+	Nonce    uint64  `json:"nonce"`
+	Gas      uint64  `json:"gas"`
+	GasPrice string  `json:"gas_price"`
+	L1Fee    string  `json:"l1_fee,omitempty"`
 }
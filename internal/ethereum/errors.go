@@ -11,6 +11,10 @@ const (
 	StatusFailedCreateTx      errors.Status = "failed_create_tx"
 	StatusFailedSignTx        errors.Status = "failed_sign_tx"
 	StatusFailedSendTx        errors.Status = "failed_send_tx"
+	StatusFailedGetBlock      errors.Status = "failed_get_block"
+	StatusFailedGetLogs       errors.Status = "failed_get_logs"
+	StatusFailedCallContract  errors.Status = "failed_call_contract"
+	StatusFailedSimulateTx    errors.Status = "failed_simulate_tx"
 )
 
 var (
@@ -19,4 +23,8 @@ var (
 	ErrFailedCreateTx      = errors.New(codes.BadRequest, StatusFailedCreateTx)
 	ErrFailedSignTx        = errors.New(codes.BadRequest, StatusFailedSignTx)
 	ErrFailedSendTx        = errors.New(codes.BadRequest, StatusFailedSendTx)
+	ErrFailedGetBlock      = errors.New(codes.InternalError, StatusFailedGetBlock)
+	ErrFailedGetLogs       = errors.New(codes.InternalError, StatusFailedGetLogs)
+	ErrFailedCallContract  = errors.New(codes.InternalError, StatusFailedCallContract)
+	ErrFailedSimulateTx    = errors.New(codes.InternalError, StatusFailedSimulateTx)
 )
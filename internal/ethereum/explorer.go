@@ -0,0 +1,300 @@
+package ethereum
+
+import (
+	"context"
+	gErrors "errors"
+	"nn-blockchain-api/pkg/errors"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"nn-blockchain-api/pkg/tracing"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// logChunkBlocks is the block range of one eth_getLogs call, ranges the node still
+	// finds too large are halved until they pass.
+	logChunkBlocks = 2000
+	maxLogBlocks   = 100000
+	maxLogs        = 10000
+)
+
+var blockTags = map[string]bool{"latest": true, "earliest": true, "pending": true, "safe": true, "finalized": true}
+
+func (s *service) Block(ctx context.Context, dto *BlockDTO) (*BlockInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "ethereum.Service/Block")
+	defer span.End()
+
+	block, err := s.block(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed get block: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetBlock, err)
+	}
+
+	return block, nil
+}
+
+func (s *service) block(ctx context.Context, dto *BlockDTO) (*BlockInfoDTO, error) {
+	var block *ethereum_rpc.Block
+	if len(dto.Block) == 66 && strings.HasPrefix(dto.Block, "0x") {
+		if _, err := hexutil.Decode(dto.Block); err != nil {
+			return nil, errors.WithMessage(ErrInvalidRequest, "invalid block hash %v", dto.Block)
+		}
+		b, err := s.ethRpcSvc.BlockByHash(ctx, dto.Block, dto.Full, dto.Network)
+		if err != nil {
+			return nil, err
+		}
+		block = b
+	} else {
+		number, err := blockNumber(dto.Block)
+		if err != nil {
+			return nil, err
+		}
+		if block, err = s.ethRpcSvc.BlockByNumber(ctx, number, dto.Full, dto.Network); err != nil {
+			return nil, err
+		}
+	}
+	if block == nil {
+		return nil, errors.NewMissing(errors.StatusBlockNotFound, "block "+dto.Block+" not found")
+	}
+
+	info, err := blockInfo(block)
+	if err != nil {
+		return nil, err
+	}
+	if !dto.Full {
+		if info.TxHashes, err = block.TransactionHashes(); err != nil {
+			return nil, err
+		}
+		info.TxCount = int64(len(info.TxHashes))
+		return info, nil
+	}
+
+	txs, err := block.FullTransactions()
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		item, err := blockTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+		info.Transactions = append(info.Transactions, *item)
+	}
+	info.TxCount = int64(len(txs))
+	return info, nil
+}
+
+func blockInfo(block *ethereum_rpc.Block) (*BlockInfoDTO, error) {
+	info := &BlockInfoDTO{Hash: block.Hash, ParentHash: block.ParentHash, Miner: block.Miner}
+	var err error
+	if info.Number, err = hexutil.DecodeUint64(block.Number); err != nil {
+		return nil, err
+	}
+	if info.Timestamp, err = hexutil.DecodeUint64(block.Timestamp); err != nil {
+		return nil, err
+	}
+	if info.GasLimit, err = hexutil.DecodeUint64(block.GasLimit); err != nil {
+		return nil, err
+	}
+	if info.GasUsed, err = hexutil.DecodeUint64(block.GasUsed); err != nil {
+		return nil, err
+	}
+	if info.Size, err = hexutil.DecodeUint64(block.Size); err != nil {
+		return nil, err
+	}
+	if block.BaseFeePerGas != "" {
+		baseFee, err := hexutil.DecodeBig(block.BaseFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		info.BaseFeePerGas = baseFee.String()
+	}
+	return info, nil
+}
+
+func blockTransaction(tx ethereum_rpc.TransactionByHashResponse) (*BlockTransactionDTO, error) {
+	item := &BlockTransactionDTO{Hash: tx.Hash, From: tx.From, To: tx.To, Input: tx.Input}
+	var err error
+	if item.Nonce, err = hexutil.DecodeUint64(tx.Nonce); err != nil {
+		return nil, err
+	}
+	if item.Gas, err = hexutil.DecodeUint64(tx.Gas); err != nil {
+		return nil, err
+	}
+	if item.Index, err = hexutil.DecodeUint64(tx.TransactionIndex); err != nil {
+		return nil, err
+	}
+	value, err := hexutil.DecodeBig(tx.Value)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := hexutil.DecodeBig(tx.GasPrice)
+	if err != nil {
+		return nil, err
+	}
+	item.Value, item.GasPrice = value.String(), gasPrice.String()
+	return item, nil
+}
+
+// blockNumber turns a decimal or hex number or a tag into the block parameter of the node.
+func blockNumber(block string) (string, error) {
+	if blockTags[block] {
+		return block, nil
+	}
+	if strings.HasPrefix(block, "0x") {
+		if _, err := hexutil.DecodeUint64(block); err != nil {
+			return "", errors.WithMessage(ErrInvalidRequest, "invalid block number %v", block)
+		}
+		return block, nil
+	}
+	number, err := strconv.ParseUint(block, 10, 64)
+	if err != nil {
+		return "", errors.WithMessage(ErrInvalidRequest, "block %v is neither a number, a hash nor a tag", block)
+	}
+	return hexutil.EncodeUint64(number), nil
+}
+
+// GetLogs splits the block range into chunks the node serves, so callers can reconcile
+// events of long ranges in one request.
+func (s *service) GetLogs(ctx context.Context, dto *GetLogsDTO) (*LogsDTO, error) {
+	ctx, span := tracing.Start(ctx, "ethereum.Service/GetLogs")
+	defer span.End()
+
+	logs, err := s.getLogs(ctx, dto)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed get logs: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetLogs, err)
+	}
+
+	return logs, nil
+}
+
+func (s *service) getLogs(ctx context.Context, dto *GetLogsDTO) (*LogsDTO, error) {
+	var contract *abi.ABI
+	if dto.ABI != "" {
+		var err error
+		if contract, err = ethereum_rpc.ParseEventABI(dto.ABI); err != nil {
+			return nil, errors.WithMessage(ErrInvalidRequest, "%v", err)
+		}
+	}
+
+	toBlock := dto.ToBlock
+	if toBlock == "" {
+		toBlock = "latest"
+	}
+	from, err := s.resolveBlock(ctx, dto.FromBlock, dto.Network)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.resolveBlock(ctx, toBlock, dto.Network)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, errors.WithMessage(ErrInvalidRequest, "from_block %v is after to_block %v", from, to)
+	}
+	if to-from >= maxLogBlocks {
+		return nil, errors.WithMessage(ErrInvalidRequest, "at most %d blocks can be queried at once", maxLogBlocks)
+	}
+
+	filter := ethereum_rpc.LogFilter{Addresses: dto.Addresses, Topics: dto.Topics}
+	result := &LogsDTO{FromBlock: from, ToBlock: to, Logs: []LogDTO{}}
+	for start := from; start <= to; start += logChunkBlocks {
+		end := start + logChunkBlocks - 1
+		if end > to {
+			end = to
+		}
+		logs, err := s.logsInRange(ctx, filter, start, end, dto.Network)
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Logs)+len(logs) > maxLogs {
+			return nil, errors.NewRejected(errors.StatusResultTooLarge, "more than "+strconv.Itoa(maxLogs)+" logs match, narrow the filter or the block range")
+		}
+		for i := range logs {
+			item, err := logDTO(contract, &logs[i])
+			if err != nil {
+				return nil, err
+			}
+			result.Logs = append(result.Logs, *item)
+		}
+	}
+	return result, nil
+}
+
+// logsInRange halves the range while the node reports too many results for it.
+func (s *service) logsInRange(ctx context.Context, filter ethereum_rpc.LogFilter, from, to uint64, network string) ([]ethereum_rpc.Log, error) {
+	filter.FromBlock, filter.ToBlock = hexutil.EncodeUint64(from), hexutil.EncodeUint64(to)
+	logs, err := s.ethRpcSvc.GetLogs(ctx, filter, network)
+
+	var typed *errors.Error
+	if err == nil || from == to || !gErrors.As(err, &typed) || typed.Status != errors.StatusResultTooLarge {
+		return logs, err
+	}
+
+	mid := from + (to-from)/2
+	first, err := s.logsInRange(ctx, filter, from, mid, network)
+	if err != nil {
+		return nil, err
+	}
+	second, err := s.logsInRange(ctx, filter, mid+1, to, network)
+	if err != nil {
+		return nil, err
+	}
+	return append(first, second...), nil
+}
+
+// resolveBlock turns a block number or tag into a height, tags are resolved by the node.
+func (s *service) resolveBlock(ctx context.Context, block, network string) (uint64, error) {
+	number, err := blockNumber(block)
+	if err != nil {
+		return 0, err
+	}
+	if blockTags[number] {
+		header, err := s.ethRpcSvc.HeaderByNumber(ctx, number, network)
+		if err != nil {
+			return 0, err
+		}
+		number = header.Number
+	}
+	return hexutil.DecodeUint64(number)
+}
+
+// logDTO converts a log and decodes it when an ABI is given. Logs the ABI cannot decode
+// keep their raw topics and data along with the reason.
+func logDTO(contract *abi.ABI, log *ethereum_rpc.Log) (*LogDTO, error) {
+	item := &LogDTO{
+		Address:   log.Address,
+		Topics:    log.Topics,
+		Data:      log.Data,
+		BlockHash: log.BlockHash,
+		TxHash:    log.TransactionHash,
+		Removed:   log.Removed,
+	}
+	var err error
+	if item.BlockNumber, err = hexutil.DecodeUint64(log.BlockNumber); err != nil {
+		return nil, err
+	}
+	if item.TxIndex, err = hexutil.DecodeUint64(log.TransactionIndex); err != nil {
+		return nil, err
+	}
+	if item.LogIndex, err = hexutil.DecodeUint64(log.LogIndex); err != nil {
+		return nil, err
+	}
+
+	if contract == nil {
+		return item, nil
+	}
+	event, err := ethereum_rpc.DecodeEvent(contract, log)
+	if err != nil {
+		item.DecodeError = err.Error()
+	} else if event != nil {
+		item.Event = &EventDTO{Name: event.Name, Signature: event.Signature, Args: event.Args}
+	}
+	return item, nil
+}
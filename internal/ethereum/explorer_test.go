@@ -0,0 +1,223 @@
+package ethereum_test
+
+import (
+	"context"
+	"encoding/json"
+	"nn-blockchain-api/internal/ethereum"
+	mock_wallet "nn-blockchain-api/internal/wallet/mocks"
+	"nn-blockchain-api/pkg/errors"
+	mock_keystore "nn-blockchain-api/pkg/keystore/mocks"
+	"nn-blockchain-api/pkg/logger"
+	mock_policy "nn-blockchain-api/pkg/policy/mocks"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	mock_ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	token         = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	transferABI   = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+	transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	addressTopic  = "0x000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d359"
+)
+
+func newExplorerService(t *testing.T, controller *gomock.Controller) (ethereum.Service, *mock_ethereum_rpc.MockService) {
+	ethRpcSvc := mock_ethereum_rpc.NewMockService(controller)
+
+	newLogger, _ := logger.NewLogger("development")
+	zapLogger, _ := newLogger.SetupZapLogger()
+	service, err := ethereum.NewService(ethereum_rpc.KnownChains["ethereum"], ethRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
+	assert.Nil(t, err)
+	return service, ethRpcSvc
+}
+
+func TestService_Block(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, ethRpcSvc := newExplorerService(t, controller)
+	header := ethereum_rpc.BlockHeader{Number: "0x10", Hash: "0xabc", Timestamp: "0x5f5e100", GasLimit: "0x1c9c380", GasUsed: "0x5208", BaseFeePerGas: "0x3b9aca00"}
+
+	tests := []struct {
+		name   string
+		dto    *ethereum.BlockDTO
+		setup  func()
+		expect func(t *testing.T, block *ethereum.BlockInfoDTO, err error)
+	}{
+		{
+			name: "should get block by decimal number",
+			dto:  &ethereum.BlockDTO{Block: "16", Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().BlockByNumber(gomock.Any(), "0x10", false, "test").
+					Return(&ethereum_rpc.Block{BlockHeader: header, Size: "0x220", Transactions: json.RawMessage(`["0x01","0x02"]`)}, nil)
+			},
+			expect: func(t *testing.T, block *ethereum.BlockInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, &ethereum.BlockInfoDTO{
+					Number:        16,
+					Hash:          "0xabc",
+					Timestamp:     100000000,
+					GasLimit:      30000000,
+					GasUsed:       21000,
+					BaseFeePerGas: "1000000000",
+					Size:          544,
+					TxCount:       2,
+					TxHashes:      []string{"0x01", "0x02"},
+				}, block)
+			},
+		},
+		{
+			name: "should get full transactions by tag",
+			dto:  &ethereum.BlockDTO{Block: "latest", Network: "test", Full: true},
+			setup: func() {
+				ethRpcSvc.EXPECT().BlockByNumber(gomock.Any(), "latest", true, "test").
+					Return(&ethereum_rpc.Block{BlockHeader: header, Size: "0x220", Transactions: json.RawMessage(`[{"hash":"0x01","from":"0xaa","to":"0xbb","nonce":"0x1","value":"0xde0b6b3a7640000","gas":"0x5208","gasPrice":"0x3b9aca00","input":"0x","transactionIndex":"0x0"}]`)}, nil)
+			},
+			expect: func(t *testing.T, block *ethereum.BlockInfoDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []ethereum.BlockTransactionDTO{{Hash: "0x01", From: "0xaa", To: "0xbb", Nonce: 1, Value: "1000000000000000000", Gas: 21000, GasPrice: "1000000000", Input: "0x"}}, block.Transactions)
+				assert.Equal(t, int64(1), block.TxCount)
+			},
+		},
+		{
+			name: "should report unknown block",
+			dto:  &ethereum.BlockDTO{Block: "0x88e96d4537bea4d9c05d12549907b32561d3bf31f45aae734cdc119f13406cb6", Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().BlockByHash(gomock.Any(), "0x88e96d4537bea4d9c05d12549907b32561d3bf31f45aae734cdc119f13406cb6", false, "test").Return(nil, nil)
+			},
+			expect: func(t *testing.T, block *ethereum.BlockInfoDTO, err error) {
+				assert.Nil(t, block)
+				assert.Equal(t, errors.NewMissing(errors.StatusBlockNotFound, "block 0x88e96d4537bea4d9c05d12549907b32561d3bf31f45aae734cdc119f13406cb6 not found"), err)
+			},
+		},
+		{
+			name:  "should reject invalid block",
+			dto:   &ethereum.BlockDTO{Block: "tip", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, block *ethereum.BlockInfoDTO, err error) {
+				assert.Nil(t, block)
+				assert.Equal(t, errors.WithMessage(ethereum.ErrInvalidRequest, "block tip is neither a number, a hash nor a tag"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			block, err := service.Block(context.Background(), tc.dto)
+			tc.expect(t, block, err)
+		})
+	}
+}
+
+func TestService_GetLogs(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, ethRpcSvc := newExplorerService(t, controller)
+	transfer := ethereum_rpc.Log{
+		Address:          token,
+		Topics:           []string{transferTopic, addressTopic, addressTopic},
+		Data:             "0x00000000000000000000000000000000000000000000000000000000000003e8",
+		BlockNumber:      "0x1388",
+		BlockHash:        "0xabc",
+		TransactionHash:  "0xdef",
+		TransactionIndex: "0x1",
+		LogIndex:         "0x2",
+	}
+	filter := func(from, to string) ethereum_rpc.LogFilter {
+		return ethereum_rpc.LogFilter{FromBlock: from, ToBlock: to, Addresses: []string{token}, Topics: [][]string{{transferTopic}}}
+	}
+
+	tests := []struct {
+		name   string
+		dto    *ethereum.GetLogsDTO
+		setup  func()
+		expect func(t *testing.T, logs *ethereum.LogsDTO, err error)
+	}{
+		{
+			name: "should query in chunks and decode events",
+			dto:  &ethereum.GetLogsDTO{Addresses: []string{token}, Topics: [][]string{{transferTopic}}, FromBlock: "1000", ToBlock: "latest", ABI: transferABI, Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().HeaderByNumber(gomock.Any(), "latest", "test").Return(&ethereum_rpc.BlockHeader{Number: "0x1770"}, nil)
+				gomock.InOrder(
+					ethRpcSvc.EXPECT().GetLogs(gomock.Any(), filter("0x3e8", "0xbb7"), "test").Return(nil, nil),
+					ethRpcSvc.EXPECT().GetLogs(gomock.Any(), filter("0xbb8", "0x1387"), "test").Return(nil, nil),
+					ethRpcSvc.EXPECT().GetLogs(gomock.Any(), filter("0x1388", "0x1770"), "test").Return([]ethereum_rpc.Log{transfer}, nil),
+				)
+			},
+			expect: func(t *testing.T, logs *ethereum.LogsDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, uint64(1000), logs.FromBlock)
+				assert.Equal(t, uint64(6000), logs.ToBlock)
+				assert.Len(t, logs.Logs, 1)
+				assert.Equal(t, uint64(5000), logs.Logs[0].BlockNumber)
+				assert.Equal(t, uint64(2), logs.Logs[0].LogIndex)
+				assert.Equal(t, &ethereum.EventDTO{
+					Name:      "Transfer",
+					Signature: "Transfer(address,address,uint256)",
+					Args: map[string]interface{}{
+						"from":  "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+						"to":    "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+						"value": "1000",
+					},
+				}, logs.Logs[0].Event)
+			},
+		},
+		{
+			name: "should halve ranges the node finds too large",
+			dto:  &ethereum.GetLogsDTO{Addresses: []string{token}, Topics: [][]string{{transferTopic}}, FromBlock: "0x0", ToBlock: "0x3", Network: "test"},
+			setup: func() {
+				gomock.InOrder(
+					ethRpcSvc.EXPECT().GetLogs(gomock.Any(), filter("0x0", "0x3"), "test").
+						Return(nil, errors.FromEthereumRPC(-32005, "query returned more than 10000 results")),
+					ethRpcSvc.EXPECT().GetLogs(gomock.Any(), filter("0x0", "0x1"), "test").Return([]ethereum_rpc.Log{transfer}, nil),
+					ethRpcSvc.EXPECT().GetLogs(gomock.Any(), filter("0x2", "0x3"), "test").Return([]ethereum_rpc.Log{transfer}, nil),
+				)
+			},
+			expect: func(t *testing.T, logs *ethereum.LogsDTO, err error) {
+				assert.Nil(t, err)
+				assert.Len(t, logs.Logs, 2)
+				assert.Nil(t, logs.Logs[0].Event)
+			},
+		},
+		{
+			name:  "should reject reversed range",
+			dto:   &ethereum.GetLogsDTO{FromBlock: "10", ToBlock: "5", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, logs *ethereum.LogsDTO, err error) {
+				assert.Nil(t, logs)
+				assert.Equal(t, errors.WithMessage(ethereum.ErrInvalidRequest, "from_block 10 is after to_block 5"), err)
+			},
+		},
+		{
+			name:  "should reject range beyond limit",
+			dto:   &ethereum.GetLogsDTO{FromBlock: "0", ToBlock: "100000", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, logs *ethereum.LogsDTO, err error) {
+				assert.Nil(t, logs)
+				assert.Equal(t, errors.WithMessage(ethereum.ErrInvalidRequest, "at most 100000 blocks can be queried at once"), err)
+			},
+		},
+		{
+			name:  "should reject invalid abi",
+			dto:   &ethereum.GetLogsDTO{FromBlock: "0", ToBlock: "1", ABI: "[]", Network: "test"},
+			setup: func() {},
+			expect: func(t *testing.T, logs *ethereum.LogsDTO, err error) {
+				assert.Nil(t, logs)
+				assert.Equal(t, errors.WithMessage(ethereum.ErrInvalidRequest, "abi declares no events"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			logs, err := service.GetLogs(context.Background(), tc.dto)
+			tc.expect(t, logs, err)
+		})
+	}
+}
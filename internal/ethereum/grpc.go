@@ -0,0 +1,386 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	gErrors "errors"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	pb "nn-blockchain-api/pkg/grpc_server/proto/ethereum"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// GRPCServer exposes Service over gRPC with the same validation as the REST handler.
+// Requests pick the EVM chain by their chain field, ethSvcs is keyed by chain name.
+type GRPCServer struct {
+	pb.UnimplementedEthereumServiceServer
+	ethSvcs map[string]Service
+}
+
+func NewGRPCServer(ethSvcs map[string]Service) (*GRPCServer, error) {
+	for name, ethSvc := range ethSvcs {
+		if ethSvc == nil {
+			return nil, gErrors.New("invalid " + name + " service")
+		}
+	}
+
+	return &GRPCServer{ethSvcs: ethSvcs}, nil
+}
+
+func (s *GRPCServer) Register(registrar grpc.ServiceRegistrar) {
+	pb.RegisterEthereumServiceServer(registrar, s)
+}
+
+// Rules mirrors the scopes SetupRoutes requires for the REST endpoints. The interceptor
+// checks the key against the chain of the request when it names one.
+func (s *GRPCServer) Rules() map[string]auth.Rule {
+	method := func(name string) string {
+		return "/" + pb.EthereumService_ServiceDesc.ServiceName + "/" + name
+	}
+
+	return map[string]auth.Rule{
+		method("StatusNode"):                {Chain: chain, Scope: auth.ScopeRead},
+		method("CreateRawTransaction"):      {Chain: chain, Scope: auth.ScopeBuild},
+		method("SignRawTransaction"):        {Chain: chain, Scope: auth.ScopeSign},
+		method("SendRawTransaction"):        {Chain: chain, Scope: auth.ScopeBroadcast},
+		method("SimulateTransaction"):       {Chain: chain, Scope: auth.ScopeRead},
+		method("Block"):                     {Chain: chain, Scope: auth.ScopeRead},
+		method("GetLogs"):                   {Chain: chain, Scope: auth.ScopeRead},
+		method("CallContract"):              {Chain: chain, Scope: auth.ScopeRead},
+		method("CreateContractTransaction"): {Chain: chain, Scope: auth.ScopeBuild},
+	}
+}
+
+func (s *GRPCServer) StatusNode(ctx context.Context, req *pb.StatusNodeRequest) (*pb.StatusNodeResponse, error) {
+	dto := StatusNodeDTO{Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := ethSvc.StatusNode(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StatusNodeResponse{
+		CurrentBlock:        status.CurrentBlock,
+		HealedBytecodeBytes: status.HealedBytecodeBytes,
+		HealedBytecodes:     status.HealedBytecodes,
+		HealedTrienodeBytes: status.HealedTrienodeBytes,
+		HealedTrienodes:     status.HealedTrienodes,
+		HealingBytecode:     status.HealingBytecode,
+		HealingTrienodes:    status.HealingTrienodes,
+		HighestBlock:        status.HighestBlock,
+		StartingBlock:       status.StartingBlock,
+		SyncedAccountBytes:  status.SyncedAccountBytes,
+		SyncedAccounts:      status.SyncedAccounts,
+		SyncedBytecodeBytes: status.SyncedBytecodeBytes,
+		SyncedBytecodes:     status.SyncedBytecodes,
+		SyncedStorage:       status.SyncedStorage,
+		SyncedStorageBytes:  status.SyncedStorageBytes,
+		SyncMessage:         status.SyncMessage,
+	}, nil
+}
+
+func (s *GRPCServer) CreateRawTransaction(ctx context.Context, req *pb.CreateRawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
+	dto := CreateRawTransactionDTO{
+		FromAddress: req.GetFromAddress(),
+		ToAddress:   req.GetToAddress(),
+		Amount:      req.GetAmount(),
+		Network:     req.GetNetwork(),
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := ethSvc.CreateTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateRawTransactionResponse{Tx: tx.Tx, Fee: tx.Fee}, nil
+}
+
+func (s *GRPCServer) SignRawTransaction(ctx context.Context, req *pb.SignRawTransactionRequest) (*pb.SignRawTransactionResponse, error) {
+	dto := SignRawTransactionDTO{Tx: req.GetTx(), PrivateKey: req.GetPrivateKey(), WalletId: req.GetWalletId(), KeyId: req.GetKeyId(), Passphrase: req.GetPassphrase(), ApprovalId: req.GetApprovalId(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := ethSvc.SignTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SignRawTransactionResponse{SignedTx: signed.SignedTx}, nil
+}
+
+func (s *GRPCServer) SendRawTransaction(ctx context.Context, req *pb.SendRawTransactionRequest) (*pb.SendRawTransactionResponse, error) {
+	dto := SendRawTransactionDTO{SignedTx: req.GetSignedTx(), Network: req.GetNetwork(), DryRun: req.GetDryRun()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := ethSvc.SendTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SendRawTransactionResponse{TxId: sent.TxId, DryRun: sent.DryRun}
+	if sent.Simulation != nil {
+		resp.Simulation = simulationResponse(sent.Simulation)
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) SimulateTransaction(ctx context.Context, req *pb.SimulateTransactionRequest) (*pb.SimulateTransactionResponse, error) {
+	dto := SimulateTransactionDTO{SignedTx: req.GetSignedTx(), Network: req.GetNetwork()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	simulation, err := ethSvc.SimulateTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return simulationResponse(simulation), nil
+}
+
+func simulationResponse(simulation *SimulationDTO) *pb.SimulateTransactionResponse {
+	resp := &pb.SimulateTransactionResponse{TxId: simulation.TxId, From: simulation.From, Passed: simulation.Passed}
+	for _, c := range simulation.Checks {
+		resp.Checks = append(resp.Checks, &pb.Check{Name: c.Name, Passed: c.Passed, Status: string(c.Status), Message: c.Message})
+	}
+	return resp
+}
+
+func (s *GRPCServer) Block(ctx context.Context, req *pb.BlockRequest) (*pb.BlockResponse, error) {
+	dto := BlockDTO{Block: req.GetBlock(), Network: req.GetNetwork(), Full: req.GetFull()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := ethSvc.Block(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.BlockResponse{
+		Number:        block.Number,
+		Hash:          block.Hash,
+		ParentHash:    block.ParentHash,
+		Timestamp:     block.Timestamp,
+		Miner:         block.Miner,
+		GasLimit:      block.GasLimit,
+		GasUsed:       block.GasUsed,
+		BaseFeePerGas: block.BaseFeePerGas,
+		Size:          block.Size,
+		TxCount:       block.TxCount,
+		TxHashes:      block.TxHashes,
+	}
+	for _, tx := range block.Transactions {
+		resp.Transactions = append(resp.Transactions, &pb.BlockTransaction{
+			Hash:     tx.Hash,
+			From:     tx.From,
+			To:       tx.To,
+			Nonce:    tx.Nonce,
+			Value:    tx.Value,
+			Gas:      tx.Gas,
+			GasPrice: tx.GasPrice,
+			Input:    tx.Input,
+			Index:    tx.Index,
+		})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) GetLogs(ctx context.Context, req *pb.GetLogsRequest) (*pb.GetLogsResponse, error) {
+	dto := GetLogsDTO{
+		Addresses: req.GetAddresses(),
+		FromBlock: req.GetFromBlock(),
+		ToBlock:   req.GetToBlock(),
+		ABI:       req.GetAbi(),
+		Network:   req.GetNetwork(),
+	}
+	for _, topic := range req.GetTopics() {
+		dto.Topics = append(dto.Topics, topic.GetValues())
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := ethSvc.GetLogs(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetLogsResponse{FromBlock: logs.FromBlock, ToBlock: logs.ToBlock}
+	for _, log := range logs.Logs {
+		item := &pb.Log{
+			Address:     log.Address,
+			Topics:      log.Topics,
+			Data:        log.Data,
+			BlockNumber: log.BlockNumber,
+			BlockHash:   log.BlockHash,
+			TxHash:      log.TxHash,
+			TxIndex:     log.TxIndex,
+			LogIndex:    log.LogIndex,
+			Removed:     log.Removed,
+			DecodeError: log.DecodeError,
+		}
+		if log.Event != nil {
+			args, err := json.Marshal(log.Event.Args)
+			if err != nil {
+				return nil, err
+			}
+			item.Event = &pb.Event{Name: log.Event.Name, Signature: log.Event.Signature, ArgsJson: string(args)}
+		}
+		resp.Logs = append(resp.Logs, item)
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) CallContract(ctx context.Context, req *pb.CallContractRequest) (*pb.CallContractResponse, error) {
+	args, err := decodeArgs(req.GetArgsJson())
+	if err != nil {
+		return nil, err
+	}
+	dto := ContractCallDTO{
+		Contract: req.GetContract(),
+		ABI:      req.GetAbi(),
+		Method:   req.GetMethod(),
+		Args:     args,
+		From:     req.GetFrom(),
+		Block:    req.GetBlock(),
+		Network:  req.GetNetwork(),
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ethSvc.CallContract(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CallContractResponse{
+		Reverted:     result.Reverted,
+		RevertReason: result.RevertReason,
+		RevertData:   result.RevertData,
+	}
+	for _, output := range result.Outputs {
+		value, err := json.Marshal(output.Value)
+		if err != nil {
+			return nil, err
+		}
+		resp.Outputs = append(resp.Outputs, &pb.ContractOutput{Name: output.Name, Type: output.Type, ValueJson: string(value)})
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) CreateContractTransaction(ctx context.Context, req *pb.CreateContractTransactionRequest) (*pb.CreateContractTransactionResponse, error) {
+	args, err := decodeArgs(req.GetArgsJson())
+	if err != nil {
+		return nil, err
+	}
+	dto := CreateContractTransactionDTO{
+		FromAddress: req.GetFromAddress(),
+		Contract:    req.GetContract(),
+		ABI:         req.GetAbi(),
+		Method:      req.GetMethod(),
+		Args:        args,
+		Amount:      req.GetAmount(),
+		Network:     req.GetNetwork(),
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	ethSvc, err := s.service(req.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := ethSvc.CreateContractTransaction(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateContractTransactionResponse{
+		Tx:       tx.Tx,
+		Fee:      tx.Fee,
+		Data:     tx.Data,
+		Nonce:    tx.Nonce,
+		Gas:      tx.Gas,
+		GasPrice: tx.GasPrice,
+		L1Fee:    tx.L1Fee,
+	}, nil
+}
+
+func (s *GRPCServer) service(name string) (Service, error) {
+	if name == "" {
+		name = chain
+	}
+	ethSvc, ok := s.ethSvcs[name]
+	if !ok {
+		return nil, errors.NewNotFound("unknown chain " + name)
+	}
+	return ethSvc, nil
+}
+
+// decodeArgs keeps numbers as json.Number so integers past 2^53 survive.
+func decodeArgs(argsJSON string) ([]interface{}, error) {
+	if argsJSON == "" {
+		return nil, nil
+	}
+	var args []interface{}
+	decoder := json.NewDecoder(strings.NewReader(argsJSON))
+	decoder.UseNumber()
+	if err := decoder.Decode(&args); err != nil {
+		return nil, errors.WithMessage(ErrInvalidRequest, "invalid args_json: %v", err)
+	}
+	return args, nil
+}
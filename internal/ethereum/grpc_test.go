@@ -0,0 +1,231 @@
+package ethereum_test
+
+import (
+	"context"
+	"net"
+	"nn-blockchain-api/internal/ethereum"
+	mock_ethereum "nn-blockchain-api/internal/ethereum/mocks"
+	"nn-blockchain-api/pkg/errors"
+	pb "nn-blockchain-api/pkg/grpc_server/proto/ethereum"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestNewGRPCServer(t *testing.T) {
+	srv, err := ethereum.NewGRPCServer(nil)
+	assert.NotNil(t, srv)
+	assert.Nil(t, err)
+
+	srv, err = ethereum.NewGRPCServer(map[string]ethereum.Service{"ethereum": nil})
+	assert.Nil(t, srv)
+	assert.EqualError(t, err, "invalid ethereum service")
+}
+
+func TestGRPCServer_Rules(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	srv, err := ethereum.NewGRPCServer(map[string]ethereum.Service{"ethereum": mock_ethereum.NewMockService(controller)})
+	assert.Nil(t, err)
+
+	rules := srv.Rules()
+	assert.Len(t, rules, len(pb.EthereumService_ServiceDesc.Methods))
+	for _, method := range pb.EthereumService_ServiceDesc.Methods {
+		rule, ok := rules["/"+pb.EthereumService_ServiceDesc.ServiceName+"/"+method.MethodName]
+		assert.True(t, ok, method.MethodName)
+		assert.Equal(t, "ethereum", rule.Chain)
+	}
+}
+
+func TestGRPCServer(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ethSvc := mock_ethereum.NewMockService(controller)
+	client := newEthereumClient(t, ethSvc)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		req    *pb.SendRawTransactionRequest
+		expect func()
+		code   codes.Code
+		txId   string
+	}{
+		{
+			name: "send raw transaction",
+			req:  &pb.SendRawTransactionRequest{SignedTx: "f86b", Network: "main"},
+			expect: func() {
+				ethSvc.EXPECT().SendTransaction(gomock.Any(), &ethereum.SendRawTransactionDTO{SignedTx: "f86b", Network: "main"}).
+					Return(&ethereum.SentRawTransactionDTO{TxId: "0xabc"}, nil)
+			},
+			code: codes.OK,
+			txId: "0xabc",
+		},
+		{
+			name:   "invalid request",
+			req:    &pb.SendRawTransactionRequest{SignedTx: "f86b"},
+			expect: func() {},
+			code:   codes.InvalidArgument,
+		},
+		{
+			name: "nonce too low",
+			req:  &pb.SendRawTransactionRequest{SignedTx: "f86b", Network: "test"},
+			expect: func() {
+				ethSvc.EXPECT().SendTransaction(gomock.Any(), gomock.Any()).
+					Return(nil, errors.FromEthereumRPC(-32000, "nonce too low"))
+			},
+			code: codes.Aborted,
+		},
+		{
+			name: "node unavailable",
+			req:  &pb.SendRawTransactionRequest{SignedTx: "f86b", Network: "test"},
+			expect: func() {
+				ethSvc.EXPECT().SendTransaction(gomock.Any(), gomock.Any()).
+					Return(nil, errors.NewNodeUnavailable("connection refused"))
+			},
+			code: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.expect()
+
+			resp, err := client.SendRawTransaction(ctx, tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Equal(t, tt.txId, resp.GetTxId())
+		})
+	}
+}
+
+func TestGRPCServer_Chain(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ethSvc := mock_ethereum.NewMockService(controller)
+	polygonSvc := mock_ethereum.NewMockService(controller)
+	client := newEVMClient(t, map[string]ethereum.Service{"ethereum": ethSvc, "polygon": polygonSvc})
+	ctx := context.Background()
+
+	polygonSvc.EXPECT().StatusNode(gomock.Any(), &ethereum.StatusNodeDTO{Network: "main"}).Return(&ethereum.NodeInfoDTO{SyncMessage: "node has synced"}, nil)
+	resp, err := client.StatusNode(ctx, &pb.StatusNodeRequest{Network: "main", Chain: "polygon"})
+	assert.Nil(t, err)
+	assert.Equal(t, "node has synced", resp.GetSyncMessage())
+
+	ethSvc.EXPECT().StatusNode(gomock.Any(), &ethereum.StatusNodeDTO{Network: "main"}).Return(&ethereum.NodeInfoDTO{}, nil)
+	_, err = client.StatusNode(ctx, &pb.StatusNodeRequest{Network: "main"})
+	assert.Nil(t, err)
+
+	_, err = client.StatusNode(ctx, &pb.StatusNodeRequest{Network: "main", Chain: "bsc"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPCServer_SignRawTransaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ethSvc := mock_ethereum.NewMockService(controller)
+	client := newEthereumClient(t, ethSvc)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		req    *pb.SignRawTransactionRequest
+		expect func()
+		code   codes.Code
+	}{
+		{
+			name: "sign with wallet id",
+			req:  &pb.SignRawTransactionRequest{Tx: "f86b", WalletId: "wallet", Network: "main"},
+			expect: func() {
+				ethSvc.EXPECT().SignTransaction(gomock.Any(), &ethereum.SignRawTransactionDTO{Tx: "f86b", WalletId: "wallet", Network: "main"}).
+					Return(&ethereum.SignedRawTransactionDTO{SignedTx: "f86c"}, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "neither key nor wallet id",
+			req:    &pb.SignRawTransactionRequest{Tx: "f86b", Network: "main"},
+			expect: func() {},
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "both key and wallet id",
+			req:    &pb.SignRawTransactionRequest{Tx: "f86b", PrivateKey: strings.Repeat("ab", 32), WalletId: "wallet", Network: "main"},
+			expect: func() {},
+			code:   codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.expect()
+
+			_, err := client.SignRawTransaction(ctx, tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestGRPCServer_GetLogs(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ethSvc := mock_ethereum.NewMockService(controller)
+	client := newEthereumClient(t, ethSvc)
+
+	ethSvc.EXPECT().GetLogs(gomock.Any(), &ethereum.GetLogsDTO{
+		Topics:    [][]string{{transferTopic}, nil, {addressTopic}},
+		FromBlock: "1000",
+		Network:   "test",
+	}).Return(&ethereum.LogsDTO{FromBlock: 1000, ToBlock: 1001, Logs: []ethereum.LogDTO{{
+		Address:     token,
+		BlockNumber: 1001,
+		Event:       &ethereum.EventDTO{Name: "Transfer", Args: map[string]interface{}{"value": "1000"}},
+	}}}, nil)
+
+	resp, err := client.GetLogs(context.Background(), &pb.GetLogsRequest{
+		Topics:    []*pb.TopicFilter{{Values: []string{transferTopic}}, {}, {Values: []string{addressTopic}}},
+		FromBlock: "1000",
+		Network:   "test",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1001), resp.Logs[0].BlockNumber)
+	assert.Equal(t, "Transfer", resp.Logs[0].Event.Name)
+	assert.JSONEq(t, `{"value":"1000"}`, resp.Logs[0].Event.ArgsJson)
+}
+
+func newEthereumClient(t *testing.T, ethSvc ethereum.Service) pb.EthereumServiceClient {
+	return newEVMClient(t, map[string]ethereum.Service{"ethereum": ethSvc})
+}
+
+func newEVMClient(t *testing.T, ethSvcs map[string]ethereum.Service) pb.EthereumServiceClient {
+	srv, err := ethereum.NewGRPCServer(ethSvcs)
+	assert.Nil(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	srv.Register(grpcServer)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewEthereumServiceClient(conn)
+}
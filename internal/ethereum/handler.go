@@ -4,33 +4,87 @@ import (
 	"encoding/json"
 	gErrors "errors"
 	"net/http"
+	"nn-blockchain-api/pkg/auth"
 	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/params"
 	"nn-blockchain-api/pkg/respond"
 
 	"github.com/go-chi/chi/v5"
 )
 
+// ChainParam is the path parameter of the /evm/{chain} prefix, routes mounted without it
+// serve Ethereum.
+const ChainParam = "chain"
+
+// Handler serves every EVM chain, ethSvcs is keyed by chain name and may lack any of them.
 type Handler struct {
-	ethSvc Service
+	ethSvcs map[string]Service
+	guard   auth.Guard
 }
 
-func NewHandler(ethSvc Service) (*Handler, error) {
-	if ethSvc == nil {
-		return nil, gErrors.New("invalid bitcoin service")
+func NewHandler(ethSvcs map[string]Service, guard auth.Guard) (*Handler, error) {
+	for name, ethSvc := range ethSvcs {
+		if ethSvc == nil {
+			return nil, gErrors.New("invalid " + name + " service")
+		}
+	}
+	if guard == nil {
+		return nil, gErrors.New("invalid guard")
 	}
 
 	return &Handler{
-		ethSvc: ethSvc,
+		ethSvcs: ethSvcs,
+		guard:   guard,
 	}, nil
 }
 
+// SetupRoutes registers the routes for the chain of the prefix they are mounted under.
 func (h *Handler) SetupRoutes(router chi.Router) {
-	router.Post("/status", h.StatusNode)
+	router.With(h.require(auth.ScopeRead)).Post("/status", h.StatusNode)
 
 	// Transaction
-	router.Post("/create-raw-tx", h.CreateRawTransaction)
-	router.Post("/sign-raw-tx", h.SignRawTransaction)
-	router.Post("/send-raw-tx", h.SendRawTransaction)
+	router.With(h.require(auth.ScopeBuild)).Post("/create-raw-tx", h.CreateRawTransaction)
+	router.With(h.require(auth.ScopeSign)).Post("/sign-raw-tx", h.SignRawTransaction)
+	router.With(h.require(auth.ScopeBroadcast)).Post("/send-raw-tx", h.SendRawTransaction)
+	router.With(h.require(auth.ScopeRead)).Post("/simulate", h.SimulateTransaction)
+
+	// Explorer
+	router.With(h.require(auth.ScopeRead)).Get("/blocks/{block}", h.Block)
+	router.With(h.require(auth.ScopeRead)).Post("/logs", h.GetLogs)
+
+	// Contract
+	router.With(h.require(auth.ScopeRead)).Post("/contract/call", h.CallContract)
+	router.With(h.require(auth.ScopeBuild)).Post("/contract/create-tx", h.CreateContractTransaction)
+}
+
+// require resolves the chain of the request and lets the guard check the key for it.
+func (h *Handler) require(scope auth.Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		guarded := map[string]http.Handler{}
+		for name := range h.ethSvcs {
+			guarded[name] = h.guard.Require(name, scope)(next)
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler, ok := guarded[requestChain(r)]
+			if !ok {
+				respond.Respond(w, http.StatusNotFound, errors.NewNotFound("unknown chain "+requestChain(r)))
+				return
+			}
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (h *Handler) service(r *http.Request) Service {
+	return h.ethSvcs[requestChain(r)]
+}
+
+func requestChain(r *http.Request) string {
+	if name := chi.URLParam(r, ChainParam); name != "" {
+		return name
+	}
+	return chain
 }
 
 func (h *Handler) StatusNode(w http.ResponseWriter, r *http.Request) {
@@ -47,7 +101,7 @@ func (h *Handler) StatusNode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	status, err := h.ethSvc.StatusNode(r.Context(), &dto)
+	status, err := h.service(r).StatusNode(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -77,7 +131,7 @@ func (h *Handler) CreateRawTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	transaction, err := h.ethSvc.CreateTransaction(r.Context(), &dto)
+	transaction, err := h.service(r).CreateTransaction(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -99,7 +153,7 @@ func (h *Handler) SignRawTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	signedTx, err := h.ethSvc.SignTransaction(r.Context(), &dto)
+	signedTx, err := h.service(r).SignTransaction(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -122,7 +176,7 @@ func (h *Handler) SendRawTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	transactionId, err := h.ethSvc.SendTransaction(r.Context(), &dto)
+	transactionId, err := h.service(r).SendTransaction(r.Context(), &dto)
 	if err != nil {
 		respond.Respond(w, errors.HTTPCode(err), err)
 		return
@@ -130,3 +184,117 @@ func (h *Handler) SendRawTransaction(w http.ResponseWriter, r *http.Request) {
 
 	respond.Respond(w, http.StatusOK, transactionId)
 }
+
+func (h *Handler) Block(w http.ResponseWriter, r *http.Request) {
+	var dto BlockDTO
+
+	if err := params.Decode(r, &dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	block, err := h.service(r).Block(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, block)
+}
+
+func (h *Handler) GetLogs(w http.ResponseWriter, r *http.Request) {
+	var dto GetLogsDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	logs, err := h.service(r).GetLogs(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, logs)
+}
+
+func (h *Handler) CallContract(w http.ResponseWriter, r *http.Request) {
+	var dto ContractCallDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	result, err := h.service(r).CallContract(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, result)
+}
+
+func (h *Handler) CreateContractTransaction(w http.ResponseWriter, r *http.Request) {
+	var dto CreateContractTransactionDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	tx, err := h.service(r).CreateContractTransaction(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, tx)
+}
+
+func (h *Handler) SimulateTransaction(w http.ResponseWriter, r *http.Request) {
+	var dto SimulateTransactionDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), errors.NewInternal(err.Error()))
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	simulation, err := h.service(r).SimulateTransaction(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, simulation)
+}
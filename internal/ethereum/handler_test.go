@@ -1,10 +1,16 @@
 package ethereum_test
 
 import (
+	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
 	"nn-blockchain-api/internal/ethereum"
 	mock_ethereum "nn-blockchain-api/internal/ethereum/mocks"
+	"nn-blockchain-api/pkg/auth"
+	mock_auth "nn-blockchain-api/pkg/auth/mocks"
+	"strings"
 	"testing"
 )
 
@@ -13,33 +19,111 @@ func TestNewHandler(t *testing.T) {
 	defer controller.Finish()
 
 	tests := []struct {
-		name   string
-		ethSvc ethereum.Service
-		expect func(*testing.T, *ethereum.Handler, error)
+		name    string
+		ethSvcs map[string]ethereum.Service
+		guard   auth.Guard
+		expect  func(*testing.T, *ethereum.Handler, error)
 	}{
 		{
-			name:   "should return service",
-			ethSvc: mock_ethereum.NewMockService(controller),
+			name:    "should return service",
+			ethSvcs: map[string]ethereum.Service{"ethereum": mock_ethereum.NewMockService(controller), "polygon": mock_ethereum.NewMockService(controller)},
+			guard:   mock_auth.NewMockGuard(controller),
 			expect: func(t *testing.T, s *ethereum.Handler, err error) {
 				assert.NotNil(t, s)
 				assert.Nil(t, err)
 			},
 		},
 		{
-			name:   "should return invalid bitcoin service",
-			ethSvc: nil,
+			name:    "should return service without ethereum",
+			ethSvcs: map[string]ethereum.Service{"polygon": mock_ethereum.NewMockService(controller)},
+			guard:   mock_auth.NewMockGuard(controller),
+			expect: func(t *testing.T, s *ethereum.Handler, err error) {
+				assert.NotNil(t, s)
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name:    "should return invalid chain service",
+			ethSvcs: map[string]ethereum.Service{"ethereum": mock_ethereum.NewMockService(controller), "polygon": nil},
+			guard:   mock_auth.NewMockGuard(controller),
+			expect: func(t *testing.T, s *ethereum.Handler, err error) {
+				assert.Nil(t, s)
+				assert.NotNil(t, err)
+				assert.EqualError(t, err, "invalid polygon service")
+			},
+		},
+		{
+			name:    "should return invalid guard",
+			ethSvcs: map[string]ethereum.Service{"ethereum": mock_ethereum.NewMockService(controller)},
+			guard:   nil,
 			expect: func(t *testing.T, s *ethereum.Handler, err error) {
 				assert.Nil(t, s)
 				assert.NotNil(t, err)
-				assert.EqualError(t, err, "invalid bitcoin service")
+				assert.EqualError(t, err, "invalid guard")
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := ethereum.NewHandler(tc.ethSvc)
+			svc, err := ethereum.NewHandler(tc.ethSvcs, tc.guard)
 			tc.expect(t, svc, err)
 		})
 	}
 }
+
+func TestHandler_Chain(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ethSvc := mock_ethereum.NewMockService(controller)
+	polygonSvc := mock_ethereum.NewMockService(controller)
+
+	guard, err := auth.NewGuard(auth.NewMultiKeyStore(), false)
+	assert.Nil(t, err)
+	handler, err := ethereum.NewHandler(map[string]ethereum.Service{"ethereum": ethSvc, "polygon": polygonSvc}, guard)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	router.Route("/ethereum", handler.SetupRoutes)
+	router.Route("/evm/{"+ethereum.ChainParam+"}", handler.SetupRoutes)
+
+	tests := []struct {
+		name   string
+		path   string
+		expect func()
+		code   int
+	}{
+		{
+			name: "ethereum route",
+			path: "/ethereum/status",
+			expect: func() {
+				ethSvc.EXPECT().StatusNode(gomock.Any(), &ethereum.StatusNodeDTO{Network: "main"}).Return(&ethereum.NodeInfoDTO{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "evm route",
+			path: "/evm/polygon/status",
+			expect: func() {
+				polygonSvc.EXPECT().StatusNode(gomock.Any(), &ethereum.StatusNodeDTO{Network: "main"}).Return(&ethereum.NodeInfoDTO{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "unknown chain",
+			path:   "/evm/bsc/status",
+			expect: func() {},
+			code:   http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.expect()
+
+			res := httptest.NewRecorder()
+			router.ServeHTTP(res, httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(`{"network":"main"}`)))
+			assert.Equal(t, tt.code, res.Code)
+		})
+	}
+}
@@ -35,6 +35,51 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Block mocks base method.
+func (m *MockService) Block(ctx context.Context, dto *ethereum.BlockDTO) (*ethereum.BlockInfoDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", ctx, dto)
+	ret0, _ := ret[0].(*ethereum.BlockInfoDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockServiceMockRecorder) Block(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockService)(nil).Block), ctx, dto)
+}
+
+// CallContract mocks base method.
+func (m *MockService) CallContract(ctx context.Context, dto *ethereum.ContractCallDTO) (*ethereum.ContractCallResultDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", ctx, dto)
+	ret0, _ := ret[0].(*ethereum.ContractCallResultDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract.
+func (mr *MockServiceMockRecorder) CallContract(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockService)(nil).CallContract), ctx, dto)
+}
+
+// CreateContractTransaction mocks base method.
+func (m *MockService) CreateContractTransaction(ctx context.Context, dto *ethereum.CreateContractTransactionDTO) (*ethereum.CreatedContractTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContractTransaction", ctx, dto)
+	ret0, _ := ret[0].(*ethereum.CreatedContractTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContractTransaction indicates an expected call of CreateContractTransaction.
+func (mr *MockServiceMockRecorder) CreateContractTransaction(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContractTransaction", reflect.TypeOf((*MockService)(nil).CreateContractTransaction), ctx, dto)
+}
+
 // CreateTransaction mocks base method.
 func (m *MockService) CreateTransaction(ctx context.Context, dto *ethereum.CreateRawTransactionDTO) (*ethereum.CreatedRawTransactionDTO, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +95,21 @@ func (mr *MockServiceMockRecorder) CreateTransaction(ctx, dto interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockService)(nil).CreateTransaction), ctx, dto)
 }
 
+// GetLogs mocks base method.
+func (m *MockService) GetLogs(ctx context.Context, dto *ethereum.GetLogsDTO) (*ethereum.LogsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogs", ctx, dto)
+	ret0, _ := ret[0].(*ethereum.LogsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogs indicates an expected call of GetLogs.
+func (mr *MockServiceMockRecorder) GetLogs(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockService)(nil).GetLogs), ctx, dto)
+}
+
 // SendTransaction mocks base method.
 func (m *MockService) SendTransaction(ctx context.Context, dto *ethereum.SendRawTransactionDTO) (*ethereum.SentRawTransactionDTO, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +140,21 @@ func (mr *MockServiceMockRecorder) SignTransaction(ctx, dto interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockService)(nil).SignTransaction), ctx, dto)
 }
 
+// SimulateTransaction mocks base method.
+func (m *MockService) SimulateTransaction(ctx context.Context, dto *ethereum.SimulateTransactionDTO) (*ethereum.SimulationDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTransaction", ctx, dto)
+	ret0, _ := ret[0].(*ethereum.SimulationDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTransaction indicates an expected call of SimulateTransaction.
+func (mr *MockServiceMockRecorder) SimulateTransaction(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTransaction", reflect.TypeOf((*MockService)(nil).SimulateTransaction), ctx, dto)
+}
+
 // StatusNode mocks base method.
 func (m *MockService) StatusNode(ctx context.Context, dto *ethereum.StatusNodeDTO) (*ethereum.NodeInfoDTO, error) {
 	m.ctrl.T.Helper()
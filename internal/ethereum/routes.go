@@ -0,0 +1,25 @@
+package ethereum
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/openapi"
+)
+
+// Routes documents the endpoints registered by SetupRoutes.
+func Routes() []openapi.Route {
+	return []openapi.Route{
+		{Method: http.MethodPost, Path: "/status", Name: "StatusNode", Summary: "Sync status of the node.", Scope: string(auth.ScopeRead), Request: StatusNodeDTO{}, Response: NodeInfoDTO{}},
+
+		{Method: http.MethodPost, Path: "/create-raw-tx", Name: "CreateRawTransaction", Summary: "Build an unsigned EIP-1559 transfer.", Scope: string(auth.ScopeBuild), Request: CreateRawTransactionDTO{}, Response: CreatedRawTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/sign-raw-tx", Name: "SignRawTransaction", Summary: "Sign a raw transaction with a hex key or the key of a wallet held by the wallet service. Signing policies may deny it or hold it for approval with a 202, sign again with the approval_id once approved.", Scope: string(auth.ScopeSign), Request: SignRawTransactionDTO{}, Response: SignedRawTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/send-raw-tx", Name: "SendRawTransaction", Summary: "Broadcast a signed transaction once it passes the pre-flight checks of /simulate. A dry run stops after the checks.", Scope: string(auth.ScopeBroadcast), Request: SendRawTransactionDTO{}, Response: SentRawTransactionDTO{}},
+		{Method: http.MethodPost, Path: "/simulate", Name: "SimulateTransaction", Summary: "Check a signed transaction without broadcasting it: chain id, sender, nonce, balance and a replay as eth_call.", Scope: string(auth.ScopeRead), Request: SimulateTransactionDTO{}, Response: SimulationDTO{}},
+
+		{Method: http.MethodGet, Path: "/blocks/{block}", Name: "Block", Summary: "Block by number, hash or tag, with its transaction hashes or, when full, its transactions.", Scope: string(auth.ScopeRead), Request: BlockDTO{}, Response: BlockInfoDTO{}},
+		{Method: http.MethodPost, Path: "/logs", Name: "GetLogs", Summary: "Logs of contracts and topics over a block range of up to 100000 blocks, queried in chunks the node accepts. Logs are decoded into events when an ABI is given.", Scope: string(auth.ScopeRead), Request: GetLogsDTO{}, Response: LogsDTO{}},
+
+		{Method: http.MethodPost, Path: "/contract/call", Name: "CallContract", Summary: "Read-only call of a contract method described by an ABI fragment, with decoded outputs or the revert reason.", Scope: string(auth.ScopeRead), Request: ContractCallDTO{}, Response: ContractCallResultDTO{}},
+		{Method: http.MethodPost, Path: "/contract/create-tx", Name: "CreateContractTransaction", Summary: "Build an unsigned call of a contract method described by an ABI fragment, to sign with /sign-raw-tx.", Scope: string(auth.ScopeBuild), Request: CreateContractTransactionDTO{}, Response: CreatedContractTransactionDTO{}},
+	}
+}
@@ -4,10 +4,21 @@ import (
 	"context"
 	gErrors "errors"
 	"go.uber.org/zap"
+	"nn-blockchain-api/internal/wallet"
+	"nn-blockchain-api/pkg/auth"
 	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/keystore"
+	"nn-blockchain-api/pkg/metrics"
+	"nn-blockchain-api/pkg/policy"
 	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"nn-blockchain-api/pkg/storage"
+	"nn-blockchain-api/pkg/tracing"
 )
 
+// chain is the chain of the /ethereum routes and the keystore chain of the keys that sign
+// for every EVM chain.
+const chain = ethereum_rpc.DefaultChain
+
 //go:generate mockgen -source=service.go -destination=mocks/service_mock.go
 
 type Service interface {
@@ -16,28 +27,59 @@ type Service interface {
 	CreateTransaction(ctx context.Context, dto *CreateRawTransactionDTO) (*CreatedRawTransactionDTO, error)
 	SignTransaction(ctx context.Context, dto *SignRawTransactionDTO) (*SignedRawTransactionDTO, error)
 	SendTransaction(ctx context.Context, dto *SendRawTransactionDTO) (*SentRawTransactionDTO, error)
+	SimulateTransaction(ctx context.Context, dto *SimulateTransactionDTO) (*SimulationDTO, error)
+
+	Block(ctx context.Context, dto *BlockDTO) (*BlockInfoDTO, error)
+	GetLogs(ctx context.Context, dto *GetLogsDTO) (*LogsDTO, error)
+
+	CallContract(ctx context.Context, dto *ContractCallDTO) (*ContractCallResultDTO, error)
+	CreateContractTransaction(ctx context.Context, dto *CreateContractTransactionDTO) (*CreatedContractTransactionDTO, error)
 }
 
 type service struct {
+	evm       ethereum_rpc.Chain
 	ethRpcSvc ethereum_rpc.Service
+	walletSvc wallet.Service
+	keys      keystore.Keystore
+	policies  policy.Engine
+	store     storage.Storage
 	logger    *zap.SugaredLogger
 }
 
-func NewService(ethRpcSvc ethereum_rpc.Service, logger *zap.SugaredLogger) (Service, error) {
+func NewService(evm ethereum_rpc.Chain, ethRpcSvc ethereum_rpc.Service, walletSvc wallet.Service, keys keystore.Keystore, policies policy.Engine, store storage.Storage, logger *zap.SugaredLogger) (Service, error) {
+	if evm.Name == "" || evm.Decimals <= 0 {
+		return nil, gErrors.New("invalid chain")
+	}
 	if ethRpcSvc == nil {
 		return nil, gErrors.New("invalid ethereum rpc service")
 	}
+	if walletSvc == nil {
+		return nil, gErrors.New("invalid wallet service")
+	}
+	if keys == nil {
+		return nil, gErrors.New("invalid keystore")
+	}
+	if policies == nil {
+		return nil, gErrors.New("invalid policy engine")
+	}
+	if store == nil {
+		return nil, gErrors.New("invalid storage")
+	}
 	if logger == nil {
 		return nil, gErrors.New("invalid logger")
 	}
-	return &service{ethRpcSvc: ethRpcSvc, logger: logger}, nil
+	return &service{evm: evm, ethRpcSvc: ethRpcSvc, walletSvc: walletSvc, keys: keys, policies: policies, store: store, logger: logger}, nil
 }
 
 func (s *service) StatusNode(ctx context.Context, dto *StatusNodeDTO) (*NodeInfoDTO, error) {
+	ctx, span := tracing.Start(ctx, "ethereum.Service/StatusNode")
+	defer span.End()
+
 	status, err := s.ethRpcSvc.Status(ctx, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed check node status: %v", err)
-		return nil, errors.WithMessage(ErrFailedGetStatusNode, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed check node status: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedGetStatusNode, err)
 	}
 
 	return &NodeInfoDTO{
@@ -61,13 +103,21 @@ func (s *service) StatusNode(ctx context.Context, dto *StatusNodeDTO) (*NodeInfo
 }
 
 func (s *service) CreateTransaction(ctx context.Context, dto *CreateRawTransactionDTO) (*CreatedRawTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "ethereum.Service/CreateTransaction")
+	defer span.End()
+
 	tx, fee, err := s.ethRpcSvc.CreateTransaction(ctx, dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network)
 	if err != nil {
-		s.logger.Errorf("failed create transaction: %v", err)
-		return nil, errors.WithMessage(ErrFailedCreateTx, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed create transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedCreateTx, err)
 		//return nil, ErrFailedCreateTx
 	}
 
+	if _, err := storage.RecordCreatedTx(ctx, s.store, s.evm.Name, dto.Network, *tx, *fee); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record created transaction: %v", err)
+	}
+
 	return &CreatedRawTransactionDTO{
 		Tx:  *tx,
 		Fee: *fee,
@@ -75,23 +125,113 @@ func (s *service) CreateTransaction(ctx context.Context, dto *CreateRawTransacti
 }
 
 func (s *service) SignTransaction(ctx context.Context, dto *SignRawTransactionDTO) (*SignedRawTransactionDTO, error) {
-	signedTx, err := s.ethRpcSvc.SignTransaction(ctx, dto.Tx, dto.PrivateKey, dto.Network)
+	ctx, span := tracing.Start(ctx, "ethereum.Service/SignTransaction")
+	defer span.End()
+
+	signedTx, err := s.sign(ctx, dto)
 	if err != nil {
-		s.logger.Errorf("failed sign transaction: %v", err)
-		return nil, errors.WithMessage(ErrFailedSignTx, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed sign transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSignTx, err)
 		//return nil, ErrFailedSignTx
 	}
 
+	if _, err := storage.RecordSignedTx(ctx, s.store, s.evm.Name, dto.Network, dto.Tx, signedTx); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record signed transaction: %v", err)
+	}
+
 	return &SignedRawTransactionDTO{
-		SignedTx: *signedTx,
+		SignedTx: signedTx,
 	}, nil
 }
 
+// sign checks the signing policies, then uses the key held by the wallet service when the
+// request names a wallet, otherwise a keystore or pasted key.
+func (s *service) sign(ctx context.Context, dto *SignRawTransactionDTO) (string, error) {
+	tx, err := policy.DecodeEVM(s.evm.Name, dto.Tx, dto.Network)
+	if err != nil {
+		return "", err
+	}
+	subject, err := s.subject(ctx, dto)
+	if err != nil {
+		return "", err
+	}
+	reservation, err := s.policies.CheckSign(ctx, tx, subject, dto.ApprovalId, auth.NameFromContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	signed, err := s.signWithKey(ctx, dto)
+	if err != nil {
+		if err := s.policies.Release(ctx, reservation); err != nil {
+			tracing.Logger(ctx, s.logger).Warnf("failed release policy reservation: %v", err)
+		}
+		return "", err
+	}
+	return signed, nil
+}
+
+func (s *service) signWithKey(ctx context.Context, dto *SignRawTransactionDTO) (string, error) {
+	if dto.WalletId != "" {
+		signed, err := s.walletSvc.SignTransaction(ctx, &wallet.SignTransactionDTO{
+			WalletId: dto.WalletId,
+			Chain:    chain,
+			Network:  dto.Network,
+			Tx:       dto.Tx,
+		})
+		if err != nil {
+			return "", err
+		}
+		return signed.SignedTx, nil
+	}
+
+	privateKey, err := s.privateKey(dto)
+	if err != nil {
+		return "", err
+	}
+	signedTx, err := s.ethRpcSvc.SignTransaction(ctx, dto.Tx, privateKey, dto.Network)
+	if err != nil {
+		return "", err
+	}
+	return *signedTx, nil
+}
+
 func (s *service) SendTransaction(ctx context.Context, dto *SendRawTransactionDTO) (*SentRawTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "ethereum.Service/SendTransaction")
+	defer span.End()
+
+	if err := s.checkBroadcast(ctx, dto); err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed send transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSendTx, err)
+	}
+
+	simulation, checks, err := s.preflight(ctx, dto.SignedTx, dto.Network)
+	if err == nil {
+		err = firstFailure(checks)
+	}
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed send transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSendTx, err)
+	}
+	if dto.DryRun {
+		return &SentRawTransactionDTO{TxId: simulation.TxId, DryRun: true, Simulation: simulation}, nil
+	}
+
 	txId, err := s.ethRpcSvc.SendTransaction(ctx, dto.SignedTx, dto.Network)
+	metrics.ObserveBroadcast(s.evm.Name, dto.Network, err)
+	var sentTxId string
+	if txId != nil {
+		sentTxId = *txId
+	}
+	if _, recordErr := storage.RecordSentTx(ctx, s.store, s.evm.Name, dto.Network, dto.SignedTx, sentTxId, err); recordErr != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record sent transaction: %v", recordErr)
+	}
 	if err != nil {
-		s.logger.Errorf("failed send transaction: %v", err)
-		return nil, errors.WithMessage(ErrFailedSendTx, err.Error())
+		tracing.Logger(ctx, s.logger).Errorf("failed send transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSendTx, err)
 		//return nil, ErrFailedSendTx
 	}
 
@@ -99,3 +239,55 @@ func (s *service) SendTransaction(ctx context.Context, dto *SendRawTransactionDT
 		TxId: *txId,
 	}, nil
 }
+
+// subject identifies the signer for the policies along with the addresses its change goes
+// back to. Keys that may not sign the request are refused here, before any policy is checked.
+func (s *service) subject(ctx context.Context, dto *SignRawTransactionDTO) (policy.Subject, error) {
+	switch {
+	case dto.WalletId != "":
+		addresses, err := s.store.Addresses().ListByWallet(ctx, dto.WalletId)
+		if err != nil {
+			return policy.Subject{}, err
+		}
+		subject := policy.Subject{WalletId: dto.WalletId}
+		for _, address := range addresses {
+			subject.Addresses = append(subject.Addresses, address.Address)
+		}
+		return subject, nil
+	case dto.KeyId != "":
+		key, err := s.keys.Get(dto.KeyId)
+		if err != nil {
+			return policy.Subject{}, err
+		}
+		if err := key.Allows(chain, dto.Network); err != nil {
+			return policy.Subject{}, err
+		}
+		return policy.Subject{KeyId: key.Id, Addresses: []string{key.Address}}, nil
+	}
+
+	if !s.keys.AllowsRawKeys() {
+		return policy.Subject{}, keystore.ErrRawKeysDisabled
+	}
+	address, err := policy.EthereumKeyAddress(dto.PrivateKey)
+	if err != nil {
+		return policy.Subject{}, errors.NewInvalid(errors.StatusInvalidPrivateKey, err.Error())
+	}
+	return policy.Subject{Addresses: []string{address}}, nil
+}
+
+// privateKey unlocks the keystore key named by the request, plaintext keys were already
+// checked by subject.
+func (s *service) privateKey(dto *SignRawTransactionDTO) (string, error) {
+	if dto.KeyId == "" {
+		return dto.PrivateKey, nil
+	}
+	return s.keys.Unlock(dto.KeyId, dto.Passphrase)
+}
+
+func (s *service) checkBroadcast(ctx context.Context, dto *SendRawTransactionDTO) error {
+	tx, err := policy.DecodeEVM(s.evm.Name, dto.SignedTx, dto.Network)
+	if err != nil {
+		return err
+	}
+	return s.policies.CheckBroadcast(ctx, tx)
+}
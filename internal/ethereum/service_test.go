@@ -2,14 +2,27 @@ package ethereum_test
 
 import (
 	"context"
+	gErrors "errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"math/big"
 	"nn-blockchain-api/internal/ethereum"
+	"nn-blockchain-api/internal/wallet"
+	mock_wallet "nn-blockchain-api/internal/wallet/mocks"
+	"nn-blockchain-api/pkg/auth"
 	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/keystore"
+	mock_keystore "nn-blockchain-api/pkg/keystore/mocks"
 	"nn-blockchain-api/pkg/logger"
+	"nn-blockchain-api/pkg/policy"
+	mock_policy "nn-blockchain-api/pkg/policy/mocks"
 	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
 	mock_ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum/mocks"
+	"nn-blockchain-api/pkg/storage"
+	bolt_storage "nn-blockchain-api/pkg/storage/bolt"
+	mock_storage "nn-blockchain-api/pkg/storage/mocks"
+	"path/filepath"
 	"testing"
 )
 
@@ -19,22 +32,50 @@ func TestNewService(t *testing.T) {
 
 	tests := []struct {
 		name      string
+		evm       ethereum_rpc.Chain
 		ethRpcSvc ethereum_rpc.Service
+		walletSvc wallet.Service
+		keys      keystore.Keystore
+		policies  policy.Engine
+		store     storage.Storage
 		logger    *zap.SugaredLogger
 		expect    func(*testing.T, ethereum.Service, error)
 	}{
 		{
 			name:      "should return ethereum service",
+			evm:       ethereum_rpc.KnownChains["ethereum"],
 			ethRpcSvc: mock_ethereum_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
 			logger:    &zap.SugaredLogger{},
 			expect: func(t *testing.T, s ethereum.Service, err error) {
 				assert.NotNil(t, s)
 				assert.Nil(t, err)
 			},
 		},
+		{
+			name:      "should return invalid chain",
+			ethRpcSvc: mock_ethereum_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s ethereum.Service, err error) {
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid chain")
+			},
+		},
 		{
 			name:      "should return invalid ethereum rpc service",
+			evm:       ethereum_rpc.KnownChains["ethereum"],
 			ethRpcSvc: nil,
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
 			logger:    &zap.SugaredLogger{},
 			expect: func(t *testing.T, s ethereum.Service, err error) {
 				assert.NotNil(t, err)
@@ -42,9 +83,74 @@ func TestNewService(t *testing.T) {
 				assert.EqualError(t, err, "invalid ethereum rpc service")
 			},
 		},
+		{
+			name:      "should return invalid wallet service",
+			evm:       ethereum_rpc.KnownChains["ethereum"],
+			ethRpcSvc: mock_ethereum_rpc.NewMockService(controller),
+			walletSvc: nil,
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s ethereum.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid wallet service")
+			},
+		},
+		{
+			name:      "should return invalid keystore",
+			evm:       ethereum_rpc.KnownChains["ethereum"],
+			ethRpcSvc: mock_ethereum_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      nil,
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s ethereum.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid keystore")
+			},
+		},
+		{
+			name:      "should return invalid policy engine",
+			evm:       ethereum_rpc.KnownChains["ethereum"],
+			ethRpcSvc: mock_ethereum_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  nil,
+			store:     mock_storage.NewMockStorage(controller),
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s ethereum.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid policy engine")
+			},
+		},
+		{
+			name:      "should return invalid storage",
+			evm:       ethereum_rpc.KnownChains["ethereum"],
+			ethRpcSvc: mock_ethereum_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     nil,
+			logger:    &zap.SugaredLogger{},
+			expect: func(t *testing.T, s ethereum.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid storage")
+			},
+		},
 		{
 			name:      "should return invalid logger",
+			evm:       ethereum_rpc.KnownChains["ethereum"],
 			ethRpcSvc: mock_ethereum_rpc.NewMockService(controller),
+			walletSvc: mock_wallet.NewMockService(controller),
+			keys:      mock_keystore.NewMockKeystore(controller),
+			policies:  mock_policy.NewMockEngine(controller),
+			store:     mock_storage.NewMockStorage(controller),
 			logger:    nil,
 			expect: func(t *testing.T, s ethereum.Service, err error) {
 				assert.NotNil(t, err)
@@ -56,7 +162,7 @@ func TestNewService(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := ethereum.NewService(tc.ethRpcSvc, tc.logger)
+			svc, err := ethereum.NewService(tc.evm, tc.ethRpcSvc, tc.walletSvc, tc.keys, tc.policies, tc.store, tc.logger)
 			tc.expect(t, svc, err)
 		})
 	}
@@ -70,7 +176,7 @@ func TestService_StatusNode(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := ethereum.NewService(ethRpcSvc, zapLogger)
+	service, _ := ethereum.NewService(ethereum_rpc.KnownChains["ethereum"], ethRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	statusInfo := ethereum_rpc.StatusNodeResponse{
 		CurrentBlock:        "0x321",
@@ -106,7 +212,7 @@ func TestService_StatusNode(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *ethereum.StatusNodeDTO) {
-				ethRpcSvc.EXPECT().Status(ctx, dto.Network).Return(&statusInfo, nil)
+				ethRpcSvc.EXPECT().Status(gomock.Any(), dto.Network).Return(&statusInfo, nil)
 			},
 			expect: func(t *testing.T, status *ethereum.NodeInfoDTO, err error) {
 				assert.Nil(t, err)
@@ -118,11 +224,11 @@ func TestService_StatusNode(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *ethereum.StatusNodeDTO) {
-				ethRpcSvc.EXPECT().Status(ctx, dto.Network).Return(nil, ethereum.ErrFailedGetStatusNode)
+				ethRpcSvc.EXPECT().Status(gomock.Any(), dto.Network).Return(nil, ethereum.ErrFailedGetStatusNode)
 			},
 			expect: func(t *testing.T, status *ethereum.NodeInfoDTO, err error) {
 				assert.NotNil(t, err)
-				assert.Equal(t, err, errors.WithMessage(ethereum.ErrFailedGetStatusNode, ethereum.ErrFailedGetStatusNode.Error()))
+				assert.Equal(t, err, ethereum.ErrFailedGetStatusNode)
 			},
 		},
 	}
@@ -144,7 +250,7 @@ func TestService_CreateTransaction(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := ethereum.NewService(ethRpcSvc, zapLogger)
+	service, _ := ethereum.NewService(ethereum_rpc.KnownChains["ethereum"], ethRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), mock_policy.NewMockEngine(controller), newStorage(t), zapLogger)
 
 	tx := "transaction"
 	fee := 0.000528288415914
@@ -168,7 +274,7 @@ func TestService_CreateTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *ethereum.CreateRawTransactionDTO) {
-				ethRpcSvc.EXPECT().CreateTransaction(ctx, dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network).Return(&tx, &fee, nil)
+				ethRpcSvc.EXPECT().CreateTransaction(gomock.Any(), dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network).Return(&tx, &fee, nil)
 			},
 			expect: func(t *testing.T, createdTxDto *ethereum.CreatedRawTransactionDTO, err error) {
 				assert.Nil(t, err)
@@ -181,11 +287,11 @@ func TestService_CreateTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *ethereum.CreateRawTransactionDTO) {
-				ethRpcSvc.EXPECT().CreateTransaction(ctx, dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network).Return(nil, nil, ethereum.ErrFailedCreateTx)
+				ethRpcSvc.EXPECT().CreateTransaction(gomock.Any(), dto.FromAddress, dto.ToAddress, dto.Amount, dto.Network).Return(nil, nil, ethereum.ErrFailedCreateTx)
 			},
 			expect: func(t *testing.T, createdTxDto *ethereum.CreatedRawTransactionDTO, err error) {
 				assert.NotNil(t, err)
-				assert.Equal(t, err, errors.WithMessage(ethereum.ErrFailedCreateTx, ethereum.ErrFailedCreateTx.Error()))
+				assert.Equal(t, err, ethereum.ErrFailedCreateTx)
 			},
 		},
 	}
@@ -199,24 +305,36 @@ func TestService_CreateTransaction(t *testing.T) {
 	}
 }
 
+// unsignedTx sends 0.001 ether to 0x9858EfFD232B4033E47d90003D41EC34EcaEda94.
+const unsignedTx = "ea01843b9aca00825208949858effd232b4033e47d90003d41ec34ecaeda9487038d7ea4c6800080808080"
+
 func TestService_SignTransaction(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 
 	ethRpcSvc := mock_ethereum_rpc.NewMockService(controller)
+	walletSvc := mock_wallet.NewMockService(controller)
+	keys := mock_keystore.NewMockKeystore(controller)
+	policies := mock_policy.NewMockEngine(controller)
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := ethereum.NewService(ethRpcSvc, zapLogger)
+	service, _ := ethereum.NewService(ethereum_rpc.KnownChains["ethereum"], ethRpcSvc, walletSvc, keys, policies, newStorage(t), zapLogger)
 
 	signedTx := "signed_transaction"
 
 	dto := &ethereum.SignRawTransactionDTO{
-		Tx:         "transaction",
-		PrivateKey: "private_key",
+		Tx:         unsignedTx,
+		PrivateKey: "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
 		Network:    "test",
 	}
 
+	key := &keystore.Key{Id: "key", Chain: "ethereum", Address: "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"}
+	reservation := &policy.Reservation{}
+
+	decoded, err := policy.DecodeEthereum(unsignedTx, "test")
+	assert.Nil(t, err)
+
 	tests := []struct {
 		name   string
 		ctx    context.Context
@@ -229,7 +347,9 @@ func TestService_SignTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {
-				ethRpcSvc.EXPECT().SignTransaction(ctx, dto.Tx, dto.PrivateKey, dto.Network).Return(&signedTx, nil)
+				keys.EXPECT().AllowsRawKeys().Return(true)
+				policies.EXPECT().CheckSign(gomock.Any(), decoded, policy.Subject{Addresses: []string{"0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"}}, "", "").Return(reservation, nil)
+				ethRpcSvc.EXPECT().SignTransaction(gomock.Any(), dto.Tx, dto.PrivateKey, dto.Network).Return(&signedTx, nil)
 			},
 			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
 				assert.Nil(t, err)
@@ -241,11 +361,106 @@ func TestService_SignTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {
-				ethRpcSvc.EXPECT().SignTransaction(ctx, dto.Tx, dto.PrivateKey, dto.Network).Return(nil, ethereum.ErrFailedSignTx)
+				keys.EXPECT().AllowsRawKeys().Return(true)
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "", "").Return(reservation, nil)
+				ethRpcSvc.EXPECT().SignTransaction(gomock.Any(), dto.Tx, dto.PrivateKey, dto.Network).Return(nil, ethereum.ErrFailedSignTx)
+				policies.EXPECT().Release(gomock.Any(), reservation)
 			},
 			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
 				assert.NotNil(t, err)
-				assert.Equal(t, err, errors.WithMessage(ethereum.ErrFailedSignTx, ethereum.ErrFailedSignTx.Error()))
+				assert.Equal(t, err, ethereum.ErrFailedSignTx)
+			},
+		},
+		{
+			name: "should reject raw key when disabled",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {
+				keys.EXPECT().AllowsRawKeys().Return(false)
+			},
+			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTxDto)
+				assert.Equal(t, keystore.ErrRawKeysDisabled, err)
+			},
+		},
+		{
+			name:  "should reject undecodable transaction",
+			ctx:   context.Background(),
+			dto:   &ethereum.SignRawTransactionDTO{Tx: "transaction", KeyId: "key", Network: "test"},
+			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {},
+			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTxDto)
+				assert.Equal(t, 400, errors.HTTPCode(err))
+			},
+		},
+		{
+			name: "should sign with keystore key",
+			ctx:  context.Background(),
+			dto:  &ethereum.SignRawTransactionDTO{Tx: unsignedTx, KeyId: "key", Network: "test"},
+			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(key, nil)
+				policies.EXPECT().CheckSign(gomock.Any(), decoded, policy.Subject{KeyId: "key", Addresses: []string{key.Address}}, "", "").Return(reservation, nil)
+				keys.EXPECT().Unlock("key", "").Return("unlocked", nil)
+				ethRpcSvc.EXPECT().SignTransaction(gomock.Any(), dto.Tx, "unlocked", dto.Network).Return(&signedTx, nil)
+			},
+			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, signedTx, signedTxDto.SignedTx)
+			},
+		},
+		{
+			name: "should reject keystore key of another chain",
+			ctx:  context.Background(),
+			dto:  &ethereum.SignRawTransactionDTO{Tx: unsignedTx, KeyId: "key", Network: "test"},
+			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(&keystore.Key{Id: "key", Chain: "bitcoin", Network: "test"}, nil)
+			},
+			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTxDto)
+				assert.Equal(t, errors.WithMessage(keystore.ErrKeyMismatch, "key key belongs to bitcoin"), err)
+			},
+		},
+		{
+			name: "should return key not found",
+			ctx:  context.Background(),
+			dto:  &ethereum.SignRawTransactionDTO{Tx: unsignedTx, KeyId: "key", Network: "test"},
+			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(nil, keystore.ErrKeyNotFound)
+			},
+			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTxDto)
+				assert.Equal(t, keystore.ErrKeyNotFound, err)
+			},
+		},
+		{
+			name: "should hold transaction for approval",
+			ctx:  auth.WithKey(context.Background(), &auth.Key{Name: "signer"}),
+			dto:  &ethereum.SignRawTransactionDTO{Tx: unsignedTx, KeyId: "key", Network: "test"},
+			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {
+				keys.EXPECT().Get("key").Return(key, nil)
+				policies.EXPECT().CheckSign(gomock.Any(), gomock.Any(), gomock.Any(), "", "signer").Return(nil, policy.ErrApprovalRequired)
+			},
+			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, signedTxDto)
+				assert.Equal(t, 202, errors.HTTPCode(err))
+			},
+		},
+		{
+			name: "should sign with wallet service",
+			ctx:  context.Background(),
+			dto:  &ethereum.SignRawTransactionDTO{Tx: unsignedTx, WalletId: "wallet", Network: "test"},
+			setup: func(ctx context.Context, dto *ethereum.SignRawTransactionDTO) {
+				policies.EXPECT().CheckSign(gomock.Any(), decoded, policy.Subject{WalletId: "wallet"}, "", "").Return(reservation, nil)
+				walletSvc.EXPECT().SignTransaction(gomock.Any(), &wallet.SignTransactionDTO{
+					WalletId: "wallet",
+					Chain:    "ethereum",
+					Network:  dto.Network,
+					Tx:       dto.Tx,
+				}).Return(&wallet.SignedTransactionDTO{SignedTx: signedTx}, nil)
+			},
+			expect: func(t *testing.T, signedTxDto *ethereum.SignedRawTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, signedTx, signedTxDto.SignedTx)
 			},
 		},
 	}
@@ -264,13 +479,14 @@ func TestService_SendTransaction(t *testing.T) {
 	defer controller.Finish()
 
 	ethRpcSvc := mock_ethereum_rpc.NewMockService(controller)
+	policies := mock_policy.NewMockEngine(controller)
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := ethereum.NewService(ethRpcSvc, zapLogger)
+	service, _ := ethereum.NewService(ethereum_rpc.KnownChains["ethereum"], ethRpcSvc, mock_wallet.NewMockService(controller), mock_keystore.NewMockKeystore(controller), policies, newStorage(t), zapLogger)
 
 	dto := &ethereum.SendRawTransactionDTO{
-		SignedTx: "signed_tx",
+		SignedTx: signTx(t, 5),
 		Network:  "test",
 	}
 
@@ -288,7 +504,9 @@ func TestService_SendTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *ethereum.SendRawTransactionDTO) {
-				ethRpcSvc.EXPECT().SendTransaction(ctx, dto.SignedTx, dto.Network).Return(&txId, nil)
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				expectPreflight(ethRpcSvc)
+				ethRpcSvc.EXPECT().SendTransaction(gomock.Any(), dto.SignedTx, dto.Network).Return(&txId, nil)
 			},
 			expect: func(t *testing.T, sentTxDto *ethereum.SentRawTransactionDTO, err error) {
 				assert.Nil(t, err)
@@ -300,11 +518,86 @@ func TestService_SendTransaction(t *testing.T) {
 			ctx:  context.Background(),
 			dto:  dto,
 			setup: func(ctx context.Context, dto *ethereum.SendRawTransactionDTO) {
-				ethRpcSvc.EXPECT().SendTransaction(ctx, dto.SignedTx, dto.Network).Return(nil, ethereum.ErrFailedSendTx)
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				expectPreflight(ethRpcSvc)
+				ethRpcSvc.EXPECT().SendTransaction(gomock.Any(), dto.SignedTx, dto.Network).Return(nil, ethereum.ErrFailedSendTx)
 			},
 			expect: func(t *testing.T, sentTxDto *ethereum.SentRawTransactionDTO, err error) {
 				assert.NotNil(t, err)
-				assert.Equal(t, err, errors.WithMessage(ethereum.ErrFailedSendTx, ethereum.ErrFailedSendTx.Error()))
+				assert.Equal(t, err, ethereum.ErrFailedSendTx)
+			},
+		},
+		{
+			name: "should return node rejection",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *ethereum.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				expectPreflight(ethRpcSvc)
+				ethRpcSvc.EXPECT().SendTransaction(gomock.Any(), dto.SignedTx, dto.Network).Return(nil, errors.FromEthereumRPC(-32000, "nonce too low"))
+			},
+			expect: func(t *testing.T, sentTxDto *ethereum.SentRawTransactionDTO, err error) {
+				assert.Nil(t, sentTxDto)
+				assert.Equal(t, 409, errors.HTTPCode(err))
+				assert.Equal(t, err, errors.FromEthereumRPC(-32000, "nonce too low"))
+			},
+		},
+		{
+			name: "should wrap untyped error",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *ethereum.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				expectPreflight(ethRpcSvc)
+				ethRpcSvc.EXPECT().SendTransaction(gomock.Any(), dto.SignedTx, dto.Network).Return(nil, gErrors.New("unexpected EOF"))
+			},
+			expect: func(t *testing.T, sentTxDto *ethereum.SentRawTransactionDTO, err error) {
+				assert.Nil(t, sentTxDto)
+				assert.Equal(t, err, errors.WithMessage(ethereum.ErrFailedSendTx, "unexpected EOF"))
+			},
+		},
+		{
+			name: "should refuse transaction failing pre-flight checks",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *ethereum.SendRawTransactionDTO) {
+				nonce := "0x2"
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				ethRpcSvc.EXPECT().ChainId(gomock.Any(), "test").Return(big.NewInt(5), nil)
+				ethRpcSvc.EXPECT().PendingNonceAt(gomock.Any(), sender, "test").Return(&nonce, nil)
+				ethRpcSvc.EXPECT().BalanceAt(gomock.Any(), sender, "pending", "test").Return(big.NewInt(1e18), nil)
+				ethRpcSvc.EXPECT().Call(gomock.Any(), gomock.Any(), "pending", "test").Return(&ethereum_rpc.CallResult{}, nil)
+			},
+			expect: func(t *testing.T, sentTxDto *ethereum.SentRawTransactionDTO, err error) {
+				assert.Nil(t, sentTxDto)
+				assert.Equal(t, errors.FromEthereumRPC(-32000, "nonce too low: next nonce 2, tx nonce 1"), err)
+			},
+		},
+		{
+			name: "should stop dry run after pre-flight checks",
+			ctx:  context.Background(),
+			dto:  &ethereum.SendRawTransactionDTO{SignedTx: dto.SignedTx, Network: "test", DryRun: true},
+			setup: func(ctx context.Context, dto *ethereum.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(nil)
+				expectPreflight(ethRpcSvc)
+			},
+			expect: func(t *testing.T, sentTxDto *ethereum.SentRawTransactionDTO, err error) {
+				assert.Nil(t, err)
+				assert.True(t, sentTxDto.DryRun)
+				assert.Equal(t, sentTxDto.Simulation.TxId, sentTxDto.TxId)
+				assert.True(t, sentTxDto.Simulation.Passed)
+			},
+		},
+		{
+			name: "should return policy denial",
+			ctx:  context.Background(),
+			dto:  dto,
+			setup: func(ctx context.Context, dto *ethereum.SendRawTransactionDTO) {
+				policies.EXPECT().CheckBroadcast(gomock.Any(), gomock.Any()).Return(policy.ErrPolicyDenied)
+			},
+			expect: func(t *testing.T, sentTxDto *ethereum.SentRawTransactionDTO, err error) {
+				assert.Nil(t, sentTxDto)
+				assert.Equal(t, policy.ErrPolicyDenied, err)
 			},
 		},
 	}
@@ -317,3 +610,13 @@ func TestService_SendTransaction(t *testing.T) {
 		})
 	}
 }
+
+func newStorage(t *testing.T) storage.Storage {
+	store, err := bolt_storage.NewStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
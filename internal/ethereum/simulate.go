@@ -0,0 +1,165 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/hex"
+	gErrors "errors"
+	"fmt"
+	"nn-blockchain-api/pkg/errors"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"nn-blockchain-api/pkg/tracing"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	CheckChainId   = "chain_id"
+	CheckSender    = "sender"
+	CheckNonce     = "nonce"
+	CheckBalance   = "balance"
+	CheckExecution = "execution"
+)
+
+// check is the outcome of one pre-flight check, err holds the error the node would have
+// answered with. A warning does not fail the check, the node accepts the transaction.
+type check struct {
+	name    string
+	err     error
+	warning string
+}
+
+func (s *service) SimulateTransaction(ctx context.Context, dto *SimulateTransactionDTO) (*SimulationDTO, error) {
+	ctx, span := tracing.Start(ctx, "ethereum.Service/SimulateTransaction")
+	defer span.End()
+
+	simulation, _, err := s.preflight(ctx, dto.SignedTx, dto.Network)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed simulate transaction: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.Wrap(ErrFailedSimulateTx, err)
+	}
+
+	return simulation, nil
+}
+
+// preflight decodes a signed transaction and checks it the way the node would before
+// accepting it.
+func (s *service) preflight(ctx context.Context, signedTx, network string) (*SimulationDTO, []check, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(signedTx, "0x"))
+	if err != nil {
+		return nil, nil, errors.NewInvalid(errors.StatusInvalidTxEncoding, err.Error())
+	}
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(raw, &tx); err != nil {
+		return nil, nil, errors.NewInvalid(errors.StatusInvalidTxEncoding, err.Error())
+	}
+
+	simulation := &SimulationDTO{TxId: tx.Hash().Hex()}
+	checks, err := s.checkTransaction(ctx, tx, simulation, network)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	simulation.Passed = true
+	for _, c := range checks {
+		item := CheckDTO{Name: c.name, Passed: c.err == nil, Warning: c.warning}
+		if c.err != nil {
+			simulation.Passed = false
+			if typed, ok := c.err.(*errors.Error); ok {
+				item.Status, item.Message = typed.Status, typed.Message
+			}
+		}
+		simulation.Checks = append(simulation.Checks, item)
+	}
+	return simulation, checks, nil
+}
+
+// firstFailure returns the error of the first failed check, the one send reports.
+func firstFailure(checks []check) error {
+	for _, c := range checks {
+		if c.err != nil {
+			return c.err
+		}
+	}
+	return nil
+}
+
+// checkTransaction runs the checks in order. The nonce, balance and execution checks need
+// the sender and are skipped when it cannot be recovered.
+func (s *service) checkTransaction(ctx context.Context, tx *types.Transaction, simulation *SimulationDTO, network string) ([]check, error) {
+	chainID, err := s.ethRpcSvc.ChainId(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []check
+	switch {
+	case !tx.Protected():
+		checks = append(checks, check{name: CheckChainId, err: errors.FromEthereumRPC(-32000, "only replay-protected (EIP-155) transactions allowed over RPC")})
+	case tx.ChainId().Cmp(chainID) != 0:
+		checks = append(checks, check{name: CheckChainId, err: errors.FromEthereumRPC(-32000, fmt.Sprintf("invalid sender: chain id %v, the network is on %v", tx.ChainId(), chainID))})
+	default:
+		checks = append(checks, check{name: CheckChainId})
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return append(checks, check{name: CheckSender, err: errors.FromEthereumRPC(-32000, "invalid sender: "+err.Error())}), nil
+	}
+	simulation.From = from.Hex()
+	checks = append(checks, check{name: CheckSender})
+
+	pending, err := s.ethRpcSvc.PendingNonceAt(ctx, simulation.From, network)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hexutil.DecodeUint64(*pending)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case tx.Nonce() < nonce:
+		checks = append(checks, check{name: CheckNonce, err: errors.FromEthereumRPC(-32000, fmt.Sprintf("nonce too low: next nonce %v, tx nonce %v", nonce, tx.Nonce()))})
+	case tx.Nonce() > nonce:
+		// Nodes queue future nonces, the transaction is mined once the gap is filled.
+		checks = append(checks, check{name: CheckNonce, warning: fmt.Sprintf("nonce too high: next nonce %v, tx nonce %v, queued until the gap is filled", nonce, tx.Nonce())})
+	default:
+		checks = append(checks, check{name: CheckNonce})
+	}
+
+	balance, err := s.ethRpcSvc.BalanceAt(ctx, simulation.From, "pending", network)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Cmp(tx.Cost()) < 0 {
+		checks = append(checks, check{name: CheckBalance, err: errors.FromEthereumRPC(-32000, fmt.Sprintf("insufficient funds for gas * price + value: balance %v, tx cost %v", balance, tx.Cost()))})
+	} else {
+		checks = append(checks, check{name: CheckBalance})
+	}
+
+	gas := hexutil.Uint64(tx.Gas())
+	msg := ethereum_rpc.CallMsg{From: simulation.From, Gas: &gas, Data: tx.Data(), Value: (*hexutil.Big)(tx.Value())}
+	if tx.To() != nil {
+		msg.To = tx.To().Hex()
+	}
+	call, err := s.ethRpcSvc.Call(ctx, msg, "pending", network)
+	if err != nil {
+		// Errors such as gas too low are about the transaction, the others about the node.
+		var typed *errors.Error
+		if !gErrors.As(err, &typed) || typed.Status == errors.StatusNodeError || typed.Status == errors.StatusNodeUnavailable {
+			return nil, err
+		}
+		return append(checks, check{name: CheckExecution, err: err}), nil
+	}
+	if call.Reverted {
+		message := call.Message
+		if reason := ethereum_rpc.RevertReason(nil, call.RevertData); reason != "" {
+			message = "execution reverted: " + reason
+		}
+		return append(checks, check{name: CheckExecution, err: errors.NewRejected(errors.StatusExecutionReverted, message)}), nil
+	}
+	return append(checks, check{name: CheckExecution}), nil
+}
@@ -0,0 +1,159 @@
+package ethereum_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"nn-blockchain-api/internal/ethereum"
+	"nn-blockchain-api/pkg/errors"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	mock_ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sender is the address of the key signTx signs with.
+const sender = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+
+// signTx signs unsignedTx, nonce 1, for the chain.
+func signTx(t *testing.T, chainID int64) string {
+	raw, err := hex.DecodeString(unsignedTx)
+	require.NoError(t, err)
+	tx := new(types.Transaction)
+	require.NoError(t, rlp.DecodeBytes(raw, &tx))
+
+	key, err := crypto.HexToECDSA("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+	signed, err := types.SignTx(tx, types.NewLondonSigner(big.NewInt(chainID)), key)
+	require.NoError(t, err)
+	data, err := rlp.EncodeToBytes(signed)
+	require.NoError(t, err)
+	return hex.EncodeToString(data)
+}
+
+// expectPreflight lets the checks of a transaction signed by signTx for chain 5 pass.
+func expectPreflight(ethRpcSvc *mock_ethereum_rpc.MockService) {
+	nonce := "0x1"
+	ethRpcSvc.EXPECT().ChainId(gomock.Any(), "test").Return(big.NewInt(5), nil)
+	ethRpcSvc.EXPECT().PendingNonceAt(gomock.Any(), sender, "test").Return(&nonce, nil)
+	ethRpcSvc.EXPECT().BalanceAt(gomock.Any(), sender, "pending", "test").Return(big.NewInt(1e18), nil)
+	ethRpcSvc.EXPECT().Call(gomock.Any(), gomock.Any(), "pending", "test").Return(&ethereum_rpc.CallResult{}, nil)
+}
+
+func TestService_SimulateTransaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service, ethRpcSvc := newExplorerService(t, controller)
+	nonce := "0x1"
+
+	tests := []struct {
+		name   string
+		dto    *ethereum.SimulateTransactionDTO
+		setup  func()
+		expect func(t *testing.T, simulation *ethereum.SimulationDTO, err error)
+	}{
+		{
+			name:  "should pass all checks",
+			dto:   &ethereum.SimulateTransactionDTO{SignedTx: signTx(t, 5), Network: "test"},
+			setup: func() { expectPreflight(ethRpcSvc) },
+			expect: func(t *testing.T, simulation *ethereum.SimulationDTO, err error) {
+				assert.Nil(t, err)
+				assert.True(t, simulation.Passed)
+				assert.Equal(t, sender, simulation.From)
+				assert.Equal(t, []ethereum.CheckDTO{
+					{Name: ethereum.CheckChainId, Passed: true},
+					{Name: ethereum.CheckSender, Passed: true},
+					{Name: ethereum.CheckNonce, Passed: true},
+					{Name: ethereum.CheckBalance, Passed: true},
+					{Name: ethereum.CheckExecution, Passed: true},
+				}, simulation.Checks)
+			},
+		},
+		{
+			name: "should report wrong chain, stale nonce, low balance and revert",
+			dto:  &ethereum.SimulateTransactionDTO{SignedTx: signTx(t, 1), Network: "test"},
+			setup: func() {
+				stale := "0x2"
+				ethRpcSvc.EXPECT().ChainId(gomock.Any(), "test").Return(big.NewInt(5), nil)
+				ethRpcSvc.EXPECT().PendingNonceAt(gomock.Any(), sender, "test").Return(&stale, nil)
+				ethRpcSvc.EXPECT().BalanceAt(gomock.Any(), sender, "pending", "test").Return(big.NewInt(1), nil)
+				ethRpcSvc.EXPECT().Call(gomock.Any(), gomock.Any(), "pending", "test").
+					Return(&ethereum_rpc.CallResult{Reverted: true, Message: "execution reverted"}, nil)
+			},
+			expect: func(t *testing.T, simulation *ethereum.SimulationDTO, err error) {
+				assert.Nil(t, err)
+				assert.False(t, simulation.Passed)
+				assert.Equal(t, []ethereum.CheckDTO{
+					{Name: ethereum.CheckChainId, Status: errors.StatusInvalidSignature, Message: "invalid sender: chain id 1, the network is on 5"},
+					{Name: ethereum.CheckSender, Passed: true},
+					{Name: ethereum.CheckNonce, Status: errors.StatusNonceTooLow, Message: "nonce too low: next nonce 2, tx nonce 1"},
+					{Name: ethereum.CheckBalance, Status: errors.StatusInsufficientFunds, Message: "insufficient funds for gas * price + value: balance 1, tx cost 1021000000000000"},
+					{Name: ethereum.CheckExecution, Status: errors.StatusExecutionReverted, Message: "execution reverted"},
+				}, simulation.Checks)
+			},
+		},
+		{
+			name: "should pass a future nonce with a warning",
+			dto:  &ethereum.SimulateTransactionDTO{SignedTx: signTx(t, 5), Network: "test"},
+			setup: func() {
+				next := "0x0"
+				ethRpcSvc.EXPECT().ChainId(gomock.Any(), "test").Return(big.NewInt(5), nil)
+				ethRpcSvc.EXPECT().PendingNonceAt(gomock.Any(), sender, "test").Return(&next, nil)
+				ethRpcSvc.EXPECT().BalanceAt(gomock.Any(), sender, "pending", "test").Return(big.NewInt(1e18), nil)
+				ethRpcSvc.EXPECT().Call(gomock.Any(), gomock.Any(), "pending", "test").Return(&ethereum_rpc.CallResult{}, nil)
+			},
+			expect: func(t *testing.T, simulation *ethereum.SimulationDTO, err error) {
+				assert.Nil(t, err)
+				assert.True(t, simulation.Passed)
+				assert.Equal(t, ethereum.CheckDTO{
+					Name:    ethereum.CheckNonce,
+					Passed:  true,
+					Warning: "nonce too high: next nonce 0, tx nonce 1, queued until the gap is filled",
+				}, simulation.Checks[2])
+			},
+		},
+		{
+			name: "should skip sender checks of unsigned transaction",
+			dto:  &ethereum.SimulateTransactionDTO{SignedTx: unsignedTx, Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().ChainId(gomock.Any(), "test").Return(big.NewInt(5), nil)
+			},
+			expect: func(t *testing.T, simulation *ethereum.SimulationDTO, err error) {
+				assert.Nil(t, err)
+				assert.False(t, simulation.Passed)
+				assert.Len(t, simulation.Checks, 2)
+				assert.Equal(t, errors.StatusTxRejected, simulation.Checks[0].Status)
+				assert.Equal(t, errors.StatusInvalidSignature, simulation.Checks[1].Status)
+			},
+		},
+		{
+			name: "should fail when the node is unavailable",
+			dto:  &ethereum.SimulateTransactionDTO{SignedTx: signTx(t, 5), Network: "test"},
+			setup: func() {
+				ethRpcSvc.EXPECT().ChainId(gomock.Any(), "test").Return(big.NewInt(5), nil)
+				ethRpcSvc.EXPECT().PendingNonceAt(gomock.Any(), sender, "test").Return(&nonce, nil)
+				ethRpcSvc.EXPECT().BalanceAt(gomock.Any(), sender, "pending", "test").Return(big.NewInt(1e18), nil)
+				ethRpcSvc.EXPECT().Call(gomock.Any(), gomock.Any(), "pending", "test").Return(nil, errors.NewNodeUnavailable("connection refused"))
+			},
+			expect: func(t *testing.T, simulation *ethereum.SimulationDTO, err error) {
+				assert.Nil(t, simulation)
+				assert.Equal(t, errors.NewNodeUnavailable("connection refused"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			simulation, err := service.SimulateTransaction(context.Background(), tc.dto)
+			tc.expect(t, simulation, err)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/grpc/connectivity"
+)
+
+// UTXOCheck verifies the node of a UTXO chain is out of initial block download, its tip is recent and
+// it has peers. The check is named after the chain and network.
+func UTXOCheck(chain string, btcRpcSvc bitcoin_rpc.Service, network string, maxTipAge time.Duration, minPeers int64, timeout time.Duration) Check {
+	return Check{
+		Name:    chain + "_" + network,
+		Timeout: timeout,
+		Run: func(ctx context.Context) (map[string]interface{}, error) {
+			status, err := btcRpcSvc.Status(ctx, network)
+			if err != nil {
+				return nil, err
+			}
+
+			blocks, _ := status.Blocks.(float64)
+			headers, _ := status.Headers.(float64)
+			details := map[string]interface{}{"blocks": blocks, "headers": headers}
+
+			if status.Initialblockdownload || blocks < headers {
+				return details, fmt.Errorf("node is syncing: %v of %v blocks", blocks, headers)
+			}
+
+			tip, err := btcRpcSvc.BlockHeader(ctx, status.Bestblockhash, network)
+			if err != nil {
+				return details, err
+			}
+			tipAge := time.Since(time.Unix(tip.Time, 0)).Truncate(time.Second)
+			details["tip_age"] = tipAge.String()
+
+			info, err := btcRpcSvc.NetworkInfo(ctx, network)
+			if err != nil {
+				return details, err
+			}
+			details["peers"] = info.Connections
+
+			if tipAge > maxTipAge {
+				return details, fmt.Errorf("chain tip is %s old", tipAge)
+			}
+			if info.Connections < minPeers {
+				return details, fmt.Errorf("node has %d peers, expected at least %d", info.Connections, minPeers)
+			}
+
+			return details, nil
+		},
+	}
+}
+
+// EVMCheck verifies the node of an EVM chain is not syncing, its latest block is recent and it has
+// peers. The check is named after the chain and network. Peers are not checked when minPeers is
+// zero or the node refuses net_peerCount, as hosted RPC providers do.
+func EVMCheck(chain string, ethRpcSvc ethereum_rpc.Service, network string, maxTipAge time.Duration, minPeers int64, timeout time.Duration) Check {
+	return Check{
+		Name:    chain + "_" + network,
+		Timeout: timeout,
+		Run: func(ctx context.Context) (map[string]interface{}, error) {
+			status, err := ethRpcSvc.Status(ctx, network)
+			if err != nil {
+				return nil, err
+			}
+			if status.CurrentBlock != "" {
+				return map[string]interface{}{"current_block": status.CurrentBlock, "highest_block": status.HighestBlock},
+					fmt.Errorf("node is syncing: %s of %s", status.CurrentBlock, status.HighestBlock)
+			}
+
+			latest, err := ethRpcSvc.HeaderByNumber(ctx, "latest", network)
+			if err != nil {
+				return nil, err
+			}
+			number, err := hexutil.DecodeUint64(latest.Number)
+			if err != nil {
+				return nil, err
+			}
+			timestamp, err := hexutil.DecodeUint64(latest.Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			tipAge := time.Since(time.Unix(int64(timestamp), 0)).Truncate(time.Second)
+			details := map[string]interface{}{"block": number, "tip_age": tipAge.String()}
+
+			if tipAge > maxTipAge {
+				return details, fmt.Errorf("chain tip is %s old", tipAge)
+			}
+			if minPeers <= 0 {
+				return details, nil
+			}
+
+			peers, err := ethRpcSvc.PeerCount(ctx, network)
+			if errors.IsNodeError(err) {
+				details["peers"] = "unsupported"
+				return details, nil
+			}
+			if err != nil {
+				return details, err
+			}
+			details["peers"] = peers
+
+			if int64(peers) < minPeers {
+				return details, fmt.Errorf("node has %d peers, expected at least %d", peers, minPeers)
+			}
+
+			return details, nil
+		},
+	}
+}
+
+// Connection is the part of *grpc.ClientConn used to probe connectivity.
+type Connection interface {
+	GetState() connectivity.State
+	Connect()
+	WaitForStateChange(ctx context.Context, sourceState connectivity.State) bool
+}
+
+// GRPCCheck reports the connection ready, waiting for an idle or connecting channel to settle.
+func GRPCCheck(name string, conn Connection, timeout time.Duration) Check {
+	return Check{
+		Name:    name,
+		Timeout: timeout,
+		Run: func(ctx context.Context) (map[string]interface{}, error) {
+			state := conn.GetState()
+			if state == connectivity.Idle {
+				conn.Connect()
+			}
+
+			for state != connectivity.Ready {
+				if state == connectivity.Shutdown {
+					break
+				}
+				if !conn.WaitForStateChange(ctx, state) {
+					break
+				}
+				state = conn.GetState()
+			}
+
+			details := map[string]interface{}{"state": state.String()}
+			if state != connectivity.Ready {
+				return details, fmt.Errorf("grpc connection is %s", state)
+			}
+
+			return details, nil
+		},
+	}
+}
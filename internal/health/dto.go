@@ -0,0 +1,13 @@
+package health
+
+type ReadinessDTO struct {
+	Status       string                    `json:"status"`
+	Dependencies map[string]*DependencyDTO `json:"dependencies"`
+}
+
+type DependencyDTO struct {
+	Status    string                 `json:"status"`
+	LatencyMs int64                  `json:"latency_ms"`
+	Error     string                 `json:"error,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
@@ -4,17 +4,28 @@ import (
 	"github.com/go-chi/chi/v5"
 	"net/http"
 	"nn-blockchain-api/pkg/respond"
+	"sync"
+	"time"
 )
 
 type Handler struct {
+	checks   []Check
+	cacheTTL time.Duration
+
+	mu       sync.Mutex
+	cached   *ReadinessDTO
+	cachedAt time.Time
 }
 
-func NewHandler() *Handler {
-	return &Handler{}
+// NewHandler serves the readiness report of the checks, reusing it for cacheTTL so frequent
+// probes don't reach the nodes on every request. Zero runs the checks every time.
+func NewHandler(cacheTTL time.Duration, checks ...Check) *Handler {
+	return &Handler{checks: checks, cacheTTL: cacheTTL}
 }
 
 func (h *Handler) SetupRoutes(router chi.Router) {
 	router.Get("/health", h.HealthCheckHandler)
+	router.Get("/ready", h.ReadinessHandler)
 }
 
 func (h *Handler) HealthCheckHandler(w http.ResponseWriter, r *http.Request) {
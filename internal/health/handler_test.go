@@ -15,7 +15,7 @@ func TestHandler_HealthCheckHandler(t *testing.T) {
 
 	res := httptest.NewRecorder()
 
-	handler := NewHandler()
+	handler := NewHandler(0)
 	handler.HealthCheckHandler(res, req)
 
 	assert.Equal(t, http.StatusOK, res.Code)
@@ -0,0 +1,100 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"nn-blockchain-api/pkg/respond"
+	"sync"
+	"time"
+)
+
+const (
+	statusOK       = "ok"
+	statusDegraded = "degraded"
+
+	defaultCheckTimeout = 5 * time.Second
+)
+
+// Check probes a single dependency, returning details for the report or an error when it is unhealthy.
+type Check struct {
+	Name    string
+	Timeout time.Duration
+	Run     func(ctx context.Context) (map[string]interface{}, error)
+}
+
+func (h *Handler) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	report := h.report(r.Context())
+
+	code := http.StatusOK
+	if report.Status != statusOK {
+		code = http.StatusServiceUnavailable
+	}
+
+	respond.Respond(w, code, report)
+}
+
+// report returns the cached report while it is fresh, otherwise runs the checks. Concurrent
+// probes wait for the same run instead of starting their own.
+func (h *Handler) report(ctx context.Context) *ReadinessDTO {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cached != nil && time.Since(h.cachedAt) < h.cacheTTL {
+		return h.cached
+	}
+
+	report := h.check(ctx)
+	// A probe that went away cut the checks short, its report is not cached.
+	if ctx.Err() == nil {
+		h.cached, h.cachedAt = report, time.Now()
+	}
+	return report
+}
+
+// check runs every dependency check concurrently, each bounded by its own timeout.
+func (h *Handler) check(ctx context.Context) *ReadinessDTO {
+	report := &ReadinessDTO{
+		Status:       statusOK,
+		Dependencies: make(map[string]*DependencyDTO, len(h.checks)),
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, check := range h.checks {
+		wg.Add(1)
+		go func(check Check) {
+			defer wg.Done()
+
+			timeout := check.Timeout
+			if timeout <= 0 {
+				timeout = defaultCheckTimeout
+			}
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			details, err := check.Run(checkCtx)
+			dependency := &DependencyDTO{
+				Status:    statusOK,
+				LatencyMs: time.Since(start).Milliseconds(),
+				Details:   details,
+			}
+			if err != nil {
+				dependency.Status = statusDegraded
+				dependency.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Dependencies[check.Name] = dependency
+			if err != nil {
+				report.Status = statusDegraded
+			}
+		}(check)
+	}
+	wg.Wait()
+
+	return report
+}
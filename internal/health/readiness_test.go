@@ -0,0 +1,208 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	pkgErrors "nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	mock_bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin/mocks"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	mock_ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum/mocks"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestHandler_ReadinessHandler(t *testing.T) {
+	ok := Check{Name: "ok", Run: func(ctx context.Context) (map[string]interface{}, error) {
+		return map[string]interface{}{"peers": 8}, nil
+	}}
+	failing := Check{Name: "failing", Run: func(ctx context.Context) (map[string]interface{}, error) {
+		return nil, errors.New("connection refused")
+	}}
+	slow := Check{Name: "slow", Timeout: 10 * time.Millisecond, Run: func(ctx context.Context) (map[string]interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}}
+
+	tests := []struct {
+		name   string
+		checks []Check
+		code   int
+		expect func(*testing.T, *ReadinessDTO)
+	}{
+		{
+			name:   "should return ready",
+			checks: []Check{ok},
+			code:   http.StatusOK,
+			expect: func(t *testing.T, report *ReadinessDTO) {
+				assert.Equal(t, statusOK, report.Status)
+				assert.Equal(t, float64(8), report.Dependencies["ok"].Details["peers"])
+			},
+		},
+		{
+			name:   "should return degraded",
+			checks: []Check{ok, failing, slow},
+			code:   http.StatusServiceUnavailable,
+			expect: func(t *testing.T, report *ReadinessDTO) {
+				assert.Equal(t, statusDegraded, report.Status)
+				assert.Equal(t, statusOK, report.Dependencies["ok"].Status)
+				assert.Equal(t, "connection refused", report.Dependencies["failing"].Error)
+				assert.Equal(t, "context deadline exceeded", report.Dependencies["slow"].Error)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := httptest.NewRecorder()
+			NewHandler(0, tc.checks...).ReadinessHandler(res, httptest.NewRequest(http.MethodGet, "/api/v1/ready", nil))
+
+			assert.Equal(t, tc.code, res.Code)
+
+			var report ReadinessDTO
+			assert.Nil(t, json.NewDecoder(res.Body).Decode(&report))
+			tc.expect(t, &report)
+		})
+	}
+}
+
+func TestHandler_ReadinessHandlerCache(t *testing.T) {
+	var runs int
+	counting := Check{Name: "counting", Run: func(ctx context.Context) (map[string]interface{}, error) {
+		runs++
+		return nil, nil
+	}}
+
+	serve := func(handler *Handler) {
+		res := httptest.NewRecorder()
+		handler.ReadinessHandler(res, httptest.NewRequest(http.MethodGet, "/api/v1/ready", nil))
+		assert.Equal(t, http.StatusOK, res.Code)
+	}
+
+	handler := NewHandler(time.Minute, counting)
+	serve(handler)
+	serve(handler)
+	assert.Equal(t, 1, runs)
+
+	handler.cachedAt = time.Now().Add(-time.Minute)
+	serve(handler)
+	assert.Equal(t, 2, runs)
+
+	uncached := NewHandler(0, counting)
+	serve(uncached)
+	serve(uncached)
+	assert.Equal(t, 4, runs)
+}
+
+func TestUTXOCheck(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	btcRpcSvc := mock_bitcoin_rpc.NewMockService(controller)
+	check := UTXOCheck("litecoin", btcRpcSvc, "test", time.Hour, 1, time.Second)
+	assert.Equal(t, "litecoin_test", check.Name)
+
+	btcRpcSvc.EXPECT().Status(gomock.Any(), "test").Return(&bitcoin_rpc.StatusNode{Blocks: float64(100), Headers: float64(100), Bestblockhash: "tip"}, nil).Times(2)
+	btcRpcSvc.EXPECT().BlockHeader(gomock.Any(), "tip", "test").Return(&bitcoin_rpc.BlockHeader{Time: time.Now().Add(-time.Minute).Unix()}, nil)
+	btcRpcSvc.EXPECT().NetworkInfo(gomock.Any(), "test").Return(&bitcoin_rpc.NetworkInfo{Connections: 8}, nil)
+
+	details, err := check.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(8), details["peers"])
+
+	btcRpcSvc.EXPECT().BlockHeader(gomock.Any(), "tip", "test").Return(&bitcoin_rpc.BlockHeader{Time: time.Now().Add(-3 * time.Hour).Unix()}, nil)
+	btcRpcSvc.EXPECT().NetworkInfo(gomock.Any(), "test").Return(&bitcoin_rpc.NetworkInfo{Connections: 8}, nil)
+
+	_, err = check.Run(context.Background())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "chain tip is 3h0m")
+
+	btcRpcSvc.EXPECT().Status(gomock.Any(), "test").Return(&bitcoin_rpc.StatusNode{Blocks: float64(90), Headers: float64(100), Initialblockdownload: true}, nil)
+
+	_, err = check.Run(context.Background())
+	assert.EqualError(t, err, "node is syncing: 90 of 100 blocks")
+}
+
+func TestEVMCheck(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ethRpcSvc := mock_ethereum_rpc.NewMockService(controller)
+	check := EVMCheck("polygon", ethRpcSvc, "main", 5*time.Minute, 3, time.Second)
+	assert.Equal(t, "polygon_main", check.Name)
+
+	ethRpcSvc.EXPECT().Status(gomock.Any(), "main").Return(&ethereum_rpc.StatusNodeResponse{SyncMessage: "node has synced"}, nil)
+	ethRpcSvc.EXPECT().HeaderByNumber(gomock.Any(), "latest", "main").Return(&ethereum_rpc.BlockHeader{
+		Number:    "0x10",
+		Timestamp: hexutil.EncodeUint64(uint64(time.Now().Unix())),
+	}, nil)
+	ethRpcSvc.EXPECT().PeerCount(gomock.Any(), "main").Return(uint64(1), nil)
+
+	details, err := check.Run(context.Background())
+	assert.EqualError(t, err, "node has 1 peers, expected at least 3")
+	assert.Equal(t, uint64(16), details["block"])
+
+	ethRpcSvc.EXPECT().Status(gomock.Any(), "main").Return(&ethereum_rpc.StatusNodeResponse{CurrentBlock: "0x1", HighestBlock: "0x10"}, nil)
+
+	_, err = check.Run(context.Background())
+	assert.EqualError(t, err, "node is syncing: 0x1 of 0x10")
+}
+
+func TestEVMCheck_Peers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ethRpcSvc := mock_ethereum_rpc.NewMockService(controller)
+	ethRpcSvc.EXPECT().Status(gomock.Any(), "main").Return(&ethereum_rpc.StatusNodeResponse{SyncMessage: "node has synced"}, nil).Times(2)
+	ethRpcSvc.EXPECT().HeaderByNumber(gomock.Any(), "latest", "main").Return(&ethereum_rpc.BlockHeader{
+		Number:    "0x10",
+		Timestamp: hexutil.EncodeUint64(uint64(time.Now().Unix())),
+	}, nil).Times(2)
+
+	details, err := EVMCheck("ethereum", ethRpcSvc, "main", 5*time.Minute, 0, time.Second).Run(context.Background())
+	assert.Nil(t, err)
+	assert.NotContains(t, details, "peers")
+
+	ethRpcSvc.EXPECT().PeerCount(gomock.Any(), "main").Return(uint64(0), pkgErrors.FromEthereumRPC(-32601, "the method net_peerCount does not exist/is not available"))
+
+	details, err = EVMCheck("ethereum", ethRpcSvc, "main", 5*time.Minute, 1, time.Second).Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "unsupported", details["peers"])
+}
+
+type fakeConnection struct {
+	states []connectivity.State
+}
+
+func (c *fakeConnection) GetState() connectivity.State { return c.states[0] }
+
+func (c *fakeConnection) Connect() {}
+
+func (c *fakeConnection) WaitForStateChange(ctx context.Context, _ connectivity.State) bool {
+	if len(c.states) == 1 {
+		<-ctx.Done()
+		return false
+	}
+	c.states = c.states[1:]
+	return true
+}
+
+func TestGRPCCheck(t *testing.T) {
+	details, err := GRPCCheck("wallet_grpc", &fakeConnection{states: []connectivity.State{connectivity.Idle, connectivity.Connecting, connectivity.Ready}}, time.Second).Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "READY", details["state"])
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = GRPCCheck("wallet_grpc", &fakeConnection{states: []connectivity.State{connectivity.TransientFailure}}, time.Second).Run(ctx)
+	assert.EqualError(t, err, "grpc connection is TRANSIENT_FAILURE")
+}
@@ -0,0 +1,14 @@
+package health
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/openapi"
+)
+
+// Routes documents the endpoints registered by SetupRoutes.
+func Routes() []openapi.Route {
+	return []openapi.Route{
+		{Method: http.MethodGet, Path: "/health", Name: "Check", Summary: "Liveness probe.", Response: map[string]string{}},
+		{Method: http.MethodGet, Path: "/ready", Name: "Ready", Summary: "Readiness of upstream dependencies; 503 when any is degraded.", Response: ReadinessDTO{}},
+	}
+}
@@ -0,0 +1,36 @@
+package keystore
+
+import (
+	"nn-blockchain-api/pkg/codes"
+	"nn-blockchain-api/pkg/errors"
+	ks "nn-blockchain-api/pkg/keystore"
+	"nn-blockchain-api/pkg/validation"
+)
+
+const (
+	StatusInvalidRequest errors.Status = "invalid_request"
+	StatusInvalidPayload errors.Status = "invalid_payload"
+	StatusInternalError  errors.Status = "internal_error"
+)
+
+var (
+	ErrInvalidRequest = errors.New(codes.BadRequest, StatusInvalidRequest)
+	ErrInvalidPayload = errors.New(codes.BadRequest, StatusInvalidPayload)
+	ErrInternal       = errors.New(codes.InternalError, StatusInternalError)
+)
+
+func Validate(dto interface{}) error {
+	return validation.Validate(ErrInvalidRequest, dto)
+}
+
+// ImportKeyDTO takes a hex Ethereum key or the WIF of a UTXO chain, the passphrase may be
+// left empty when the server has a key encryption key.
+type ImportKeyDTO struct {
+	Chain      string `json:"chain" validate:"required"`
+	PrivateKey string `json:"private_key" validate:"required"`
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+type KeysDTO struct {
+	Keys []*ks.Key `json:"keys"`
+}
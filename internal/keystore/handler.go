@@ -0,0 +1,83 @@
+package keystore
+
+import (
+	"encoding/json"
+	gErrors "errors"
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	ks "nn-blockchain-api/pkg/keystore"
+	"nn-blockchain-api/pkg/respond"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	keys  ks.Keystore
+	guard auth.Guard
+}
+
+func NewHandler(keys ks.Keystore, guard auth.Guard) (*Handler, error) {
+	if keys == nil {
+		return nil, gErrors.New("invalid keystore")
+	}
+	if guard == nil {
+		return nil, gErrors.New("invalid guard")
+	}
+
+	return &Handler{
+		keys:  keys,
+		guard: guard,
+	}, nil
+}
+
+func (h *Handler) SetupRoutes(router chi.Router) {
+	router.With(h.guard.Require("", auth.ScopeSign)).Post("/import", h.Import)
+	router.With(h.guard.Require("", auth.ScopeRead)).Get("/keys", h.Keys)
+}
+
+func (h *Handler) Import(w http.ResponseWriter, r *http.Request) {
+	var dto ImportKeyDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(ErrInvalidPayload), ErrInvalidPayload)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+	if !ks.SupportsChain(dto.Chain) {
+		respond.Respond(w, errors.HTTPCode(ks.ErrUnsupportedKeyChain), ks.ErrUnsupportedKeyChain)
+		return
+	}
+
+	// The route only requires the sign scope, the chain comes from the body.
+	if key, ok := auth.KeyFromContext(r.Context()); ok && !key.AllowsChain(dto.Chain) {
+		err := errors.NewForbidden("api key is not allowed on " + dto.Chain)
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	key, err := h.keys.Import(dto.Chain, dto.PrivateKey, dto.Passphrase)
+	if err != nil {
+		err = errors.Wrap(ErrInternal, err)
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, key)
+}
+
+func (h *Handler) Keys(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.keys.List()
+	if err != nil {
+		err = errors.Wrap(ErrInternal, err)
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, &KeysDTO{Keys: keys})
+}
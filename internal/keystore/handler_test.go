@@ -0,0 +1,199 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	gErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/internal/keystore"
+	"nn-blockchain-api/pkg/auth"
+	mock_auth "nn-blockchain-api/pkg/auth/mocks"
+	ks "nn-blockchain-api/pkg/keystore"
+	mock_keystore "nn-blockchain-api/pkg/keystore/mocks"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name   string
+		keys   ks.Keystore
+		guard  auth.Guard
+		expect func(*testing.T, *keystore.Handler, error)
+	}{
+		{
+			name:  "should return handler",
+			keys:  mock_keystore.NewMockKeystore(controller),
+			guard: mock_auth.NewMockGuard(controller),
+			expect: func(t *testing.T, h *keystore.Handler, err error) {
+				assert.NotNil(t, h)
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name:  "should return invalid keystore",
+			keys:  nil,
+			guard: mock_auth.NewMockGuard(controller),
+			expect: func(t *testing.T, h *keystore.Handler, err error) {
+				assert.Nil(t, h)
+				assert.EqualError(t, err, "invalid keystore")
+			},
+		},
+		{
+			name:  "should return invalid guard",
+			keys:  mock_keystore.NewMockKeystore(controller),
+			guard: nil,
+			expect: func(t *testing.T, h *keystore.Handler, err error) {
+				assert.Nil(t, h)
+				assert.EqualError(t, err, "invalid guard")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := keystore.NewHandler(tc.keys, tc.guard)
+			tc.expect(t, h, err)
+		})
+	}
+}
+
+func newRouter(t *testing.T, keys ks.Keystore) chi.Router {
+	apiKeys, err := auth.NewConfigKeyStore([]string{
+		"reader:" + auth.HashKey("reader") + ":read",
+		"signer:" + auth.HashKey("signer") + ":read|sign:bitcoin",
+	})
+	assert.Nil(t, err)
+	guard, _ := auth.NewGuard(apiKeys, true)
+	handler, _ := keystore.NewHandler(keys, guard)
+
+	router := chi.NewRouter()
+	handler.SetupRoutes(router)
+	return router
+}
+
+func TestHandler_Import(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	keys := mock_keystore.NewMockKeystore(controller)
+	router := newRouter(t, keys)
+
+	tests := []struct {
+		name  string
+		key   string
+		body  string
+		setup func()
+		code  int
+	}{
+		{
+			name: "should import key with sign scope",
+			key:  "signer",
+			body: `{"chain":"bitcoin","private_key":"wif","passphrase":"secret"}`,
+			setup: func() {
+				keys.EXPECT().Import("bitcoin", "wif", "secret").Return(&ks.Key{Id: "key", Chain: "bitcoin"}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "should require sign scope",
+			key:  "reader",
+			body: `{"chain":"bitcoin","private_key":"wif","passphrase":"secret"}`,
+			code: http.StatusForbidden,
+		},
+		{
+			name: "should check chain from body",
+			key:  "signer",
+			body: `{"chain":"ethereum","private_key":"hex","passphrase":"secret"}`,
+			code: http.StatusForbidden,
+		},
+		{
+			name: "should reject unknown chain",
+			key:  "signer",
+			body: `{"chain":"solana","private_key":"key"}`,
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "should return keystore error",
+			key:  "signer",
+			body: `{"chain":"bitcoin","private_key":"wif"}`,
+			setup: func() {
+				keys.EXPECT().Import("bitcoin", "wif", "").Return(nil, ks.ErrPassphraseRequired)
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/import", strings.NewReader(tc.body))
+			req.Header.Set(auth.HeaderAPIKey, tc.key)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
+
+func TestHandler_Keys(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	keys := mock_keystore.NewMockKeystore(controller)
+	router := newRouter(t, keys)
+
+	tests := []struct {
+		name   string
+		setup  func()
+		expect func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "should list keys",
+			setup: func() {
+				keys.EXPECT().List().Return([]*ks.Key{{Id: "key", Chain: "ethereum", Address: "0x1"}}, nil)
+			},
+			expect: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				var dto keystore.KeysDTO
+				assert.Nil(t, json.NewDecoder(rec.Body).Decode(&dto))
+				assert.Len(t, dto.Keys, 1)
+				assert.Equal(t, "key", dto.Keys[0].Id)
+			},
+		},
+		{
+			name: "should return internal error",
+			setup: func() {
+				keys.EXPECT().List().Return(nil, gErrors.New("disk failure"))
+			},
+			expect: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, rec.Code)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			req := httptest.NewRequest(http.MethodGet, "/keys", nil)
+			req.Header.Set(auth.HeaderAPIKey, "reader")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			tc.expect(t, rec)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package keystore
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	ks "nn-blockchain-api/pkg/keystore"
+	"nn-blockchain-api/pkg/openapi"
+)
+
+// Routes documents the endpoints registered by SetupRoutes.
+func Routes() []openapi.Route {
+	return []openapi.Route{
+		{Method: http.MethodPost, Path: "/import", Name: "ImportKeystoreKey", Summary: "Encrypt a private key into the keystore, sign requests then reference it by key_id.", Scope: string(auth.ScopeSign), Request: ImportKeyDTO{}, Response: ks.Key{}},
+		{Method: http.MethodGet, Path: "/keys", Name: "ListKeystoreKeys", Summary: "List the keystore keys without their key material.", Scope: string(auth.ScopeRead), Response: KeysDTO{}},
+	}
+}
@@ -0,0 +1,7 @@
+package networks
+
+import "nn-blockchain-api/pkg/networks"
+
+type NetworksDTO struct {
+	Networks []networks.Info `json:"networks"`
+}
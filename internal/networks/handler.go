@@ -0,0 +1,38 @@
+package networks
+
+import (
+	gErrors "errors"
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/respond"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	registry *networks.Registry
+	guard    auth.Guard
+}
+
+func NewHandler(registry *networks.Registry, guard auth.Guard) (*Handler, error) {
+	if registry == nil {
+		return nil, gErrors.New("invalid network registry")
+	}
+	if guard == nil {
+		return nil, gErrors.New("invalid guard")
+	}
+
+	return &Handler{
+		registry: registry,
+		guard:    guard,
+	}, nil
+}
+
+func (h *Handler) SetupRoutes(router chi.Router) {
+	router.With(h.guard.Require("", auth.ScopeRead)).Get("/networks", h.List)
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	respond.Respond(w, http.StatusOK, &NetworksDTO{Networks: h.registry.Networks()})
+}
@@ -0,0 +1,78 @@
+package networks_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	networks_handler "nn-blockchain-api/internal/networks"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/networks"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	registry, err := networks.NewRegistry()
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		registry *networks.Registry
+		guard    auth.Guard
+		expect   func(*testing.T, *networks_handler.Handler, error)
+	}{
+		{
+			name:     "should return invalid network registry",
+			registry: nil,
+			expect: func(t *testing.T, h *networks_handler.Handler, err error) {
+				assert.Nil(t, h)
+				assert.EqualError(t, err, "invalid network registry")
+			},
+		},
+		{
+			name:     "should return invalid guard",
+			registry: registry,
+			expect: func(t *testing.T, h *networks_handler.Handler, err error) {
+				assert.Nil(t, h)
+				assert.EqualError(t, err, "invalid guard")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := networks_handler.NewHandler(tc.registry, tc.guard)
+			tc.expect(t, h, err)
+		})
+	}
+}
+
+func TestHandler_List(t *testing.T) {
+	registry, err := networks.NewRegistry(
+		networks.Info{Chain: "ethereum", Network: networks.Main, Currency: "ETH", ChainId: 1},
+		networks.Info{Chain: "bitcoin", Network: networks.Test, Currency: "BTC", Params: "testnet3"},
+		networks.Info{Chain: "bitcoin", Network: networks.Main, Currency: "BTC", Params: "mainnet"},
+	)
+	assert.Nil(t, err)
+	guard, err := auth.NewGuard(auth.NewMultiKeyStore(), false)
+	assert.Nil(t, err)
+	handler, err := networks_handler.NewHandler(registry, guard)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	handler.SetupRoutes(router)
+
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/networks", nil))
+	assert.Equal(t, http.StatusOK, res.Code)
+
+	var dto networks_handler.NetworksDTO
+	assert.Nil(t, json.NewDecoder(res.Body).Decode(&dto))
+	assert.Equal(t, []networks.Info{
+		{Chain: "bitcoin", Network: networks.Main, Currency: "BTC", Params: "mainnet"},
+		{Chain: "bitcoin", Network: networks.Test, Currency: "BTC", Params: "testnet3"},
+		{Chain: "ethereum", Network: networks.Main, Currency: "ETH", ChainId: 1},
+	}, dto.Networks)
+}
@@ -0,0 +1,14 @@
+package networks
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/openapi"
+)
+
+// Routes documents the endpoints registered by SetupRoutes.
+func Routes() []openapi.Route {
+	return []openapi.Route{
+		{Method: http.MethodGet, Path: "/networks", Name: "List", Summary: "Chains and networks this deployment serves.", Scope: string(auth.ScopeRead), Response: NetworksDTO{}},
+	}
+}
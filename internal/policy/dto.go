@@ -0,0 +1,38 @@
+package policy
+
+import (
+	"nn-blockchain-api/pkg/codes"
+	"nn-blockchain-api/pkg/errors"
+	pol "nn-blockchain-api/pkg/policy"
+	"nn-blockchain-api/pkg/validation"
+)
+
+const (
+	StatusInvalidRequest errors.Status = "invalid_request"
+	StatusInvalidPayload errors.Status = "invalid_payload"
+	StatusInternalError  errors.Status = "internal_error"
+)
+
+var (
+	ErrInvalidRequest = errors.New(codes.BadRequest, StatusInvalidRequest)
+	ErrInvalidPayload = errors.New(codes.BadRequest, StatusInvalidPayload)
+	ErrInternal       = errors.New(codes.InternalError, StatusInternalError)
+)
+
+func Validate(dto interface{}) error {
+	return validation.Validate(ErrInvalidRequest, dto)
+}
+
+type PoliciesDTO struct {
+	Policies []pol.Policy `json:"policies"`
+}
+
+type ApprovalsDTO struct {
+	Approvals []*pol.Approval `json:"approvals"`
+}
+
+// DecideDTO approves or rejects a held transaction, the decision is recorded under the
+// name of the calling API key.
+type DecideDTO struct {
+	ApprovalId string `json:"approval_id" validate:"required"`
+}
@@ -0,0 +1,87 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	gErrors "errors"
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	pol "nn-blockchain-api/pkg/policy"
+	"nn-blockchain-api/pkg/respond"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	policies pol.Engine
+	guard    auth.Guard
+}
+
+func NewHandler(policies pol.Engine, guard auth.Guard) (*Handler, error) {
+	if policies == nil {
+		return nil, gErrors.New("invalid policy engine")
+	}
+	if guard == nil {
+		return nil, gErrors.New("invalid guard")
+	}
+
+	return &Handler{
+		policies: policies,
+		guard:    guard,
+	}, nil
+}
+
+func (h *Handler) SetupRoutes(router chi.Router) {
+	router.With(h.guard.Require("", auth.ScopeRead)).Get("/policies", h.Policies)
+	router.With(h.guard.Require("", auth.ScopeRead)).Get("/approvals", h.Approvals)
+	router.With(h.guard.Require("", auth.ScopeApprove)).Post("/approve", h.Approve)
+	router.With(h.guard.Require("", auth.ScopeApprove)).Post("/reject", h.Reject)
+}
+
+func (h *Handler) Policies(w http.ResponseWriter, r *http.Request) {
+	respond.Respond(w, http.StatusOK, &PoliciesDTO{Policies: h.policies.Policies()})
+}
+
+func (h *Handler) Approvals(w http.ResponseWriter, r *http.Request) {
+	approvals, err := h.policies.Approvals(r.Context())
+	if err != nil {
+		err = errors.Wrap(ErrInternal, err)
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, &ApprovalsDTO{Approvals: approvals})
+}
+
+func (h *Handler) Approve(w http.ResponseWriter, r *http.Request) {
+	h.decide(w, r, h.policies.Approve)
+}
+
+func (h *Handler) Reject(w http.ResponseWriter, r *http.Request) {
+	h.decide(w, r, h.policies.Reject)
+}
+
+func (h *Handler) decide(w http.ResponseWriter, r *http.Request, decide func(ctx context.Context, id, approver string) (*pol.Approval, error)) {
+	var dto DecideDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(ErrInvalidPayload), ErrInvalidPayload)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	approval, err := decide(r.Context(), dto.ApprovalId, auth.NameFromContext(r.Context()))
+	if err != nil {
+		err = errors.Wrap(ErrInternal, err)
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, approval)
+}
@@ -0,0 +1,239 @@
+package policy_test
+
+import (
+	"encoding/json"
+	gErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/internal/policy"
+	"nn-blockchain-api/pkg/auth"
+	mock_auth "nn-blockchain-api/pkg/auth/mocks"
+	"nn-blockchain-api/pkg/errors"
+	pol "nn-blockchain-api/pkg/policy"
+	mock_policy "nn-blockchain-api/pkg/policy/mocks"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name     string
+		policies pol.Engine
+		guard    auth.Guard
+		expect   func(*testing.T, *policy.Handler, error)
+	}{
+		{
+			name:     "should return handler",
+			policies: mock_policy.NewMockEngine(controller),
+			guard:    mock_auth.NewMockGuard(controller),
+			expect: func(t *testing.T, h *policy.Handler, err error) {
+				assert.NotNil(t, h)
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name:     "should return invalid policy engine",
+			policies: nil,
+			guard:    mock_auth.NewMockGuard(controller),
+			expect: func(t *testing.T, h *policy.Handler, err error) {
+				assert.Nil(t, h)
+				assert.EqualError(t, err, "invalid policy engine")
+			},
+		},
+		{
+			name:     "should return invalid guard",
+			policies: mock_policy.NewMockEngine(controller),
+			guard:    nil,
+			expect: func(t *testing.T, h *policy.Handler, err error) {
+				assert.Nil(t, h)
+				assert.EqualError(t, err, "invalid guard")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := policy.NewHandler(tc.policies, tc.guard)
+			tc.expect(t, h, err)
+		})
+	}
+}
+
+func newRouter(t *testing.T, policies pol.Engine) chi.Router {
+	apiKeys, err := auth.NewConfigKeyStore([]string{
+		"reader:" + auth.HashKey("reader") + ":read",
+		"approver:" + auth.HashKey("approver") + ":read|approve",
+	})
+	assert.Nil(t, err)
+	guard, _ := auth.NewGuard(apiKeys, true)
+	handler, _ := policy.NewHandler(policies, guard)
+
+	router := chi.NewRouter()
+	handler.SetupRoutes(router)
+	return router
+}
+
+func TestHandler_Policies(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	policies := mock_policy.NewMockEngine(controller)
+	router := newRouter(t, policies)
+
+	policies.EXPECT().Policies().Return([]pol.Policy{{Name: "limit", DailyLimit: "100"}})
+
+	req := httptest.NewRequest(http.MethodGet, "/policies", nil)
+	req.Header.Set(auth.HeaderAPIKey, "reader")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var dto policy.PoliciesDTO
+	assert.Nil(t, json.NewDecoder(rec.Body).Decode(&dto))
+	assert.Equal(t, []pol.Policy{{Name: "limit", DailyLimit: "100"}}, dto.Policies)
+}
+
+func TestHandler_Approvals(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	policies := mock_policy.NewMockEngine(controller)
+	router := newRouter(t, policies)
+
+	tests := []struct {
+		name   string
+		setup  func()
+		expect func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "should list approvals",
+			setup: func() {
+				policies.EXPECT().Approvals(gomock.Any()).Return([]*pol.Approval{{Id: "approval", Status: pol.ApprovalPending}}, nil)
+			},
+			expect: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				var dto policy.ApprovalsDTO
+				assert.Nil(t, json.NewDecoder(rec.Body).Decode(&dto))
+				assert.Len(t, dto.Approvals, 1)
+				assert.Equal(t, "approval", dto.Approvals[0].Id)
+			},
+		},
+		{
+			name: "should return internal error",
+			setup: func() {
+				policies.EXPECT().Approvals(gomock.Any()).Return(nil, gErrors.New("store failure"))
+			},
+			expect: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, rec.Code)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+
+			req := httptest.NewRequest(http.MethodGet, "/approvals", nil)
+			req.Header.Set(auth.HeaderAPIKey, "reader")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			tc.expect(t, rec)
+		})
+	}
+}
+
+func TestHandler_Decide(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	policies := mock_policy.NewMockEngine(controller)
+	router := newRouter(t, policies)
+
+	tests := []struct {
+		name  string
+		path  string
+		key   string
+		body  string
+		setup func()
+		code  int
+	}{
+		{
+			name: "should approve as the calling api key",
+			path: "/approve",
+			key:  "approver",
+			body: `{"approval_id":"approval"}`,
+			setup: func() {
+				policies.EXPECT().Approve(gomock.Any(), "approval", "approver").Return(&pol.Approval{Id: "approval", Status: pol.ApprovalApproved}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "should reject",
+			path: "/reject",
+			key:  "approver",
+			body: `{"approval_id":"approval"}`,
+			setup: func() {
+				policies.EXPECT().Reject(gomock.Any(), "approval", "approver").Return(&pol.Approval{Id: "approval", Status: pol.ApprovalRejected}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "should require approve scope",
+			path: "/approve",
+			key:  "reader",
+			body: `{"approval_id":"approval"}`,
+			code: http.StatusForbidden,
+		},
+		{
+			name: "should require approval id",
+			path: "/approve",
+			key:  "approver",
+			body: `{}`,
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "should return approval not found",
+			path: "/reject",
+			key:  "approver",
+			body: `{"approval_id":"missing"}`,
+			setup: func() {
+				policies.EXPECT().Reject(gomock.Any(), "missing", "approver").Return(nil, pol.ErrApprovalNotFound)
+			},
+			code: http.StatusNotFound,
+		},
+		{
+			name: "should keep invalid approval",
+			path: "/approve",
+			key:  "approver",
+			body: `{"approval_id":"approval"}`,
+			setup: func() {
+				policies.EXPECT().Approve(gomock.Any(), "approval", "approver").Return(nil, errors.WithMessage(pol.ErrInvalidApproval, "approval approval is used"))
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+			req.Header.Set(auth.HeaderAPIKey, tc.key)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package policy
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/openapi"
+	pol "nn-blockchain-api/pkg/policy"
+)
+
+// Routes documents the endpoints registered by SetupRoutes.
+func Routes() []openapi.Route {
+	return []openapi.Route{
+		{Method: http.MethodGet, Path: "/policies", Name: "ListPolicies", Summary: "List the signing policies loaded from the policy file.", Scope: string(auth.ScopeRead), Response: PoliciesDTO{}},
+		{Method: http.MethodGet, Path: "/approvals", Name: "ListApprovals", Summary: "List transactions held for approval and their decisions.", Scope: string(auth.ScopeRead), Response: ApprovalsDTO{}},
+		{Method: http.MethodPost, Path: "/approve", Name: "ApproveTransaction", Summary: "Approve a held transaction, signing it again with the approval_id then passes the policy. The requester cannot approve.", Scope: string(auth.ScopeApprove), Request: DecideDTO{}, Response: pol.Approval{}},
+		{Method: http.MethodPost, Path: "/reject", Name: "RejectTransaction", Summary: "Reject a held transaction.", Scope: string(auth.ScopeApprove), Request: DecideDTO{}, Response: pol.Approval{}},
+	}
+}
@@ -0,0 +1,8 @@
+package quota
+
+import "nn-blockchain-api/pkg/ratelimit"
+
+type QuotaDTO struct {
+	Client string             `json:"client"`
+	Quotas []*ratelimit.Usage `json:"quotas"`
+}
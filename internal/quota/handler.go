@@ -0,0 +1,47 @@
+package quota
+
+import (
+	gErrors "errors"
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/ratelimit"
+	"nn-blockchain-api/pkg/respond"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	limiter *ratelimit.Limiter
+	guard   auth.Guard
+}
+
+func NewHandler(limiter *ratelimit.Limiter, guard auth.Guard) (*Handler, error) {
+	if limiter == nil {
+		return nil, gErrors.New("invalid rate limiter")
+	}
+	if guard == nil {
+		return nil, gErrors.New("invalid guard")
+	}
+
+	return &Handler{
+		limiter: limiter,
+		guard:   guard,
+	}, nil
+}
+
+func (h *Handler) SetupRoutes(router chi.Router) {
+	router.With(h.guard.Require("", auth.ScopeRead)).Get("/quota", h.Quota)
+}
+
+func (h *Handler) Quota(w http.ResponseWriter, r *http.Request) {
+	client := ratelimit.ClientID(r)
+
+	usage, err := h.limiter.Usage(r.Context(), client)
+	if err != nil {
+		respond.Respond(w, http.StatusInternalServerError, errors.NewInternal(err.Error()))
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, &QuotaDTO{Client: client, Quotas: usage})
+}
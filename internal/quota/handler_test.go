@@ -0,0 +1,83 @@
+package quota_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/internal/quota"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/ratelimit"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHandler(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), nil)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name    string
+		limiter *ratelimit.Limiter
+		guard   auth.Guard
+		expect  func(*testing.T, *quota.Handler, error)
+	}{
+		{
+			name:    "should return invalid rate limiter",
+			limiter: nil,
+			expect: func(t *testing.T, h *quota.Handler, err error) {
+				assert.Nil(t, h)
+				assert.EqualError(t, err, "invalid rate limiter")
+			},
+		},
+		{
+			name:    "should return invalid guard",
+			limiter: limiter,
+			expect: func(t *testing.T, h *quota.Handler, err error) {
+				assert.Nil(t, h)
+				assert.EqualError(t, err, "invalid guard")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := quota.NewHandler(tc.limiter, tc.guard)
+			tc.expect(t, h, err)
+		})
+	}
+}
+
+func TestHandler_Quota(t *testing.T) {
+	keys, err := auth.NewConfigKeyStore([]string{"ci:" + auth.HashKey("secret") + ":read"})
+	assert.Nil(t, err)
+	authGuard, err := auth.NewGuard(keys, true)
+	assert.Nil(t, err)
+
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads: {Rate: 10, Burst: 10, Daily: 5},
+	})
+	assert.Nil(t, err)
+	guard, err := ratelimit.NewGuard(authGuard, limiter)
+	assert.Nil(t, err)
+
+	handler, err := quota.NewHandler(limiter, guard)
+	assert.Nil(t, err)
+
+	router := http.NewServeMux()
+	router.Handle("/quota", guard.Require("", auth.ScopeRead)(http.HandlerFunc(handler.Quota)))
+
+	req := httptest.NewRequest(http.MethodGet, "/quota", nil)
+	req.Header.Set(auth.HeaderAPIKey, "secret")
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, req)
+
+	assert.Equal(t, http.StatusOK, res.Code)
+
+	var dto quota.QuotaDTO
+	assert.Nil(t, json.NewDecoder(res.Body).Decode(&dto))
+	assert.Equal(t, "key:ci", dto.Client)
+	assert.Len(t, dto.Quotas, 3)
+	assert.Equal(t, int64(1), dto.Quotas[0].Used)
+	assert.Equal(t, int64(4), dto.Quotas[0].Remaining)
+}
@@ -0,0 +1,14 @@
+package quota
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/openapi"
+)
+
+// Routes documents the endpoints registered by SetupRoutes.
+func Routes() []openapi.Route {
+	return []openapi.Route{
+		{Method: http.MethodGet, Path: "/quota", Name: "Usage", Summary: "Rate limit and daily quota usage of the caller.", Scope: string(auth.ScopeRead), Response: QuotaDTO{}},
+	}
+}
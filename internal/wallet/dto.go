@@ -1,36 +1,11 @@
 package wallet
 
 import (
-	"github.com/go-playground/validator/v10"
-	"nn-blockchain-api/pkg/errors"
-	"strings"
+	"nn-blockchain-api/pkg/validation"
 )
 
 func Validate(dto interface{}) error {
-	validate := validator.New()
-
-	_ = validate.RegisterValidation("mnemonic", func(fl validator.FieldLevel) bool {
-		mnemonic := strings.Split(fl.Field().String(), " ")
-
-		if len(mnemonic) == 12 || len(mnemonic) == 24 {
-			return true
-		}
-
-		return false
-	})
-
-	if err := validate.Struct(dto); err != nil {
-		if _, ok := err.(*validator.InvalidValidationError); ok {
-			return errors.WithMessage(ErrInvalidRequest, err.Error())
-		}
-
-		validationErr := ErrInvalidRequest
-		for _, err := range err.(validator.ValidationErrors) {
-			validationErr = errors.WithMessage(validationErr, err.Error())
-		}
-		return validationErr
-	}
-	return nil
+	return validation.Validate(ErrInvalidRequest, dto)
 }
 
 type CoinNameDTO struct {
@@ -44,12 +19,104 @@ type MnemonicDTO struct {
 }
 
 type DTO struct {
+	WalletId string
 	Mnemonic string
 	CoinName string
 	Address  string
-	Private  string
 }
 
 type CreatedMnemonicDTO struct {
 	Mnemonic string
 }
+
+// DeriveDTO takes either an account xpub or a mnemonic; only the mnemonic form handles key material.
+// StorageGap is how many consecutive addresses unknown to the storage end the window, it is
+// not the BIP44 gap limit as on-chain activity is not looked up.
+type DeriveDTO struct {
+	Chain      string `json:"chain" validate:"required,oneof=bitcoin ethereum"`
+	Network    string `json:"network" validate:"required,network"`
+	Scheme     string `json:"scheme,omitempty" validate:"omitempty,oneof=p2pkh p2wpkh p2tr eth"`
+	Xpub       string `json:"xpub,omitempty" validate:"required_without=Mnemonic,excluded_with=Mnemonic"`
+	Mnemonic   string `json:"mnemonic,omitempty" validate:"omitempty,mnemonic"`
+	Passphrase string `json:"passphrase,omitempty"`
+	Account    uint32 `json:"account"`
+	Change     bool   `json:"change"`
+	Start      uint32 `json:"start"`
+	StorageGap int    `json:"storage_gap,omitempty" validate:"omitempty,gte=1,lte=100"`
+}
+
+type DerivedDTO struct {
+	Chain     string              `json:"chain"`
+	Network   string              `json:"network"`
+	Scheme    string              `json:"scheme"`
+	Path      string              `json:"path"`
+	Xpub      string              `json:"xpub"`
+	Addresses []DerivedAddressDTO `json:"addresses"`
+}
+
+// DerivedAddressDTO is a derived address, Stored reports whether the storage knows it.
+type DerivedAddressDTO struct {
+	Index   uint32 `json:"index"`
+	Path    string `json:"path"`
+	Address string `json:"address"`
+	Stored  bool   `json:"stored"`
+}
+
+type DeriveAddressDTO struct {
+	WalletId string `json:"wallet_id" validate:"required"`
+	Index    uint32 `json:"index"`
+	Change   bool   `json:"change"`
+}
+
+type ListAddressesDTO struct {
+	WalletId string `json:"wallet_id" validate:"required"`
+}
+
+// ImportKeyDTO takes either a private key or an xpub, the latter imports a watch-only wallet.
+type ImportKeyDTO struct {
+	Name       string `json:"name" validate:"required"`
+	Network    string `json:"network" validate:"required,network"`
+	PrivateKey string `json:"private_key,omitempty" validate:"required_without=Xpub,excluded_with=Xpub"`
+	Xpub       string `json:"xpub,omitempty"`
+}
+
+type AddressDTO struct {
+	WalletId string `json:"wallet_id"`
+	Address  string `json:"address"`
+	Path     string `json:"path"`
+	Index    uint32 `json:"index"`
+	Change   bool   `json:"change"`
+}
+
+type AddressesDTO struct {
+	WalletId  string       `json:"wallet_id"`
+	Addresses []AddressDTO `json:"addresses"`
+}
+
+type ImportedWalletDTO struct {
+	WalletId  string `json:"wallet_id"`
+	CoinName  string `json:"coin_name"`
+	Address   string `json:"address"`
+	WatchOnly bool   `json:"watch_only"`
+}
+
+// SignTransactionDTO asks the wallet service to sign with the keys of a wallet, it is filled
+// by the chain services rather than decoded from a request.
+type SignTransactionDTO struct {
+	WalletId string
+	Chain    string
+	Network  string
+	Tx       string
+	Utxo     []UtxoDTO
+}
+
+type UtxoDTO struct {
+	TxId     string
+	Vout     int64
+	Amount   int64
+	PKScript string
+}
+
+type SignedTransactionDTO struct {
+	SignedTx string
+}
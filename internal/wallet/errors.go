@@ -3,6 +3,9 @@ package wallet
 import (
 	"nn-blockchain-api/pkg/codes"
 	"nn-blockchain-api/pkg/errors"
+
+	grpc_codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -11,12 +14,32 @@ const (
 	StatusInvalidWalletType    errors.Status = "invalid_wallet_type"
 	StatusInternalError        errors.Status = "internal_error"
 	StatusFailedCreateMnemonic errors.Status = "error_create_mnemonic"
+	StatusInvalidDerivation    errors.Status = "invalid_derivation"
+	StatusWalletNotFound       errors.Status = "wallet_not_found"
+	StatusInvalidWalletRequest errors.Status = "invalid_wallet_request"
+	StatusWalletUnavailable    errors.Status = "wallet_service_unavailable"
 )
 
 var (
-	ErrInvalidRequest    = errors.New(codes.BadRequest, StatusInvalidRequest)
-	ErrInvalidWalletType = errors.New(codes.NotFound, StatusInvalidWalletType)
-	ErrInternal          = errors.New(codes.InternalError, StatusInternalError)
-	ErrInvalidPayload    = errors.New(codes.InternalError, StatusInvalidPayload)
-	ErrCreateMnemonic    = errors.New(codes.InternalError, StatusFailedCreateMnemonic)
+	ErrInvalidRequest       = errors.New(codes.BadRequest, StatusInvalidRequest)
+	ErrInvalidWalletType    = errors.New(codes.NotFound, StatusInvalidWalletType)
+	ErrInternal             = errors.New(codes.InternalError, StatusInternalError)
+	ErrInvalidPayload       = errors.New(codes.InternalError, StatusInvalidPayload)
+	ErrCreateMnemonic       = errors.New(codes.InternalError, StatusFailedCreateMnemonic)
+	ErrInvalidDerivation    = errors.New(codes.BadRequest, StatusInvalidDerivation)
+	ErrWalletNotFound       = errors.New(codes.NotFound, StatusWalletNotFound)
+	ErrInvalidWalletRequest = errors.New(codes.BadRequest, StatusInvalidWalletRequest)
+	ErrWalletUnavailable    = errors.New(codes.BadGateway, StatusWalletUnavailable)
 )
+
+// fromWalletService types an error returned by the wallet service by its gRPC code.
+func fromWalletService(err error) error {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case grpc_codes.NotFound:
+		return errors.WithMessage(ErrWalletNotFound, st.Message())
+	case grpc_codes.InvalidArgument, grpc_codes.FailedPrecondition, grpc_codes.AlreadyExists:
+		return errors.WithMessage(ErrInvalidWalletRequest, st.Message())
+	}
+	return errors.WithMessage(ErrWalletUnavailable, st.Message())
+}
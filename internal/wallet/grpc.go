@@ -0,0 +1,181 @@
+package wallet
+
+import (
+	"context"
+	gErrors "errors"
+	"nn-blockchain-api/pkg/auth"
+	pb "nn-blockchain-api/pkg/grpc_server/proto/wallet"
+
+	"google.golang.org/grpc"
+)
+
+// GRPCServer exposes Service over gRPC with the same validation as the REST handler.
+type GRPCServer struct {
+	pb.UnimplementedWalletServiceServer
+	walletSvc Service
+}
+
+func NewGRPCServer(walletSvc Service) (*GRPCServer, error) {
+	if walletSvc == nil {
+		return nil, gErrors.New("invalid wallet service")
+	}
+
+	return &GRPCServer{walletSvc: walletSvc}, nil
+}
+
+func (s *GRPCServer) Register(registrar grpc.ServiceRegistrar) {
+	pb.RegisterWalletServiceServer(registrar, s)
+}
+
+// Rules mirrors the scopes SetupRoutes requires for the REST endpoints.
+func (s *GRPCServer) Rules() map[string]auth.Rule {
+	method := func(name string) string {
+		return "/" + pb.WalletService_ServiceDesc.ServiceName + "/" + name
+	}
+
+	return map[string]auth.Rule{
+		method("CreateWallet"):   {Scope: auth.ScopeSign},
+		method("CreateMnemonic"): {Scope: auth.ScopeSign},
+		method("Derive"):         {Scope: auth.ScopeRead},
+		method("DeriveAddress"):  {Scope: auth.ScopeBuild},
+		method("ListAddresses"):  {Scope: auth.ScopeRead},
+		method("ImportKey"):      {Scope: auth.ScopeSign},
+	}
+}
+
+func (s *GRPCServer) CreateWallet(ctx context.Context, req *pb.CreateWalletRequest) (*pb.CreateWalletResponse, error) {
+	dto := CoinNameDTO{Name: req.GetName(), Mnemonic: req.GetMnemonic()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.walletSvc.CreateWallet(ctx, dto.Name, &dto.Mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateWalletResponse{
+		WalletId: wallet.WalletId,
+		Mnemonic: wallet.Mnemonic,
+		CoinName: wallet.CoinName,
+		Address:  wallet.Address,
+	}, nil
+}
+
+func (s *GRPCServer) CreateMnemonic(ctx context.Context, req *pb.CreateMnemonicRequest) (*pb.CreateMnemonicResponse, error) {
+	dto := MnemonicDTO{Length: req.GetLength(), Language: req.GetLanguage()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := s.walletSvc.CreateMnemonic(ctx, dto.Length, dto.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateMnemonicResponse{Mnemonic: mnemonic.Mnemonic}, nil
+}
+
+func (s *GRPCServer) Derive(ctx context.Context, req *pb.DeriveRequest) (*pb.DeriveResponse, error) {
+	dto := DeriveDTO{
+		Chain:      req.GetChain(),
+		Network:    req.GetNetwork(),
+		Scheme:     req.GetScheme(),
+		Xpub:       req.GetXpub(),
+		Mnemonic:   req.GetMnemonic(),
+		Passphrase: req.GetPassphrase(),
+		Account:    req.GetAccount(),
+		Change:     req.GetChange(),
+		Start:      req.GetStart(),
+		StorageGap: int(req.GetGapLimit()),
+	}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+	if err := authorizeDerive(ctx, dto); err != nil {
+		return nil, err
+	}
+
+	derived, err := s.walletSvc.Derive(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.DeriveResponse{
+		Chain:   derived.Chain,
+		Network: derived.Network,
+		Scheme:  derived.Scheme,
+		Path:    derived.Path,
+		Xpub:    derived.Xpub,
+	}
+	for _, address := range derived.Addresses {
+		response.Addresses = append(response.Addresses, &pb.DerivedAddress{
+			Index:   address.Index,
+			Path:    address.Path,
+			Address: address.Address,
+			Used:    address.Stored,
+		})
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) DeriveAddress(ctx context.Context, req *pb.DeriveAddressRequest) (*pb.Address, error) {
+	dto := DeriveAddressDTO{WalletId: req.GetWalletId(), Index: req.GetIndex(), Change: req.GetChange()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	address, err := s.walletSvc.DeriveAddress(ctx, dto.WalletId, dto.Index, dto.Change)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAddress(*address), nil
+}
+
+func (s *GRPCServer) ListAddresses(ctx context.Context, req *pb.ListAddressesRequest) (*pb.ListAddressesResponse, error) {
+	dto := ListAddressesDTO{WalletId: req.GetWalletId()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	addresses, err := s.walletSvc.ListAddresses(ctx, dto.WalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListAddressesResponse{WalletId: addresses.WalletId}
+	for _, address := range addresses.Addresses {
+		response.Addresses = append(response.Addresses, toAddress(address))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) ImportKey(ctx context.Context, req *pb.ImportKeyRequest) (*pb.ImportKeyResponse, error) {
+	dto := ImportKeyDTO{Name: req.GetName(), Network: req.GetNetwork(), PrivateKey: req.GetPrivateKey(), Xpub: req.GetXpub()}
+	if err := Validate(dto); err != nil {
+		return nil, err
+	}
+
+	imported, err := s.walletSvc.ImportKey(ctx, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ImportKeyResponse{
+		WalletId:  imported.WalletId,
+		CoinName:  imported.CoinName,
+		Address:   imported.Address,
+		WatchOnly: imported.WatchOnly,
+	}, nil
+}
+
+func toAddress(address AddressDTO) *pb.Address {
+	return &pb.Address{
+		WalletId: address.WalletId,
+		Address:  address.Address,
+		Path:     address.Path,
+		Index:    address.Index,
+		Change:   address.Change,
+	}
+}
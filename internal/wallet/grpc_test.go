@@ -0,0 +1,125 @@
+package wallet_test
+
+import (
+	"context"
+	"net"
+	"nn-blockchain-api/internal/wallet"
+	mock_wallet "nn-blockchain-api/internal/wallet/mocks"
+	pb "nn-blockchain-api/pkg/grpc_server/proto/wallet"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestNewGRPCServer(t *testing.T) {
+	srv, err := wallet.NewGRPCServer(nil)
+	assert.Nil(t, srv)
+	assert.EqualError(t, err, "invalid wallet service")
+}
+
+func TestGRPCServer(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	walletSvc := mock_wallet.NewMockService(controller)
+	srv, err := wallet.NewGRPCServer(walletSvc)
+	assert.Nil(t, err)
+	assert.Len(t, srv.Rules(), len(pb.WalletService_ServiceDesc.Methods))
+
+	client := newWalletClient(t, srv)
+	ctx := context.Background()
+
+	t.Run("create wallet from mnemonic", func(t *testing.T) {
+		mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+		walletSvc.EXPECT().CreateWallet(gomock.Any(), "bitcoin", &mnemonic).
+			Return(&wallet.DTO{WalletId: "id", Mnemonic: mnemonic}, nil)
+
+		resp, err := client.CreateWallet(ctx, &pb.CreateWalletRequest{Name: "bitcoin", Mnemonic: mnemonic})
+		assert.Nil(t, err)
+		assert.Equal(t, "id", resp.WalletId)
+		assert.Equal(t, mnemonic, resp.Mnemonic)
+		assert.Empty(t, resp.PrivateKey)
+	})
+
+	t.Run("invalid mnemonic", func(t *testing.T) {
+		_, err := client.CreateWallet(ctx, &pb.CreateWalletRequest{Name: "bitcoin", Mnemonic: "abandon"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("create mnemonic", func(t *testing.T) {
+		walletSvc.EXPECT().CreateMnemonic(gomock.Any(), "12", "english").
+			Return(&wallet.CreatedMnemonicDTO{Mnemonic: "abandon ability"}, nil)
+
+		resp, err := client.CreateMnemonic(ctx, &pb.CreateMnemonicRequest{Length: "12", Language: "english"})
+		assert.Nil(t, err)
+		assert.Equal(t, "abandon ability", resp.Mnemonic)
+	})
+
+	t.Run("derive from xpub", func(t *testing.T) {
+		walletSvc.EXPECT().Derive(gomock.Any(), wallet.DeriveDTO{Chain: "bitcoin", Network: "main", Xpub: "xpub", StorageGap: 1}).
+			Return(&wallet.DerivedDTO{Path: "M", Addresses: []wallet.DerivedAddressDTO{{Path: "M/0/0", Address: "bc1q"}}}, nil)
+
+		resp, err := client.Derive(ctx, &pb.DeriveRequest{Chain: "bitcoin", Network: "main", Xpub: "xpub", GapLimit: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, "M", resp.Path)
+		assert.Equal(t, "bc1q", resp.Addresses[0].Address)
+	})
+
+	t.Run("derive address", func(t *testing.T) {
+		walletSvc.EXPECT().DeriveAddress(gomock.Any(), "wallet", uint32(3), true).
+			Return(&wallet.AddressDTO{WalletId: "wallet", Address: "address", Index: 3, Change: true}, nil)
+
+		resp, err := client.DeriveAddress(ctx, &pb.DeriveAddressRequest{WalletId: "wallet", Index: 3, Change: true})
+		assert.Nil(t, err)
+		assert.Equal(t, "address", resp.Address)
+		assert.True(t, resp.Change)
+	})
+
+	t.Run("list addresses of unknown wallet", func(t *testing.T) {
+		walletSvc.EXPECT().ListAddresses(gomock.Any(), "missing").Return(nil, wallet.ErrWalletNotFound)
+
+		_, err := client.ListAddresses(ctx, &pb.ListAddressesRequest{WalletId: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("import xpub", func(t *testing.T) {
+		walletSvc.EXPECT().ImportKey(gomock.Any(), &wallet.ImportKeyDTO{Name: "BTC", Network: "test", Xpub: "vpub"}).
+			Return(&wallet.ImportedWalletDTO{WalletId: "wallet", WatchOnly: true}, nil)
+
+		resp, err := client.ImportKey(ctx, &pb.ImportKeyRequest{Name: "BTC", Network: "test", Xpub: "vpub"})
+		assert.Nil(t, err)
+		assert.True(t, resp.WatchOnly)
+	})
+
+	t.Run("import without key", func(t *testing.T) {
+		_, err := client.ImportKey(ctx, &pb.ImportKeyRequest{Name: "BTC", Network: "test"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func newWalletClient(t *testing.T, srv *wallet.GRPCServer) pb.WalletServiceClient {
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	srv.Register(grpcServer)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewWalletServiceClient(conn)
+}
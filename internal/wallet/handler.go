@@ -1,9 +1,11 @@
 package wallet
 
 import (
+	"context"
 	"encoding/json"
 	gErrors "errors"
 	"net/http"
+	"nn-blockchain-api/pkg/auth"
 	"nn-blockchain-api/pkg/errors"
 	"nn-blockchain-api/pkg/respond"
 
@@ -12,21 +14,30 @@ import (
 
 type Handler struct {
 	walletSvc Service
+	guard     auth.Guard
 }
 
-func NewHandler(walletSvc Service) (*Handler, error) {
+func NewHandler(walletSvc Service, guard auth.Guard) (*Handler, error) {
 	if walletSvc == nil {
 		return nil, gErrors.New("invalid wallet service")
 	}
+	if guard == nil {
+		return nil, gErrors.New("invalid guard")
+	}
 
 	return &Handler{
 		walletSvc: walletSvc,
+		guard:     guard,
 	}, nil
 }
 
 func (h *Handler) SetupRoutes(router chi.Router) {
-	router.Post("/create-wallet", h.CreateWallet)
-	router.Post("/create-mnemonic", h.CreateMnemonic)
+	router.With(h.guard.Require("", auth.ScopeSign)).Post("/create-wallet", h.CreateWallet)
+	router.With(h.guard.Require("", auth.ScopeSign)).Post("/create-mnemonic", h.CreateMnemonic)
+	router.With(h.guard.Require("", auth.ScopeRead)).Post("/derive", h.Derive)
+	router.With(h.guard.Require("", auth.ScopeBuild)).Post("/derive-address", h.DeriveAddress)
+	router.With(h.guard.Require("", auth.ScopeRead)).Post("/list-addresses", h.ListAddresses)
+	router.With(h.guard.Require("", auth.ScopeSign)).Post("/import-key", h.ImportKey)
 }
 
 func (h *Handler) CreateWallet(w http.ResponseWriter, r *http.Request) {
@@ -74,3 +85,117 @@ func (h *Handler) CreateMnemonic(w http.ResponseWriter, r *http.Request) {
 
 	respond.Respond(w, http.StatusOK, mnemonic)
 }
+
+func (h *Handler) Derive(w http.ResponseWriter, r *http.Request) {
+	var dto DeriveDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(ErrInvalidPayload), ErrInvalidPayload)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	if err := authorizeDerive(r.Context(), dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	derived, err := h.walletSvc.Derive(r.Context(), dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, derived)
+}
+
+func (h *Handler) DeriveAddress(w http.ResponseWriter, r *http.Request) {
+	var dto DeriveAddressDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(ErrInvalidPayload), ErrInvalidPayload)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	address, err := h.walletSvc.DeriveAddress(r.Context(), dto.WalletId, dto.Index, dto.Change)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, address)
+}
+
+func (h *Handler) ListAddresses(w http.ResponseWriter, r *http.Request) {
+	var dto ListAddressesDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(ErrInvalidPayload), ErrInvalidPayload)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	addresses, err := h.walletSvc.ListAddresses(r.Context(), dto.WalletId)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, addresses)
+}
+
+func (h *Handler) ImportKey(w http.ResponseWriter, r *http.Request) {
+	var dto ImportKeyDTO
+
+	err := json.NewDecoder(r.Body).Decode(&dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(ErrInvalidPayload), ErrInvalidPayload)
+		return
+	}
+
+	if err := Validate(dto); err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	imported, err := h.walletSvc.ImportKey(r.Context(), &dto)
+	if err != nil {
+		respond.Respond(w, errors.HTTPCode(err), err)
+		return
+	}
+
+	respond.Respond(w, http.StatusOK, imported)
+}
+
+// authorizeDerive narrows the route-level read scope: the chain comes from the body and a
+// mnemonic is key material, so it needs the sign scope.
+func authorizeDerive(ctx context.Context, dto DeriveDTO) error {
+	key, ok := auth.KeyFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if !key.AllowsChain(dto.Chain) {
+		return errors.NewForbidden("api key is not allowed on " + dto.Chain)
+	}
+	if dto.Mnemonic != "" && !key.HasScope(auth.ScopeSign) {
+		return errors.NewForbidden("api key has no " + string(auth.ScopeSign) + " scope")
+	}
+	return nil
+}
@@ -1,10 +1,16 @@
 package wallet_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"nn-blockchain-api/internal/wallet"
 	mock_wallet "nn-blockchain-api/internal/wallet/mocks"
+	"nn-blockchain-api/pkg/auth"
+	mock_auth "nn-blockchain-api/pkg/auth/mocks"
+	"strings"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,11 +22,13 @@ func TestNewHandler(t *testing.T) {
 	tests := []struct {
 		name      string
 		walletSvc wallet.Service
+		guard     auth.Guard
 		expect    func(*testing.T, *wallet.Handler, error)
 	}{
 		{
 			name:      "should return service",
 			walletSvc: mock_wallet.NewMockService(controller),
+			guard:     mock_auth.NewMockGuard(controller),
 			expect: func(t *testing.T, s *wallet.Handler, err error) {
 				assert.NotNil(t, s)
 				assert.Nil(t, err)
@@ -29,18 +37,125 @@ func TestNewHandler(t *testing.T) {
 		{
 			name:      "should return invalid wallet service",
 			walletSvc: nil,
+			guard:     mock_auth.NewMockGuard(controller),
 			expect: func(t *testing.T, s *wallet.Handler, err error) {
 				assert.Nil(t, s)
 				assert.NotNil(t, err)
 				assert.EqualError(t, err, "invalid wallet service")
 			},
 		},
+		{
+			name:      "should return invalid guard",
+			walletSvc: mock_wallet.NewMockService(controller),
+			guard:     nil,
+			expect: func(t *testing.T, s *wallet.Handler, err error) {
+				assert.Nil(t, s)
+				assert.NotNil(t, err)
+				assert.EqualError(t, err, "invalid guard")
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := wallet.NewHandler(tc.walletSvc)
+			svc, err := wallet.NewHandler(tc.walletSvc, tc.guard)
 			tc.expect(t, svc, err)
 		})
 	}
 }
+
+func TestHandler_Derive(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	walletSvc := mock_wallet.NewMockService(controller)
+	keys, err := auth.NewConfigKeyStore([]string{
+		"reader:" + auth.HashKey("reader") + ":read:bitcoin",
+		"signer:" + auth.HashKey("signer") + ":read|sign",
+	})
+	assert.Nil(t, err)
+	guard, _ := auth.NewGuard(keys, true)
+	handler, _ := wallet.NewHandler(walletSvc, guard)
+
+	router := chi.NewRouter()
+	handler.SetupRoutes(router)
+
+	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	tests := []struct {
+		name  string
+		key   string
+		body  string
+		setup func()
+		code  int
+	}{
+		{
+			name: "should derive from xpub with read scope",
+			key:  "reader",
+			body: `{"chain":"bitcoin","network":"main","xpub":"xpub"}`,
+			setup: func() {
+				walletSvc.EXPECT().Derive(gomock.Any(), wallet.DeriveDTO{Chain: "bitcoin", Network: "main", Xpub: "xpub"}).
+					Return(&wallet.DerivedDTO{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "should require sign scope for mnemonic",
+			key:  "reader",
+			body: `{"chain":"bitcoin","network":"main","mnemonic":"` + mnemonic + `"}`,
+			code: http.StatusForbidden,
+		},
+		{
+			name: "should check chain from body",
+			key:  "reader",
+			body: `{"chain":"ethereum","network":"main","xpub":"xpub"}`,
+			code: http.StatusForbidden,
+		},
+		{
+			name: "should derive from mnemonic with sign scope",
+			key:  "signer",
+			body: `{"chain":"ethereum","network":"main","mnemonic":"` + mnemonic + `"}`,
+			setup: func() {
+				walletSvc.EXPECT().Derive(gomock.Any(), wallet.DeriveDTO{Chain: "ethereum", Network: "main", Mnemonic: mnemonic}).
+					Return(&wallet.DerivedDTO{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "should reject both xpub and mnemonic",
+			key:  "signer",
+			body: `{"chain":"bitcoin","network":"main","xpub":"xpub","mnemonic":"` + mnemonic + `"}`,
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "should reject mnemonic with bad checksum",
+			key:  "signer",
+			body: `{"chain":"bitcoin","network":"main","mnemonic":"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"}`,
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "should return service error",
+			key:  "reader",
+			body: `{"chain":"bitcoin","network":"main","xpub":"xpub"}`,
+			setup: func() {
+				walletSvc.EXPECT().Derive(gomock.Any(), gomock.Any()).Return(nil, wallet.ErrInvalidDerivation)
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/derive", strings.NewReader(tc.body))
+			req.Header.Set(auth.HeaderAPIKey, tc.key)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
@@ -64,3 +64,78 @@ func (mr *MockServiceMockRecorder) CreateWallet(ctx, walletName, mnemonic interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockService)(nil).CreateWallet), ctx, walletName, mnemonic)
 }
+
+// Derive mocks base method.
+func (m *MockService) Derive(ctx context.Context, dto wallet.DeriveDTO) (*wallet.DerivedDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Derive", ctx, dto)
+	ret0, _ := ret[0].(*wallet.DerivedDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Derive indicates an expected call of Derive.
+func (mr *MockServiceMockRecorder) Derive(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Derive", reflect.TypeOf((*MockService)(nil).Derive), ctx, dto)
+}
+
+// DeriveAddress mocks base method.
+func (m *MockService) DeriveAddress(ctx context.Context, walletId string, index uint32, change bool) (*wallet.AddressDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeriveAddress", ctx, walletId, index, change)
+	ret0, _ := ret[0].(*wallet.AddressDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveAddress indicates an expected call of DeriveAddress.
+func (mr *MockServiceMockRecorder) DeriveAddress(ctx, walletId, index, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveAddress", reflect.TypeOf((*MockService)(nil).DeriveAddress), ctx, walletId, index, change)
+}
+
+// ImportKey mocks base method.
+func (m *MockService) ImportKey(ctx context.Context, dto *wallet.ImportKeyDTO) (*wallet.ImportedWalletDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKey", ctx, dto)
+	ret0, _ := ret[0].(*wallet.ImportedWalletDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKey indicates an expected call of ImportKey.
+func (mr *MockServiceMockRecorder) ImportKey(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockService)(nil).ImportKey), ctx, dto)
+}
+
+// ListAddresses mocks base method.
+func (m *MockService) ListAddresses(ctx context.Context, walletId string) (*wallet.AddressesDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAddresses", ctx, walletId)
+	ret0, _ := ret[0].(*wallet.AddressesDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddresses indicates an expected call of ListAddresses.
+func (mr *MockServiceMockRecorder) ListAddresses(ctx, walletId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddresses", reflect.TypeOf((*MockService)(nil).ListAddresses), ctx, walletId)
+}
+
+// SignTransaction mocks base method.
+func (m *MockService) SignTransaction(ctx context.Context, dto *wallet.SignTransactionDTO) (*wallet.SignedTransactionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", ctx, dto)
+	ret0, _ := ret[0].(*wallet.SignedTransactionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction.
+func (mr *MockServiceMockRecorder) SignTransaction(ctx, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockService)(nil).SignTransaction), ctx, dto)
+}
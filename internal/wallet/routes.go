@@ -0,0 +1,19 @@
+package wallet
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/openapi"
+)
+
+// Routes documents the endpoints registered by SetupRoutes.
+func Routes() []openapi.Route {
+	return []openapi.Route{
+		{Method: http.MethodPost, Path: "/create-wallet", Name: "CreateWallet", Summary: "Derive a wallet for a coin from a mnemonic.", Scope: string(auth.ScopeSign), Request: CoinNameDTO{}, Response: DTO{}},
+		{Method: http.MethodPost, Path: "/create-mnemonic", Name: "CreateMnemonic", Summary: "Generate a BIP39 mnemonic.", Scope: string(auth.ScopeSign), Request: MnemonicDTO{}, Response: CreatedMnemonicDTO{}},
+		{Method: http.MethodPost, Path: "/derive", Name: "Derive", Summary: "Derive an account xpub and a window of addresses ending in a storage gap from an xpub or, with the sign scope, a mnemonic.", Scope: string(auth.ScopeRead), Request: DeriveDTO{}, Response: DerivedDTO{}},
+		{Method: http.MethodPost, Path: "/derive-address", Name: "DeriveAddress", Summary: "Derive an address of a wallet held by the wallet service.", Scope: string(auth.ScopeBuild), Request: DeriveAddressDTO{}, Response: AddressDTO{}},
+		{Method: http.MethodPost, Path: "/list-addresses", Name: "ListAddresses", Summary: "List the addresses of a wallet held by the wallet service.", Scope: string(auth.ScopeRead), Request: ListAddressesDTO{}, Response: AddressesDTO{}},
+		{Method: http.MethodPost, Path: "/import-key", Name: "ImportKey", Summary: "Import a private key, or an xpub as a watch-only wallet, into the wallet service.", Scope: string(auth.ScopeSign), Request: ImportKeyDTO{}, Response: ImportedWalletDTO{}},
+	}
+}
@@ -6,50 +6,273 @@ import (
 	"go.uber.org/zap"
 	"nn-blockchain-api/pkg/errors"
 	pb "nn-blockchain-api/pkg/grpc_client/proto/wallet"
+	"nn-blockchain-api/pkg/hd"
+	"nn-blockchain-api/pkg/storage"
+	"nn-blockchain-api/pkg/tracing"
+	"strings"
+
+	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=service.go -destination=mocks/service_mock.go
 type Service interface {
 	CreateWallet(ctx context.Context, walletName string, mnemonic *string) (*DTO, error)
 	CreateMnemonic(ctx context.Context, length, language string) (*CreatedMnemonicDTO, error)
+	// Derive generates the account xpub and a window of addresses that ends in StorageGap
+	// addresses unknown to the storage. Stored addresses extend the window, whether an
+	// address has been used on chain is not checked.
+	Derive(ctx context.Context, dto DeriveDTO) (*DerivedDTO, error)
+
+	// The operations below keep private keys inside the wallet service and refer to them by wallet id.
+	DeriveAddress(ctx context.Context, walletId string, index uint32, change bool) (*AddressDTO, error)
+	ListAddresses(ctx context.Context, walletId string) (*AddressesDTO, error)
+	ImportKey(ctx context.Context, dto *ImportKeyDTO) (*ImportedWalletDTO, error)
+	SignTransaction(ctx context.Context, dto *SignTransactionDTO) (*SignedTransactionDTO, error)
 }
 
+// DefaultStorageGap is the storage gap of Derive requests that leave it empty.
+const DefaultStorageGap = 20
+
 type service struct {
 	walletClient pb.WalletServiceClient
+	store        storage.Storage
 	logger       *zap.SugaredLogger
 }
 
-func NewService(walletClient pb.WalletServiceClient, logger *zap.SugaredLogger) (Service, error) {
+func NewService(walletClient pb.WalletServiceClient, store storage.Storage, logger *zap.SugaredLogger) (Service, error) {
 	if walletClient == nil {
 		return nil, gErrors.New("invalid wallet client")
 	}
+	if store == nil {
+		return nil, gErrors.New("invalid storage")
+	}
 	if logger == nil {
 		return nil, gErrors.New("invalid logger")
 	}
-	return &service{walletClient: walletClient, logger: logger}, nil
+	return &service{walletClient: walletClient, store: store, logger: logger}, nil
 }
 
 func (s *service) CreateWallet(ctx context.Context, walletName string, mnemonic *string) (*DTO, error) {
+	ctx, span := tracing.Start(ctx, "wallet.Service/CreateWallet")
+	defer span.End()
+
 	response, err := s.walletClient.CreateWallet(ctx, &pb.CreateWalletData{WalletName: walletName, Mnemonic: mnemonic})
 	if err != nil {
-		s.logger.Errorf("failed to create wallet: %v", err)
+		tracing.Logger(ctx, s.logger).Errorf("failed to create wallet: %v", err)
+		tracing.RecordError(span, err)
 		return nil, errors.WithMessage(ErrInvalidWalletType, err.Error())
 	}
 
+	walletId := response.Wallet.WalletId
+	if walletId == "" {
+		walletId = uuid.NewString()
+	}
+	coin := strings.ToLower(response.Wallet.CoinName)
+	if err := storage.RecordWallet(ctx, s.store, &storage.Wallet{Id: walletId, Chain: coin}); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record wallet: %v", err)
+	}
+	if err := storage.RecordAddress(ctx, s.store, &storage.Address{Address: response.Wallet.Address, WalletId: walletId, Chain: coin}); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record wallet address: %v", err)
+	}
+
 	return &DTO{
+		WalletId: walletId,
 		Mnemonic: response.Wallet.Mnemonic,
 		CoinName: response.Wallet.CoinName,
 		Address:  response.Wallet.Address,
-		Private:  response.Wallet.Private,
 	}, nil
 }
 
 func (s *service) CreateMnemonic(ctx context.Context, length, language string) (*CreatedMnemonicDTO, error) {
+	ctx, span := tracing.Start(ctx, "wallet.Service/CreateMnemonic")
+	defer span.End()
+
 	response, err := s.walletClient.CreateMnemonic(ctx, &pb.CreateMnemonicData{MnemonicLength: length, Language: language})
 	if err != nil {
-		s.logger.Errorf("failed to create mnemonic: %v", err)
+		tracing.Logger(ctx, s.logger).Errorf("failed to create mnemonic: %v", err)
+		tracing.RecordError(span, err)
 		return nil, errors.WithMessage(ErrCreateMnemonic, err.Error())
 	}
 
 	return &CreatedMnemonicDTO{Mnemonic: response.Mnemonic}, nil
 }
+
+func (s *service) Derive(ctx context.Context, dto DeriveDTO) (*DerivedDTO, error) {
+	ctx, span := tracing.Start(ctx, "wallet.Service/Derive")
+	defer span.End()
+
+	account, err := newAccount(dto)
+	if err != nil {
+		return nil, errors.WithMessage(ErrInvalidDerivation, err.Error())
+	}
+
+	xpub, err := account.Xpub()
+	if err != nil {
+		return nil, errors.WithMessage(ErrInternal, err.Error())
+	}
+
+	gap := dto.StorageGap
+	if gap == 0 {
+		gap = DefaultStorageGap
+	}
+	branch := hd.ChainReceive
+	if dto.Change {
+		branch = hd.ChainChange
+	}
+
+	addresses, err := account.Scan(branch, dto.Start, gap, func(address string) (bool, error) {
+		_, err := s.store.Addresses().Get(ctx, address)
+		if gErrors.Is(err, storage.ErrNotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if gErrors.Is(err, hd.ErrScanLimit) {
+		return nil, errors.WithMessage(ErrInvalidDerivation, err.Error())
+	}
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed to derive addresses: %v", err)
+		tracing.RecordError(span, err)
+		return nil, errors.WithMessage(ErrInternal, err.Error())
+	}
+
+	derived := &DerivedDTO{
+		Chain:     dto.Chain,
+		Network:   dto.Network,
+		Scheme:    string(account.Scheme()),
+		Path:      account.Path(),
+		Xpub:      xpub,
+		Addresses: make([]DerivedAddressDTO, 0, len(addresses)),
+	}
+	for _, address := range addresses {
+		derived.Addresses = append(derived.Addresses, DerivedAddressDTO{
+			Index:   address.Index,
+			Path:    address.Path,
+			Address: address.Address,
+			Stored:  address.Used,
+		})
+	}
+
+	return derived, nil
+}
+
+// newAccount parses the xpub when one is given, so that path never sees a private key.
+func newAccount(dto DeriveDTO) (*hd.Account, error) {
+	scheme := hd.Scheme(dto.Scheme)
+	if dto.Xpub != "" {
+		return hd.ParseAccount(dto.Xpub, dto.Chain, dto.Network, scheme)
+	}
+
+	seed, err := hd.Seed(dto.Mnemonic, dto.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return hd.NewAccount(seed, dto.Chain, dto.Network, scheme, dto.Account)
+}
+
+func (s *service) DeriveAddress(ctx context.Context, walletId string, index uint32, change bool) (*AddressDTO, error) {
+	ctx, span := tracing.Start(ctx, "wallet.Service/DeriveAddress")
+	defer span.End()
+
+	response, err := s.walletClient.DeriveAddress(ctx, &pb.DeriveAddressData{WalletId: walletId, Index: index, Change: change})
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed to derive address: %v", err)
+		tracing.RecordError(span, err)
+		return nil, fromWalletService(err)
+	}
+
+	address := toAddressDTO(walletId, response.Address)
+	record := &storage.Address{Address: address.Address, WalletId: walletId}
+	if wallet, err := s.store.Wallets().Get(ctx, walletId); err == nil {
+		record.Chain, record.Network = wallet.Chain, wallet.Network
+	}
+	if err := storage.RecordAddress(ctx, s.store, record); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record derived address: %v", err)
+	}
+
+	return &address, nil
+}
+
+func (s *service) ListAddresses(ctx context.Context, walletId string) (*AddressesDTO, error) {
+	ctx, span := tracing.Start(ctx, "wallet.Service/ListAddresses")
+	defer span.End()
+
+	response, err := s.walletClient.ListAddresses(ctx, &pb.ListAddressesData{WalletId: walletId})
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed to list addresses: %v", err)
+		tracing.RecordError(span, err)
+		return nil, fromWalletService(err)
+	}
+
+	addresses := &AddressesDTO{WalletId: walletId, Addresses: make([]AddressDTO, 0, len(response.Addresses))}
+	for _, address := range response.Addresses {
+		addresses.Addresses = append(addresses.Addresses, toAddressDTO(walletId, address))
+	}
+
+	return addresses, nil
+}
+
+func (s *service) ImportKey(ctx context.Context, dto *ImportKeyDTO) (*ImportedWalletDTO, error) {
+	ctx, span := tracing.Start(ctx, "wallet.Service/ImportKey")
+	defer span.End()
+
+	response, err := s.walletClient.ImportKey(ctx, &pb.ImportKeyData{
+		CoinName:   dto.Name,
+		Network:    dto.Network,
+		PrivateKey: dto.PrivateKey,
+		Xpub:       dto.Xpub,
+	})
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed to import key: %v", err)
+		tracing.RecordError(span, err)
+		return nil, fromWalletService(err)
+	}
+
+	coin := strings.ToLower(response.Wallet.CoinName)
+	walletId := response.Wallet.WalletId
+	if err := storage.RecordWallet(ctx, s.store, &storage.Wallet{Id: walletId, Chain: coin, Network: dto.Network}); err != nil {
+		tracing.Logger(ctx, s.logger).Warnf("failed record imported wallet: %v", err)
+	}
+	if response.Wallet.Address != "" {
+		address := &storage.Address{Address: response.Wallet.Address, WalletId: walletId, Chain: coin, Network: dto.Network}
+		if err := storage.RecordAddress(ctx, s.store, address); err != nil {
+			tracing.Logger(ctx, s.logger).Warnf("failed record imported wallet address: %v", err)
+		}
+	}
+
+	return &ImportedWalletDTO{
+		WalletId:  walletId,
+		CoinName:  response.Wallet.CoinName,
+		Address:   response.Wallet.Address,
+		WatchOnly: response.Wallet.WatchOnly,
+	}, nil
+}
+
+func (s *service) SignTransaction(ctx context.Context, dto *SignTransactionDTO) (*SignedTransactionDTO, error) {
+	ctx, span := tracing.Start(ctx, "wallet.Service/SignTransaction")
+	defer span.End()
+
+	request := &pb.SignTransactionData{WalletId: dto.WalletId, Network: dto.Network, Tx: dto.Tx}
+	for _, utxo := range dto.Utxo {
+		request.Utxo = append(request.Utxo, &pb.Utxo{Txid: utxo.TxId, Vout: utxo.Vout, Amount: utxo.Amount, PkScript: utxo.PKScript})
+	}
+
+	response, err := s.walletClient.SignTransaction(ctx, request)
+	if err != nil {
+		tracing.Logger(ctx, s.logger).Errorf("failed to sign %s transaction: %v", dto.Chain, err)
+		tracing.RecordError(span, err)
+		return nil, fromWalletService(err)
+	}
+
+	return &SignedTransactionDTO{SignedTx: response.SignedTx}, nil
+}
+
+func toAddressDTO(walletId string, address *pb.Address) AddressDTO {
+	return AddressDTO{
+		WalletId: walletId,
+		Address:  address.GetAddress(),
+		Path:     address.GetPath(),
+		Index:    address.GetIndex(),
+		Change:   address.GetChange(),
+	}
+}
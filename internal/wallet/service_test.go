@@ -2,14 +2,28 @@ package wallet_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
 	"nn-blockchain-api/internal/wallet"
 	"nn-blockchain-api/pkg/errors"
 	pb "nn-blockchain-api/pkg/grpc_client/proto/wallet"
 	grpc_mock "nn-blockchain-api/pkg/grpc_client/proto/wallet/mocks"
 	"nn-blockchain-api/pkg/logger"
+	"nn-blockchain-api/pkg/storage"
+	bolt_storage "nn-blockchain-api/pkg/storage/bolt"
+	mock_storage "nn-blockchain-api/pkg/storage/mocks"
+	"path/filepath"
+	"sync"
 	"testing"
 )
 
@@ -20,12 +34,14 @@ func TestNewService(t *testing.T) {
 	tests := []struct {
 		name         string
 		walletClient pb.WalletServiceClient
+		store        storage.Storage
 		logger       *zap.SugaredLogger
 		expect       func(*testing.T, wallet.Service, error)
 	}{
 		{
 			name:         "should return wallet service",
 			walletClient: grpc_mock.NewMockWalletServiceClient(controller),
+			store:        mock_storage.NewMockStorage(controller),
 			logger:       &zap.SugaredLogger{},
 			expect: func(t *testing.T, s wallet.Service, err error) {
 				assert.NotNil(t, s)
@@ -35,6 +51,7 @@ func TestNewService(t *testing.T) {
 		{
 			name:         "should return invalid wallet client",
 			walletClient: nil,
+			store:        mock_storage.NewMockStorage(controller),
 			logger:       &zap.SugaredLogger{},
 			expect: func(t *testing.T, s wallet.Service, err error) {
 				assert.NotNil(t, err)
@@ -42,9 +59,21 @@ func TestNewService(t *testing.T) {
 				assert.EqualError(t, err, "invalid wallet client")
 			},
 		},
+		{
+			name:         "should return invalid storage",
+			walletClient: grpc_mock.NewMockWalletServiceClient(controller),
+			store:        nil,
+			logger:       &zap.SugaredLogger{},
+			expect: func(t *testing.T, s wallet.Service, err error) {
+				assert.NotNil(t, err)
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid storage")
+			},
+		},
 		{
 			name:         "should return invalid logger",
 			walletClient: grpc_mock.NewMockWalletServiceClient(controller),
+			store:        mock_storage.NewMockStorage(controller),
 			logger:       nil,
 			expect: func(t *testing.T, s wallet.Service, err error) {
 				assert.NotNil(t, err)
@@ -56,7 +85,7 @@ func TestNewService(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := wallet.NewService(tc.walletClient, tc.logger)
+			svc, err := wallet.NewService(tc.walletClient, tc.store, tc.logger)
 			tc.expect(t, svc, err)
 		})
 	}
@@ -70,7 +99,7 @@ func TestService_CreateWallet(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := wallet.NewService(mockWalletClient, zapLogger)
+	service, _ := wallet.NewService(mockWalletClient, newStorage(t), zapLogger)
 
 	dto := &wallet.CoinNameDTO{
 		Name:     "BTC",
@@ -103,7 +132,7 @@ func TestService_CreateWallet(t *testing.T) {
 			walletClient: mockWalletClient,
 			dto:          dto,
 			setup: func(ctx context.Context, dto *wallet.CoinNameDTO) {
-				mockWalletClient.EXPECT().CreateWallet(ctx, &pb.CreateWalletData{
+				mockWalletClient.EXPECT().CreateWallet(gomock.Any(), &pb.CreateWalletData{
 					WalletName: dto.Name,
 					Mnemonic:   &dto.Mnemonic,
 				}).Return(&pb.WalletInfo{Wallet: walletData}, nil)
@@ -111,6 +140,10 @@ func TestService_CreateWallet(t *testing.T) {
 			expect: func(t *testing.T, w *wallet.DTO, err error) {
 				assert.Nil(t, err)
 				assert.Equal(t, w.CoinName, dto.Name)
+				assert.NotEmpty(t, w.WalletId)
+				body, err := json.Marshal(w)
+				assert.Nil(t, err)
+				assert.NotContains(t, string(body), "privateKey")
 			},
 		},
 		{
@@ -119,7 +152,7 @@ func TestService_CreateWallet(t *testing.T) {
 			walletClient: mockWalletClient,
 			dto:          invalidWalletNameDto,
 			setup: func(ctx context.Context, dto *wallet.CoinNameDTO) {
-				mockWalletClient.EXPECT().CreateWallet(ctx, &pb.CreateWalletData{
+				mockWalletClient.EXPECT().CreateWallet(gomock.Any(), &pb.CreateWalletData{
 					WalletName: dto.Name,
 					Mnemonic:   &dto.Mnemonic,
 				}).Return(nil, wallet.ErrInvalidWalletType)
@@ -148,7 +181,7 @@ func TestService_CreateMnemonic(t *testing.T) {
 
 	newLogger, _ := logger.NewLogger("development")
 	zapLogger, _ := newLogger.SetupZapLogger()
-	service, _ := wallet.NewService(mockWalletClient, zapLogger)
+	service, _ := wallet.NewService(mockWalletClient, newStorage(t), zapLogger)
 
 	mnemonicReturns := "mnemonic"
 
@@ -176,7 +209,7 @@ func TestService_CreateMnemonic(t *testing.T) {
 			walletClient: mockWalletClient,
 			dto:          dto,
 			setup: func(ctx context.Context, dto *wallet.MnemonicDTO) {
-				mockWalletClient.EXPECT().CreateMnemonic(ctx, &pb.CreateMnemonicData{
+				mockWalletClient.EXPECT().CreateMnemonic(gomock.Any(), &pb.CreateMnemonicData{
 					MnemonicLength: dto.Length,
 					Language:       dto.Language,
 				}).Return(&pb.MnemonicInfo{Mnemonic: mnemonicReturns}, nil)
@@ -192,7 +225,7 @@ func TestService_CreateMnemonic(t *testing.T) {
 			walletClient: mockWalletClient,
 			dto:          invalidDto,
 			setup: func(ctx context.Context, dto *wallet.MnemonicDTO) {
-				mockWalletClient.EXPECT().CreateMnemonic(ctx, &pb.CreateMnemonicData{
+				mockWalletClient.EXPECT().CreateMnemonic(gomock.Any(), &pb.CreateMnemonicData{
 					MnemonicLength: dto.Length,
 					Language:       dto.Language,
 				}).Return(nil, wallet.ErrCreateMnemonic)
@@ -212,3 +245,272 @@ func TestService_CreateMnemonic(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Derive(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := newStorage(t)
+	newLogger, _ := logger.NewLogger("development")
+	zapLogger, _ := newLogger.SetupZapLogger()
+	service, _ := wallet.NewService(grpc_mock.NewMockWalletServiceClient(controller), store, zapLogger)
+
+	const (
+		mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+		zpub     = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+	)
+	assert.Nil(t, storage.RecordAddress(context.Background(), store, &storage.Address{
+		Address: "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", WalletId: "wallet", Chain: "bitcoin", Network: "main",
+	}))
+
+	tests := []struct {
+		name   string
+		dto    wallet.DeriveDTO
+		expect func(*testing.T, *wallet.DerivedDTO, error)
+	}{
+		{
+			name: "should derive from mnemonic",
+			dto:  wallet.DeriveDTO{Chain: "bitcoin", Network: "main", Scheme: "p2tr", Mnemonic: mnemonic, StorageGap: 1},
+			expect: func(t *testing.T, derived *wallet.DerivedDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "m/86'/0'/0'", derived.Path)
+				assert.Equal(t, "p2tr", derived.Scheme)
+				assert.Equal(t, []wallet.DerivedAddressDTO{
+					{Index: 0, Path: "m/86'/0'/0'/0/0", Address: "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"},
+				}, derived.Addresses)
+			},
+		},
+		{
+			name: "should derive from xpub and skip stored addresses",
+			dto:  wallet.DeriveDTO{Chain: "bitcoin", Network: "main", Xpub: zpub, StorageGap: 2},
+			expect: func(t *testing.T, derived *wallet.DerivedDTO, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "M", derived.Path)
+				assert.Equal(t, zpub, derived.Xpub)
+				assert.Len(t, derived.Addresses, 3)
+				assert.True(t, derived.Addresses[0].Stored)
+				assert.Equal(t, "M/0/2", derived.Addresses[2].Path)
+			},
+		},
+		{
+			name: "should use default storage gap on change chain",
+			dto:  wallet.DeriveDTO{Chain: "ethereum", Network: "main", Mnemonic: mnemonic, Change: true},
+			expect: func(t *testing.T, derived *wallet.DerivedDTO, err error) {
+				assert.Nil(t, err)
+				assert.Len(t, derived.Addresses, 20)
+				assert.Equal(t, "m/44'/60'/0'/1/0", derived.Addresses[0].Path)
+			},
+		},
+		{
+			name: "should return invalid derivation for xpub of another scheme",
+			dto:  wallet.DeriveDTO{Chain: "bitcoin", Network: "main", Scheme: "p2pkh", Xpub: zpub},
+			expect: func(t *testing.T, derived *wallet.DerivedDTO, err error) {
+				assert.Nil(t, derived)
+				assert.Equal(t, errors.WithMessage(wallet.ErrInvalidDerivation, "invalid extended public key"), err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			derived, err := service.Derive(context.Background(), tc.dto)
+			tc.expect(t, derived, err)
+		})
+	}
+}
+
+func newStorage(t *testing.T) storage.Storage {
+	store, err := bolt_storage.NewStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
+
+// walletServer is an in-memory stand-in for the external wallet service.
+type walletServer struct {
+	pb.UnimplementedWalletServiceServer
+
+	mu      sync.Mutex
+	wallets map[string]*pb.Wallet
+	derived map[string][]*pb.Address
+}
+
+func (s *walletServer) ImportKey(_ context.Context, req *pb.ImportKeyData) (*pb.WalletInfo, error) {
+	if (req.PrivateKey == "") == (req.Xpub == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of private key and xpub is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := fmt.Sprintf("wallet-%d", len(s.wallets)+1)
+	s.wallets[id] = &pb.Wallet{WalletId: id, CoinName: req.CoinName, Address: id + "-0-0", WatchOnly: req.Xpub != ""}
+	return &pb.WalletInfo{Wallet: s.wallets[id]}, nil
+}
+
+func (s *walletServer) DeriveAddress(_ context.Context, req *pb.DeriveAddressData) (*pb.AddressInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.wallets[req.WalletId]; !ok {
+		return nil, status.Error(codes.NotFound, "unknown wallet")
+	}
+
+	chain := 0
+	if req.Change {
+		chain = 1
+	}
+	address := &pb.Address{
+		Address: fmt.Sprintf("%s-%d-%d", req.WalletId, chain, req.Index),
+		Path:    fmt.Sprintf("m/84'/0'/0'/%d/%d", chain, req.Index),
+		Index:   req.Index,
+		Change:  req.Change,
+	}
+	s.derived[req.WalletId] = append(s.derived[req.WalletId], address)
+	return &pb.AddressInfo{Address: address}, nil
+}
+
+func (s *walletServer) ListAddresses(_ context.Context, req *pb.ListAddressesData) (*pb.AddressList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.wallets[req.WalletId]; !ok {
+		return nil, status.Error(codes.NotFound, "unknown wallet")
+	}
+	return &pb.AddressList{Addresses: s.derived[req.WalletId]}, nil
+}
+
+func (s *walletServer) SignTransaction(_ context.Context, req *pb.SignTransactionData) (*pb.SignedTransaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, ok := s.wallets[req.WalletId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown wallet")
+	}
+	if w.WatchOnly {
+		return nil, status.Error(codes.FailedPrecondition, "watch-only wallet cannot sign")
+	}
+	return &pb.SignedTransaction{SignedTx: fmt.Sprintf("signed:%s:%d", req.Tx, len(req.Utxo))}, nil
+}
+
+func newRemoteWalletClient(t *testing.T) pb.WalletServiceClient {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterWalletServiceServer(server, &walletServer{wallets: map[string]*pb.Wallet{}, derived: map[string][]*pb.Address{}})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewWalletServiceClient(conn)
+}
+
+func TestService_RemoteWallet(t *testing.T) {
+	ctx := context.Background()
+	store := newStorage(t)
+	newLogger, _ := logger.NewLogger("development")
+	zapLogger, _ := newLogger.SetupZapLogger()
+	service, err := wallet.NewService(newRemoteWalletClient(t), store, zapLogger)
+	require.NoError(t, err)
+
+	imported, err := service.ImportKey(ctx, &wallet.ImportKeyDTO{Name: "BTC", Network: "test", PrivateKey: "wif"})
+	require.NoError(t, err)
+	assert.Equal(t, &wallet.ImportedWalletDTO{WalletId: "wallet-1", CoinName: "BTC", Address: "wallet-1-0-0"}, imported)
+
+	watchOnly, err := service.ImportKey(ctx, &wallet.ImportKeyDTO{Name: "BTC", Network: "test", Xpub: "vpub"})
+	require.NoError(t, err)
+	assert.True(t, watchOnly.WatchOnly)
+
+	tests := []struct {
+		name   string
+		run    func() (interface{}, error)
+		expect func(*testing.T, interface{}, error)
+	}{
+		{
+			name: "should derive address and record it",
+			run: func() (interface{}, error) {
+				return service.DeriveAddress(ctx, imported.WalletId, 1, true)
+			},
+			expect: func(t *testing.T, result interface{}, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, &wallet.AddressDTO{
+					WalletId: "wallet-1", Address: "wallet-1-1-1", Path: "m/84'/0'/0'/1/1", Index: 1, Change: true,
+				}, result)
+
+				recorded, err := store.Addresses().Get(ctx, "wallet-1-1-1")
+				require.NoError(t, err)
+				assert.Equal(t, "btc", recorded.Chain)
+				assert.Equal(t, "test", recorded.Network)
+			},
+		},
+		{
+			name: "should list derived addresses",
+			run: func() (interface{}, error) {
+				return service.ListAddresses(ctx, imported.WalletId)
+			},
+			expect: func(t *testing.T, result interface{}, err error) {
+				require.NoError(t, err)
+				addresses := result.(*wallet.AddressesDTO)
+				require.Len(t, addresses.Addresses, 1)
+				assert.Equal(t, "wallet-1-1-1", addresses.Addresses[0].Address)
+			},
+		},
+		{
+			name: "should sign by wallet id",
+			run: func() (interface{}, error) {
+				return service.SignTransaction(ctx, &wallet.SignTransactionDTO{
+					WalletId: imported.WalletId, Chain: "bitcoin", Network: "test", Tx: "tx", Utxo: []wallet.UtxoDTO{{TxId: "txid"}},
+				})
+			},
+			expect: func(t *testing.T, result interface{}, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, &wallet.SignedTransactionDTO{SignedTx: "signed:tx:1"}, result)
+			},
+		},
+		{
+			name: "should return wallet not found",
+			run: func() (interface{}, error) {
+				return service.DeriveAddress(ctx, "missing", 0, false)
+			},
+			expect: func(t *testing.T, _ interface{}, err error) {
+				assert.Equal(t, errors.WithMessage(wallet.ErrWalletNotFound, "unknown wallet"), err)
+			},
+		},
+		{
+			name: "should reject signing with watch-only wallet",
+			run: func() (interface{}, error) {
+				return service.SignTransaction(ctx, &wallet.SignTransactionDTO{WalletId: watchOnly.WalletId, Chain: "bitcoin", Network: "test", Tx: "tx"})
+			},
+			expect: func(t *testing.T, _ interface{}, err error) {
+				assert.Equal(t, errors.WithMessage(wallet.ErrInvalidWalletRequest, "watch-only wallet cannot sign"), err)
+			},
+		},
+		{
+			name: "should reject import with both key and xpub",
+			run: func() (interface{}, error) {
+				return service.ImportKey(ctx, &wallet.ImportKeyDTO{Name: "BTC", Network: "test", PrivateKey: "wif", Xpub: "vpub"})
+			},
+			expect: func(t *testing.T, _ interface{}, err error) {
+				assert.Equal(t, errors.HTTPCode(wallet.ErrInvalidWalletRequest), errors.HTTPCode(err))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.run()
+			tc.expect(t, result, err)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package auth
+
+import "context"
+
+type keyContextKey struct{}
+
+func WithKey(ctx context.Context, key *Key) context.Context {
+	return context.WithValue(ctx, keyContextKey{}, key)
+}
+
+func KeyFromContext(ctx context.Context) (*Key, bool) {
+	key, ok := ctx.Value(keyContextKey{}).(*Key)
+	return key, ok
+}
+
+// NameFromContext names the caller for audit fields, empty when auth is disabled.
+func NameFromContext(ctx context.Context) string {
+	if key, ok := KeyFromContext(ctx); ok {
+		return key.Name
+	}
+	return ""
+}
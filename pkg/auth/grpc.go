@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"nn-blockchain-api/pkg/errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const metadataAPIKey = "x-api-key"
+
+// publicServices are the method prefixes served without a key, the standard health and
+// reflection services.
+var publicServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+	"/grpc.reflection.v1.ServerReflection/",
+}
+
+// Rule is what a gRPC method requires from the calling key, the counterpart of Guard.Require.
+type Rule struct {
+	Chain string
+	Scope Scope
+}
+
+// UnaryServerInterceptor authorizes gRPC calls by the x-api-key or authorization: Bearer
+// metadata. Only the health and reflection services are public, any other method without a
+// rule is denied.
+func UnaryServerInterceptor(keys KeyStore, enabled bool, rules map[string]Rule) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !enabled || isPublic(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		rule, ok := rules[info.FullMethod]
+		if !ok {
+			return nil, errors.NewForbidden("method " + info.FullMethod + " has no authorization rule")
+		}
+
+		// Requests naming a chain, such as one of the EVM chains, are authorized for it.
+		chain := rule.Chain
+		if withChain, ok := req.(interface{ GetChain() string }); ok && withChain.GetChain() != "" {
+			chain = withChain.GetChain()
+		}
+
+		key, err := authorize(ctx, keys, RawKeyFromMetadata(ctx), chain, rule.Scope)
+		if err != nil {
+			return nil, err
+		}
+
+		if withNetwork, ok := req.(interface{ GetNetwork() string }); ok {
+			if err := authorizeNetwork(key, withNetwork.GetNetwork()); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(WithKey(ctx, key), req)
+	}
+}
+
+func isPublic(method string) bool {
+	for _, prefix := range publicServices {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RawKeyFromMetadata extracts the API key from incoming gRPC metadata.
+func RawKeyFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(metadataAPIKey); len(values) > 0 && values[0] != "" {
+		return strings.TrimSpace(values[0])
+	}
+
+	if values := md.Get("authorization"); len(values) > 0 {
+		header := values[0]
+		if len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+			return strings.TrimSpace(header[7:])
+		}
+	}
+
+	return ""
+}
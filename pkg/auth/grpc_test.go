@@ -0,0 +1,88 @@
+package auth_test
+
+import (
+	"context"
+	"nn-blockchain-api/pkg/auth"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type networkRequest struct {
+	chain   string
+	network string
+}
+
+func (r *networkRequest) GetChain() string {
+	return r.chain
+}
+
+func (r *networkRequest) GetNetwork() string {
+	return r.network
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	keys, err := auth.NewConfigKeyStore([]string{
+		"reader:" + auth.HashKey("reader") + ":read:bitcoin:test",
+		"admin:" + auth.HashKey("admin") + ":*",
+		"polygon:" + auth.HashKey("polygon") + ":read:polygon:test",
+	})
+	assert.Nil(t, err)
+
+	rules := map[string]auth.Rule{
+		"/api.bitcoin.v1.BitcoinService/StatusNode":         {Chain: "bitcoin", Scope: auth.ScopeRead},
+		"/api.bitcoin.v1.BitcoinService/SendRawTransaction": {Chain: "bitcoin", Scope: auth.ScopeBroadcast},
+		"/api.ethereum.v1.EthereumService/StatusNode":       {Chain: "ethereum", Scope: auth.ScopeRead},
+	}
+
+	tests := []struct {
+		name    string
+		enabled bool
+		method  string
+		md      metadata.MD
+		chain   string
+		network string
+		code    codes.Code
+		key     string
+	}{
+		{name: "public method", enabled: true, method: "/grpc.health.v1.Health/Check", code: codes.OK},
+		{name: "method without rule", enabled: true, method: "/api.bitcoin.v1.BitcoinService/WalletInfo", md: metadata.Pairs("authorization", "Bearer admin"), code: codes.PermissionDenied},
+		{name: "unknown service", enabled: true, method: "/grpc.healthz.v1.Health/Check", code: codes.PermissionDenied},
+		{name: "disabled", enabled: false, method: "/api.bitcoin.v1.BitcoinService/StatusNode", code: codes.OK},
+		{name: "missing key", enabled: true, method: "/api.bitcoin.v1.BitcoinService/StatusNode", code: codes.Unauthenticated},
+		{name: "invalid key", enabled: true, method: "/api.bitcoin.v1.BitcoinService/StatusNode", md: metadata.Pairs("x-api-key", "unknown"), code: codes.Unauthenticated},
+		{name: "api key header", enabled: true, method: "/api.bitcoin.v1.BitcoinService/StatusNode", md: metadata.Pairs("x-api-key", "reader"), network: "test", code: codes.OK, key: "reader"},
+		{name: "bearer token", enabled: true, method: "/api.bitcoin.v1.BitcoinService/StatusNode", md: metadata.Pairs("authorization", "Bearer admin"), network: "main", code: codes.OK, key: "admin"},
+		{name: "missing scope", enabled: true, method: "/api.bitcoin.v1.BitcoinService/SendRawTransaction", md: metadata.Pairs("x-api-key", "reader"), network: "test", code: codes.PermissionDenied},
+		{name: "chain not allowed", enabled: true, method: "/api.ethereum.v1.EthereumService/StatusNode", md: metadata.Pairs("x-api-key", "reader"), network: "test", code: codes.PermissionDenied},
+		{name: "chain of request", enabled: true, method: "/api.ethereum.v1.EthereumService/StatusNode", md: metadata.Pairs("x-api-key", "polygon"), chain: "polygon", network: "test", code: codes.OK, key: "polygon"},
+		{name: "chain of rule", enabled: true, method: "/api.ethereum.v1.EthereumService/StatusNode", md: metadata.Pairs("x-api-key", "polygon"), network: "test", code: codes.PermissionDenied},
+		{name: "network not allowed", enabled: true, method: "/api.bitcoin.v1.BitcoinService/StatusNode", md: metadata.Pairs("x-api-key", "reader"), network: "main", code: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := auth.UnaryServerInterceptor(keys, tt.enabled, rules)
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var key string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				if k, ok := auth.KeyFromContext(ctx); ok {
+					key = k.Name
+				}
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, &networkRequest{chain: tt.chain, network: tt.network}, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Equal(t, tt.key, key)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	gErrors "errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/respond"
+	"strings"
+)
+
+const (
+	HeaderAPIKey = "X-API-Key"
+
+	maxPeekBody = 1 << 20
+)
+
+//go:generate mockgen -source=guard.go -destination=mocks/guard_mock.go
+type Guard interface {
+	// Require returns a middleware that lets through only requests whose API key
+	// holds the scope and is allowed on the chain and requested network.
+	Require(chain string, scope Scope) func(http.Handler) http.Handler
+}
+
+type guard struct {
+	keys    KeyStore
+	enabled bool
+}
+
+func NewGuard(keys KeyStore, enabled bool) (Guard, error) {
+	if keys == nil {
+		return nil, gErrors.New("invalid key store")
+	}
+
+	return &guard{keys: keys, enabled: enabled}, nil
+}
+
+func (g *guard) Require(chain string, scope Scope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !g.enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key, err := authorize(r.Context(), g.keys, RawKey(r), chain, scope)
+			if err != nil {
+				respond.Respond(w, errors.HTTPCode(err), err)
+				return
+			}
+
+			network, err := requestNetwork(r)
+			if err != nil {
+				respond.Respond(w, http.StatusBadRequest, errors.NewBadRequest(err.Error()))
+				return
+			}
+			// Routes of a chain always act on a network, the others only when they name one.
+			if chain != "" || network != "" {
+				if err := authorizeNetwork(key, network); err != nil {
+					respond.Respond(w, errors.HTTPCode(err), err)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithKey(r.Context(), key)))
+		})
+	}
+}
+
+// authorize resolves the raw key and checks it holds the scope and is allowed on the chain.
+func authorize(ctx context.Context, keys KeyStore, raw, chain string, scope Scope) (*Key, error) {
+	if raw == "" {
+		return nil, errors.NewUnauthorized("missing api key")
+	}
+
+	key, err := keys.FindByHash(ctx, HashKey(raw))
+	if gErrors.Is(err, ErrKeyNotFound) {
+		return nil, errors.NewUnauthorized("invalid api key")
+	}
+	if err != nil {
+		return nil, errors.NewInternal(err.Error())
+	}
+
+	if !key.HasScope(scope) {
+		return nil, errors.NewForbidden("api key has no " + string(scope) + " scope")
+	}
+	if !key.AllowsChain(chain) {
+		return nil, errors.NewForbidden("api key is not allowed on " + chain)
+	}
+
+	return key, nil
+}
+
+func authorizeNetwork(key *Key, network string) error {
+	if !key.AllowsNetwork(network) {
+		return errors.NewForbidden("api key is not allowed on " + network + " network")
+	}
+	return nil
+}
+
+// RawKey extracts the API key from the X-API-Key or Authorization: Bearer headers.
+func RawKey(r *http.Request) string {
+	if key := r.Header.Get(HeaderAPIKey); key != "" {
+		return strings.TrimSpace(key)
+	}
+
+	header := r.Header.Get("Authorization")
+	if len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+
+	return ""
+}
+
+// requestNetwork reads the network from the query string of GET requests and from the JSON
+// body of the others, decoded the way the handlers decode it so both see the same network.
+// The body is left intact for the handler.
+func requestNetwork(r *http.Request) (string, error) {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return r.URL.Query().Get("network"), nil
+	}
+	if r.Body == nil {
+		return "", gErrors.New("missing request body")
+	}
+
+	data, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxPeekBody))
+	if err != nil {
+		return "", err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	var body struct {
+		Network string `json:"network"`
+	}
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(&body); err != nil {
+		return "", fmt.Errorf("invalid request body: %w", err)
+	}
+
+	return body.Network, nil
+}
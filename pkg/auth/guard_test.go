@@ -0,0 +1,205 @@
+package auth_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/pkg/auth"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGuard(t *testing.T) {
+	guard, err := auth.NewGuard(nil, true)
+	assert.Nil(t, guard)
+	assert.EqualError(t, err, "invalid key store")
+}
+
+func TestGuard_Require(t *testing.T) {
+	keys, err := auth.NewConfigKeyStore([]string{
+		"reader:" + auth.HashKey("reader") + ":read:bitcoin:test",
+		"admin:" + auth.HashKey("admin") + ":*",
+	})
+	assert.Nil(t, err)
+
+	guard, err := auth.NewGuard(keys, true)
+	assert.Nil(t, err)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, ok := auth.KeyFromContext(r.Context())
+		assert.True(t, ok)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		_, _ = w.Write([]byte(key.Name + ":" + string(body)))
+	})
+
+	tests := []struct {
+		name   string
+		chain  string
+		scope  auth.Scope
+		header map[string]string
+		query  string
+		body   string
+		code   int
+		expect string
+	}{
+		{
+			name:  "should return unauthorized without key",
+			chain: "bitcoin",
+			scope: auth.ScopeRead,
+			body:  `{}`,
+			code:  http.StatusUnauthorized,
+		},
+		{
+			name:   "should return unauthorized with unknown key",
+			chain:  "bitcoin",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "unknown"},
+			body:   `{}`,
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "should return forbidden without scope",
+			chain:  "bitcoin",
+			scope:  auth.ScopeBroadcast,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			body:   `{"network":"test"}`,
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "should return forbidden for chain",
+			chain:  "ethereum",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			body:   `{"network":"test"}`,
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "should return forbidden for network",
+			chain:  "bitcoin",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			body:   `{"network":"main"}`,
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "should return forbidden for network of the body despite the query",
+			chain:  "bitcoin",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			query:  "?network=test",
+			body:   `{"network":"main"}`,
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "should return forbidden for network decoded before trailing data",
+			chain:  "bitcoin",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			body:   `{"network":"main"} x`,
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "should return bad request for malformed body",
+			chain:  "bitcoin",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			body:   `{"network":`,
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "should return forbidden without network for restricted key",
+			chain:  "bitcoin",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			body:   `{}`,
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "should pass routes without chain and network",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			body:   `{}`,
+			code:   http.StatusOK,
+			expect: `reader:{}`,
+		},
+		{
+			name:   "should pass with api key header",
+			chain:  "bitcoin",
+			scope:  auth.ScopeRead,
+			header: map[string]string{auth.HeaderAPIKey: "reader"},
+			body:   `{"network":"test"}`,
+			code:   http.StatusOK,
+			expect: `reader:{"network":"test"}`,
+		},
+		{
+			name:   "should pass with bearer token",
+			chain:  "ethereum",
+			scope:  auth.ScopeBroadcast,
+			header: map[string]string{"Authorization": "Bearer admin"},
+			body:   `{"network":"main"}`,
+			code:   http.StatusOK,
+			expect: `admin:{"network":"main"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/"+tc.query, strings.NewReader(tc.body))
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			guard.Require(tc.chain, tc.scope)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.code, rec.Code)
+			if tc.expect != "" {
+				assert.Equal(t, tc.expect, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGuard_RequireQuery(t *testing.T) {
+	keys, err := auth.NewConfigKeyStore([]string{"reader:" + auth.HashKey("reader") + ":read:bitcoin:test"})
+	assert.Nil(t, err)
+
+	guard, err := auth.NewGuard(keys, true)
+	assert.Nil(t, err)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	for target, code := range map[string]int{
+		"/blocks/1?network=test": http.StatusNoContent,
+		"/blocks/1?network=main": http.StatusForbidden,
+		"/blocks/1":              http.StatusForbidden,
+	} {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.Header.Set(auth.HeaderAPIKey, "reader")
+		rec := httptest.NewRecorder()
+
+		guard.Require("bitcoin", auth.ScopeRead)(next).ServeHTTP(rec, req)
+
+		assert.Equal(t, code, rec.Code, target)
+	}
+}
+
+func TestGuard_Disabled(t *testing.T) {
+	keys, err := auth.NewConfigKeyStore(nil)
+	assert.Nil(t, err)
+
+	guard, err := auth.NewGuard(keys, false)
+	assert.Nil(t, err)
+
+	rec := httptest.NewRecorder()
+	guard.Require("bitcoin", auth.ScopeSign)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"nn-blockchain-api/pkg/helpers"
+	"strings"
+)
+
+type Scope string
+
+const (
+	ScopeRead      Scope = "read"
+	ScopeBuild     Scope = "build"
+	ScopeSign      Scope = "sign"
+	ScopeBroadcast Scope = "broadcast"
+	ScopeApprove   Scope = "approve"
+
+	wildcard = "*"
+)
+
+var scopes = []string{string(ScopeRead), string(ScopeBuild), string(ScopeSign), string(ScopeBroadcast), string(ScopeApprove), wildcard}
+
+// Key is an API key identity, empty Chains/Networks mean no restriction.
+type Key struct {
+	Id       string
+	Name     string
+	Hash     string
+	Scopes   []string
+	Chains   []string
+	Networks []string
+}
+
+func (k *Key) HasScope(scope Scope) bool {
+	return helpers.ContainsStr(k.Scopes, wildcard) || helpers.ContainsStr(k.Scopes, string(scope))
+}
+
+func (k *Key) AllowsChain(chain string) bool {
+	return chain == "" || allows(k.Chains, chain)
+}
+
+// AllowsNetwork refuses requests that name no network when the key is restricted to some.
+func (k *Key) AllowsNetwork(network string) bool {
+	return allows(k.Networks, network)
+}
+
+func allows(list []string, value string) bool {
+	return len(list) == 0 || helpers.ContainsStr(list, wildcard) || helpers.ContainsStr(list, value)
+}
+
+func HashKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateKey returns a new random raw API key.
+func GenerateKey() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return "nnk_" + hex.EncodeToString(buf), nil
+}
+
+// ParseKey reads a key definition in the form name:sha256:scopes:chains:networks,
+// where lists are separated by "|" and may be "*" or empty.
+func ParseKey(definition string) (*Key, error) {
+	parts := strings.Split(strings.TrimSpace(definition), ":")
+	if len(parts) < 3 || len(parts) > 5 {
+		return nil, fmt.Errorf("invalid api key definition %q", definition)
+	}
+	for len(parts) < 5 {
+		parts = append(parts, "")
+	}
+
+	name, hash := parts[0], strings.ToLower(parts[1])
+	if name == "" {
+		return nil, fmt.Errorf("invalid api key definition %q: empty name", definition)
+	}
+	if decoded, err := hex.DecodeString(hash); err != nil || len(decoded) != sha256.Size {
+		return nil, fmt.Errorf("invalid api key %q: hash must be sha256 hex", name)
+	}
+
+	key := &Key{
+		Id:       name,
+		Name:     name,
+		Hash:     hash,
+		Scopes:   splitList(parts[2]),
+		Chains:   splitList(parts[3]),
+		Networks: splitList(parts[4]),
+	}
+
+	if len(key.Scopes) == 0 {
+		return nil, fmt.Errorf("invalid api key %q: no scopes", name)
+	}
+	for _, scope := range key.Scopes {
+		if !helpers.ContainsStr(scopes, scope) {
+			return nil, fmt.Errorf("invalid api key %q: unknown scope %q", name, scope)
+		}
+	}
+
+	return key, nil
+}
+
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, "|") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
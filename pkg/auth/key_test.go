@@ -0,0 +1,108 @@
+package auth_test
+
+import (
+	"context"
+	gErrors "errors"
+	"nn-blockchain-api/pkg/auth"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKey(t *testing.T) {
+	hash := auth.HashKey("secret")
+
+	tests := []struct {
+		name       string
+		definition string
+		expect     func(*testing.T, *auth.Key, error)
+	}{
+		{
+			name:       "should return key",
+			definition: "ci:" + hash + ":read|build:bitcoin:test",
+			expect: func(t *testing.T, key *auth.Key, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "ci", key.Name)
+				assert.Equal(t, []string{"read", "build"}, key.Scopes)
+				assert.Equal(t, []string{"bitcoin"}, key.Chains)
+				assert.Equal(t, []string{"test"}, key.Networks)
+			},
+		},
+		{
+			name:       "should return key without restrictions",
+			definition: "admin:" + hash + ":*",
+			expect: func(t *testing.T, key *auth.Key, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, key.Chains)
+				assert.Nil(t, key.Networks)
+			},
+		},
+		{
+			name:       "should return invalid hash",
+			definition: "ci:secret:read",
+			expect: func(t *testing.T, key *auth.Key, err error) {
+				assert.Nil(t, key)
+				assert.EqualError(t, err, `invalid api key "ci": hash must be sha256 hex`)
+			},
+		},
+		{
+			name:       "should return unknown scope",
+			definition: "ci:" + hash + ":admin",
+			expect: func(t *testing.T, key *auth.Key, err error) {
+				assert.Nil(t, key)
+				assert.EqualError(t, err, `invalid api key "ci": unknown scope "admin"`)
+			},
+		},
+		{
+			name:       "should return invalid definition",
+			definition: "ci",
+			expect: func(t *testing.T, key *auth.Key, err error) {
+				assert.Nil(t, key)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := auth.ParseKey(tc.definition)
+			tc.expect(t, key, err)
+		})
+	}
+}
+
+func TestKey_Allows(t *testing.T) {
+	key := &auth.Key{Scopes: []string{"read"}, Chains: []string{"bitcoin"}, Networks: []string{"test"}}
+
+	assert.True(t, key.HasScope(auth.ScopeRead))
+	assert.False(t, key.HasScope(auth.ScopeBroadcast))
+	assert.True(t, key.AllowsChain("bitcoin"))
+	assert.True(t, key.AllowsChain(""))
+	assert.False(t, key.AllowsChain("ethereum"))
+	assert.True(t, key.AllowsNetwork("test"))
+	assert.False(t, key.AllowsNetwork("main"))
+	assert.False(t, key.AllowsNetwork(""))
+
+	admin := &auth.Key{Scopes: []string{"*"}}
+	assert.True(t, admin.HasScope(auth.ScopeSign))
+	assert.True(t, admin.AllowsChain("ethereum"))
+	assert.True(t, admin.AllowsNetwork("main"))
+	assert.True(t, admin.AllowsNetwork(""))
+}
+
+func TestMultiKeyStore(t *testing.T) {
+	ctx := context.Background()
+	first, err := auth.NewConfigKeyStore([]string{"first:" + auth.HashKey("first") + ":read"})
+	assert.Nil(t, err)
+	second, err := auth.NewConfigKeyStore([]string{"second:" + auth.HashKey("second") + ":sign"})
+	assert.Nil(t, err)
+
+	store := auth.NewMultiKeyStore(first, second)
+
+	key, err := store.FindByHash(ctx, auth.HashKey("second"))
+	assert.Nil(t, err)
+	assert.Equal(t, "second", key.Name)
+
+	_, err = store.FindByHash(ctx, auth.HashKey("missing"))
+	assert.True(t, gErrors.Is(err, auth.ErrKeyNotFound))
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"nn-blockchain-api/pkg/storage"
+)
+
+var ErrKeyNotFound = errors.New("api key not found")
+
+//go:generate mockgen -source=keystore.go -destination=mocks/keystore_mock.go
+type KeyStore interface {
+	FindByHash(ctx context.Context, hash string) (*Key, error)
+}
+
+type configKeyStore struct {
+	keys map[string]*Key
+}
+
+func NewConfigKeyStore(definitions []string) (KeyStore, error) {
+	keys := make(map[string]*Key, len(definitions))
+	for _, definition := range definitions {
+		key, err := ParseKey(definition)
+		if err != nil {
+			return nil, err
+		}
+		keys[key.Hash] = key
+	}
+
+	return &configKeyStore{keys: keys}, nil
+}
+
+func (s *configKeyStore) FindByHash(_ context.Context, hash string) (*Key, error) {
+	key, ok := s.keys[hash]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	return key, nil
+}
+
+type storageKeyStore struct {
+	repo storage.APIKeyRepository
+}
+
+func NewStorageKeyStore(repo storage.APIKeyRepository) (KeyStore, error) {
+	if repo == nil {
+		return nil, errors.New("invalid api key repository")
+	}
+
+	return &storageKeyStore{repo: repo}, nil
+}
+
+func (s *storageKeyStore) FindByHash(ctx context.Context, hash string) (*Key, error) {
+	stored, err := s.repo.GetByHash(ctx, hash)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stored.Revoked {
+		return nil, ErrKeyNotFound
+	}
+
+	return &Key{
+		Id:       stored.Id,
+		Name:     stored.Name,
+		Hash:     stored.Hash,
+		Scopes:   stored.Scopes,
+		Chains:   stored.Chains,
+		Networks: stored.Networks,
+	}, nil
+}
+
+type multiKeyStore []KeyStore
+
+// NewMultiKeyStore looks a key up in every store in order.
+func NewMultiKeyStore(stores ...KeyStore) KeyStore {
+	return multiKeyStore(stores)
+}
+
+func (m multiKeyStore) FindByHash(ctx context.Context, hash string) (*Key, error) {
+	for _, store := range m {
+		key, err := store.FindByHash(ctx, hash)
+		if err == nil {
+			return key, nil
+		}
+		if !errors.Is(err, ErrKeyNotFound) {
+			return nil, err
+		}
+	}
+
+	return nil, ErrKeyNotFound
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: guard.go
+
+// Package mock_auth is a generated GoMock package.
+package mock_auth
+
+import (
+	http "net/http"
+	auth "nn-blockchain-api/pkg/auth"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGuard is a mock of Guard interface.
+type MockGuard struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuardMockRecorder
+}
+
+// MockGuardMockRecorder is the mock recorder for MockGuard.
+type MockGuardMockRecorder struct {
+	mock *MockGuard
+}
+
+// NewMockGuard creates a new mock instance.
+func NewMockGuard(ctrl *gomock.Controller) *MockGuard {
+	mock := &MockGuard{ctrl: ctrl}
+	mock.recorder = &MockGuardMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuard) EXPECT() *MockGuardMockRecorder {
+	return m.recorder
+}
+
+// Require mocks base method.
+func (m *MockGuard) Require(chain string, scope auth.Scope) func(http.Handler) http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Require", chain, scope)
+	ret0, _ := ret[0].(func(http.Handler) http.Handler)
+	return ret0
+}
+
+// Require indicates an expected call of Require.
+func (mr *MockGuardMockRecorder) Require(chain, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Require", reflect.TypeOf((*MockGuard)(nil).Require), chain, scope)
+}
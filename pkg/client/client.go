@@ -0,0 +1,119 @@
+// Package client is a typed Go client for the Multi Blockchain API.
+//
+// Request and response types and the endpoint methods are generated from the
+// handler DTOs; run go generate after changing them.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	gErrors "errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/codes"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/params"
+	"strings"
+)
+
+//go:generate go run ../../cmd/clientgen -out client_gen.go -package client
+
+// StatusUnexpectedResponse marks errors whose body is not an API error, e.g. from a proxy.
+const StatusUnexpectedResponse errors.Status = "unexpected_response"
+
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+type Option func(*Client)
+
+// WithAPIKey authenticates every request with the X-API-Key header.
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, gErrors.New("invalid base url")
+	}
+
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.httpClient == nil {
+		return nil, gErrors.New("invalid http client")
+	}
+
+	return c, nil
+}
+
+// do sends in as the JSON body and decodes the response into out. Failed requests
+// return the API's *errors.Error so callers can branch on its Status.
+func (c *Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	if in != nil && method == http.MethodGet {
+		path, in = params.Encode(path, in), nil
+	}
+
+	var body bytes.Buffer
+	if in != nil {
+		if err := json.NewEncoder(&body).Encode(in); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.apiKey != "" {
+		req.Header.Set(auth.HeaderAPIKey, c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr errors.Error
+		if err := json.Unmarshal(data, &apiErr); err != nil || apiErr.Status == "" {
+			return &errors.Error{
+				Code:    codes.Code(resp.StatusCode),
+				Status:  StatusUnexpectedResponse,
+				Message: fmt.Sprintf("%s %s: %s", method, path, http.StatusText(resp.StatusCode)),
+			}
+		}
+		return &apiErr
+	}
+
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,2264 @@
+// Code generated by clientgen. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+type Approval struct {
+	Id          string     `json:"id"`
+	Status      string     `json:"status"`
+	Chain       string     `json:"chain"`
+	Network     string     `json:"network"`
+	TxHash      string     `json:"tx_hash"`
+	Subject     string     `json:"subject"`
+	Spend       string     `json:"spend"`
+	Policy      string     `json:"policy"`
+	RequestedBy string     `json:"requested_by,omitempty"`
+	DecidedBy   string     `json:"decided_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+}
+
+type BitcoinBlock struct {
+	Block   string `json:"block" path:"block"`
+	Network string `json:"network" query:"network"`
+	Page    int64  `json:"page" query:"page"`
+	PerPage int64  `json:"per_page" query:"per_page"`
+}
+
+type BitcoinBlockInfo struct {
+	Hash              string   `json:"hash"`
+	Height            int64    `json:"height"`
+	Confirmations     int64    `json:"confirmations"`
+	Version           int64    `json:"version"`
+	MerkleRoot        string   `json:"merkle_root"`
+	Time              int64    `json:"time"`
+	MedianTime        int64    `json:"median_time"`
+	Nonce             int64    `json:"nonce"`
+	Bits              string   `json:"bits"`
+	Difficulty        float64  `json:"difficulty"`
+	Size              int64    `json:"size"`
+	Weight            int64    `json:"weight"`
+	PreviousBlockHash string   `json:"previous_block_hash,omitempty"`
+	NextBlockHash     string   `json:"next_block_hash,omitempty"`
+	TxCount           int64    `json:"tx_count"`
+	Page              int64    `json:"page"`
+	PerPage           int64    `json:"per_page"`
+	TxIds             []string `json:"txids"`
+}
+
+type BitcoinCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type BitcoinCombineMultisigTransactions struct {
+	Txs     []string `json:"txs"`
+	Network string   `json:"network"`
+}
+
+type BitcoinCombinedMultisigTransaction struct {
+	Tx string `json:"tx"`
+}
+
+type BitcoinCreateMultisig struct {
+	Required   int      `json:"required"`
+	Keys       []string `json:"keys"`
+	Index      uint32   `json:"index,omitempty"`
+	ScriptType string   `json:"script_type"`
+	Network    string   `json:"network"`
+}
+
+type BitcoinCreateMultisigTransaction struct {
+	Utxo []struct {
+		TxId   string `json:"txid"`
+		Vout   int64  `json:"vout"`
+		Amount int64  `json:"amount"`
+	} `json:"utxo"`
+	WitnessScript string `json:"witness_script"`
+	ScriptType    string `json:"script_type"`
+	ToAddress     string `json:"to_address"`
+	ChangeAddress string `json:"change_address,omitempty"`
+	Amount        int64  `json:"amount"`
+	Network       string `json:"network"`
+}
+
+type BitcoinCreateRawTransaction struct {
+	Utxo []struct {
+		TxId     string `json:"txid"`
+		Vout     int64  `json:"vout"`
+		Amount   int64  `json:"amount"`
+		PKScript string `json:"pk_script"`
+	} `json:"utxo"`
+	FromAddress string `json:"from_address"`
+	ToAddress   string `json:"to_address"`
+	Amount      int64  `json:"amount"`
+	Network     string `json:"network"`
+}
+
+type BitcoinCreateWallet struct {
+	Network string `json:"network"`
+}
+
+type BitcoinCreatedMultisigTransaction struct {
+	Tx      string          `json:"tx"`
+	Fee     float64         `json:"fee"`
+	PrevTxs []BitcoinPrevTx `json:"prev_txs"`
+}
+
+type BitcoinCreatedRawTransaction struct {
+	Tx  string  `json:"tx"`
+	Fee float64 `json:"fee"`
+}
+
+type BitcoinCreatedWalletInfo struct {
+	WalletId string `json:"wallet_id"`
+	Password string `json:"password"`
+}
+
+type BitcoinDecodeRawTransaction struct {
+	Tx      string `json:"tx"`
+	Network string `json:"network"`
+}
+
+type BitcoinDecodedRawTransaction struct {
+	Txid     string `json:"txid"`
+	Hash     string `json:"hash"`
+	Version  int    `json:"version"`
+	Size     int    `json:"size"`
+	Vsize    int    `json:"vsize"`
+	Weight   int    `json:"weight"`
+	Locktime int    `json:"locktime"`
+	Vin      []struct {
+		Txid      string `json:"txid"`
+		Vout      int    `json:"vout"`
+		ScriptSig struct {
+			Asm string `json:"asm"`
+			Hex string `json:"hex"`
+		} `json:"scriptSig"`
+		Sequence int64 `json:"sequence"`
+	} `json:"vin"`
+	Vout []struct {
+		Value        float64 `json:"value"`
+		N            int     `json:"n"`
+		ScriptPubKey struct {
+			Asm     string `json:"asm"`
+			Hex     string `json:"hex"`
+			Address string `json:"address"`
+			Type    string `json:"type"`
+		} `json:"scriptPubKey"`
+	} `json:"vout"`
+}
+
+type BitcoinDeriveAddresses struct {
+	Descriptor string  `json:"descriptor"`
+	Range      []int64 `json:"range,omitempty"`
+	Network    string  `json:"network"`
+}
+
+type BitcoinDerivedAddresses struct {
+	Addresses []string `json:"addresses"`
+}
+
+type BitcoinDescriptor struct {
+	Descriptor string `json:"descriptor"`
+	Network    string `json:"network"`
+}
+
+type BitcoinDescriptorInfo struct {
+	Descriptor string `json:"descriptor"`
+	Checksum   string `json:"checksum"`
+	Type       string `json:"type"`
+	IsRange    bool   `json:"is_range"`
+	IsSolvable bool   `json:"is_solvable"`
+}
+
+type BitcoinFinalizeMultisigTransaction struct {
+	Tx      string          `json:"tx"`
+	PrevTxs []BitcoinPrevTx `json:"prev_txs"`
+	Network string          `json:"network"`
+}
+
+type BitcoinFundForRawTransaction struct {
+	CreatedTxHex  string `json:"created_tx_hex"`
+	ChangeAddress string `json:"change_address"`
+	Network       string `json:"network"`
+}
+
+type BitcoinFundedRawTransaction struct {
+	Tx  string  `json:"tx"`
+	Fee float64 `json:"fee"`
+}
+
+type BitcoinImportAddress struct {
+	Address  string `json:"address"`
+	WalletId string `json:"wallet_id"`
+	Network  string `json:"network"`
+}
+
+type BitcoinImportAddressInfo struct {
+	Message string `json:"message"`
+}
+
+type BitcoinImportDescriptor struct {
+	Descriptor string  `json:"descriptor"`
+	Range      []int64 `json:"range,omitempty"`
+	Timestamp  int64   `json:"timestamp,omitempty"`
+	Active     bool    `json:"active,omitempty"`
+	Internal   bool    `json:"internal,omitempty"`
+	Label      string  `json:"label,omitempty"`
+}
+
+type BitcoinImportDescriptors struct {
+	WalletId    string                    `json:"wallet_id"`
+	Descriptors []BitcoinImportDescriptor `json:"descriptors"`
+	Network     string                    `json:"network"`
+}
+
+type BitcoinImportDescriptorsInfo struct {
+	Result []BitcoinImportedDescriptor `json:"result"`
+}
+
+type BitcoinImportedDescriptor struct {
+	Descriptor string   `json:"descriptor"`
+	Success    bool     `json:"success"`
+	Warnings   []string `json:"warnings,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+type BitcoinListUnspent struct {
+	Address  string `json:"address"`
+	WalletId string `json:"wallet_id"`
+	Network  string `json:"network"`
+}
+
+type BitcoinListUnspentInfo struct {
+	Result []*BitcoinUnspentInfo `json:"Result"`
+}
+
+type BitcoinLoadWallet struct {
+	WalletId string `json:"wallet_id"`
+	Network  string `json:"network"`
+}
+
+type BitcoinLoadWalletInfo struct {
+	Message string `json:"message"`
+}
+
+type BitcoinMempool struct {
+	Network string `json:"network" query:"network"`
+}
+
+type BitcoinMempoolEntry struct {
+	TxId    string `json:"txid" path:"txid"`
+	Network string `json:"network" query:"network"`
+}
+
+type BitcoinMempoolEntryInfo struct {
+	TxId            string   `json:"txid"`
+	Vsize           int64    `json:"vsize"`
+	Weight          int64    `json:"weight"`
+	Time            int64    `json:"time"`
+	Height          int64    `json:"height"`
+	Fee             float64  `json:"fee"`
+	ModifiedFee     float64  `json:"modified_fee"`
+	AncestorCount   int64    `json:"ancestor_count"`
+	DescendantCount int64    `json:"descendant_count"`
+	Depends         []string `json:"depends"`
+	SpentBy         []string `json:"spent_by"`
+	Replaceable     bool     `json:"replaceable"`
+}
+
+type BitcoinMempoolInfo struct {
+	Loaded        bool    `json:"loaded"`
+	Size          int64   `json:"size"`
+	Bytes         int64   `json:"bytes"`
+	Usage         int64   `json:"usage"`
+	MaxMempool    int64   `json:"max_mempool"`
+	MempoolMinFee float64 `json:"mempool_min_fee"`
+	MinRelayTxFee float64 `json:"min_relay_tx_fee"`
+}
+
+type BitcoinMultisig struct {
+	Address       string   `json:"address"`
+	ScriptType    string   `json:"script_type"`
+	Required      int      `json:"required"`
+	Keys          []string `json:"keys"`
+	WitnessScript string   `json:"witness_script"`
+	RedeemScript  string   `json:"redeem_script,omitempty"`
+	ScriptPubKey  string   `json:"script_pub_key"`
+	Descriptor    string   `json:"descriptor"`
+}
+
+type BitcoinPrevTx struct {
+	TxId          string `json:"txid"`
+	Vout          int64  `json:"vout"`
+	ScriptPubKey  string `json:"script_pub_key"`
+	RedeemScript  string `json:"redeem_script,omitempty"`
+	WitnessScript string `json:"witness_script"`
+	Amount        int64  `json:"amount"`
+}
+
+type BitcoinRescanWallet struct {
+	WalletId string `json:"wallet_id"`
+	Network  string `json:"network"`
+}
+
+type BitcoinRescanWalletInfo struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type BitcoinSendRawTransaction struct {
+	SignedTx string `json:"signed_tx"`
+	Network  string `json:"network"`
+	DryRun   bool   `json:"dry_run,omitempty"`
+}
+
+type BitcoinSentRawTransaction struct {
+	TxId       string             `json:"tx_id"`
+	DryRun     bool               `json:"dry_run,omitempty"`
+	Simulation *BitcoinSimulation `json:"simulation,omitempty"`
+}
+
+type BitcoinSignMultisigTransaction struct {
+	Tx         string          `json:"tx"`
+	PrivateKey string          `json:"privateKey,omitempty"`
+	KeyId      string          `json:"key_id,omitempty"`
+	Passphrase string          `json:"passphrase,omitempty"`
+	ApprovalId string          `json:"approval_id,omitempty"`
+	PrevTxs    []BitcoinPrevTx `json:"prev_txs"`
+	Network    string          `json:"network"`
+}
+
+type BitcoinSignRawTransaction struct {
+	Tx         string `json:"tx"`
+	PrivateKey string `json:"privateKey,omitempty"`
+	WalletId   string `json:"wallet_id,omitempty"`
+	KeyId      string `json:"key_id,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+	ApprovalId string `json:"approval_id,omitempty"`
+	Utxo       []struct {
+		TxId     string `json:"txid"`
+		Vout     int64  `json:"vout"`
+		Amount   int64  `json:"amount"`
+		PKScript string `json:"pk_script"`
+	} `json:"utxo"`
+	Network string `json:"network"`
+}
+
+type BitcoinSignedMultisigTransaction struct {
+	Tx       string `json:"tx"`
+	Complete bool   `json:"complete"`
+}
+
+type BitcoinSignedRawTransaction struct {
+	Hash string `json:"hash"`
+}
+
+type BitcoinSimulateTransaction struct {
+	SignedTx string `json:"signed_tx"`
+	Network  string `json:"network"`
+}
+
+type BitcoinSimulation struct {
+	TxId    string         `json:"tx_id"`
+	WTxId   string         `json:"wtxid"`
+	Passed  bool           `json:"passed"`
+	Checks  []BitcoinCheck `json:"checks"`
+	Vsize   int64          `json:"vsize,omitempty"`
+	Fee     float64        `json:"fee,omitempty"`
+	FeeRate float64        `json:"fee_rate,omitempty"`
+}
+
+type BitcoinStatusNode struct {
+	Network string `json:"network"`
+}
+
+type BitcoinStatusNodeInfo struct {
+	Chain                string      `json:"chain"`
+	Blocks               interface{} `json:"blocks"`
+	Headers              interface{} `json:"headers"`
+	Verificationprogress interface{} `json:"verificationprogress"`
+	Softforks            struct {
+		Bip34 struct {
+			Type   string      `json:"type"`
+			Active bool        `json:"active"`
+			Height interface{} `json:"height"`
+		} `json:"bip34"`
+		Bip66 struct {
+			Type   string      `json:"type"`
+			Active bool        `json:"active"`
+			Height interface{} `json:"height"`
+		} `json:"bip66"`
+		Bip65 struct {
+			Type   string      `json:"type"`
+			Active bool        `json:"active"`
+			Height interface{} `json:"height"`
+		} `json:"bip65"`
+		Csv struct {
+			Type   string      `json:"type"`
+			Active bool        `json:"active"`
+			Height interface{} `json:"height"`
+		} `json:"csv"`
+		Segwit struct {
+			Type   string      `json:"type"`
+			Active bool        `json:"active"`
+			Height interface{} `json:"height"`
+		} `json:"segwit"`
+		Taproot struct {
+			Type string `json:"type"`
+			Bip9 struct {
+				Status              string      `json:"status"`
+				StartTime           interface{} `json:"start_time"`
+				Timeout             interface{} `json:"timeout"`
+				Since               interface{} `json:"since"`
+				MinActivationHeight int         `json:"min_activation_height"`
+			} `json:"bip9"`
+			Active bool `json:"active"`
+		} `json:"taproot"`
+	} `json:"softforks"`
+	Warnings string `json:"warnings"`
+}
+
+type BitcoinTransaction struct {
+	TxId    string `json:"txid" path:"txid"`
+	Network string `json:"network" query:"network"`
+	Verbose bool   `json:"verbose" query:"verbose"`
+}
+
+type BitcoinTransactionInfo struct {
+	TxId          string            `json:"txid"`
+	Hex           string            `json:"hex"`
+	Hash          string            `json:"hash,omitempty"`
+	Version       int64             `json:"version,omitempty"`
+	Size          int64             `json:"size,omitempty"`
+	Vsize         int64             `json:"vsize,omitempty"`
+	Weight        int64             `json:"weight,omitempty"`
+	Locktime      int64             `json:"locktime,omitempty"`
+	Inputs        []BitcoinTxInput  `json:"inputs,omitempty"`
+	Outputs       []BitcoinTxOutput `json:"outputs,omitempty"`
+	Fee           float64           `json:"fee,omitempty"`
+	BlockHash     string            `json:"block_hash,omitempty"`
+	Confirmations int64             `json:"confirmations,omitempty"`
+	BlockTime     int64             `json:"block_time,omitempty"`
+}
+
+type BitcoinTxInput struct {
+	TxId     string   `json:"txid,omitempty"`
+	Vout     int64    `json:"vout"`
+	Coinbase string   `json:"coinbase,omitempty"`
+	Sequence int64    `json:"sequence"`
+	Witness  []string `json:"witness,omitempty"`
+	Value    float64  `json:"value"`
+	Address  string   `json:"address,omitempty"`
+}
+
+type BitcoinTxOut struct {
+	TxId          string `json:"txid" path:"txid"`
+	Vout          int64  `json:"vout" path:"vout"`
+	Network       string `json:"network" query:"network"`
+	ConfirmedOnly bool   `json:"confirmed_only" query:"confirmed_only"`
+}
+
+type BitcoinTxOutInfo struct {
+	Unspent       bool    `json:"unspent"`
+	BestBlock     string  `json:"best_block,omitempty"`
+	Confirmations int64   `json:"confirmations,omitempty"`
+	Value         float64 `json:"value,omitempty"`
+	Address       string  `json:"address,omitempty"`
+	ScriptPubKey  string  `json:"script_pub_key,omitempty"`
+	Type          string  `json:"type,omitempty"`
+	Coinbase      bool    `json:"coinbase,omitempty"`
+}
+
+type BitcoinTxOutput struct {
+	N            int64   `json:"n"`
+	Value        float64 `json:"value"`
+	Address      string  `json:"address,omitempty"`
+	ScriptPubKey string  `json:"script_pub_key"`
+	Type         string  `json:"type"`
+}
+
+type BitcoinUnspentInfo struct {
+	Txid          string  `json:"txid"`
+	Vout          int     `json:"vout"`
+	Address       string  `json:"address"`
+	Label         string  `json:"label"`
+	ScriptPubKey  string  `json:"scriptPubKey"`
+	Amount        float64 `json:"amount"`
+	Confirmations int     `json:"confirmations"`
+	Spendable     bool    `json:"spendable"`
+	Solvable      bool    `json:"solvable"`
+	Safe          bool    `json:"safe"`
+}
+
+type BitcoinWallet struct {
+	WalletId string `json:"wallet_id"`
+	Network  string `json:"network"`
+}
+
+type BitcoinWalletInfo struct {
+	Walletname            string      `json:"walletname"`
+	Walletversion         int         `json:"walletversion"`
+	Format                string      `json:"format"`
+	Balance               float64     `json:"balance"`
+	UnconfirmedBalance    float64     `json:"unconfirmed_balance"`
+	ImmatureBalance       float64     `json:"immature_balance"`
+	Txcount               int         `json:"txcount"`
+	Keypoololdest         int         `json:"keypoololdest"`
+	Keypoolsize           int         `json:"keypoolsize"`
+	Hdseedid              string      `json:"hdseedid"`
+	KeypoolsizeHdInternal int         `json:"keypoolsize_hd_internal"`
+	Paytxfee              float64     `json:"paytxfee"`
+	PrivateKeysEnabled    bool        `json:"private_keys_enabled"`
+	AvoidReuse            bool        `json:"avoid_reuse"`
+	Scanning              interface{} `json:"scanning"`
+	Descriptors           bool        `json:"descriptors"`
+}
+
+type EthereumBlock struct {
+	Block   string `json:"block" path:"block"`
+	Network string `json:"network" query:"network"`
+	Full    bool   `json:"full" query:"full"`
+}
+
+type EthereumBlockInfo struct {
+	Number        uint64                     `json:"number"`
+	Hash          string                     `json:"hash"`
+	ParentHash    string                     `json:"parent_hash"`
+	Timestamp     uint64                     `json:"timestamp"`
+	Miner         string                     `json:"miner"`
+	GasLimit      uint64                     `json:"gas_limit"`
+	GasUsed       uint64                     `json:"gas_used"`
+	BaseFeePerGas string                     `json:"base_fee_per_gas,omitempty"`
+	Size          uint64                     `json:"size"`
+	TxCount       int64                      `json:"tx_count"`
+	TxHashes      []string                   `json:"tx_hashes,omitempty"`
+	Transactions  []EthereumBlockTransaction `json:"transactions,omitempty"`
+}
+
+type EthereumBlockTransaction struct {
+	Hash     string `json:"hash"`
+	From     string `json:"from"`
+	To       string `json:"to,omitempty"`
+	Nonce    uint64 `json:"nonce"`
+	Value    string `json:"value"`
+	Gas      uint64 `json:"gas"`
+	GasPrice string `json:"gas_price"`
+	Input    string `json:"input"`
+	Index    uint64 `json:"index"`
+}
+
+type EthereumCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+	Warning string `json:"warning,omitempty"`
+}
+
+type EthereumContractCall struct {
+	Contract string        `json:"contract"`
+	ABI      string        `json:"abi"`
+	Method   string        `json:"method"`
+	Args     []interface{} `json:"args,omitempty"`
+	From     string        `json:"from,omitempty"`
+	Block    string        `json:"block,omitempty"`
+	Network  string        `json:"network"`
+}
+
+type EthereumContractCallResult struct {
+	Outputs      []EthereumContractOutput `json:"outputs"`
+	Reverted     bool                     `json:"reverted,omitempty"`
+	RevertReason string                   `json:"revert_reason,omitempty"`
+	RevertData   string                   `json:"revert_data,omitempty"`
+}
+
+type EthereumContractOutput struct {
+	Name  string      `json:"name,omitempty"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+type EthereumCreateContractTransaction struct {
+	FromAddress string        `json:"from_address"`
+	Contract    string        `json:"contract"`
+	ABI         string        `json:"abi"`
+	Method      string        `json:"method"`
+	Args        []interface{} `json:"args,omitempty"`
+	Amount      float64       `json:"amount,omitempty"`
+	Network     string        `json:"network"`
+}
+
+type EthereumCreateRawTransaction struct {
+	FromAddress string  `json:"from_address"`
+	ToAddress   string  `json:"to_address"`
+	Amount      float64 `json:"amount"`
+	Network     string  `json:"network"`
+}
+
+type EthereumCreatedContractTransaction struct {
+	Tx       string  `json:"tx"`
+	Fee      float64 `json:"fee"`
+	Data     string  `json:"data"`
+	Nonce    uint64  `json:"nonce"`
+	Gas      uint64  `json:"gas"`
+	GasPrice string  `json:"gas_price"`
+	L1Fee    string  `json:"l1_fee,omitempty"`
+}
+
+type EthereumCreatedRawTransaction struct {
+	Tx  string  `json:"tx"`
+	Fee float64 `json:"fee"`
+}
+
+type EthereumEvent struct {
+	Name      string                 `json:"name"`
+	Signature string                 `json:"signature"`
+	Args      map[string]interface{} `json:"args"`
+}
+
+type EthereumGetLogs struct {
+	Addresses []string   `json:"addresses,omitempty"`
+	Topics    [][]string `json:"topics,omitempty"`
+	FromBlock string     `json:"from_block"`
+	ToBlock   string     `json:"to_block,omitempty"`
+	ABI       string     `json:"abi,omitempty"`
+	Network   string     `json:"network"`
+}
+
+type EthereumLog struct {
+	Address     string         `json:"address"`
+	Topics      []string       `json:"topics"`
+	Data        string         `json:"data"`
+	BlockNumber uint64         `json:"block_number"`
+	BlockHash   string         `json:"block_hash"`
+	TxHash      string         `json:"tx_hash"`
+	TxIndex     uint64         `json:"tx_index"`
+	LogIndex    uint64         `json:"log_index"`
+	Removed     bool           `json:"removed,omitempty"`
+	Event       *EthereumEvent `json:"event,omitempty"`
+	DecodeError string         `json:"decode_error,omitempty"`
+}
+
+type EthereumLogs struct {
+	FromBlock uint64        `json:"from_block"`
+	ToBlock   uint64        `json:"to_block"`
+	Logs      []EthereumLog `json:"logs"`
+}
+
+type EthereumNodeInfo struct {
+	CurrentBlock        string `json:"currentBlock,omitempty"`
+	HealedBytecodeBytes string `json:"healedBytecodeBytes,omitempty"`
+	HealedBytecodes     string `json:"healedBytecodes,omitempty"`
+	HealedTrienodeBytes string `json:"healedTrienodeBytes,omitempty"`
+	HealedTrienodes     string `json:"healedTrienodes,omitempty"`
+	HealingBytecode     string `json:"healingBytecode,omitempty"`
+	HealingTrienodes    string `json:"healingTrienodes,omitempty"`
+	HighestBlock        string `json:"highestBlock,omitempty"`
+	StartingBlock       string `json:"startingBlock,omitempty"`
+	SyncedAccountBytes  string `json:"syncedAccountBytes,omitempty"`
+	SyncedAccounts      string `json:"syncedAccounts,omitempty"`
+	SyncedBytecodeBytes string `json:"syncedBytecodeBytes,omitempty"`
+	SyncedBytecodes     string `json:"syncedBytecodes,omitempty"`
+	SyncedStorage       string `json:"syncedStorage,omitempty"`
+	SyncedStorageBytes  string `json:"syncedStorageBytes,omitempty"`
+	SyncMessage         string `json:"sync_message,omitempty"`
+}
+
+type EthereumSendRawTransaction struct {
+	SignedTx string `json:"signed_tx"`
+	Network  string `json:"network"`
+	DryRun   bool   `json:"dry_run,omitempty"`
+}
+
+type EthereumSentRawTransaction struct {
+	TxId       string              `json:"tx_id"`
+	DryRun     bool                `json:"dry_run,omitempty"`
+	Simulation *EthereumSimulation `json:"simulation,omitempty"`
+}
+
+type EthereumSignRawTransaction struct {
+	Tx         string `json:"tx"`
+	PrivateKey string `json:"privateKey,omitempty"`
+	WalletId   string `json:"wallet_id,omitempty"`
+	KeyId      string `json:"key_id,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+	ApprovalId string `json:"approval_id,omitempty"`
+	Network    string `json:"network"`
+}
+
+type EthereumSignedRawTransaction struct {
+	SignedTx string `json:"signed_tx"`
+}
+
+type EthereumSimulateTransaction struct {
+	SignedTx string `json:"signed_tx"`
+	Network  string `json:"network"`
+}
+
+type EthereumSimulation struct {
+	TxId   string          `json:"tx_id"`
+	From   string          `json:"from,omitempty"`
+	Passed bool            `json:"passed"`
+	Checks []EthereumCheck `json:"checks"`
+}
+
+type EthereumStatusNode struct {
+	Network string `json:"network"`
+}
+
+type HealthDependency struct {
+	Status    string                 `json:"status"`
+	LatencyMs int64                  `json:"latency_ms"`
+	Error     string                 `json:"error,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+type HealthReadiness struct {
+	Status       string                       `json:"status"`
+	Dependencies map[string]*HealthDependency `json:"dependencies"`
+}
+
+type Info struct {
+	Chain    string `json:"chain"`
+	Network  string `json:"network"`
+	Currency string `json:"currency"`
+	ChainId  int64  `json:"chain_id,omitempty"`
+	Params   string `json:"params,omitempty"`
+}
+
+type Key struct {
+	Id        string    `json:"id"`
+	Chain     string    `json:"chain"`
+	Network   string    `json:"network,omitempty"`
+	Address   string    `json:"address"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type KeystoreImportKey struct {
+	Chain      string `json:"chain"`
+	PrivateKey string `json:"private_key"`
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+type KeystoreKeys struct {
+	Keys []*Key `json:"keys"`
+}
+
+type Networks struct {
+	Networks []Info `json:"networks"`
+}
+
+type Policy struct {
+	Name          string   `json:"name"`
+	Chain         string   `json:"chain,omitempty"`
+	KeyIds        []string `json:"key_ids,omitempty"`
+	WalletIds     []string `json:"wallet_ids,omitempty"`
+	Networks      []string `json:"networks,omitempty"`
+	AllowList     []string `json:"allow_list,omitempty"`
+	DenyList      []string `json:"deny_list,omitempty"`
+	DailyLimit    string   `json:"daily_limit,omitempty"`
+	MaxFeeRatio   float64  `json:"max_fee_ratio,omitempty"`
+	ApprovalAbove string   `json:"approval_above,omitempty"`
+}
+
+type PolicyApprovals struct {
+	Approvals []*Approval `json:"approvals"`
+}
+
+type PolicyDecide struct {
+	ApprovalId string `json:"approval_id"`
+}
+
+type PolicyPolicies struct {
+	Policies []Policy `json:"policies"`
+}
+
+type Quota struct {
+	Client string   `json:"client"`
+	Quotas []*Usage `json:"quotas"`
+}
+
+type Usage struct {
+	Class     string    `json:"class"`
+	Used      int64     `json:"used"`
+	Limit     int64     `json:"limit"`
+	Remaining int64     `json:"remaining"`
+	ResetAt   time.Time `json:"reset_at"`
+}
+
+type Wallet struct {
+	WalletId string `json:"WalletId"`
+	Mnemonic string `json:"Mnemonic"`
+	CoinName string `json:"CoinName"`
+	Address  string `json:"Address"`
+}
+
+type WalletAddress struct {
+	WalletId string `json:"wallet_id"`
+	Address  string `json:"address"`
+	Path     string `json:"path"`
+	Index    uint32 `json:"index"`
+	Change   bool   `json:"change"`
+}
+
+type WalletAddresses struct {
+	WalletId  string          `json:"wallet_id"`
+	Addresses []WalletAddress `json:"addresses"`
+}
+
+type WalletCoinName struct {
+	Name     string `json:"name"`
+	Mnemonic string `json:"mnemonic"`
+}
+
+type WalletCreatedMnemonic struct {
+	Mnemonic string `json:"Mnemonic"`
+}
+
+type WalletDerive struct {
+	Chain      string `json:"chain"`
+	Network    string `json:"network"`
+	Scheme     string `json:"scheme,omitempty"`
+	Xpub       string `json:"xpub,omitempty"`
+	Mnemonic   string `json:"mnemonic,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+	Account    uint32 `json:"account"`
+	Change     bool   `json:"change"`
+	Start      uint32 `json:"start"`
+	StorageGap int    `json:"storage_gap,omitempty"`
+}
+
+type WalletDeriveAddress struct {
+	WalletId string `json:"wallet_id"`
+	Index    uint32 `json:"index"`
+	Change   bool   `json:"change"`
+}
+
+type WalletDerived struct {
+	Chain     string                 `json:"chain"`
+	Network   string                 `json:"network"`
+	Scheme    string                 `json:"scheme"`
+	Path      string                 `json:"path"`
+	Xpub      string                 `json:"xpub"`
+	Addresses []WalletDerivedAddress `json:"addresses"`
+}
+
+type WalletDerivedAddress struct {
+	Index   uint32 `json:"index"`
+	Path    string `json:"path"`
+	Address string `json:"address"`
+	Stored  bool   `json:"stored"`
+}
+
+type WalletImportKey struct {
+	Name       string `json:"name"`
+	Network    string `json:"network"`
+	PrivateKey string `json:"private_key,omitempty"`
+	Xpub       string `json:"xpub,omitempty"`
+}
+
+type WalletImportedWallet struct {
+	WalletId  string `json:"wallet_id"`
+	CoinName  string `json:"coin_name"`
+	Address   string `json:"address"`
+	WatchOnly bool   `json:"watch_only"`
+}
+
+type WalletListAddresses struct {
+	WalletId string `json:"wallet_id"`
+}
+
+type WalletMnemonic struct {
+	Length   string `json:"length"`
+	Language string `json:"language"`
+}
+
+// HealthCheck calls GET /api/v1/health.
+// Liveness probe.
+func (c *Client) HealthCheck(ctx context.Context) (map[string]string, error) {
+	var resp map[string]string
+	if err := c.do(ctx, "GET", "/api/v1/health", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HealthReady calls GET /api/v1/ready.
+// Readiness of upstream dependencies; 503 when any is degraded.
+func (c *Client) HealthReady(ctx context.Context) (*HealthReadiness, error) {
+	var resp HealthReadiness
+	if err := c.do(ctx, "GET", "/api/v1/ready", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// NetworksList calls GET /api/v1/networks.
+// Chains and networks this deployment serves.
+func (c *Client) NetworksList(ctx context.Context) (*Networks, error) {
+	var resp Networks
+	if err := c.do(ctx, "GET", "/api/v1/networks", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QuotaUsage calls GET /api/v1/quota.
+// Rate limit and daily quota usage of the caller.
+func (c *Client) QuotaUsage(ctx context.Context) (*Quota, error) {
+	var resp Quota
+	if err := c.do(ctx, "GET", "/api/v1/quota", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WalletCreateWallet calls POST /api/v1/create-wallet.
+// Derive a wallet for a coin from a mnemonic.
+func (c *Client) WalletCreateWallet(ctx context.Context, req *WalletCoinName) (*Wallet, error) {
+	var resp Wallet
+	if err := c.do(ctx, "POST", "/api/v1/create-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WalletCreateMnemonic calls POST /api/v1/create-mnemonic.
+// Generate a BIP39 mnemonic.
+func (c *Client) WalletCreateMnemonic(ctx context.Context, req *WalletMnemonic) (*WalletCreatedMnemonic, error) {
+	var resp WalletCreatedMnemonic
+	if err := c.do(ctx, "POST", "/api/v1/create-mnemonic", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WalletDerive calls POST /api/v1/derive.
+// Derive an account xpub and a window of addresses ending in a storage gap from an xpub or, with the sign scope, a mnemonic.
+func (c *Client) WalletDerive(ctx context.Context, req *WalletDerive) (*WalletDerived, error) {
+	var resp WalletDerived
+	if err := c.do(ctx, "POST", "/api/v1/derive", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WalletDeriveAddress calls POST /api/v1/derive-address.
+// Derive an address of a wallet held by the wallet service.
+func (c *Client) WalletDeriveAddress(ctx context.Context, req *WalletDeriveAddress) (*WalletAddress, error) {
+	var resp WalletAddress
+	if err := c.do(ctx, "POST", "/api/v1/derive-address", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WalletListAddresses calls POST /api/v1/list-addresses.
+// List the addresses of a wallet held by the wallet service.
+func (c *Client) WalletListAddresses(ctx context.Context, req *WalletListAddresses) (*WalletAddresses, error) {
+	var resp WalletAddresses
+	if err := c.do(ctx, "POST", "/api/v1/list-addresses", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WalletImportKey calls POST /api/v1/import-key.
+// Import a private key, or an xpub as a watch-only wallet, into the wallet service.
+func (c *Client) WalletImportKey(ctx context.Context, req *WalletImportKey) (*WalletImportedWallet, error) {
+	var resp WalletImportedWallet
+	if err := c.do(ctx, "POST", "/api/v1/import-key", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinStatusNode calls POST /api/v1/bitcoin/status.
+// Blockchain info of the node.
+func (c *Client) BitcoinStatusNode(ctx context.Context, req *BitcoinStatusNode) (*BitcoinStatusNodeInfo, error) {
+	var resp BitcoinStatusNodeInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/status", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinCreateRawTransaction calls POST /api/v1/bitcoin/create-raw-tx.
+// Build an unsigned transaction from the given UTXOs.
+func (c *Client) BitcoinCreateRawTransaction(ctx context.Context, req *BitcoinCreateRawTransaction) (*BitcoinCreatedRawTransaction, error) {
+	var resp BitcoinCreatedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/create-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinDecodeRawTransaction calls POST /api/v1/bitcoin/decode-raw-tx.
+// Decode a raw transaction.
+func (c *Client) BitcoinDecodeRawTransaction(ctx context.Context, req *BitcoinDecodeRawTransaction) (*BitcoinDecodedRawTransaction, error) {
+	var resp BitcoinDecodedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/decode-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinFundForRawTransaction calls POST /api/v1/bitcoin/fund-for-raw-tx.
+// Add inputs and change to a raw transaction.
+func (c *Client) BitcoinFundForRawTransaction(ctx context.Context, req *BitcoinFundForRawTransaction) (*BitcoinFundedRawTransaction, error) {
+	var resp BitcoinFundedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/fund-for-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinSignRawTransaction calls POST /api/v1/bitcoin/sign-raw-tx.
+// Sign a raw transaction with a WIF key or the key of a wallet held by the wallet service. Signing policies may deny it or hold it for approval with a 202, sign again with the approval_id once approved.
+func (c *Client) BitcoinSignRawTransaction(ctx context.Context, req *BitcoinSignRawTransaction) (*BitcoinSignedRawTransaction, error) {
+	var resp BitcoinSignedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/sign-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinSendRawTransaction calls POST /api/v1/bitcoin/send-raw-tx.
+// Broadcast a signed transaction once testmempoolaccept accepts it. A dry run stops after the check.
+func (c *Client) BitcoinSendRawTransaction(ctx context.Context, req *BitcoinSendRawTransaction) (*BitcoinSentRawTransaction, error) {
+	var resp BitcoinSentRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/send-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinSimulateTransaction calls POST /api/v1/bitcoin/simulate.
+// Check a signed transaction with testmempoolaccept without broadcasting it.
+func (c *Client) BitcoinSimulateTransaction(ctx context.Context, req *BitcoinSimulateTransaction) (*BitcoinSimulation, error) {
+	var resp BitcoinSimulation
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/simulate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinWalletInfo calls POST /api/v1/bitcoin/wallet-info.
+// State of a node wallet.
+func (c *Client) BitcoinWalletInfo(ctx context.Context, req *BitcoinWallet) (*BitcoinWalletInfo, error) {
+	var resp BitcoinWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/wallet-info", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinCreateWallet calls POST /api/v1/bitcoin/create-wallet.
+// Create a watch-only node wallet.
+func (c *Client) BitcoinCreateWallet(ctx context.Context, req *BitcoinCreateWallet) (*BitcoinCreatedWalletInfo, error) {
+	var resp BitcoinCreatedWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/create-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinLoadWallet calls POST /api/v1/bitcoin/load-wallet.
+// Load a node wallet.
+func (c *Client) BitcoinLoadWallet(ctx context.Context, req *BitcoinLoadWallet) (*BitcoinLoadWalletInfo, error) {
+	var resp BitcoinLoadWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/load-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinImportAddress calls POST /api/v1/bitcoin/import-address.
+// Watch an address in a legacy node wallet, descriptor wallets import addr() descriptors instead.
+func (c *Client) BitcoinImportAddress(ctx context.Context, req *BitcoinImportAddress) (*BitcoinImportAddressInfo, error) {
+	var resp BitcoinImportAddressInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/import-address", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinRescanWallet calls POST /api/v1/bitcoin/rescan-wallet.
+// Rescan the chain for wallet transactions.
+func (c *Client) BitcoinRescanWallet(ctx context.Context, req *BitcoinRescanWallet) (*BitcoinRescanWalletInfo, error) {
+	var resp BitcoinRescanWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/rescan-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinListUnspent calls POST /api/v1/bitcoin/list-utx.
+// Unspent outputs of an address.
+func (c *Client) BitcoinListUnspent(ctx context.Context, req *BitcoinListUnspent) (*BitcoinListUnspentInfo, error) {
+	var resp BitcoinListUnspentInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/list-utx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinCreateMultisig calls POST /api/v1/bitcoin/multisig/create.
+// Create an m-of-n P2WSH or P2SH-P2WSH multisig address from public keys or xpubs.
+func (c *Client) BitcoinCreateMultisig(ctx context.Context, req *BitcoinCreateMultisig) (*BitcoinMultisig, error) {
+	var resp BitcoinMultisig
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/multisig/create", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinCreateMultisigTransaction calls POST /api/v1/bitcoin/multisig/create-tx.
+// Build an unsigned transaction spending multisig outputs, along with the prev_txs co-signers sign with.
+func (c *Client) BitcoinCreateMultisigTransaction(ctx context.Context, req *BitcoinCreateMultisigTransaction) (*BitcoinCreatedMultisigTransaction, error) {
+	var resp BitcoinCreatedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/multisig/create-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinSignMultisigTransaction calls POST /api/v1/bitcoin/multisig/sign.
+// Add the signatures of one co-signer to a multisig transaction. Signing policies apply as for sign-raw-tx.
+func (c *Client) BitcoinSignMultisigTransaction(ctx context.Context, req *BitcoinSignMultisigTransaction) (*BitcoinSignedMultisigTransaction, error) {
+	var resp BitcoinSignedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/multisig/sign", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinCombineMultisigTransactions calls POST /api/v1/bitcoin/multisig/combine.
+// Merge copies of a multisig transaction signed by different co-signers.
+func (c *Client) BitcoinCombineMultisigTransactions(ctx context.Context, req *BitcoinCombineMultisigTransactions) (*BitcoinCombinedMultisigTransaction, error) {
+	var resp BitcoinCombinedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/multisig/combine", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinFinalizeMultisigTransaction calls POST /api/v1/bitcoin/multisig/finalize.
+// Check that a multisig transaction carries enough signatures to broadcast.
+func (c *Client) BitcoinFinalizeMultisigTransaction(ctx context.Context, req *BitcoinFinalizeMultisigTransaction) (*BitcoinSignedMultisigTransaction, error) {
+	var resp BitcoinSignedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/multisig/finalize", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinDescriptorInfo calls POST /api/v1/bitcoin/descriptor/info.
+// Validate an output descriptor and compute its checksum.
+func (c *Client) BitcoinDescriptorInfo(ctx context.Context, req *BitcoinDescriptor) (*BitcoinDescriptorInfo, error) {
+	var resp BitcoinDescriptorInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/descriptor/info", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinImportDescriptors calls POST /api/v1/bitcoin/descriptor/import.
+// Import wpkh, tr, sh(wsh(multi)) or addr descriptors into a descriptor node wallet, ranged descriptors need a range.
+func (c *Client) BitcoinImportDescriptors(ctx context.Context, req *BitcoinImportDescriptors) (*BitcoinImportDescriptorsInfo, error) {
+	var resp BitcoinImportDescriptorsInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/descriptor/import", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinDeriveAddresses calls POST /api/v1/bitcoin/descriptor/derive-addresses.
+// Addresses of an output descriptor, ranged descriptors need a range.
+func (c *Client) BitcoinDeriveAddresses(ctx context.Context, req *BitcoinDeriveAddresses) (*BitcoinDerivedAddresses, error) {
+	var resp BitcoinDerivedAddresses
+	if err := c.do(ctx, "POST", "/api/v1/bitcoin/descriptor/derive-addresses", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinBlock calls GET /api/v1/bitcoin/blocks/{block}.
+// Block by hash or height with a page of its txids.
+func (c *Client) BitcoinBlock(ctx context.Context, req *BitcoinBlock) (*BitcoinBlockInfo, error) {
+	var resp BitcoinBlockInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoin/blocks/{block}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinTransaction calls GET /api/v1/bitcoin/tx/{txid}.
+// Raw transaction, verbose adds inputs with the values of the outputs they spend and the fee, for transactions spending outputs of at most 100 transactions. Confirmed transactions outside node wallets need a node with -txindex.
+func (c *Client) BitcoinTransaction(ctx context.Context, req *BitcoinTransaction) (*BitcoinTransactionInfo, error) {
+	var resp BitcoinTransactionInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoin/tx/{txid}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinMempoolEntry calls GET /api/v1/bitcoin/tx/{txid}/mempool.
+// Mempool entry of an unconfirmed transaction.
+func (c *Client) BitcoinMempoolEntry(ctx context.Context, req *BitcoinMempoolEntry) (*BitcoinMempoolEntryInfo, error) {
+	var resp BitcoinMempoolEntryInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoin/tx/{txid}/mempool", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinTxOut calls GET /api/v1/bitcoin/tx/{txid}/out/{vout}.
+// Whether a transaction output is unspent, and its value if so.
+func (c *Client) BitcoinTxOut(ctx context.Context, req *BitcoinTxOut) (*BitcoinTxOutInfo, error) {
+	var resp BitcoinTxOutInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoin/tx/{txid}/out/{vout}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoinMempoolInfo calls GET /api/v1/bitcoin/mempool.
+// Size and fee floor of the node mempool.
+func (c *Client) BitcoinMempoolInfo(ctx context.Context, req *BitcoinMempool) (*BitcoinMempoolInfo, error) {
+	var resp BitcoinMempoolInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoin/mempool", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinStatusNode calls POST /api/v1/litecoin/status.
+// Blockchain info of the node.
+func (c *Client) LitecoinStatusNode(ctx context.Context, req *BitcoinStatusNode) (*BitcoinStatusNodeInfo, error) {
+	var resp BitcoinStatusNodeInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/status", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinCreateRawTransaction calls POST /api/v1/litecoin/create-raw-tx.
+// Build an unsigned transaction from the given UTXOs.
+func (c *Client) LitecoinCreateRawTransaction(ctx context.Context, req *BitcoinCreateRawTransaction) (*BitcoinCreatedRawTransaction, error) {
+	var resp BitcoinCreatedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/create-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinDecodeRawTransaction calls POST /api/v1/litecoin/decode-raw-tx.
+// Decode a raw transaction.
+func (c *Client) LitecoinDecodeRawTransaction(ctx context.Context, req *BitcoinDecodeRawTransaction) (*BitcoinDecodedRawTransaction, error) {
+	var resp BitcoinDecodedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/decode-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinFundForRawTransaction calls POST /api/v1/litecoin/fund-for-raw-tx.
+// Add inputs and change to a raw transaction.
+func (c *Client) LitecoinFundForRawTransaction(ctx context.Context, req *BitcoinFundForRawTransaction) (*BitcoinFundedRawTransaction, error) {
+	var resp BitcoinFundedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/fund-for-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinSignRawTransaction calls POST /api/v1/litecoin/sign-raw-tx.
+// Sign a raw transaction with a WIF key or the key of a wallet held by the wallet service. Signing policies may deny it or hold it for approval with a 202, sign again with the approval_id once approved.
+func (c *Client) LitecoinSignRawTransaction(ctx context.Context, req *BitcoinSignRawTransaction) (*BitcoinSignedRawTransaction, error) {
+	var resp BitcoinSignedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/sign-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinSendRawTransaction calls POST /api/v1/litecoin/send-raw-tx.
+// Broadcast a signed transaction once testmempoolaccept accepts it. A dry run stops after the check.
+func (c *Client) LitecoinSendRawTransaction(ctx context.Context, req *BitcoinSendRawTransaction) (*BitcoinSentRawTransaction, error) {
+	var resp BitcoinSentRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/send-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinSimulateTransaction calls POST /api/v1/litecoin/simulate.
+// Check a signed transaction with testmempoolaccept without broadcasting it.
+func (c *Client) LitecoinSimulateTransaction(ctx context.Context, req *BitcoinSimulateTransaction) (*BitcoinSimulation, error) {
+	var resp BitcoinSimulation
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/simulate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinWalletInfo calls POST /api/v1/litecoin/wallet-info.
+// State of a node wallet.
+func (c *Client) LitecoinWalletInfo(ctx context.Context, req *BitcoinWallet) (*BitcoinWalletInfo, error) {
+	var resp BitcoinWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/wallet-info", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinCreateWallet calls POST /api/v1/litecoin/create-wallet.
+// Create a watch-only node wallet.
+func (c *Client) LitecoinCreateWallet(ctx context.Context, req *BitcoinCreateWallet) (*BitcoinCreatedWalletInfo, error) {
+	var resp BitcoinCreatedWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/create-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinLoadWallet calls POST /api/v1/litecoin/load-wallet.
+// Load a node wallet.
+func (c *Client) LitecoinLoadWallet(ctx context.Context, req *BitcoinLoadWallet) (*BitcoinLoadWalletInfo, error) {
+	var resp BitcoinLoadWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/load-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinImportAddress calls POST /api/v1/litecoin/import-address.
+// Watch an address in a legacy node wallet, descriptor wallets import addr() descriptors instead.
+func (c *Client) LitecoinImportAddress(ctx context.Context, req *BitcoinImportAddress) (*BitcoinImportAddressInfo, error) {
+	var resp BitcoinImportAddressInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/import-address", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinRescanWallet calls POST /api/v1/litecoin/rescan-wallet.
+// Rescan the chain for wallet transactions.
+func (c *Client) LitecoinRescanWallet(ctx context.Context, req *BitcoinRescanWallet) (*BitcoinRescanWalletInfo, error) {
+	var resp BitcoinRescanWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/rescan-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinListUnspent calls POST /api/v1/litecoin/list-utx.
+// Unspent outputs of an address.
+func (c *Client) LitecoinListUnspent(ctx context.Context, req *BitcoinListUnspent) (*BitcoinListUnspentInfo, error) {
+	var resp BitcoinListUnspentInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/list-utx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinCreateMultisig calls POST /api/v1/litecoin/multisig/create.
+// Create an m-of-n P2WSH or P2SH-P2WSH multisig address from public keys or xpubs.
+func (c *Client) LitecoinCreateMultisig(ctx context.Context, req *BitcoinCreateMultisig) (*BitcoinMultisig, error) {
+	var resp BitcoinMultisig
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/multisig/create", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinCreateMultisigTransaction calls POST /api/v1/litecoin/multisig/create-tx.
+// Build an unsigned transaction spending multisig outputs, along with the prev_txs co-signers sign with.
+func (c *Client) LitecoinCreateMultisigTransaction(ctx context.Context, req *BitcoinCreateMultisigTransaction) (*BitcoinCreatedMultisigTransaction, error) {
+	var resp BitcoinCreatedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/multisig/create-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinSignMultisigTransaction calls POST /api/v1/litecoin/multisig/sign.
+// Add the signatures of one co-signer to a multisig transaction. Signing policies apply as for sign-raw-tx.
+func (c *Client) LitecoinSignMultisigTransaction(ctx context.Context, req *BitcoinSignMultisigTransaction) (*BitcoinSignedMultisigTransaction, error) {
+	var resp BitcoinSignedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/multisig/sign", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinCombineMultisigTransactions calls POST /api/v1/litecoin/multisig/combine.
+// Merge copies of a multisig transaction signed by different co-signers.
+func (c *Client) LitecoinCombineMultisigTransactions(ctx context.Context, req *BitcoinCombineMultisigTransactions) (*BitcoinCombinedMultisigTransaction, error) {
+	var resp BitcoinCombinedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/multisig/combine", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinFinalizeMultisigTransaction calls POST /api/v1/litecoin/multisig/finalize.
+// Check that a multisig transaction carries enough signatures to broadcast.
+func (c *Client) LitecoinFinalizeMultisigTransaction(ctx context.Context, req *BitcoinFinalizeMultisigTransaction) (*BitcoinSignedMultisigTransaction, error) {
+	var resp BitcoinSignedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/multisig/finalize", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinDescriptorInfo calls POST /api/v1/litecoin/descriptor/info.
+// Validate an output descriptor and compute its checksum.
+func (c *Client) LitecoinDescriptorInfo(ctx context.Context, req *BitcoinDescriptor) (*BitcoinDescriptorInfo, error) {
+	var resp BitcoinDescriptorInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/descriptor/info", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinImportDescriptors calls POST /api/v1/litecoin/descriptor/import.
+// Import wpkh, tr, sh(wsh(multi)) or addr descriptors into a descriptor node wallet, ranged descriptors need a range.
+func (c *Client) LitecoinImportDescriptors(ctx context.Context, req *BitcoinImportDescriptors) (*BitcoinImportDescriptorsInfo, error) {
+	var resp BitcoinImportDescriptorsInfo
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/descriptor/import", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinDeriveAddresses calls POST /api/v1/litecoin/descriptor/derive-addresses.
+// Addresses of an output descriptor, ranged descriptors need a range.
+func (c *Client) LitecoinDeriveAddresses(ctx context.Context, req *BitcoinDeriveAddresses) (*BitcoinDerivedAddresses, error) {
+	var resp BitcoinDerivedAddresses
+	if err := c.do(ctx, "POST", "/api/v1/litecoin/descriptor/derive-addresses", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinBlock calls GET /api/v1/litecoin/blocks/{block}.
+// Block by hash or height with a page of its txids.
+func (c *Client) LitecoinBlock(ctx context.Context, req *BitcoinBlock) (*BitcoinBlockInfo, error) {
+	var resp BitcoinBlockInfo
+	if err := c.do(ctx, "GET", "/api/v1/litecoin/blocks/{block}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinTransaction calls GET /api/v1/litecoin/tx/{txid}.
+// Raw transaction, verbose adds inputs with the values of the outputs they spend and the fee, for transactions spending outputs of at most 100 transactions. Confirmed transactions outside node wallets need a node with -txindex.
+func (c *Client) LitecoinTransaction(ctx context.Context, req *BitcoinTransaction) (*BitcoinTransactionInfo, error) {
+	var resp BitcoinTransactionInfo
+	if err := c.do(ctx, "GET", "/api/v1/litecoin/tx/{txid}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinMempoolEntry calls GET /api/v1/litecoin/tx/{txid}/mempool.
+// Mempool entry of an unconfirmed transaction.
+func (c *Client) LitecoinMempoolEntry(ctx context.Context, req *BitcoinMempoolEntry) (*BitcoinMempoolEntryInfo, error) {
+	var resp BitcoinMempoolEntryInfo
+	if err := c.do(ctx, "GET", "/api/v1/litecoin/tx/{txid}/mempool", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinTxOut calls GET /api/v1/litecoin/tx/{txid}/out/{vout}.
+// Whether a transaction output is unspent, and its value if so.
+func (c *Client) LitecoinTxOut(ctx context.Context, req *BitcoinTxOut) (*BitcoinTxOutInfo, error) {
+	var resp BitcoinTxOutInfo
+	if err := c.do(ctx, "GET", "/api/v1/litecoin/tx/{txid}/out/{vout}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LitecoinMempoolInfo calls GET /api/v1/litecoin/mempool.
+// Size and fee floor of the node mempool.
+func (c *Client) LitecoinMempoolInfo(ctx context.Context, req *BitcoinMempool) (*BitcoinMempoolInfo, error) {
+	var resp BitcoinMempoolInfo
+	if err := c.do(ctx, "GET", "/api/v1/litecoin/mempool", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinStatusNode calls POST /api/v1/dogecoin/status.
+// Blockchain info of the node.
+func (c *Client) DogecoinStatusNode(ctx context.Context, req *BitcoinStatusNode) (*BitcoinStatusNodeInfo, error) {
+	var resp BitcoinStatusNodeInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/status", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinCreateRawTransaction calls POST /api/v1/dogecoin/create-raw-tx.
+// Build an unsigned transaction from the given UTXOs.
+func (c *Client) DogecoinCreateRawTransaction(ctx context.Context, req *BitcoinCreateRawTransaction) (*BitcoinCreatedRawTransaction, error) {
+	var resp BitcoinCreatedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/create-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinDecodeRawTransaction calls POST /api/v1/dogecoin/decode-raw-tx.
+// Decode a raw transaction.
+func (c *Client) DogecoinDecodeRawTransaction(ctx context.Context, req *BitcoinDecodeRawTransaction) (*BitcoinDecodedRawTransaction, error) {
+	var resp BitcoinDecodedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/decode-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinFundForRawTransaction calls POST /api/v1/dogecoin/fund-for-raw-tx.
+// Add inputs and change to a raw transaction.
+func (c *Client) DogecoinFundForRawTransaction(ctx context.Context, req *BitcoinFundForRawTransaction) (*BitcoinFundedRawTransaction, error) {
+	var resp BitcoinFundedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/fund-for-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinSignRawTransaction calls POST /api/v1/dogecoin/sign-raw-tx.
+// Sign a raw transaction with a WIF key or the key of a wallet held by the wallet service. Signing policies may deny it or hold it for approval with a 202, sign again with the approval_id once approved.
+func (c *Client) DogecoinSignRawTransaction(ctx context.Context, req *BitcoinSignRawTransaction) (*BitcoinSignedRawTransaction, error) {
+	var resp BitcoinSignedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/sign-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinSendRawTransaction calls POST /api/v1/dogecoin/send-raw-tx.
+// Broadcast a signed transaction once testmempoolaccept accepts it. A dry run stops after the check.
+func (c *Client) DogecoinSendRawTransaction(ctx context.Context, req *BitcoinSendRawTransaction) (*BitcoinSentRawTransaction, error) {
+	var resp BitcoinSentRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/send-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinSimulateTransaction calls POST /api/v1/dogecoin/simulate.
+// Check a signed transaction with testmempoolaccept without broadcasting it.
+func (c *Client) DogecoinSimulateTransaction(ctx context.Context, req *BitcoinSimulateTransaction) (*BitcoinSimulation, error) {
+	var resp BitcoinSimulation
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/simulate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinWalletInfo calls POST /api/v1/dogecoin/wallet-info.
+// State of a node wallet.
+func (c *Client) DogecoinWalletInfo(ctx context.Context, req *BitcoinWallet) (*BitcoinWalletInfo, error) {
+	var resp BitcoinWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/wallet-info", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinCreateWallet calls POST /api/v1/dogecoin/create-wallet.
+// Create a watch-only node wallet.
+func (c *Client) DogecoinCreateWallet(ctx context.Context, req *BitcoinCreateWallet) (*BitcoinCreatedWalletInfo, error) {
+	var resp BitcoinCreatedWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/create-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinLoadWallet calls POST /api/v1/dogecoin/load-wallet.
+// Load a node wallet.
+func (c *Client) DogecoinLoadWallet(ctx context.Context, req *BitcoinLoadWallet) (*BitcoinLoadWalletInfo, error) {
+	var resp BitcoinLoadWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/load-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinImportAddress calls POST /api/v1/dogecoin/import-address.
+// Watch an address in a legacy node wallet, descriptor wallets import addr() descriptors instead.
+func (c *Client) DogecoinImportAddress(ctx context.Context, req *BitcoinImportAddress) (*BitcoinImportAddressInfo, error) {
+	var resp BitcoinImportAddressInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/import-address", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinRescanWallet calls POST /api/v1/dogecoin/rescan-wallet.
+// Rescan the chain for wallet transactions.
+func (c *Client) DogecoinRescanWallet(ctx context.Context, req *BitcoinRescanWallet) (*BitcoinRescanWalletInfo, error) {
+	var resp BitcoinRescanWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/rescan-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinListUnspent calls POST /api/v1/dogecoin/list-utx.
+// Unspent outputs of an address.
+func (c *Client) DogecoinListUnspent(ctx context.Context, req *BitcoinListUnspent) (*BitcoinListUnspentInfo, error) {
+	var resp BitcoinListUnspentInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/list-utx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinCreateMultisig calls POST /api/v1/dogecoin/multisig/create.
+// Create an m-of-n P2WSH or P2SH-P2WSH multisig address from public keys or xpubs.
+func (c *Client) DogecoinCreateMultisig(ctx context.Context, req *BitcoinCreateMultisig) (*BitcoinMultisig, error) {
+	var resp BitcoinMultisig
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/multisig/create", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinCreateMultisigTransaction calls POST /api/v1/dogecoin/multisig/create-tx.
+// Build an unsigned transaction spending multisig outputs, along with the prev_txs co-signers sign with.
+func (c *Client) DogecoinCreateMultisigTransaction(ctx context.Context, req *BitcoinCreateMultisigTransaction) (*BitcoinCreatedMultisigTransaction, error) {
+	var resp BitcoinCreatedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/multisig/create-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinSignMultisigTransaction calls POST /api/v1/dogecoin/multisig/sign.
+// Add the signatures of one co-signer to a multisig transaction. Signing policies apply as for sign-raw-tx.
+func (c *Client) DogecoinSignMultisigTransaction(ctx context.Context, req *BitcoinSignMultisigTransaction) (*BitcoinSignedMultisigTransaction, error) {
+	var resp BitcoinSignedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/multisig/sign", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinCombineMultisigTransactions calls POST /api/v1/dogecoin/multisig/combine.
+// Merge copies of a multisig transaction signed by different co-signers.
+func (c *Client) DogecoinCombineMultisigTransactions(ctx context.Context, req *BitcoinCombineMultisigTransactions) (*BitcoinCombinedMultisigTransaction, error) {
+	var resp BitcoinCombinedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/multisig/combine", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinFinalizeMultisigTransaction calls POST /api/v1/dogecoin/multisig/finalize.
+// Check that a multisig transaction carries enough signatures to broadcast.
+func (c *Client) DogecoinFinalizeMultisigTransaction(ctx context.Context, req *BitcoinFinalizeMultisigTransaction) (*BitcoinSignedMultisigTransaction, error) {
+	var resp BitcoinSignedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/multisig/finalize", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinDescriptorInfo calls POST /api/v1/dogecoin/descriptor/info.
+// Validate an output descriptor and compute its checksum.
+func (c *Client) DogecoinDescriptorInfo(ctx context.Context, req *BitcoinDescriptor) (*BitcoinDescriptorInfo, error) {
+	var resp BitcoinDescriptorInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/descriptor/info", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinImportDescriptors calls POST /api/v1/dogecoin/descriptor/import.
+// Import wpkh, tr, sh(wsh(multi)) or addr descriptors into a descriptor node wallet, ranged descriptors need a range.
+func (c *Client) DogecoinImportDescriptors(ctx context.Context, req *BitcoinImportDescriptors) (*BitcoinImportDescriptorsInfo, error) {
+	var resp BitcoinImportDescriptorsInfo
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/descriptor/import", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinDeriveAddresses calls POST /api/v1/dogecoin/descriptor/derive-addresses.
+// Addresses of an output descriptor, ranged descriptors need a range.
+func (c *Client) DogecoinDeriveAddresses(ctx context.Context, req *BitcoinDeriveAddresses) (*BitcoinDerivedAddresses, error) {
+	var resp BitcoinDerivedAddresses
+	if err := c.do(ctx, "POST", "/api/v1/dogecoin/descriptor/derive-addresses", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinBlock calls GET /api/v1/dogecoin/blocks/{block}.
+// Block by hash or height with a page of its txids.
+func (c *Client) DogecoinBlock(ctx context.Context, req *BitcoinBlock) (*BitcoinBlockInfo, error) {
+	var resp BitcoinBlockInfo
+	if err := c.do(ctx, "GET", "/api/v1/dogecoin/blocks/{block}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinTransaction calls GET /api/v1/dogecoin/tx/{txid}.
+// Raw transaction, verbose adds inputs with the values of the outputs they spend and the fee, for transactions spending outputs of at most 100 transactions. Confirmed transactions outside node wallets need a node with -txindex.
+func (c *Client) DogecoinTransaction(ctx context.Context, req *BitcoinTransaction) (*BitcoinTransactionInfo, error) {
+	var resp BitcoinTransactionInfo
+	if err := c.do(ctx, "GET", "/api/v1/dogecoin/tx/{txid}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinMempoolEntry calls GET /api/v1/dogecoin/tx/{txid}/mempool.
+// Mempool entry of an unconfirmed transaction.
+func (c *Client) DogecoinMempoolEntry(ctx context.Context, req *BitcoinMempoolEntry) (*BitcoinMempoolEntryInfo, error) {
+	var resp BitcoinMempoolEntryInfo
+	if err := c.do(ctx, "GET", "/api/v1/dogecoin/tx/{txid}/mempool", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinTxOut calls GET /api/v1/dogecoin/tx/{txid}/out/{vout}.
+// Whether a transaction output is unspent, and its value if so.
+func (c *Client) DogecoinTxOut(ctx context.Context, req *BitcoinTxOut) (*BitcoinTxOutInfo, error) {
+	var resp BitcoinTxOutInfo
+	if err := c.do(ctx, "GET", "/api/v1/dogecoin/tx/{txid}/out/{vout}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DogecoinMempoolInfo calls GET /api/v1/dogecoin/mempool.
+// Size and fee floor of the node mempool.
+func (c *Client) DogecoinMempoolInfo(ctx context.Context, req *BitcoinMempool) (*BitcoinMempoolInfo, error) {
+	var resp BitcoinMempoolInfo
+	if err := c.do(ctx, "GET", "/api/v1/dogecoin/mempool", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashStatusNode calls POST /api/v1/bitcoincash/status.
+// Blockchain info of the node.
+func (c *Client) BitcoincashStatusNode(ctx context.Context, req *BitcoinStatusNode) (*BitcoinStatusNodeInfo, error) {
+	var resp BitcoinStatusNodeInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/status", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashCreateRawTransaction calls POST /api/v1/bitcoincash/create-raw-tx.
+// Build an unsigned transaction from the given UTXOs.
+func (c *Client) BitcoincashCreateRawTransaction(ctx context.Context, req *BitcoinCreateRawTransaction) (*BitcoinCreatedRawTransaction, error) {
+	var resp BitcoinCreatedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/create-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashDecodeRawTransaction calls POST /api/v1/bitcoincash/decode-raw-tx.
+// Decode a raw transaction.
+func (c *Client) BitcoincashDecodeRawTransaction(ctx context.Context, req *BitcoinDecodeRawTransaction) (*BitcoinDecodedRawTransaction, error) {
+	var resp BitcoinDecodedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/decode-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashFundForRawTransaction calls POST /api/v1/bitcoincash/fund-for-raw-tx.
+// Add inputs and change to a raw transaction.
+func (c *Client) BitcoincashFundForRawTransaction(ctx context.Context, req *BitcoinFundForRawTransaction) (*BitcoinFundedRawTransaction, error) {
+	var resp BitcoinFundedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/fund-for-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashSignRawTransaction calls POST /api/v1/bitcoincash/sign-raw-tx.
+// Sign a raw transaction with a WIF key or the key of a wallet held by the wallet service. Signing policies may deny it or hold it for approval with a 202, sign again with the approval_id once approved.
+func (c *Client) BitcoincashSignRawTransaction(ctx context.Context, req *BitcoinSignRawTransaction) (*BitcoinSignedRawTransaction, error) {
+	var resp BitcoinSignedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/sign-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashSendRawTransaction calls POST /api/v1/bitcoincash/send-raw-tx.
+// Broadcast a signed transaction once testmempoolaccept accepts it. A dry run stops after the check.
+func (c *Client) BitcoincashSendRawTransaction(ctx context.Context, req *BitcoinSendRawTransaction) (*BitcoinSentRawTransaction, error) {
+	var resp BitcoinSentRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/send-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashSimulateTransaction calls POST /api/v1/bitcoincash/simulate.
+// Check a signed transaction with testmempoolaccept without broadcasting it.
+func (c *Client) BitcoincashSimulateTransaction(ctx context.Context, req *BitcoinSimulateTransaction) (*BitcoinSimulation, error) {
+	var resp BitcoinSimulation
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/simulate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashWalletInfo calls POST /api/v1/bitcoincash/wallet-info.
+// State of a node wallet.
+func (c *Client) BitcoincashWalletInfo(ctx context.Context, req *BitcoinWallet) (*BitcoinWalletInfo, error) {
+	var resp BitcoinWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/wallet-info", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashCreateWallet calls POST /api/v1/bitcoincash/create-wallet.
+// Create a watch-only node wallet.
+func (c *Client) BitcoincashCreateWallet(ctx context.Context, req *BitcoinCreateWallet) (*BitcoinCreatedWalletInfo, error) {
+	var resp BitcoinCreatedWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/create-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashLoadWallet calls POST /api/v1/bitcoincash/load-wallet.
+// Load a node wallet.
+func (c *Client) BitcoincashLoadWallet(ctx context.Context, req *BitcoinLoadWallet) (*BitcoinLoadWalletInfo, error) {
+	var resp BitcoinLoadWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/load-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashImportAddress calls POST /api/v1/bitcoincash/import-address.
+// Watch an address in a legacy node wallet, descriptor wallets import addr() descriptors instead.
+func (c *Client) BitcoincashImportAddress(ctx context.Context, req *BitcoinImportAddress) (*BitcoinImportAddressInfo, error) {
+	var resp BitcoinImportAddressInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/import-address", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashRescanWallet calls POST /api/v1/bitcoincash/rescan-wallet.
+// Rescan the chain for wallet transactions.
+func (c *Client) BitcoincashRescanWallet(ctx context.Context, req *BitcoinRescanWallet) (*BitcoinRescanWalletInfo, error) {
+	var resp BitcoinRescanWalletInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/rescan-wallet", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashListUnspent calls POST /api/v1/bitcoincash/list-utx.
+// Unspent outputs of an address.
+func (c *Client) BitcoincashListUnspent(ctx context.Context, req *BitcoinListUnspent) (*BitcoinListUnspentInfo, error) {
+	var resp BitcoinListUnspentInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/list-utx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashCreateMultisig calls POST /api/v1/bitcoincash/multisig/create.
+// Create an m-of-n P2WSH or P2SH-P2WSH multisig address from public keys or xpubs.
+func (c *Client) BitcoincashCreateMultisig(ctx context.Context, req *BitcoinCreateMultisig) (*BitcoinMultisig, error) {
+	var resp BitcoinMultisig
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/multisig/create", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashCreateMultisigTransaction calls POST /api/v1/bitcoincash/multisig/create-tx.
+// Build an unsigned transaction spending multisig outputs, along with the prev_txs co-signers sign with.
+func (c *Client) BitcoincashCreateMultisigTransaction(ctx context.Context, req *BitcoinCreateMultisigTransaction) (*BitcoinCreatedMultisigTransaction, error) {
+	var resp BitcoinCreatedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/multisig/create-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashSignMultisigTransaction calls POST /api/v1/bitcoincash/multisig/sign.
+// Add the signatures of one co-signer to a multisig transaction. Signing policies apply as for sign-raw-tx.
+func (c *Client) BitcoincashSignMultisigTransaction(ctx context.Context, req *BitcoinSignMultisigTransaction) (*BitcoinSignedMultisigTransaction, error) {
+	var resp BitcoinSignedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/multisig/sign", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashCombineMultisigTransactions calls POST /api/v1/bitcoincash/multisig/combine.
+// Merge copies of a multisig transaction signed by different co-signers.
+func (c *Client) BitcoincashCombineMultisigTransactions(ctx context.Context, req *BitcoinCombineMultisigTransactions) (*BitcoinCombinedMultisigTransaction, error) {
+	var resp BitcoinCombinedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/multisig/combine", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashFinalizeMultisigTransaction calls POST /api/v1/bitcoincash/multisig/finalize.
+// Check that a multisig transaction carries enough signatures to broadcast.
+func (c *Client) BitcoincashFinalizeMultisigTransaction(ctx context.Context, req *BitcoinFinalizeMultisigTransaction) (*BitcoinSignedMultisigTransaction, error) {
+	var resp BitcoinSignedMultisigTransaction
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/multisig/finalize", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashDescriptorInfo calls POST /api/v1/bitcoincash/descriptor/info.
+// Validate an output descriptor and compute its checksum.
+func (c *Client) BitcoincashDescriptorInfo(ctx context.Context, req *BitcoinDescriptor) (*BitcoinDescriptorInfo, error) {
+	var resp BitcoinDescriptorInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/descriptor/info", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashImportDescriptors calls POST /api/v1/bitcoincash/descriptor/import.
+// Import wpkh, tr, sh(wsh(multi)) or addr descriptors into a descriptor node wallet, ranged descriptors need a range.
+func (c *Client) BitcoincashImportDescriptors(ctx context.Context, req *BitcoinImportDescriptors) (*BitcoinImportDescriptorsInfo, error) {
+	var resp BitcoinImportDescriptorsInfo
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/descriptor/import", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashDeriveAddresses calls POST /api/v1/bitcoincash/descriptor/derive-addresses.
+// Addresses of an output descriptor, ranged descriptors need a range.
+func (c *Client) BitcoincashDeriveAddresses(ctx context.Context, req *BitcoinDeriveAddresses) (*BitcoinDerivedAddresses, error) {
+	var resp BitcoinDerivedAddresses
+	if err := c.do(ctx, "POST", "/api/v1/bitcoincash/descriptor/derive-addresses", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashBlock calls GET /api/v1/bitcoincash/blocks/{block}.
+// Block by hash or height with a page of its txids.
+func (c *Client) BitcoincashBlock(ctx context.Context, req *BitcoinBlock) (*BitcoinBlockInfo, error) {
+	var resp BitcoinBlockInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoincash/blocks/{block}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashTransaction calls GET /api/v1/bitcoincash/tx/{txid}.
+// Raw transaction, verbose adds inputs with the values of the outputs they spend and the fee, for transactions spending outputs of at most 100 transactions. Confirmed transactions outside node wallets need a node with -txindex.
+func (c *Client) BitcoincashTransaction(ctx context.Context, req *BitcoinTransaction) (*BitcoinTransactionInfo, error) {
+	var resp BitcoinTransactionInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoincash/tx/{txid}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashMempoolEntry calls GET /api/v1/bitcoincash/tx/{txid}/mempool.
+// Mempool entry of an unconfirmed transaction.
+func (c *Client) BitcoincashMempoolEntry(ctx context.Context, req *BitcoinMempoolEntry) (*BitcoinMempoolEntryInfo, error) {
+	var resp BitcoinMempoolEntryInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoincash/tx/{txid}/mempool", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashTxOut calls GET /api/v1/bitcoincash/tx/{txid}/out/{vout}.
+// Whether a transaction output is unspent, and its value if so.
+func (c *Client) BitcoincashTxOut(ctx context.Context, req *BitcoinTxOut) (*BitcoinTxOutInfo, error) {
+	var resp BitcoinTxOutInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoincash/tx/{txid}/out/{vout}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BitcoincashMempoolInfo calls GET /api/v1/bitcoincash/mempool.
+// Size and fee floor of the node mempool.
+func (c *Client) BitcoincashMempoolInfo(ctx context.Context, req *BitcoinMempool) (*BitcoinMempoolInfo, error) {
+	var resp BitcoinMempoolInfo
+	if err := c.do(ctx, "GET", "/api/v1/bitcoincash/mempool", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumStatusNode calls POST /api/v1/ethereum/status.
+// Sync status of the node.
+func (c *Client) EthereumStatusNode(ctx context.Context, req *EthereumStatusNode) (*EthereumNodeInfo, error) {
+	var resp EthereumNodeInfo
+	if err := c.do(ctx, "POST", "/api/v1/ethereum/status", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumCreateRawTransaction calls POST /api/v1/ethereum/create-raw-tx.
+// Build an unsigned EIP-1559 transfer.
+func (c *Client) EthereumCreateRawTransaction(ctx context.Context, req *EthereumCreateRawTransaction) (*EthereumCreatedRawTransaction, error) {
+	var resp EthereumCreatedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/ethereum/create-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumSignRawTransaction calls POST /api/v1/ethereum/sign-raw-tx.
+// Sign a raw transaction with a hex key or the key of a wallet held by the wallet service. Signing policies may deny it or hold it for approval with a 202, sign again with the approval_id once approved.
+func (c *Client) EthereumSignRawTransaction(ctx context.Context, req *EthereumSignRawTransaction) (*EthereumSignedRawTransaction, error) {
+	var resp EthereumSignedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/ethereum/sign-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumSendRawTransaction calls POST /api/v1/ethereum/send-raw-tx.
+// Broadcast a signed transaction once it passes the pre-flight checks of /simulate. A dry run stops after the checks.
+func (c *Client) EthereumSendRawTransaction(ctx context.Context, req *EthereumSendRawTransaction) (*EthereumSentRawTransaction, error) {
+	var resp EthereumSentRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/ethereum/send-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumSimulateTransaction calls POST /api/v1/ethereum/simulate.
+// Check a signed transaction without broadcasting it: chain id, sender, nonce, balance and a replay as eth_call.
+func (c *Client) EthereumSimulateTransaction(ctx context.Context, req *EthereumSimulateTransaction) (*EthereumSimulation, error) {
+	var resp EthereumSimulation
+	if err := c.do(ctx, "POST", "/api/v1/ethereum/simulate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumBlock calls GET /api/v1/ethereum/blocks/{block}.
+// Block by number, hash or tag, with its transaction hashes or, when full, its transactions.
+func (c *Client) EthereumBlock(ctx context.Context, req *EthereumBlock) (*EthereumBlockInfo, error) {
+	var resp EthereumBlockInfo
+	if err := c.do(ctx, "GET", "/api/v1/ethereum/blocks/{block}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumGetLogs calls POST /api/v1/ethereum/logs.
+// Logs of contracts and topics over a block range of up to 100000 blocks, queried in chunks the node accepts. Logs are decoded into events when an ABI is given.
+func (c *Client) EthereumGetLogs(ctx context.Context, req *EthereumGetLogs) (*EthereumLogs, error) {
+	var resp EthereumLogs
+	if err := c.do(ctx, "POST", "/api/v1/ethereum/logs", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumCallContract calls POST /api/v1/ethereum/contract/call.
+// Read-only call of a contract method described by an ABI fragment, with decoded outputs or the revert reason.
+func (c *Client) EthereumCallContract(ctx context.Context, req *EthereumContractCall) (*EthereumContractCallResult, error) {
+	var resp EthereumContractCallResult
+	if err := c.do(ctx, "POST", "/api/v1/ethereum/contract/call", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EthereumCreateContractTransaction calls POST /api/v1/ethereum/contract/create-tx.
+// Build an unsigned call of a contract method described by an ABI fragment, to sign with /sign-raw-tx.
+func (c *Client) EthereumCreateContractTransaction(ctx context.Context, req *EthereumCreateContractTransaction) (*EthereumCreatedContractTransaction, error) {
+	var resp EthereumCreatedContractTransaction
+	if err := c.do(ctx, "POST", "/api/v1/ethereum/contract/create-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmStatusNode calls POST /api/v1/evm/{chain}/status.
+// Sync status of the node.
+func (c *Client) EvmStatusNode(ctx context.Context, chain string, req *EthereumStatusNode) (*EthereumNodeInfo, error) {
+	var resp EthereumNodeInfo
+	if err := c.do(ctx, "POST", "/api/v1/evm/"+url.PathEscape(chain)+"/status", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmCreateRawTransaction calls POST /api/v1/evm/{chain}/create-raw-tx.
+// Build an unsigned EIP-1559 transfer.
+func (c *Client) EvmCreateRawTransaction(ctx context.Context, chain string, req *EthereumCreateRawTransaction) (*EthereumCreatedRawTransaction, error) {
+	var resp EthereumCreatedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/evm/"+url.PathEscape(chain)+"/create-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmSignRawTransaction calls POST /api/v1/evm/{chain}/sign-raw-tx.
+// Sign a raw transaction with a hex key or the key of a wallet held by the wallet service. Signing policies may deny it or hold it for approval with a 202, sign again with the approval_id once approved.
+func (c *Client) EvmSignRawTransaction(ctx context.Context, chain string, req *EthereumSignRawTransaction) (*EthereumSignedRawTransaction, error) {
+	var resp EthereumSignedRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/evm/"+url.PathEscape(chain)+"/sign-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmSendRawTransaction calls POST /api/v1/evm/{chain}/send-raw-tx.
+// Broadcast a signed transaction once it passes the pre-flight checks of /simulate. A dry run stops after the checks.
+func (c *Client) EvmSendRawTransaction(ctx context.Context, chain string, req *EthereumSendRawTransaction) (*EthereumSentRawTransaction, error) {
+	var resp EthereumSentRawTransaction
+	if err := c.do(ctx, "POST", "/api/v1/evm/"+url.PathEscape(chain)+"/send-raw-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmSimulateTransaction calls POST /api/v1/evm/{chain}/simulate.
+// Check a signed transaction without broadcasting it: chain id, sender, nonce, balance and a replay as eth_call.
+func (c *Client) EvmSimulateTransaction(ctx context.Context, chain string, req *EthereumSimulateTransaction) (*EthereumSimulation, error) {
+	var resp EthereumSimulation
+	if err := c.do(ctx, "POST", "/api/v1/evm/"+url.PathEscape(chain)+"/simulate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmBlock calls GET /api/v1/evm/{chain}/blocks/{block}.
+// Block by number, hash or tag, with its transaction hashes or, when full, its transactions.
+func (c *Client) EvmBlock(ctx context.Context, chain string, req *EthereumBlock) (*EthereumBlockInfo, error) {
+	var resp EthereumBlockInfo
+	if err := c.do(ctx, "GET", "/api/v1/evm/"+url.PathEscape(chain)+"/blocks/{block}", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmGetLogs calls POST /api/v1/evm/{chain}/logs.
+// Logs of contracts and topics over a block range of up to 100000 blocks, queried in chunks the node accepts. Logs are decoded into events when an ABI is given.
+func (c *Client) EvmGetLogs(ctx context.Context, chain string, req *EthereumGetLogs) (*EthereumLogs, error) {
+	var resp EthereumLogs
+	if err := c.do(ctx, "POST", "/api/v1/evm/"+url.PathEscape(chain)+"/logs", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmCallContract calls POST /api/v1/evm/{chain}/contract/call.
+// Read-only call of a contract method described by an ABI fragment, with decoded outputs or the revert reason.
+func (c *Client) EvmCallContract(ctx context.Context, chain string, req *EthereumContractCall) (*EthereumContractCallResult, error) {
+	var resp EthereumContractCallResult
+	if err := c.do(ctx, "POST", "/api/v1/evm/"+url.PathEscape(chain)+"/contract/call", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EvmCreateContractTransaction calls POST /api/v1/evm/{chain}/contract/create-tx.
+// Build an unsigned call of a contract method described by an ABI fragment, to sign with /sign-raw-tx.
+func (c *Client) EvmCreateContractTransaction(ctx context.Context, chain string, req *EthereumCreateContractTransaction) (*EthereumCreatedContractTransaction, error) {
+	var resp EthereumCreatedContractTransaction
+	if err := c.do(ctx, "POST", "/api/v1/evm/"+url.PathEscape(chain)+"/contract/create-tx", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// KeystoreImportKeystoreKey calls POST /api/v1/keystore/import.
+// Encrypt a private key into the keystore, sign requests then reference it by key_id.
+func (c *Client) KeystoreImportKeystoreKey(ctx context.Context, req *KeystoreImportKey) (*Key, error) {
+	var resp Key
+	if err := c.do(ctx, "POST", "/api/v1/keystore/import", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// KeystoreListKeystoreKeys calls GET /api/v1/keystore/keys.
+// List the keystore keys without their key material.
+func (c *Client) KeystoreListKeystoreKeys(ctx context.Context) (*KeystoreKeys, error) {
+	var resp KeystoreKeys
+	if err := c.do(ctx, "GET", "/api/v1/keystore/keys", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PolicyListPolicies calls GET /api/v1/policy/policies.
+// List the signing policies loaded from the policy file.
+func (c *Client) PolicyListPolicies(ctx context.Context) (*PolicyPolicies, error) {
+	var resp PolicyPolicies
+	if err := c.do(ctx, "GET", "/api/v1/policy/policies", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PolicyListApprovals calls GET /api/v1/policy/approvals.
+// List transactions held for approval and their decisions.
+func (c *Client) PolicyListApprovals(ctx context.Context) (*PolicyApprovals, error) {
+	var resp PolicyApprovals
+	if err := c.do(ctx, "GET", "/api/v1/policy/approvals", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PolicyApproveTransaction calls POST /api/v1/policy/approve.
+// Approve a held transaction, signing it again with the approval_id then passes the policy. The requester cannot approve.
+func (c *Client) PolicyApproveTransaction(ctx context.Context, req *PolicyDecide) (*Approval, error) {
+	var resp Approval
+	if err := c.do(ctx, "POST", "/api/v1/policy/approve", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PolicyRejectTransaction calls POST /api/v1/policy/reject.
+// Reject a held transaction.
+func (c *Client) PolicyRejectTransaction(ctx context.Context, req *PolicyDecide) (*Approval, error) {
+	var resp Approval
+	if err := c.do(ctx, "POST", "/api/v1/policy/reject", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
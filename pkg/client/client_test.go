@@ -0,0 +1,274 @@
+package client_test
+
+import (
+	"context"
+	gErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/internal/api"
+	"nn-blockchain-api/internal/bitcoin"
+	mock_bitcoin "nn-blockchain-api/internal/bitcoin/mocks"
+	"nn-blockchain-api/internal/ethereum"
+	mock_ethereum "nn-blockchain-api/internal/ethereum/mocks"
+	"nn-blockchain-api/internal/health"
+	"nn-blockchain-api/internal/keystore"
+	networks_handler "nn-blockchain-api/internal/networks"
+	"nn-blockchain-api/internal/policy"
+	"nn-blockchain-api/internal/quota"
+	"nn-blockchain-api/internal/wallet"
+	mock_wallet "nn-blockchain-api/internal/wallet/mocks"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/client"
+	"nn-blockchain-api/pkg/codes"
+	"nn-blockchain-api/pkg/errors"
+	mock_keystore "nn-blockchain-api/pkg/keystore/mocks"
+	"nn-blockchain-api/pkg/networks"
+	mock_policy "nn-blockchain-api/pkg/policy/mocks"
+	"nn-blockchain-api/pkg/ratelimit"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type services struct {
+	bitcoin  *mock_bitcoin.MockService
+	ethereum *mock_ethereum.MockService
+	wallet   *mock_wallet.MockService
+	keystore *mock_keystore.MockKeystore
+	policy   *mock_policy.MockEngine
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		opts    []client.Option
+		wantErr error
+	}{
+		{name: "success", baseURL: "http://localhost:8080"},
+		{name: "relative url", baseURL: "/api", wantErr: gErrors.New("invalid base url")},
+		{name: "invalid url", baseURL: "://", wantErr: gErrors.New("invalid base url")},
+		{name: "invalid http client", baseURL: "http://localhost", opts: []client.Option{client.WithHTTPClient(nil)}, wantErr: gErrors.New("invalid http client")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := client.New(tt.baseURL, tt.opts...)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.NotNil(t, c)
+			}
+		})
+	}
+}
+
+func TestClient_Contract(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("bitcoin create raw transaction", func(t *testing.T) {
+		c, svc := newClient(t, "secret")
+		svc.bitcoin.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, dto *bitcoin.CreateRawTransactionDTO) (*bitcoin.CreatedRawTransactionDTO, error) {
+				assert.Equal(t, "mkHS9ne12qx9pS9VojpwU5xtRd4T7X7ZUt", dto.FromAddress)
+				assert.Equal(t, int64(1000), dto.Amount)
+				assert.Len(t, dto.Utxo, 1)
+				return &bitcoin.CreatedRawTransactionDTO{Tx: "0200", Fee: 0.0001}, nil
+			})
+
+		req := &client.BitcoinCreateRawTransaction{
+			FromAddress: "mkHS9ne12qx9pS9VojpwU5xtRd4T7X7ZUt",
+			ToAddress:   "mkHS9ne12qx9pS9VojpwU5xtRd4T7X7ZUt",
+			Amount:      1000,
+			Network:     "test",
+		}
+		req.Utxo = append(req.Utxo, struct {
+			TxId     string `json:"txid"`
+			Vout     int64  `json:"vout"`
+			Amount   int64  `json:"amount"`
+			PKScript string `json:"pk_script"`
+		}{
+			TxId:     "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b",
+			Amount:   5000,
+			PKScript: "76a914",
+		})
+
+		res, err := c.BitcoinCreateRawTransaction(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, &client.BitcoinCreatedRawTransaction{Tx: "0200", Fee: 0.0001}, res)
+	})
+
+	t.Run("bitcoin transaction output", func(t *testing.T) {
+		c, svc := newClient(t, "secret")
+		svc.bitcoin.EXPECT().TxOut(gomock.Any(), &bitcoin.TxOutDTO{
+			TxId:          "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b",
+			Vout:          1,
+			Network:       "test",
+			ConfirmedOnly: true,
+		}).Return(&bitcoin.TxOutInfoDTO{Unspent: true, Value: 0.5}, nil)
+
+		res, err := c.BitcoinTxOut(ctx, &client.BitcoinTxOut{
+			TxId:          "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b",
+			Vout:          1,
+			Network:       "test",
+			ConfirmedOnly: true,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &client.BitcoinTxOutInfo{Unspent: true, Value: 0.5}, res)
+	})
+
+	t.Run("bitcoin invalid query parameter", func(t *testing.T) {
+		c, _ := newClient(t, "secret")
+
+		_, err := c.BitcoinBlock(ctx, &client.BitcoinBlock{Block: "7", Network: "Moon"})
+
+		var apiErr *errors.Error
+		assert.True(t, gErrors.As(err, &apiErr))
+		assert.Equal(t, codes.Code(codes.BadRequest), apiErr.Code)
+	})
+
+	t.Run("ethereum send raw transaction", func(t *testing.T) {
+		c, svc := newClient(t, "secret")
+		svc.ethereum.EXPECT().SendTransaction(gomock.Any(), &ethereum.SendRawTransactionDTO{SignedTx: "f86b", Network: "main"}).
+			Return(&ethereum.SentRawTransactionDTO{TxId: "0xabc"}, nil)
+
+		res, err := c.EthereumSendRawTransaction(ctx, &client.EthereumSendRawTransaction{SignedTx: "f86b", Network: "main"})
+		assert.Nil(t, err)
+		assert.Equal(t, "0xabc", res.TxId)
+	})
+
+	t.Run("wallet create mnemonic", func(t *testing.T) {
+		c, svc := newClient(t, "secret")
+		svc.wallet.EXPECT().CreateMnemonic(gomock.Any(), "12", "english").
+			Return(&wallet.CreatedMnemonicDTO{Mnemonic: "abandon ability"}, nil)
+
+		res, err := c.WalletCreateMnemonic(ctx, &client.WalletMnemonic{Length: "12", Language: "english"})
+		assert.Nil(t, err)
+		assert.Equal(t, "abandon ability", res.Mnemonic)
+	})
+
+	t.Run("health and quota", func(t *testing.T) {
+		c, _ := newClient(t, "secret")
+
+		res, err := c.HealthCheck(ctx)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, res)
+
+		usage, err := c.QuotaUsage(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "key:test", usage.Client)
+		assert.Equal(t, "reads", usage.Quotas[0].Class)
+		assert.Equal(t, int64(1), usage.Quotas[0].Used)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		c, _ := newClient(t, "secret")
+
+		_, err := c.EthereumSendRawTransaction(ctx, &client.EthereumSendRawTransaction{SignedTx: "0xzz", Network: "moon"})
+
+		var apiErr *errors.Error
+		assert.True(t, gErrors.As(err, &apiErr))
+		assert.Equal(t, codes.Code(codes.BadRequest), apiErr.Code)
+		assert.NotEmpty(t, apiErr.Errors)
+	})
+
+	t.Run("node rejection", func(t *testing.T) {
+		c, svc := newClient(t, "secret")
+		svc.bitcoin.EXPECT().SendTransaction(gomock.Any(), gomock.Any()).
+			Return(nil, errors.FromBitcoinRPC(-27, "transaction already in block chain"))
+
+		_, err := c.BitcoinSendRawTransaction(ctx, &client.BitcoinSendRawTransaction{SignedTx: "0200", Network: "test"})
+
+		var apiErr *errors.Error
+		assert.True(t, gErrors.As(err, &apiErr))
+		assert.Equal(t, codes.Code(codes.DuplicateError), apiErr.Code)
+		assert.Equal(t, errors.StatusTxAlreadyKnown, apiErr.Status)
+	})
+
+	t.Run("missing api key", func(t *testing.T) {
+		c, _ := newClient(t, "")
+
+		_, err := c.EthereumSendRawTransaction(ctx, &client.EthereumSendRawTransaction{SignedTx: "f86b", Network: "main"})
+
+		var apiErr *errors.Error
+		assert.True(t, gErrors.As(err, &apiErr))
+		assert.Equal(t, codes.Code(codes.Unauthorized), apiErr.Code)
+	})
+
+	t.Run("unexpected response", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "bad gateway", http.StatusBadGateway)
+		}))
+		defer srv.Close()
+		c, err := client.New(srv.URL)
+		assert.Nil(t, err)
+
+		_, err = c.HealthCheck(ctx)
+
+		var apiErr *errors.Error
+		assert.True(t, gErrors.As(err, &apiErr))
+		assert.Equal(t, codes.Code(http.StatusBadGateway), apiErr.Code)
+		assert.Equal(t, client.StatusUnexpectedResponse, apiErr.Status)
+	})
+}
+
+func newClient(t *testing.T, apiKey string) (*client.Client, services) {
+	controller := gomock.NewController(t)
+	t.Cleanup(controller.Finish)
+
+	svc := services{
+		bitcoin:  mock_bitcoin.NewMockService(controller),
+		ethereum: mock_ethereum.NewMockService(controller),
+		wallet:   mock_wallet.NewMockService(controller),
+		keystore: mock_keystore.NewMockKeystore(controller),
+		policy:   mock_policy.NewMockEngine(controller),
+	}
+
+	keys, err := auth.NewConfigKeyStore([]string{"test:" + auth.HashKey("secret") + ":*"})
+	assert.Nil(t, err)
+	authGuard, err := auth.NewGuard(keys, true)
+	assert.Nil(t, err)
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads: {Rate: 100, Burst: 100, Daily: 1000},
+	})
+	assert.Nil(t, err)
+	guard, err := ratelimit.NewGuard(authGuard, limiter)
+	assert.Nil(t, err)
+
+	registry, err := networks.NewRegistry(networks.Info{Chain: "bitcoin", Network: networks.Main, Currency: "BTC", Params: "mainnet"})
+	assert.Nil(t, err)
+	networksHandler, err := networks_handler.NewHandler(registry, guard)
+	assert.Nil(t, err)
+	quotaHandler, err := quota.NewHandler(limiter, guard)
+	assert.Nil(t, err)
+	walletHandler, err := wallet.NewHandler(svc.wallet, guard)
+	assert.Nil(t, err)
+	bitcoinHandler, err := bitcoin.NewHandler(map[string]bitcoin.Service{"bitcoin": svc.bitcoin}, guard)
+	assert.Nil(t, err)
+	ethereumHandler, err := ethereum.NewHandler(map[string]ethereum.Service{"ethereum": svc.ethereum}, guard)
+	assert.Nil(t, err)
+	keystoreHandler, err := keystore.NewHandler(svc.keystore, guard)
+	assert.Nil(t, err)
+	policyHandler, err := policy.NewHandler(svc.policy, guard)
+	assert.Nil(t, err)
+
+	router := chi.NewRouter()
+	assert.Nil(t, api.Mount(router, api.Handlers{
+		Health:   health.NewHandler(0),
+		Networks: networksHandler,
+		Quota:    quotaHandler,
+		Wallet:   walletHandler,
+		Bitcoin:  bitcoinHandler,
+		Ethereum: ethereumHandler,
+		Keystore: keystoreHandler,
+		Policy:   policyHandler,
+	}))
+
+	srv := httptest.NewServer(router)
+	t.Cleanup(srv.Close)
+
+	c, err := client.New(srv.URL, client.WithAPIKey(apiKey))
+	assert.Nil(t, err)
+
+	return c, svc
+}
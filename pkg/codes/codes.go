@@ -3,10 +3,14 @@ package codes
 type Code int
 
 const (
-	BadRequest     = 400
-	Unauthorized   = 401
-	Forbidden      = 403
-	NotFound       = 404
-	DuplicateError = 409
-	InternalError  = 500
+	Accepted            = 202
+	BadRequest          = 400
+	Unauthorized        = 401
+	Forbidden           = 403
+	NotFound            = 404
+	DuplicateError      = 409
+	UnprocessableEntity = 422
+	TooManyRequests     = 429
+	InternalError       = 500
+	BadGateway          = 502
 )
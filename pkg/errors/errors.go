@@ -7,9 +7,17 @@ import (
 )
 
 type Error struct {
-	Code    codes.Code `json:"code"`
-	Status  Status     `json:"status"`
-	Message string     `json:"message,omitempty"`
+	Code    codes.Code   `json:"code"`
+	Status  Status       `json:"status"`
+	Message string       `json:"message,omitempty"`
+	Errors  []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError describes why a single request field was rejected.
+type FieldError struct {
+	Field   string `json:"field"`
+	Tag     string `json:"tag"`
+	Message string `json:"message"`
 }
 
 func (err Error) Error() string {
@@ -33,6 +41,20 @@ func WithMessage(target error, msg string, args ...interface{}) error {
 		Code:    err.Code,
 		Status:  err.Status,
 		Message: fmt.Sprintf(msg, args...),
+		Errors:  err.Errors,
+	}
+}
+
+func WithFields(target error, fields []FieldError) error {
+	err, ok := target.(*Error)
+	if !ok {
+		return target
+	}
+	return &Error{
+		Code:    err.Code,
+		Status:  err.Status,
+		Message: err.Message,
+		Errors:  fields,
 	}
 }
 
@@ -67,3 +89,27 @@ func NewNotFound(msg string) error {
 		Message: msg,
 	}
 }
+
+func NewUnauthorized(msg string) error {
+	return &Error{
+		Code:    codes.Unauthorized,
+		Status:  statusUnauthorized,
+		Message: msg,
+	}
+}
+
+func NewForbidden(msg string) error {
+	return &Error{
+		Code:    codes.Forbidden,
+		Status:  statusForbidden,
+		Message: msg,
+	}
+}
+
+func NewTooManyRequests(msg string) error {
+	return &Error{
+		Code:    codes.TooManyRequests,
+		Status:  statusTooManyRequests,
+		Message: msg,
+	}
+}
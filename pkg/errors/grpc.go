@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"nn-blockchain-api/pkg/codes"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain identifies this API in the ErrorInfo detail of gRPC statuses.
+const ErrorDomain = "nn-blockchain-api"
+
+var grpcCodeByCode = map[codes.Code]grpcCodes.Code{
+	codes.Accepted:            grpcCodes.FailedPrecondition,
+	codes.BadRequest:          grpcCodes.InvalidArgument,
+	codes.Unauthorized:        grpcCodes.Unauthenticated,
+	codes.Forbidden:           grpcCodes.PermissionDenied,
+	codes.NotFound:            grpcCodes.NotFound,
+	codes.DuplicateError:      grpcCodes.Aborted,
+	codes.UnprocessableEntity: grpcCodes.FailedPrecondition,
+	codes.TooManyRequests:     grpcCodes.ResourceExhausted,
+	codes.InternalError:       grpcCodes.Internal,
+	codes.BadGateway:          grpcCodes.Unavailable,
+}
+
+// GRPCCode maps the HTTP code of the error onto the closest gRPC code.
+func GRPCCode(err Error) grpcCodes.Code {
+	switch err.Status {
+	case StatusTxAlreadyKnown, StatusWalletAlreadyLoaded:
+		return grpcCodes.AlreadyExists
+	}
+	if code, ok := grpcCodeByCode[err.Code]; ok {
+		return code
+	}
+	return grpcCodes.Unknown
+}
+
+// GRPCStatus lets gRPC servers return *Error as is. The API status travels as the
+// ErrorInfo reason and field errors as BadRequest violations.
+func (err Error) GRPCStatus() *status.Status {
+	message := err.Message
+	if message == "" {
+		message = string(err.Status)
+	}
+	st := status.New(GRPCCode(err), message)
+
+	info := &errdetails.ErrorInfo{Reason: string(err.Status), Domain: ErrorDomain}
+	withDetails, detailsErr := st.WithDetails(info)
+	if len(err.Errors) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(err.Errors))
+		for _, field := range err.Errors {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field.Field, Description: field.Message})
+		}
+		withDetails, detailsErr = st.WithDetails(info, &errdetails.BadRequest{FieldViolations: violations})
+	}
+	if detailsErr != nil {
+		return st
+	}
+	return withDetails
+}
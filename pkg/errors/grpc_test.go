@@ -0,0 +1,60 @@
+package errors
+
+import (
+	"nn-blockchain-api/pkg/codes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestError_GRPCStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    grpcCodes.Code
+		message string
+	}{
+		{"bad request", NewBadRequest("bad body"), grpcCodes.InvalidArgument, "bad body"},
+		{"unauthorized", NewUnauthorized("missing api key"), grpcCodes.Unauthenticated, "missing api key"},
+		{"forbidden", NewForbidden("no scope"), grpcCodes.PermissionDenied, "no scope"},
+		{"not found", FromBitcoinRPC(-5, "No such mempool or blockchain transaction"), grpcCodes.NotFound, "No such mempool or blockchain transaction"},
+		{"already known", FromEthereumRPC(-32000, "already known"), grpcCodes.AlreadyExists, "already known"},
+		{"conflict", FromEthereumRPC(-32000, "nonce too low"), grpcCodes.Aborted, "nonce too low"},
+		{"rejected", NewRejected(StatusInsufficientFunds, "insufficient funds"), grpcCodes.FailedPrecondition, "insufficient funds"},
+		{"too many requests", NewTooManyRequests("slow down"), grpcCodes.ResourceExhausted, "slow down"},
+		{"node unavailable", NewNodeUnavailable("connection refused"), grpcCodes.Unavailable, "connection refused"},
+		{"without message", New(codes.InternalError, statusInternalError), grpcCodes.Internal, "internal_error"},
+		{"unknown code", New(418, "teapot"), grpcCodes.Unknown, "teapot"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(tt.err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			assert.True(t, ok)
+			assert.Equal(t, string(tt.err.(*Error).Status), info.Reason)
+			assert.Equal(t, ErrorDomain, info.Domain)
+		})
+	}
+}
+
+func TestError_GRPCStatusFieldViolations(t *testing.T) {
+	err := WithFields(NewBadRequest("invalid request"), []FieldError{
+		{Field: "network", Tag: "network", Message: "network must be one of main, test"},
+	})
+
+	st := status.Convert(err)
+	assert.Equal(t, grpcCodes.InvalidArgument, st.Code())
+	assert.Len(t, st.Details(), 2)
+
+	badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+	assert.True(t, ok)
+	assert.Equal(t, "network", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "network must be one of main, test", badRequest.FieldViolations[0].Description)
+}
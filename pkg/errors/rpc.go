@@ -0,0 +1,185 @@
+package errors
+
+import (
+	gErrors "errors"
+	"net/url"
+	"nn-blockchain-api/pkg/codes"
+	"strings"
+)
+
+// Bitcoin Core JSON-RPC error codes, see src/rpc/protocol.h.
+const (
+	btcTypeError               = -3
+	btcWalletError             = -4
+	btcInvalidAddressOrKey     = -5
+	btcWalletInsufficientFunds = -6
+	btcInvalidParameter        = -8
+	btcClientNotConnected      = -9
+	btcClientInInitialDownload = -10
+	btcWalletUnlockNeeded      = -13
+	btcWalletNotFound          = -18
+	btcDeserializationError    = -22
+	btcVerifyError             = -25
+	btcVerifyRejected          = -26
+	btcVerifyAlreadyInChain    = -27
+	btcInWarmup                = -28
+	btcWalletAlreadyLoaded     = -35
+
+	rpcInvalidParams = -32602
+)
+
+// ethExecutionReverted is the code geth returns for a reverted eth_call or eth_estimateGas.
+const ethExecutionReverted = 3
+
+// rule maps node error messages containing any of the fragments to a status.
+type rule struct {
+	fragments []string
+	code      codes.Code
+	status    Status
+}
+
+var bitcoinRules = []rule{
+	{[]string{"insufficient funds"}, codes.UnprocessableEntity, StatusInsufficientFunds},
+	{[]string{"missing inputs", "missingorspent"}, codes.DuplicateError, StatusMissingInputs},
+	{[]string{"txn-already-known", "txn-already-in-mempool", "transaction already in block chain"}, codes.DuplicateError, StatusTxAlreadyKnown},
+	{[]string{"txn-mempool-conflict", "insufficient fee, rejecting replacement"}, codes.DuplicateError, StatusTxConflict},
+	{[]string{"min relay fee not met", "mempool min fee not met", "insufficient fee"}, codes.UnprocessableEntity, StatusFeeTooLow},
+	{[]string{"fee exceeds maximum", "max-fee-exceeded", "absurdly-high-fee"}, codes.UnprocessableEntity, StatusFeeExceedsCap},
+	{[]string{"no such mempool or blockchain transaction", "no such mempool transaction"}, codes.NotFound, StatusTxNotFound},
+}
+
+var ethereumRules = []rule{
+	{[]string{"nonce too low"}, codes.DuplicateError, StatusNonceTooLow},
+	{[]string{"nonce too high", "nonce has max value"}, codes.UnprocessableEntity, StatusNonceTooHigh},
+	{[]string{"already known", "known transaction"}, codes.DuplicateError, StatusTxAlreadyKnown},
+	{[]string{"replacement transaction underpriced"}, codes.DuplicateError, StatusReplacementUnderpriced},
+	{[]string{"insufficient funds"}, codes.UnprocessableEntity, StatusInsufficientFunds},
+	{[]string{"transaction underpriced", "less than block base fee", "max priority fee per gas higher than max fee per gas"}, codes.UnprocessableEntity, StatusFeeTooLow},
+	{[]string{"exceeds the configured cap"}, codes.UnprocessableEntity, StatusFeeExceedsCap},
+	{[]string{"intrinsic gas too low", "exceeds block gas limit", "gas limit reached", "gas required exceeds allowance"}, codes.UnprocessableEntity, StatusGasLimit},
+	{[]string{"execution reverted"}, codes.UnprocessableEntity, StatusExecutionReverted},
+	{[]string{"invalid sender", "invalid transaction v, r, s values"}, codes.BadRequest, StatusInvalidSignature},
+	{[]string{"rlp:", "typed transaction too short", "transaction type not supported"}, codes.BadRequest, StatusInvalidTxEncoding},
+	{[]string{"only replay-protected", "oversized data", "negative value"}, codes.UnprocessableEntity, StatusTxRejected},
+	{[]string{"query returned more than", "exceed maximum block range", "block range is too wide", "response size exceeded"}, codes.UnprocessableEntity, StatusResultTooLarge},
+}
+
+// FromBitcoinRPC maps a Bitcoin Core JSON-RPC error to a typed error.
+func FromBitcoinRPC(code int64, message string) error {
+	if err := matchRules(bitcoinRules, message); err != nil {
+		return err
+	}
+
+	switch code {
+	case btcTypeError, btcInvalidParameter, rpcInvalidParams:
+		return newRPCError(codes.BadRequest, StatusInvalidParameter, message)
+	case btcInvalidAddressOrKey:
+		return newRPCError(codes.BadRequest, StatusInvalidAddress, message)
+	case btcDeserializationError:
+		return newRPCError(codes.BadRequest, StatusInvalidTxEncoding, message)
+	case btcWalletNotFound:
+		return newRPCError(codes.NotFound, StatusWalletNotFound, message)
+	case btcVerifyAlreadyInChain:
+		return newRPCError(codes.DuplicateError, StatusTxAlreadyKnown, message)
+	case btcWalletAlreadyLoaded:
+		return newRPCError(codes.DuplicateError, StatusWalletAlreadyLoaded, message)
+	case btcWalletInsufficientFunds:
+		return newRPCError(codes.UnprocessableEntity, StatusInsufficientFunds, message)
+	case btcVerifyError, btcVerifyRejected:
+		return newRPCError(codes.UnprocessableEntity, StatusTxRejected, message)
+	case btcWalletUnlockNeeded:
+		return newRPCError(codes.UnprocessableEntity, StatusWalletLocked, message)
+	case btcWalletError:
+		return newRPCError(codes.UnprocessableEntity, StatusWalletError, message)
+	case btcClientNotConnected, btcClientInInitialDownload, btcInWarmup:
+		return newRPCError(codes.BadGateway, StatusNodeUnavailable, message)
+	}
+
+	return newRPCError(codes.BadGateway, StatusNodeError, message)
+}
+
+// FromEthereumRPC maps a geth JSON-RPC error to a typed error. Geth reports most
+// transaction pool rejections with the generic -32000 code, so the message decides.
+func FromEthereumRPC(code int64, message string) error {
+	if code == ethExecutionReverted {
+		return newRPCError(codes.UnprocessableEntity, StatusExecutionReverted, message)
+	}
+	if err := matchRules(ethereumRules, message); err != nil {
+		return err
+	}
+	if code == rpcInvalidParams {
+		return newRPCError(codes.BadRequest, StatusInvalidParameter, message)
+	}
+
+	return newRPCError(codes.BadGateway, StatusNodeError, message)
+}
+
+// NewNodeUnavailable reports a node that could not be reached.
+func NewNodeUnavailable(msg string) error {
+	return newRPCError(codes.BadGateway, StatusNodeUnavailable, msg)
+}
+
+// WithoutURL drops the request URL net/http wraps its errors in, node endpoints carry
+// credentials and API keys.
+func WithoutURL(err error) error {
+	var urlErr *url.Error
+	if gErrors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+// IsNodeUnavailable reports whether err is a NewNodeUnavailable error.
+func IsNodeUnavailable(err error) bool {
+	var typed *Error
+	return gErrors.As(err, &typed) && typed.Status == StatusNodeUnavailable
+}
+
+// IsNodeError reports whether err is a node error no other status matched, such as a
+// method the node does not support.
+func IsNodeError(err error) bool {
+	var typed *Error
+	return gErrors.As(err, &typed) && typed.Status == StatusNodeError
+}
+
+// NewInvalid reports a client input the node-facing code rejected before calling the node.
+func NewInvalid(status Status, msg string) error {
+	return newRPCError(codes.BadRequest, status, msg)
+}
+
+// NewMissing reports an object the node does not know, e.g. a block beyond the chain tip.
+func NewMissing(status Status, msg string) error {
+	return newRPCError(codes.NotFound, status, msg)
+}
+
+// NewRejected reports a well-formed request that cannot be executed, e.g. a wallet without enough funds.
+func NewRejected(status Status, msg string) error {
+	return newRPCError(codes.UnprocessableEntity, status, msg)
+}
+
+// Wrap returns err unchanged if it is already typed and otherwise attaches its message to fallback.
+func Wrap(fallback error, err error) error {
+	var typed *Error
+	if gErrors.As(err, &typed) {
+		return typed
+	}
+
+	return WithMessage(fallback, err.Error())
+}
+
+func matchRules(rules []rule, message string) error {
+	lower := strings.ToLower(message)
+	for _, r := range rules {
+		for _, fragment := range r.fragments {
+			if strings.Contains(lower, fragment) {
+				return newRPCError(r.code, r.status, message)
+			}
+		}
+	}
+
+	return nil
+}
+
+func newRPCError(code codes.Code, status Status, msg string) error {
+	return &Error{Code: code, Status: status, Message: msg}
+}
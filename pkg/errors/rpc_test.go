@@ -0,0 +1,99 @@
+package errors
+
+import (
+	gErrors "errors"
+	"nn-blockchain-api/pkg/codes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromBitcoinRPC(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    int64
+		message string
+		status  Status
+		http    codes.Code
+	}{
+		{"insufficient funds", -6, "Insufficient funds", StatusInsufficientFunds, codes.UnprocessableEntity},
+		{"missing inputs", -25, "bad-txns-inputs-missingorspent", StatusMissingInputs, codes.DuplicateError},
+		{"already in chain", -27, "Transaction already in block chain", StatusTxAlreadyKnown, codes.DuplicateError},
+		{"already in mempool", -26, "txn-already-in-mempool", StatusTxAlreadyKnown, codes.DuplicateError},
+		{"mempool conflict", -26, "txn-mempool-conflict", StatusTxConflict, codes.DuplicateError},
+		{"fee too low", -26, "min relay fee not met, 100 < 141", StatusFeeTooLow, codes.UnprocessableEntity},
+		{"fee exceeds cap", -25, "Fee exceeds maximum configured by user (e.g. -maxtxfee, maxfeerate)", StatusFeeExceedsCap, codes.UnprocessableEntity},
+		{"rejected", -26, "non-mandatory-script-verify-flag", StatusTxRejected, codes.UnprocessableEntity},
+		{"tx not found", -5, "No such mempool or blockchain transaction", StatusTxNotFound, codes.NotFound},
+		{"invalid address", -5, "Invalid Bitcoin address", StatusInvalidAddress, codes.BadRequest},
+		{"decode failed", -22, "TX decode failed", StatusInvalidTxEncoding, codes.BadRequest},
+		{"invalid parameter", -8, "Invalid parameter, missing vout", StatusInvalidParameter, codes.BadRequest},
+		{"wallet not found", -18, "Requested wallet does not exist or is not loaded", StatusWalletNotFound, codes.NotFound},
+		{"wallet loaded", -35, "Wallet \"w\" is already loaded.", StatusWalletAlreadyLoaded, codes.DuplicateError},
+		{"warmup", -28, "Loading block index...", StatusNodeUnavailable, codes.BadGateway},
+		{"unknown", -1, "something odd", StatusNodeError, codes.BadGateway},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := FromBitcoinRPC(tc.code, tc.message)
+			assert.Equal(t, &Error{Code: tc.http, Status: tc.status, Message: tc.message}, err)
+		})
+	}
+}
+
+func TestFromEthereumRPC(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    int64
+		message string
+		status  Status
+		http    codes.Code
+	}{
+		{"nonce too low", -32000, "nonce too low", StatusNonceTooLow, codes.DuplicateError},
+		{"nonce too high", -32000, "nonce too high", StatusNonceTooHigh, codes.UnprocessableEntity},
+		{"already known", -32000, "already known", StatusTxAlreadyKnown, codes.DuplicateError},
+		{"replacement", -32000, "replacement transaction underpriced", StatusReplacementUnderpriced, codes.DuplicateError},
+		{"insufficient funds", -32000, "insufficient funds for gas * price + value", StatusInsufficientFunds, codes.UnprocessableEntity},
+		{"underpriced", -32000, "transaction underpriced", StatusFeeTooLow, codes.UnprocessableEntity},
+		{"base fee", -32000, "max fee per gas less than block base fee", StatusFeeTooLow, codes.UnprocessableEntity},
+		{"fee cap", -32000, "tx fee (1.20 ether) exceeds the configured cap (1.00 ether)", StatusFeeExceedsCap, codes.UnprocessableEntity},
+		{"intrinsic gas", -32000, "intrinsic gas too low", StatusGasLimit, codes.UnprocessableEntity},
+		{"reverted", 3, "execution reverted: ERC20: transfer amount exceeds balance", StatusExecutionReverted, codes.UnprocessableEntity},
+		{"invalid sender", -32000, "invalid sender", StatusInvalidSignature, codes.BadRequest},
+		{"rlp", -32000, "rlp: expected input list for types.LegacyTx", StatusInvalidTxEncoding, codes.BadRequest},
+		{"too many logs", -32005, "query returned more than 10000 results", StatusResultTooLarge, codes.UnprocessableEntity},
+		{"invalid params", -32602, "invalid argument 0: hex string without 0x prefix", StatusInvalidParameter, codes.BadRequest},
+		{"unknown", -32603, "internal error", StatusNodeError, codes.BadGateway},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := FromEthereumRPC(tc.code, tc.message)
+			assert.Equal(t, &Error{Code: tc.http, Status: tc.status, Message: tc.message}, err)
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	fallback := New(codes.InternalError, "failed_send_tx")
+	typed := NewNodeUnavailable("connection refused")
+
+	assert.Equal(t, typed, Wrap(fallback, typed))
+	assert.Equal(t, WithMessage(fallback, "unexpected EOF"), Wrap(fallback, gErrors.New("unexpected EOF")))
+}
+
+func TestIsNodeUnavailable(t *testing.T) {
+	assert.True(t, IsNodeUnavailable(NewNodeUnavailable("connection refused")))
+	assert.True(t, IsNodeUnavailable(FromBitcoinRPC(-28, "Loading block index...")))
+	assert.False(t, IsNodeUnavailable(FromBitcoinRPC(-25, "bad-txns-inputs-missingorspent")))
+	assert.False(t, IsNodeUnavailable(gErrors.New("unexpected EOF")))
+	assert.False(t, IsNodeUnavailable(nil))
+}
+
+func TestIsNodeError(t *testing.T) {
+	assert.True(t, IsNodeError(FromEthereumRPC(-32601, "the method net_peerCount does not exist/is not available")))
+	assert.False(t, IsNodeError(NewNodeUnavailable("connection refused")))
+	assert.False(t, IsNodeError(gErrors.New("unexpected EOF")))
+	assert.False(t, IsNodeError(nil))
+}
@@ -6,4 +6,49 @@ const (
 	statusInternalError   Status = "internal_error"
 	statusNotFoundError   Status = "not_found_error"
 	statusBadRequestError Status = "bad_request_error"
+	statusUnauthorized    Status = "unauthorized"
+	statusForbidden       Status = "forbidden"
+	statusTooManyRequests Status = "too_many_requests"
+)
+
+// Statuses of errors reported by blockchain nodes. They are part of the API contract:
+// clients branch on them, so existing values must never change.
+const (
+	// 400: the request itself is malformed.
+	StatusInvalidParameter  Status = "invalid_parameter"
+	StatusInvalidAddress    Status = "invalid_address"
+	StatusInvalidPrivateKey Status = "invalid_private_key"
+	StatusInvalidTxEncoding Status = "invalid_tx_encoding"
+	StatusInvalidSignature  Status = "invalid_signature"
+	StatusInvalidNetwork    Status = "invalid_network"
+
+	// 404: the node does not know the requested object.
+	StatusTxNotFound     Status = "tx_not_found"
+	StatusWalletNotFound Status = "wallet_not_found"
+	StatusBlockNotFound  Status = "block_not_found"
+
+	// 409: the request conflicts with the current chain or mempool state.
+	StatusTxAlreadyKnown         Status = "tx_already_known"
+	StatusTxConflict             Status = "tx_conflict"
+	StatusMissingInputs          Status = "missing_inputs"
+	StatusNonceTooLow            Status = "nonce_too_low"
+	StatusReplacementUnderpriced Status = "replacement_underpriced"
+	StatusWalletAlreadyLoaded    Status = "wallet_already_loaded"
+
+	// 422: the request is well-formed but the node refuses to execute it.
+	StatusInsufficientFunds Status = "insufficient_funds"
+	StatusNonceTooHigh      Status = "nonce_too_high"
+	StatusFeeTooLow         Status = "fee_too_low"
+	StatusFeeExceedsCap     Status = "fee_exceeds_cap"
+	StatusGasLimit          Status = "gas_limit"
+	StatusExecutionReverted Status = "execution_reverted"
+	StatusTxRejected        Status = "tx_rejected"
+	StatusWalletLocked      Status = "wallet_locked"
+	StatusWalletError       Status = "wallet_error"
+	StatusSigningIncomplete Status = "signing_incomplete"
+	StatusResultTooLarge    Status = "result_too_large"
+
+	// 502: the node is unreachable, not ready or failed in an unexpected way.
+	StatusNodeUnavailable Status = "node_unavailable"
+	StatusNodeError       Status = "node_error"
 )
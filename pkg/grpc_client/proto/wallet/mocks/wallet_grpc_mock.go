@@ -76,6 +76,86 @@ func (mr *MockWalletServiceClientMockRecorder) CreateWallet(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockWalletServiceClient)(nil).CreateWallet), varargs...)
 }
 
+// DeriveAddress mocks base method.
+func (m *MockWalletServiceClient) DeriveAddress(ctx context.Context, in *__.DeriveAddressData, opts ...grpc.CallOption) (*__.AddressInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeriveAddress", varargs...)
+	ret0, _ := ret[0].(*__.AddressInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveAddress indicates an expected call of DeriveAddress.
+func (mr *MockWalletServiceClientMockRecorder) DeriveAddress(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveAddress", reflect.TypeOf((*MockWalletServiceClient)(nil).DeriveAddress), varargs...)
+}
+
+// ImportKey mocks base method.
+func (m *MockWalletServiceClient) ImportKey(ctx context.Context, in *__.ImportKeyData, opts ...grpc.CallOption) (*__.WalletInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportKey", varargs...)
+	ret0, _ := ret[0].(*__.WalletInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKey indicates an expected call of ImportKey.
+func (mr *MockWalletServiceClientMockRecorder) ImportKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockWalletServiceClient)(nil).ImportKey), varargs...)
+}
+
+// ListAddresses mocks base method.
+func (m *MockWalletServiceClient) ListAddresses(ctx context.Context, in *__.ListAddressesData, opts ...grpc.CallOption) (*__.AddressList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAddresses", varargs...)
+	ret0, _ := ret[0].(*__.AddressList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddresses indicates an expected call of ListAddresses.
+func (mr *MockWalletServiceClientMockRecorder) ListAddresses(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddresses", reflect.TypeOf((*MockWalletServiceClient)(nil).ListAddresses), varargs...)
+}
+
+// SignTransaction mocks base method.
+func (m *MockWalletServiceClient) SignTransaction(ctx context.Context, in *__.SignTransactionData, opts ...grpc.CallOption) (*__.SignedTransaction, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignTransaction", varargs...)
+	ret0, _ := ret[0].(*__.SignedTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction.
+func (mr *MockWalletServiceClientMockRecorder) SignTransaction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockWalletServiceClient)(nil).SignTransaction), varargs...)
+}
+
 // MockWalletServiceServer is a mock of WalletServiceServer interface.
 type MockWalletServiceServer struct {
 	ctrl     *gomock.Controller
@@ -129,6 +209,66 @@ func (mr *MockWalletServiceServerMockRecorder) CreateWallet(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockWalletServiceServer)(nil).CreateWallet), arg0, arg1)
 }
 
+// DeriveAddress mocks base method.
+func (m *MockWalletServiceServer) DeriveAddress(arg0 context.Context, arg1 *__.DeriveAddressData) (*__.AddressInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeriveAddress", arg0, arg1)
+	ret0, _ := ret[0].(*__.AddressInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveAddress indicates an expected call of DeriveAddress.
+func (mr *MockWalletServiceServerMockRecorder) DeriveAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveAddress", reflect.TypeOf((*MockWalletServiceServer)(nil).DeriveAddress), arg0, arg1)
+}
+
+// ImportKey mocks base method.
+func (m *MockWalletServiceServer) ImportKey(arg0 context.Context, arg1 *__.ImportKeyData) (*__.WalletInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKey", arg0, arg1)
+	ret0, _ := ret[0].(*__.WalletInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKey indicates an expected call of ImportKey.
+func (mr *MockWalletServiceServerMockRecorder) ImportKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockWalletServiceServer)(nil).ImportKey), arg0, arg1)
+}
+
+// ListAddresses mocks base method.
+func (m *MockWalletServiceServer) ListAddresses(arg0 context.Context, arg1 *__.ListAddressesData) (*__.AddressList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*__.AddressList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddresses indicates an expected call of ListAddresses.
+func (mr *MockWalletServiceServerMockRecorder) ListAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddresses", reflect.TypeOf((*MockWalletServiceServer)(nil).ListAddresses), arg0, arg1)
+}
+
+// SignTransaction mocks base method.
+func (m *MockWalletServiceServer) SignTransaction(arg0 context.Context, arg1 *__.SignTransactionData) (*__.SignedTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*__.SignedTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction.
+func (mr *MockWalletServiceServerMockRecorder) SignTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockWalletServiceServer)(nil).SignTransaction), arg0, arg1)
+}
+
 // mustEmbedUnimplementedWalletServiceServer mocks base method.
 func (m *MockWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {
 	m.ctrl.T.Helper()
@@ -25,6 +25,10 @@ const _ = grpc.SupportPackageIsVersion7
 type WalletServiceClient interface {
 	CreateWallet(ctx context.Context, in *CreateWalletData, opts ...grpc.CallOption) (*WalletInfo, error)
 	CreateMnemonic(ctx context.Context, in *CreateMnemonicData, opts ...grpc.CallOption) (*MnemonicInfo, error)
+	DeriveAddress(ctx context.Context, in *DeriveAddressData, opts ...grpc.CallOption) (*AddressInfo, error)
+	ListAddresses(ctx context.Context, in *ListAddressesData, opts ...grpc.CallOption) (*AddressList, error)
+	ImportKey(ctx context.Context, in *ImportKeyData, opts ...grpc.CallOption) (*WalletInfo, error)
+	SignTransaction(ctx context.Context, in *SignTransactionData, opts ...grpc.CallOption) (*SignedTransaction, error)
 }
 
 type walletServiceClient struct {
@@ -53,12 +57,52 @@ func (c *walletServiceClient) CreateMnemonic(ctx context.Context, in *CreateMnem
 	return out, nil
 }
 
+func (c *walletServiceClient) DeriveAddress(ctx context.Context, in *DeriveAddressData, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, "/WalletService/DeriveAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListAddresses(ctx context.Context, in *ListAddressesData, opts ...grpc.CallOption) (*AddressList, error) {
+	out := new(AddressList)
+	err := c.cc.Invoke(ctx, "/WalletService/ListAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportKey(ctx context.Context, in *ImportKeyData, opts ...grpc.CallOption) (*WalletInfo, error) {
+	out := new(WalletInfo)
+	err := c.cc.Invoke(ctx, "/WalletService/ImportKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTransactionData, opts ...grpc.CallOption) (*SignedTransaction, error) {
+	out := new(SignedTransaction)
+	err := c.cc.Invoke(ctx, "/WalletService/SignTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletServiceServer is the server API for WalletService service.
 // All implementations must embed UnimplementedWalletServiceServer
 // for forward compatibility
 type WalletServiceServer interface {
 	CreateWallet(context.Context, *CreateWalletData) (*WalletInfo, error)
 	CreateMnemonic(context.Context, *CreateMnemonicData) (*MnemonicInfo, error)
+	DeriveAddress(context.Context, *DeriveAddressData) (*AddressInfo, error)
+	ListAddresses(context.Context, *ListAddressesData) (*AddressList, error)
+	ImportKey(context.Context, *ImportKeyData) (*WalletInfo, error)
+	SignTransaction(context.Context, *SignTransactionData) (*SignedTransaction, error)
 	mustEmbedUnimplementedWalletServiceServer()
 }
 
@@ -72,6 +116,18 @@ func (UnimplementedWalletServiceServer) CreateWallet(context.Context, *CreateWal
 func (UnimplementedWalletServiceServer) CreateMnemonic(context.Context, *CreateMnemonicData) (*MnemonicInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMnemonic not implemented")
 }
+func (UnimplementedWalletServiceServer) DeriveAddress(context.Context, *DeriveAddressData) (*AddressInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeriveAddress not implemented")
+}
+func (UnimplementedWalletServiceServer) ListAddresses(context.Context, *ListAddressesData) (*AddressList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddresses not implemented")
+}
+func (UnimplementedWalletServiceServer) ImportKey(context.Context, *ImportKeyData) (*WalletInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKey not implemented")
+}
+func (UnimplementedWalletServiceServer) SignTransaction(context.Context, *SignTransactionData) (*SignedTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+}
 func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
 
 // UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -121,6 +177,78 @@ func _WalletService_CreateMnemonic_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_DeriveAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeriveAddressData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeriveAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/DeriveAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeriveAddress(ctx, req.(*DeriveAddressData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressesData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/ListAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListAddresses(ctx, req.(*ListAddressesData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ImportKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKeyData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImportKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/ImportKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImportKey(ctx, req.(*ImportKeyData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/SignTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -136,6 +264,22 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateMnemonic",
 			Handler:    _WalletService_CreateMnemonic_Handler,
 		},
+		{
+			MethodName: "DeriveAddress",
+			Handler:    _WalletService_DeriveAddress_Handler,
+		},
+		{
+			MethodName: "ListAddresses",
+			Handler:    _WalletService_ListAddresses_Handler,
+		},
+		{
+			MethodName: "ImportKey",
+			Handler:    _WalletService_ImportKey_Handler,
+		},
+		{
+			MethodName: "SignTransaction",
+			Handler:    _WalletService_SignTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "wallet.proto",
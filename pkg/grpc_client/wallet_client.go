@@ -1,17 +1,23 @@
 package grpc_client
 
 import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	pb "nn-blockchain-api/pkg/grpc_client/proto/wallet"
 )
 
-func NewWalletClient(host string) (pb.WalletServiceClient, error) {
-	rpcConnection, err := grpc.Dial(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewWalletClient dials the wallet service, the connection is returned so callers can
+// check its state and close it on shutdown.
+func NewWalletClient(host string) (pb.WalletServiceClient, *grpc.ClientConn, error) {
+	rpcConnection, err := grpc.Dial(host,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	)
 	if err != nil {
-		defer rpcConnection.Close()
-		return nil, err
+		return nil, nil, err
 	}
 
-	return pb.NewWalletServiceClient(rpcConnection), nil
+	return pb.NewWalletServiceClient(rpcConnection), rpcConnection, nil
 }
@@ -0,0 +1,48 @@
+package grpc_server
+
+import (
+	"context"
+	"nn-blockchain-api/pkg/tracing"
+	"runtime/debug"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LoggingInterceptor logs every call with its status code and duration.
+func LoggingInterceptor(logger *zap.SugaredLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		log := tracing.Logger(ctx, logger).With("method", info.FullMethod, "code", code.String(), "duration", time.Since(start))
+		switch code {
+		case codes.OK:
+			log.Info("gRPC call")
+		case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+			log.Errorf("gRPC call failed: %v", err)
+		default:
+			log.Warnf("gRPC call rejected: %v", err)
+		}
+
+		return resp, err
+	}
+}
+
+// RecoveryInterceptor turns a panicking handler into an Internal error instead of crashing the process.
+func RecoveryInterceptor(logger *zap.SugaredLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				tracing.Logger(ctx, logger).Errorf("gRPC handler %s panicked: %v\n%s", info.FullMethod, r, debug.Stack())
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
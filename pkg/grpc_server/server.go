@@ -0,0 +1,124 @@
+package grpc_server
+
+import (
+	"context"
+	"crypto/tls"
+	gErrors "errors"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+type Config struct {
+	Addr            string
+	ShutdownTimeout time.Duration
+	Reflection      bool
+	// TLSConfig enables TLS, it is shared with the HTTP server so both listeners use the same certificates.
+	TLSConfig *tls.Config
+}
+
+// Server serves the gRPC API with the standard health service. Every registered
+// service is reported as SERVING until shutdown.
+type Server struct {
+	grpc   *grpc.Server
+	health *health.Server
+	cfg    Config
+	logger *zap.SugaredLogger
+}
+
+// New chains recovery, tracing and logging in front of the given interceptors, so
+// rejected calls are still traced and logged.
+func New(cfg Config, logger *zap.SugaredLogger, interceptors ...grpc.UnaryServerInterceptor) (*Server, error) {
+	if logger == nil {
+		return nil, gErrors.New("invalid logger")
+	}
+
+	chain := append([]grpc.UnaryServerInterceptor{
+		RecoveryInterceptor(logger),
+		otelgrpc.UnaryServerInterceptor(),
+		LoggingInterceptor(logger),
+	}, interceptors...)
+
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(chain...)}
+	if cfg.TLSConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(cfg.TLSConfig)))
+	}
+
+	s := &Server{
+		grpc:   grpc.NewServer(opts...),
+		health: health.NewServer(),
+		cfg:    cfg,
+		logger: logger,
+	}
+	healthpb.RegisterHealthServer(s.grpc, s.health)
+	if cfg.Reflection {
+		reflection.Register(s.grpc)
+	}
+
+	return s, nil
+}
+
+// RegisterService implements grpc.ServiceRegistrar, so generated Register functions accept the Server.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	s.grpc.RegisterService(desc, impl)
+	s.health.SetServingStatus(desc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+}
+
+// Run listens on the configured address until ctx is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.cfg.Addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, listener)
+}
+
+// Serve accepts connections on listener until ctx is cancelled, then reports NOT_SERVING
+// and waits up to ShutdownTimeout for in-flight calls before closing them.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.grpc.Serve(listener)
+	}()
+
+	s.logger.Infof("gRPC server listening on %s (tls: %t)", listener.Addr(), s.cfg.TLSConfig != nil)
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.logger.Info("shutting down gRPC server")
+	s.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(stopped)
+	}()
+
+	var timeout <-chan time.Time
+	if s.cfg.ShutdownTimeout > 0 {
+		timer := time.NewTimer(s.cfg.ShutdownTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-stopped:
+		return nil
+	case <-timeout:
+		s.logger.Error("failed to drain in-flight gRPC calls")
+		s.grpc.Stop()
+		return context.DeadlineExceeded
+	}
+}
@@ -0,0 +1,153 @@
+package grpc_server_test
+
+import (
+	"context"
+	"net"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/grpc_server"
+	pb "nn-blockchain-api/pkg/grpc_server/proto/wallet"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type walletServer struct {
+	pb.UnimplementedWalletServiceServer
+}
+
+func (walletServer) CreateMnemonic(_ context.Context, req *pb.CreateMnemonicRequest) (*pb.CreateMnemonicResponse, error) {
+	if req.GetLength() == "panic" {
+		panic("boom")
+	}
+	return &pb.CreateMnemonicResponse{Mnemonic: "abandon ability"}, nil
+}
+
+func TestNew(t *testing.T) {
+	srv, err := grpc_server.New(grpc_server.Config{}, nil)
+	assert.Nil(t, srv)
+	assert.EqualError(t, err, "invalid logger")
+}
+
+func TestServer(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	reject := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == "/api.wallet.v1.WalletService/CreateWallet" {
+			return nil, errors.NewUnauthorized("missing api key")
+		}
+		return handler(ctx, req)
+	}
+
+	srv, err := grpc_server.New(grpc_server.Config{Reflection: true, ShutdownTimeout: time.Second}, zap.New(core).Sugar(), reject)
+	assert.Nil(t, err)
+	pb.RegisterWalletServiceServer(srv, walletServer{})
+
+	conn, stop := serve(t, srv)
+	defer stop()
+	ctx := context.Background()
+
+	t.Run("health", func(t *testing.T) {
+		health := healthpb.NewHealthClient(conn)
+		for _, service := range []string{"", "api.wallet.v1.WalletService"} {
+			resp, err := health.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+			assert.Nil(t, err)
+			assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+		}
+	})
+
+	t.Run("reflection", func(t *testing.T) {
+		stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		assert.Nil(t, err)
+		assert.Nil(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+		}))
+		resp, err := stream.Recv()
+		assert.Nil(t, err)
+
+		var services []string
+		for _, service := range resp.GetListServicesResponse().GetService() {
+			services = append(services, service.Name)
+		}
+		assert.Contains(t, services, "api.wallet.v1.WalletService")
+		assert.Contains(t, services, "grpc.health.v1.Health")
+	})
+
+	t.Run("call is logged", func(t *testing.T) {
+		resp, err := pb.NewWalletServiceClient(conn).CreateMnemonic(ctx, &pb.CreateMnemonicRequest{Length: "12"})
+		assert.Nil(t, err)
+		assert.Equal(t, "abandon ability", resp.Mnemonic)
+
+		entries := logs.FilterMessage("gRPC call").FilterField(zap.String("method", "/api.wallet.v1.WalletService/CreateMnemonic")).All()
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "OK", entries[0].ContextMap()["code"])
+	})
+
+	t.Run("interceptor error keeps its code", func(t *testing.T) {
+		_, err := pb.NewWalletServiceClient(conn).CreateWallet(ctx, &pb.CreateWalletRequest{Name: "bitcoin"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, "missing api key", status.Convert(err).Message())
+	})
+
+	t.Run("panic is recovered", func(t *testing.T) {
+		_, err := pb.NewWalletServiceClient(conn).CreateMnemonic(ctx, &pb.CreateMnemonicRequest{Length: "panic"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		resp, err := pb.NewWalletServiceClient(conn).CreateMnemonic(ctx, &pb.CreateMnemonicRequest{Length: "12"})
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+}
+
+func TestServer_Shutdown(t *testing.T) {
+	srv, err := grpc_server.New(grpc_server.Config{ShutdownTimeout: time.Second}, zap.NewNop().Sugar())
+	assert.Nil(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Serve(ctx, listener)
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not stop")
+	}
+}
+
+func serve(t *testing.T, srv *grpc_server.Server) (*grpc.ClientConn, func()) {
+	listener := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Serve(ctx, listener)
+	}()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err)
+
+	return conn, func() {
+		_ = conn.Close()
+		cancel()
+		assert.Nil(t, <-done)
+	}
+}
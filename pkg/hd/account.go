@@ -0,0 +1,223 @@
+package hd
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	ChainReceive uint32 = 0
+	ChainChange  uint32 = 1
+
+	// MaxScan bounds how many addresses a single Scan derives.
+	MaxScan = 1000
+)
+
+var (
+	ErrInvalidExtendedKey = errors.New("invalid extended public key")
+	ErrPrivateExtendedKey = errors.New("extended private keys are not accepted")
+	ErrInvalidGapLimit    = errors.New("invalid gap limit")
+	ErrScanLimit          = errors.New("address scan limit reached")
+)
+
+// Account is a neutered account-level key, it can only derive public keys and addresses.
+type Account struct {
+	key    *hdkeychain.ExtendedKey
+	params *params
+	index  uint32
+	known  bool
+}
+
+type Address struct {
+	Index   uint32
+	Path    string
+	Address string
+	Used    bool
+}
+
+// NewAccount derives m/purpose'/coin'/account' from a seed and drops the private part.
+func NewAccount(seed []byte, coin, network string, scheme Scheme, account uint32) (*Account, error) {
+	p, err := newParams(coin, network, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hdkeychain.NewMaster(seed, p.chain)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range []uint32{hardened(p.purpose), hardened(p.coinType), hardened(account)} {
+		if key, err = key.Derive(index); err != nil {
+			return nil, err
+		}
+	}
+
+	public, err := key.Neuter()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Account{key: public, params: p, index: account, known: true}, nil
+}
+
+// ParseAccount loads an account-level extended public key. The account index is not part
+// of the serialization, so paths of parsed accounts start at the account key.
+func ParseAccount(xpub, coin, network string, scheme Scheme) (*Account, error) {
+	p, err := newParams(coin, network, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hdkeychain.NewKeyFromString(xpub)
+	if err != nil {
+		return nil, ErrInvalidExtendedKey
+	}
+	if key.IsPrivate() {
+		return nil, ErrPrivateExtendedKey
+	}
+	if !p.accepts(key.Version()) {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	return &Account{key: key, params: p}, nil
+}
+
+func (a *Account) Coin() string {
+	return a.params.coin
+}
+
+func (a *Account) Network() string {
+	return a.params.network
+}
+
+func (a *Account) Scheme() Scheme {
+	return a.params.scheme
+}
+
+// Path is the account derivation path, or "M" when the account was parsed from an xpub.
+func (a *Account) Path() string {
+	if !a.known {
+		return "M"
+	}
+	return fmt.Sprintf("m/%d'/%d'/%d'", a.params.purpose, a.params.coinType, a.index)
+}
+
+// Xpub serializes the account key with the version of its scheme (xpub, zpub, tpub, vpub).
+func (a *Account) Xpub() (string, error) {
+	key, err := a.key.CloneWithVersion(a.params.version[:])
+	if err != nil {
+		return "", err
+	}
+	return key.String(), nil
+}
+
+// Address derives the address at chain/index below the account.
+func (a *Account) Address(chain, index uint32) (*Address, error) {
+	branch, err := a.key.Derive(chain)
+	if err != nil {
+		return nil, err
+	}
+	child, err := branch.Derive(index)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := a.encode(child)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Address{Index: index, Path: fmt.Sprintf("%s/%d/%d", a.Path(), chain, index), Address: address}, nil
+}
+
+// Scan derives addresses on a chain from start until gap consecutive ones are unused,
+// so the result always ends with gap fresh addresses.
+func (a *Account) Scan(chain, start uint32, gap int, used func(address string) (bool, error)) ([]*Address, error) {
+	if gap <= 0 || gap > MaxScan {
+		return nil, ErrInvalidGapLimit
+	}
+
+	var addresses []*Address
+	for unused, index := 0, start; unused < gap; index++ {
+		if len(addresses) == MaxScan {
+			return nil, ErrScanLimit
+		}
+
+		address, err := a.Address(chain, index)
+		if err != nil {
+			return nil, err
+		}
+		if used != nil {
+			if address.Used, err = used(address.Address); err != nil {
+				return nil, err
+			}
+		}
+
+		unused++
+		if address.Used {
+			unused = 0
+		}
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+func (a *Account) encode(key *hdkeychain.ExtendedKey) (string, error) {
+	pub, err := key.ECPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	switch a.params.scheme {
+	case SchemeP2PKH:
+		address, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(pub.SerializeCompressed()), a.params.chain)
+		if err != nil {
+			return "", err
+		}
+		return address.EncodeAddress(), nil
+	case SchemeP2WPKH:
+		address, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pub.SerializeCompressed()), a.params.chain)
+		if err != nil {
+			return "", err
+		}
+		return address.EncodeAddress(), nil
+	case SchemeP2TR:
+		return taprootAddress(pub, a.params.chain.Bech32HRPSegwit)
+	case SchemeEthereum:
+		return crypto.PubkeyToAddress(*pub.ToECDSA()).Hex(), nil
+	}
+
+	return "", ErrUnsupportedScheme
+}
+
+// ChildPublicKey derives the compressed public key at chain/index below an extended public
+// key of any version, so co-signers can share the xpub of whatever scheme their wallet uses.
+func ChildPublicKey(xpub string, chain, index uint32) (string, error) {
+	key, err := hdkeychain.NewKeyFromString(xpub)
+	if err != nil {
+		return "", ErrInvalidExtendedKey
+	}
+	if key.IsPrivate() {
+		return "", ErrPrivateExtendedKey
+	}
+
+	branch, err := key.Derive(chain)
+	if err != nil {
+		return "", err
+	}
+	child, err := branch.Derive(index)
+	if err != nil {
+		return "", err
+	}
+	pub, err := child.ECPubKey()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(pub.SerializeCompressed()), nil
+}
@@ -0,0 +1,311 @@
+package hd_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"nn-blockchain-api/pkg/hd"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestSeed(t *testing.T) {
+	// BIP39 reference vector with the "TREZOR" passphrase.
+	seed, err := hd.Seed(mnemonic, "TREZOR")
+	require.NoError(t, err)
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+}
+
+func TestSeed_Invalid(t *testing.T) {
+	_, err := hd.Seed("abandon abandon abandon", "")
+	assert.Equal(t, hd.ErrInvalidMnemonic, err)
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		err      error
+	}{
+		{name: "12 words", mnemonic: mnemonic},
+		{name: "extra whitespace", mnemonic: "  abandon abandon\tabandon abandon abandon abandon\nabandon abandon abandon abandon abandon  about "},
+		{name: "15 words", mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon address"},
+		{name: "18 words", mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon agent"},
+		{name: "24 words", mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art"},
+		{name: "japanese", mnemonic: "あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら"},
+		{name: "bad checksum", mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", err: hd.ErrMnemonicChecksum},
+		{name: "unknown word", mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon bitcoin", err: hd.ErrInvalidMnemonic},
+		{name: "13 words", mnemonic: mnemonic + " abandon", err: hd.ErrInvalidMnemonic},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.err, hd.ValidateMnemonic(tc.mnemonic))
+		})
+	}
+}
+
+func TestNewAccount(t *testing.T) {
+	seed := newSeed(t)
+
+	tests := []struct {
+		name    string
+		coin    string
+		network string
+		scheme  hd.Scheme
+		path    string
+		xpub    string
+		receive string
+		change  string
+		err     error
+	}{
+		{
+			name:    "should derive BIP44 legacy addresses",
+			coin:    hd.CoinBitcoin,
+			network: hd.NetworkMain,
+			scheme:  hd.SchemeP2PKH,
+			path:    "m/44'/0'/0'",
+			xpub:    "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj",
+			receive: "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA",
+			change:  "1J3J6EvPrv8q6AC3VCjWV45Uf3nssNMRtH",
+		},
+		{
+			name:    "should derive BIP84 segwit addresses by default",
+			coin:    hd.CoinBitcoin,
+			network: hd.NetworkMain,
+			path:    "m/84'/0'/0'",
+			xpub:    "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs",
+			receive: "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu",
+			change:  "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el",
+		},
+		{
+			name:    "should derive BIP86 taproot addresses",
+			coin:    hd.CoinBitcoin,
+			network: hd.NetworkMain,
+			scheme:  hd.SchemeP2TR,
+			path:    "m/86'/0'/0'",
+			xpub:    "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ",
+			receive: "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
+			change:  "bc1p3qkhfews2uk44qtvauqyr2ttdsw7svhkl9nkm9s9c3x4ax5h60wqwruhk7",
+		},
+		{
+			name:    "should derive BIP84 testnet addresses",
+			coin:    hd.CoinBitcoin,
+			network: hd.NetworkTest,
+			scheme:  hd.SchemeP2WPKH,
+			path:    "m/84'/1'/0'",
+			xpub:    "vpub5Y6cjg78GGuNLsaPhmYsiw4gYX3HoQiRBiSwDaBXKUafCt9bNwWQiitDk5VZ5BVxYnQdwoTyXSs2JHRPAgjAvtbBrf8ZhDYe2jWAqvZVnsc",
+			receive: "tb1q6rz28mcfaxtmd6v789l9rrlrusdprr9pqcpvkl",
+			change:  "tb1q9u62588spffmq4dzjxsr5l297znf3z6j5p2688",
+		},
+		{
+			name:    "should derive ethereum addresses",
+			coin:    hd.CoinEthereum,
+			network: hd.NetworkMain,
+			path:    "m/44'/60'/0'",
+			xpub:    "xpub6DCoCpSuQZB2jawqnGMEPS63ePKWkwWPH4TU45Q7LPXWuNd8TMtVxRrgjtEshuqpK3mdhaWHPFsBngh5GFZaM6si3yZdUsT8ddYM3PwnATt",
+			receive: "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+			change:  "0x399Db6Ed32539fbDF44c3e7678b5b428e378F666",
+		},
+		{
+			name:    "should reject scheme of another coin",
+			coin:    hd.CoinEthereum,
+			network: hd.NetworkMain,
+			scheme:  hd.SchemeP2TR,
+			err:     hd.ErrUnsupportedScheme,
+		},
+		{
+			name:    "should reject unknown coin",
+			coin:    "dogecoin",
+			network: hd.NetworkMain,
+			err:     hd.ErrUnsupportedCoin,
+		},
+		{
+			name:    "should reject unknown network",
+			coin:    hd.CoinBitcoin,
+			network: "regtest",
+			err:     hd.ErrUnsupportedNetwork,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			account, err := hd.NewAccount(seed, tc.coin, tc.network, tc.scheme, 0)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			xpub, err := account.Xpub()
+			require.NoError(t, err)
+			assert.Equal(t, tc.xpub, xpub)
+			assert.Equal(t, tc.path, account.Path())
+
+			receive, err := account.Address(hd.ChainReceive, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tc.receive, receive.Address)
+			assert.Equal(t, tc.path+"/0/0", receive.Path)
+
+			change, err := account.Address(hd.ChainChange, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tc.change, change.Address)
+
+			// The serialized account derives the same addresses without the seed.
+			parsed, err := hd.ParseAccount(xpub, tc.coin, tc.network, account.Scheme())
+			require.NoError(t, err)
+			fromXpub, err := parsed.Address(hd.ChainReceive, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tc.receive, fromXpub.Address)
+			assert.Equal(t, "M/0/0", fromXpub.Path)
+		})
+	}
+}
+
+func TestParseAccount(t *testing.T) {
+	master, err := hdkeychain.NewMaster(newSeed(t), &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	const zpub = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+
+	tests := []struct {
+		name    string
+		xpub    string
+		network string
+		scheme  hd.Scheme
+		err     error
+	}{
+		{name: "should accept scheme version", xpub: zpub, network: hd.NetworkMain, scheme: hd.SchemeP2WPKH},
+		{name: "should reject version of another scheme", xpub: zpub, network: hd.NetworkMain, scheme: hd.SchemeP2PKH, err: hd.ErrInvalidExtendedKey},
+		{name: "should reject version of another network", xpub: zpub, network: hd.NetworkTest, scheme: hd.SchemeP2WPKH, err: hd.ErrInvalidExtendedKey},
+		{name: "should reject private key", xpub: master.String(), network: hd.NetworkMain, scheme: hd.SchemeP2PKH, err: hd.ErrPrivateExtendedKey},
+		{name: "should reject malformed key", xpub: "xpub123", network: hd.NetworkMain, scheme: hd.SchemeP2PKH, err: hd.ErrInvalidExtendedKey},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			account, err := hd.ParseAccount(tc.xpub, hd.CoinBitcoin, tc.network, tc.scheme)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				assert.Nil(t, account)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "M", account.Path())
+		})
+	}
+}
+
+func TestAccount_Scan(t *testing.T) {
+	account, err := hd.NewAccount(newSeed(t), hd.CoinBitcoin, hd.NetworkMain, hd.SchemeP2WPKH, 0)
+	require.NoError(t, err)
+
+	first, err := account.Address(hd.ChainReceive, 0)
+	require.NoError(t, err)
+	third, err := account.Address(hd.ChainReceive, 2)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		start  uint32
+		gap    int
+		used   func(string) (bool, error)
+		expect func(*testing.T, []*hd.Address, error)
+	}{
+		{
+			name: "should stop after gap unused addresses",
+			gap:  3,
+			expect: func(t *testing.T, addresses []*hd.Address, err error) {
+				require.NoError(t, err)
+				require.Len(t, addresses, 3)
+				assert.Equal(t, first.Address, addresses[0].Address)
+				assert.Equal(t, uint32(2), addresses[2].Index)
+			},
+		},
+		{
+			name: "should extend the window past used addresses",
+			gap:  2,
+			used: func(address string) (bool, error) {
+				return address == first.Address || address == third.Address, nil
+			},
+			expect: func(t *testing.T, addresses []*hd.Address, err error) {
+				require.NoError(t, err)
+				require.Len(t, addresses, 5)
+				assert.True(t, addresses[0].Used)
+				assert.False(t, addresses[1].Used)
+				assert.True(t, addresses[2].Used)
+				assert.Equal(t, "m/84'/0'/0'/0/4", addresses[4].Path)
+			},
+		},
+		{
+			name:  "should start at index",
+			start: 2,
+			gap:   1,
+			expect: func(t *testing.T, addresses []*hd.Address, err error) {
+				require.NoError(t, err)
+				require.Len(t, addresses, 1)
+				assert.Equal(t, third.Address, addresses[0].Address)
+			},
+		},
+		{
+			name: "should return lookup error",
+			gap:  1,
+			used: func(string) (bool, error) {
+				return false, errors.New("lookup failed")
+			},
+			expect: func(t *testing.T, addresses []*hd.Address, err error) {
+				assert.EqualError(t, err, "lookup failed")
+			},
+		},
+		{
+			name: "should stop at scan limit",
+			gap:  1,
+			used: func(string) (bool, error) {
+				return true, nil
+			},
+			expect: func(t *testing.T, addresses []*hd.Address, err error) {
+				assert.ErrorIs(t, err, hd.ErrScanLimit)
+			},
+		},
+		{
+			name: "should reject invalid gap limit",
+			gap:  0,
+			expect: func(t *testing.T, addresses []*hd.Address, err error) {
+				assert.ErrorIs(t, err, hd.ErrInvalidGapLimit)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			addresses, err := account.Scan(hd.ChainReceive, tc.start, tc.gap, tc.used)
+			tc.expect(t, addresses, err)
+		})
+	}
+}
+
+func TestChildPublicKey(t *testing.T) {
+	master, err := hdkeychain.NewMaster(newSeed(t), &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	// BIP84 test vector, the first receive key of the zpub.
+	pub, err := hd.ChildPublicKey("zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs", hd.ChainReceive, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "0330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c", pub)
+
+	_, err = hd.ChildPublicKey(master.String(), hd.ChainReceive, 0)
+	assert.ErrorIs(t, err, hd.ErrPrivateExtendedKey)
+
+	_, err = hd.ChildPublicKey("xpub123", hd.ChainReceive, 0)
+	assert.ErrorIs(t, err, hd.ErrInvalidExtendedKey)
+}
+
+func newSeed(t *testing.T) []byte {
+	seed, err := hd.Seed(mnemonic, "")
+	require.NoError(t, err)
+	return seed
+}
@@ -0,0 +1,83 @@
+package hd
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	ErrInvalidMnemonic  = errors.New("invalid mnemonic: not 12, 15, 18, 21 or 24 words of one BIP39 word list")
+	ErrMnemonicChecksum = errors.New("invalid mnemonic checksum")
+)
+
+// wordIndexes maps the words of every BIP39 word list to their index, normalized as the
+// mnemonics they are looked up for.
+var wordIndexes = func() []map[string]int {
+	lists := [][]string{
+		wordlists.English, wordlists.ChineseSimplified, wordlists.ChineseTraditional, wordlists.Czech,
+		wordlists.French, wordlists.Italian, wordlists.Japanese, wordlists.Korean, wordlists.Spanish,
+	}
+	indexes := make([]map[string]int, 0, len(lists))
+	for _, list := range lists {
+		index := make(map[string]int, len(list))
+		for i, word := range list {
+			index[norm.NFKD.String(word)] = i
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}()
+
+// ValidateMnemonic checks the words of a mnemonic are in one BIP39 word list and end in
+// the checksum of the entropy they encode. Words may be separated by any whitespace.
+func ValidateMnemonic(mnemonic string) error {
+	words := mnemonicWords(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return ErrInvalidMnemonic
+	}
+
+	for _, index := range wordIndexes {
+		bits, ok := encode(index, words)
+		if !ok {
+			continue
+		}
+
+		// Every 3 words encode 32 bits of entropy and 1 bit of checksum.
+		checksumBits := uint(len(words) / 3)
+		checksum := new(big.Int).And(bits, big.NewInt(1<<checksumBits-1))
+		entropy := make([]byte, len(words)/3*4)
+		new(big.Int).Rsh(bits, checksumBits).FillBytes(entropy)
+
+		hash := sha256.Sum256(entropy)
+		if uint64(hash[0]>>(8-checksumBits)) != checksum.Uint64() {
+			return ErrMnemonicChecksum
+		}
+		return nil
+	}
+	return ErrInvalidMnemonic
+}
+
+// mnemonicWords splits a mnemonic on any whitespace, such as the ideographic spaces of
+// Japanese mnemonics.
+func mnemonicWords(mnemonic string) []string {
+	return strings.Fields(norm.NFKD.String(mnemonic))
+}
+
+// encode concatenates the 11-bit indexes of the words, ok is false when a word is not in
+// the list.
+func encode(index map[string]int, words []string) (*big.Int, bool) {
+	bits := new(big.Int)
+	for _, word := range words {
+		i, ok := index[word]
+		if !ok {
+			return nil, false
+		}
+		bits.Lsh(bits, 11).Or(bits, big.NewInt(int64(i)))
+	}
+	return bits, true
+}
@@ -0,0 +1,133 @@
+package hd
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+const (
+	CoinBitcoin  = "bitcoin"
+	CoinEthereum = "ethereum"
+
+	NetworkMain = "main"
+	NetworkTest = "test"
+)
+
+// Scheme selects the derivation purpose and the address type derived under it.
+type Scheme string
+
+const (
+	// SchemeP2PKH is BIP44 with legacy base58 addresses.
+	SchemeP2PKH Scheme = "p2pkh"
+	// SchemeP2WPKH is BIP84 with native SegWit addresses.
+	SchemeP2WPKH Scheme = "p2wpkh"
+	// SchemeP2TR is BIP86 with single-key Taproot addresses.
+	SchemeP2TR Scheme = "p2tr"
+	// SchemeEthereum is BIP44 with coin type 60 and EIP-55 addresses.
+	SchemeEthereum Scheme = "eth"
+)
+
+var (
+	ErrUnsupportedCoin    = errors.New("unsupported coin")
+	ErrUnsupportedNetwork = errors.New("unsupported network")
+	ErrUnsupportedScheme  = errors.New("unsupported derivation scheme")
+)
+
+var purposes = map[Scheme]uint32{
+	SchemeP2PKH:    44,
+	SchemeP2WPKH:   84,
+	SchemeP2TR:     86,
+	SchemeEthereum: 44,
+}
+
+// SLIP-132 versions of the serialized account keys; BIP86 keeps the plain xpub/tpub.
+var (
+	versionXpub = [4]byte{0x04, 0x88, 0xb2, 0x1e}
+	versionTpub = [4]byte{0x04, 0x35, 0x87, 0xcf}
+	versionZpub = [4]byte{0x04, 0xb2, 0x47, 0x46}
+	versionVpub = [4]byte{0x04, 0x5f, 0x1c, 0xf6}
+)
+
+// Schemes lists the schemes a coin supports, the first one is its default.
+func Schemes(coin string) []Scheme {
+	switch coin {
+	case CoinBitcoin:
+		return []Scheme{SchemeP2WPKH, SchemeP2PKH, SchemeP2TR}
+	case CoinEthereum:
+		return []Scheme{SchemeEthereum}
+	}
+	return nil
+}
+
+// params carries everything a coin, network and scheme combination derives with.
+type params struct {
+	coin     string
+	network  string
+	scheme   Scheme
+	purpose  uint32
+	coinType uint32
+	chain    *chaincfg.Params
+	version  [4]byte
+}
+
+func newParams(coin, network string, scheme Scheme) (*params, error) {
+	if network != NetworkMain && network != NetworkTest {
+		return nil, ErrUnsupportedNetwork
+	}
+
+	supported := Schemes(coin)
+	if len(supported) == 0 {
+		return nil, ErrUnsupportedCoin
+	}
+	if scheme == "" {
+		scheme = supported[0]
+	}
+	if !containsScheme(supported, scheme) {
+		return nil, ErrUnsupportedScheme
+	}
+
+	p := &params{coin: coin, network: network, scheme: scheme, purpose: purposes[scheme]}
+	switch {
+	case coin == CoinEthereum:
+		// Ethereum test networks share coin type 60 and the mainnet serialization.
+		p.coinType, p.chain, p.version = 60, &chaincfg.MainNetParams, versionXpub
+	case network == NetworkMain:
+		p.coinType, p.chain, p.version = 0, &chaincfg.MainNetParams, versionXpub
+	default:
+		p.coinType, p.chain, p.version = 1, &chaincfg.TestNet3Params, versionTpub
+	}
+
+	if scheme == SchemeP2WPKH {
+		p.version = versionZpub
+		if network == NetworkTest {
+			p.version = versionVpub
+		}
+	}
+
+	return p, nil
+}
+
+// accepts reports whether a serialized public key version is valid for the params,
+// the generic xpub/tpub version is accepted for every scheme.
+func (p *params) accepts(version []byte) bool {
+	generic := versionXpub
+	if p.coin == CoinBitcoin && p.network == NetworkTest {
+		generic = versionTpub
+	}
+	return string(version) == string(p.version[:]) || string(version) == string(generic[:])
+}
+
+func hardened(index uint32) uint32 {
+	return hdkeychain.HardenedKeyStart + index
+}
+
+func containsScheme(schemes []Scheme, scheme Scheme) bool {
+	for _, s := range schemes {
+		if s == scheme {
+			return true
+		}
+	}
+	return false
+}
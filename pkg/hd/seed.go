@@ -0,0 +1,27 @@
+package hd
+
+import (
+	"crypto/sha512"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	seedIterations = 2048
+	seedLength     = 64
+)
+
+// Seed stretches a BIP39 mnemonic and optional passphrase into the 64-byte wallet seed,
+// after ValidateMnemonic accepted the mnemonic.
+func Seed(mnemonic, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+
+	password := []byte(strings.Join(mnemonicWords(mnemonic), " "))
+	salt := norm.NFKD.Bytes([]byte("mnemonic" + passphrase))
+
+	return pbkdf2.Key(password, salt, seedIterations, seedLength, sha512.New), nil
+}
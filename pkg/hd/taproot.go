@@ -0,0 +1,103 @@
+package hd
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+const (
+	bech32Charset   = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	bech32mConstant = 0x2bc830a3
+	taprootVersion  = 1
+)
+
+// taprootAddress encodes the BIP86 output key of an internal key without a script tree.
+func taprootAddress(internal *btcec.PublicKey, hrp string) (string, error) {
+	curve := btcec.S256()
+
+	// BIP340 keys are x-only, the internal key is lifted to the point with an even y.
+	x, y := internal.X, new(big.Int).Set(internal.Y)
+	if y.Bit(0) == 1 {
+		y.Sub(curve.P, y)
+	}
+
+	xOnly := padded(x)
+	tweak := taggedHash("TapTweak", xOnly)
+	if new(big.Int).SetBytes(tweak).Cmp(curve.N) >= 0 {
+		return "", ErrInvalidExtendedKey
+	}
+
+	tx, ty := curve.ScalarBaseMult(tweak)
+	qx, _ := curve.Add(x, y, tx, ty)
+
+	program, err := bech32.ConvertBits(padded(qx), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return encodeBech32m(hrp, append([]byte{taprootVersion}, program...)), nil
+}
+
+func taggedHash(tag string, data []byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func padded(n *big.Int) []byte {
+	out := make([]byte, 32)
+	n.FillBytes(out)
+	return out
+}
+
+// encodeBech32m implements BIP350, btcutil only ships the original bech32 checksum.
+func encodeBech32m(hrp string, data []byte) string {
+	values := append(hrpExpand(hrp), data...)
+	polymod := bech32Polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ bech32mConstant
+
+	var b strings.Builder
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range data {
+		b.WriteByte(bech32Charset[v])
+	}
+	for i := 0; i < 6; i++ {
+		b.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	return b.String()
+}
+
+func hrpExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
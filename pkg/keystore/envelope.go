@@ -0,0 +1,60 @@
+package keystore
+
+import (
+	gErrors "errors"
+	"nn-blockchain-api/pkg/seal"
+	"time"
+)
+
+const envelopeVersion = 1
+
+// envelope stores the WIF key of a UTXO chain. The metadata is bound to the ciphertext as
+// additional data, so a file edited to claim another id, chain or network fails to open.
+type envelope struct {
+	Version   int         `json:"version"`
+	Id        string      `json:"id"`
+	Chain     string      `json:"chain"`
+	Network   string      `json:"network"`
+	Address   string      `json:"address"`
+	Crypto    seal.Crypto `json:"crypto"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+func sealEnvelope(key *Key, secret []byte, passphrase string, scryptN, scryptP int) (*envelope, error) {
+	e := &envelope{
+		Version:   envelopeVersion,
+		Id:        key.Id,
+		Chain:     key.Chain,
+		Network:   key.Network,
+		Address:   key.Address,
+		CreatedAt: key.CreatedAt,
+	}
+
+	sealed, err := seal.Seal(secret, e.additionalData(), passphrase, scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	e.Crypto = *sealed
+	return e, nil
+}
+
+func openEnvelope(e *envelope, passphrase string) ([]byte, error) {
+	if e.Version != envelopeVersion {
+		return nil, ErrInvalidKeyFile
+	}
+
+	secret, err := seal.Open(&e.Crypto, e.additionalData(), passphrase)
+	switch {
+	case gErrors.Is(err, seal.ErrInvalid):
+		return nil, ErrInvalidKeyFile
+	case gErrors.Is(err, seal.ErrDecrypt):
+		return nil, ErrInvalidPassphrase
+	case err != nil:
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (e *envelope) additionalData() []byte {
+	return []byte(e.Id + "|" + e.Chain + "|" + e.Network)
+}
@@ -0,0 +1,366 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	gErrors "errors"
+	"io/ioutil"
+	"nn-blockchain-api/pkg/codes"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/networks"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+const (
+	ChainBitcoin  = bitcoin_rpc.DefaultChain
+	ChainEthereum = "ethereum"
+
+	// KEKLength is the size of the key encryption key in bytes.
+	KEKLength = 32
+
+	// Standard scrypt costs follow geth, the light ones are meant for tests and development.
+	StandardScryptN = 1 << 18
+	StandardScryptP = 1
+	LightScryptN    = 1 << 12
+	LightScryptP    = 6
+)
+
+const (
+	StatusKeyNotFound         errors.Status = "key_not_found"
+	StatusInvalidKey          errors.Status = "invalid_key"
+	StatusInvalidKeyFile      errors.Status = "invalid_key_file"
+	StatusInvalidPassphrase   errors.Status = "invalid_passphrase"
+	StatusPassphraseRequired  errors.Status = "passphrase_required"
+	StatusUnsupportedKeyChain errors.Status = "unsupported_key_chain"
+	StatusKeyMismatch         errors.Status = "key_mismatch"
+	StatusRawKeysDisabled     errors.Status = "raw_keys_disabled"
+)
+
+var (
+	ErrKeyNotFound         = errors.New(codes.NotFound, StatusKeyNotFound)
+	ErrInvalidKey          = errors.New(codes.BadRequest, StatusInvalidKey)
+	ErrInvalidKeyFile      = errors.New(codes.InternalError, StatusInvalidKeyFile)
+	ErrInvalidPassphrase   = errors.New(codes.Forbidden, StatusInvalidPassphrase)
+	ErrPassphraseRequired  = errors.New(codes.BadRequest, StatusPassphraseRequired)
+	ErrUnsupportedKeyChain = errors.New(codes.BadRequest, StatusUnsupportedKeyChain)
+	ErrKeyMismatch         = errors.New(codes.BadRequest, StatusKeyMismatch)
+	// ErrRawKeysDisabled rejects sign requests carrying a plaintext key when AllowsRawKeys is false.
+	ErrRawKeysDisabled = errors.New(codes.Forbidden, StatusRawKeysDisabled)
+)
+
+// Key is the public metadata of a stored key, an empty Network means any network.
+type Key struct {
+	Id        string    `json:"id"`
+	Chain     string    `json:"chain"`
+	Network   string    `json:"network,omitempty"`
+	Address   string    `json:"address"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Allows checks the key can sign a transaction of the chain and network.
+func (k *Key) Allows(chain, network string) error {
+	if k.Chain != chain {
+		return errors.WithMessage(ErrKeyMismatch, "key %s belongs to %s", k.Id, k.Chain)
+	}
+	if k.Network != "" && k.Network != network {
+		return errors.WithMessage(ErrKeyMismatch, "key %s belongs to the %s network", k.Id, k.Network)
+	}
+	return nil
+}
+
+// SupportsChain reports whether keys of the chain can be imported: ethereum and the known UTXO chains.
+func SupportsChain(chain string) bool {
+	_, ok := bitcoin_rpc.KnownChains[chain]
+	return ok || chain == ChainEthereum
+}
+
+//go:generate mockgen -source=keystore.go -destination=mocks/keystore_mock.go
+type Keystore interface {
+	// Import encrypts a hex Ethereum key or the WIF of a known UTXO chain with the passphrase,
+	// or with the key encryption key when the passphrase is empty.
+	Import(chain, privateKey, passphrase string) (*Key, error)
+	Get(id string) (*Key, error)
+	List() ([]*Key, error)
+	// Unlock returns the plaintext key in the form the sign RPCs take: hex for Ethereum, WIF for UTXO chains.
+	Unlock(id, passphrase string) (string, error)
+	// AllowsRawKeys reports whether sign requests may still carry plaintext keys.
+	AllowsRawKeys() bool
+}
+
+type Config struct {
+	Dir string
+	// KEK unlocks keys imported without a passphrase, it is optional.
+	KEK          []byte
+	AllowRawKeys bool
+	ScryptN      int
+	ScryptP      int
+}
+
+// dirKeystore keeps one JSON file per key, named after the key id.
+type dirKeystore struct {
+	mu      sync.RWMutex
+	dir     string
+	kek     string
+	rawKeys bool
+	scryptN int
+	scryptP int
+}
+
+func New(cfg Config) (Keystore, error) {
+	if cfg.Dir == "" {
+		return nil, gErrors.New("invalid keystore dir")
+	}
+	if len(cfg.KEK) != 0 && len(cfg.KEK) != KEKLength {
+		return nil, gErrors.New("invalid key encryption key")
+	}
+	if cfg.ScryptN == 0 {
+		cfg.ScryptN, cfg.ScryptP = StandardScryptN, StandardScryptP
+	}
+	if err := os.MkdirAll(cfg.Dir, 0700); err != nil {
+		return nil, err
+	}
+
+	ks := &dirKeystore{dir: cfg.Dir, rawKeys: cfg.AllowRawKeys, scryptN: cfg.ScryptN, scryptP: cfg.ScryptP}
+	if len(cfg.KEK) != 0 {
+		ks.kek = hex.EncodeToString(cfg.KEK)
+	}
+	return ks, nil
+}
+
+func (s *dirKeystore) AllowsRawKeys() bool {
+	return s.rawKeys
+}
+
+func (s *dirKeystore) Import(chain, privateKey, passphrase string) (*Key, error) {
+	secret, err := s.secret(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &Key{Id: uuid.NewString(), Chain: chain, CreatedAt: time.Now().UTC()}
+	var data []byte
+	switch chain {
+	case ChainEthereum:
+		private, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+		if err != nil {
+			return nil, errors.WithMessage(ErrInvalidKey, err.Error())
+		}
+		key.Address = crypto.PubkeyToAddress(private.PublicKey).Hex()
+
+		data, err = encryptWeb3(key.Id, private, secret, s.scryptN, s.scryptP)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		coin, ok := bitcoin_rpc.KnownChains[chain]
+		if !ok {
+			return nil, ErrUnsupportedKeyChain
+		}
+		wif, err := btcutil.DecodeWIF(privateKey)
+		if err != nil {
+			return nil, errors.WithMessage(ErrInvalidKey, err.Error())
+		}
+		if key.Network, key.Address, err = wifAddress(coin, wif); err != nil {
+			return nil, err
+		}
+
+		document, err := sealEnvelope(key, []byte(wif.String()), secret, s.scryptN, s.scryptP)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = json.Marshal(document); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := ioutil.WriteFile(s.path(key.Id), data, 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (s *dirKeystore) Get(id string) (*Key, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, _, err := s.load(id)
+	return key, err
+}
+
+func (s *dirKeystore) List() ([]*Key, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	files, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*Key, 0, len(files))
+	for _, file := range files {
+		key, _, err := s.load(strings.TrimSuffix(filepath.Base(file), ".json"))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+	return keys, nil
+}
+
+func (s *dirKeystore) Unlock(id, passphrase string) (string, error) {
+	secret, err := s.secret(passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.RLock()
+	key, document, err := s.load(id)
+	s.mu.RUnlock()
+	if err != nil {
+		return "", err
+	}
+
+	switch doc := document.(type) {
+	case web3Key:
+		private, err := decryptWeb3(doc, secret)
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(private), nil
+	case *envelope:
+		wif, err := openEnvelope(doc, secret)
+		if err != nil {
+			return "", err
+		}
+		return string(wif), nil
+	}
+
+	return "", errors.WithMessage(ErrInvalidKeyFile, "key %s has an unknown format", key.Id)
+}
+
+// secret picks the passphrase, falling back to the key encryption key.
+func (s *dirKeystore) secret(passphrase string) (string, error) {
+	if passphrase != "" {
+		return passphrase, nil
+	}
+	if s.kek == "" {
+		return "", ErrPassphraseRequired
+	}
+	return s.kek, nil
+}
+
+// load reads a key file, telling the formats apart by their version field.
+func (s *dirKeystore) load(id string) (*Key, interface{}, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, nil, ErrKeyNotFound
+	}
+
+	path := s.path(id)
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, nil, errors.WithMessage(ErrInvalidKeyFile, err.Error())
+	}
+
+	switch header.Version {
+	case web3Version:
+		var doc struct {
+			Address string `json:"address"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, nil, errors.WithMessage(ErrInvalidKeyFile, err.Error())
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		address := common.HexToAddress(doc.Address).Hex()
+		return &Key{Id: id, Chain: ChainEthereum, Address: address, CreatedAt: info.ModTime().UTC()}, web3Key(data), nil
+	case envelopeVersion:
+		var doc envelope
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, nil, errors.WithMessage(ErrInvalidKeyFile, err.Error())
+		}
+		return &Key{Id: id, Chain: doc.Chain, Network: doc.Network, Address: doc.Address, CreatedAt: doc.CreatedAt}, &doc, nil
+	}
+
+	return nil, nil, errors.WithMessage(ErrInvalidKeyFile, "unsupported key file version %d", header.Version)
+}
+
+func (s *dirKeystore) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+// wifAddress finds the network of the chain the WIF was encoded for and returns its P2WPKH
+// address on segwit chains, its P2PKH address otherwise or for uncompressed keys.
+func wifAddress(coin bitcoin_rpc.Chain, wif *btcutil.WIF) (string, string, error) {
+	// Signet shares the testnet prefixes, main and test come first so a key infers them.
+	names := make([]string, 0, len(coin.Networks))
+	for name := range coin.Networks {
+		names = append(names, string(name))
+	}
+	sort.Slice(names, func(i, j int) bool {
+		ri, rj := networkRank(names[i]), networkRank(names[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return names[i] < names[j]
+	})
+
+	for _, network := range names {
+		params := coin.Networks[networks.Network(network)].Params
+		if !wif.IsForNet(params) {
+			continue
+		}
+
+		hash := btcutil.Hash160(wif.SerializePubKey())
+		if !coin.SegWit || !wif.CompressPubKey {
+			address, err := btcutil.NewAddressPubKeyHash(hash, params)
+			if err != nil {
+				return "", "", err
+			}
+			return network, coin.EncodeAddress(address, network), nil
+		}
+
+		address, err := btcutil.NewAddressWitnessPubKeyHash(hash, params)
+		if err != nil {
+			return "", "", err
+		}
+		return network, address.EncodeAddress(), nil
+	}
+	return "", "", errors.WithMessage(ErrInvalidKey, "key is not a %s key", coin.Name)
+}
+
+func networkRank(network string) int {
+	switch networks.Network(network) {
+	case networks.Main:
+		return 0
+	case networks.Test:
+		return 1
+	}
+	return 2
+}
@@ -0,0 +1,308 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/keystore"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ethPrivateKey = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+	btcWIF        = "cMzLdeGd5vEqxB8B6VFQoRopQ3sLAAvEzDAoQgvX54xwofSWj1fx"
+)
+
+func newKeystore(t *testing.T, kek []byte) (keystore.Keystore, string) {
+	dir := t.TempDir()
+	ks, err := keystore.New(keystore.Config{Dir: dir, KEK: kek, ScryptN: keystore.LightScryptN, ScryptP: keystore.LightScryptP})
+	require.NoError(t, err)
+	return ks, dir
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  keystore.Config
+		err  string
+	}{
+		{name: "should create keystore", cfg: keystore.Config{Dir: filepath.Join(t.TempDir(), "keys")}},
+		{name: "should create keystore with key encryption key", cfg: keystore.Config{Dir: t.TempDir(), KEK: make([]byte, keystore.KEKLength)}},
+		{name: "should reject empty dir", cfg: keystore.Config{}, err: "invalid keystore dir"},
+		{name: "should reject short key encryption key", cfg: keystore.Config{Dir: t.TempDir(), KEK: make([]byte, 16)}, err: "invalid key encryption key"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ks, err := keystore.New(tc.cfg)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				assert.Nil(t, ks)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, ks)
+		})
+	}
+}
+
+// The documents are the test vectors of the Web3 Secret Storage Definition.
+func TestKeystore_UnlockWeb3Vectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		document string
+	}{
+		{
+			name: "should unlock pbkdf2 key",
+			id:   "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+			document: `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},` +
+				`"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2",` +
+				`"kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},` +
+				`"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},` +
+				`"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+		},
+		{
+			name: "should unlock scrypt key",
+			id:   "3198bc9c-6672-5ab3-d995-4942343ae5b7",
+			document: `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},` +
+				`"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt",` +
+				`"kdfparams":{"dklen":32,"n":262144,"p":8,"r":1,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},` +
+				`"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},` +
+				`"id":"3198bc9c-6672-5ab3-d995-4942343ae5b7","version":3}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ks, dir := newKeystore(t, nil)
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dir, tc.id+".json"), []byte(tc.document), 0600))
+
+			key, err := ks.Get(tc.id)
+			require.NoError(t, err)
+			assert.Equal(t, keystore.ChainEthereum, key.Chain)
+			assert.Empty(t, key.Network)
+
+			private, err := ks.Unlock(tc.id, "testpassword")
+			require.NoError(t, err)
+			assert.Equal(t, ethPrivateKey, private)
+
+			_, err = ks.Unlock(tc.id, "wrong")
+			assert.ErrorIs(t, err, keystore.ErrInvalidPassphrase)
+		})
+	}
+}
+
+func TestKeystore_Import(t *testing.T) {
+	kek := make([]byte, keystore.KEKLength)
+	kek[0] = 1
+
+	tests := []struct {
+		name       string
+		kek        []byte
+		chain      string
+		privateKey string
+		passphrase string
+		expect     func(*testing.T, keystore.Keystore, string, *keystore.Key, error)
+	}{
+		{
+			name:       "should import ethereum key as web3 v3 document",
+			chain:      keystore.ChainEthereum,
+			privateKey: "0x" + ethPrivateKey,
+			passphrase: "secret",
+			expect: func(t *testing.T, ks keystore.Keystore, dir string, key *keystore.Key, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "0x008AeEda4D805471dF9b2A5B0f38A0C3bCBA786b", key.Address)
+
+				data, err := ioutil.ReadFile(filepath.Join(dir, key.Id+".json"))
+				require.NoError(t, err)
+				var document map[string]interface{}
+				require.NoError(t, json.Unmarshal(data, &document))
+				assert.Equal(t, float64(3), document["version"])
+				assert.Equal(t, "008aeeda4d805471df9b2a5b0f38a0c3bcba786b", document["address"])
+				assert.NotContains(t, string(data), ethPrivateKey)
+
+				private, err := ks.Unlock(key.Id, "secret")
+				require.NoError(t, err)
+				assert.Equal(t, ethPrivateKey, private)
+			},
+		},
+		{
+			name:       "should import bitcoin key as envelope",
+			chain:      keystore.ChainBitcoin,
+			privateKey: btcWIF,
+			passphrase: "secret",
+			expect: func(t *testing.T, ks keystore.Keystore, dir string, key *keystore.Key, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "test", key.Network)
+				assert.Equal(t, "tb1qmy63mjadtw8nhzl69ukdepwzsyvv4yex7xygd7", key.Address)
+
+				data, err := ioutil.ReadFile(filepath.Join(dir, key.Id+".json"))
+				require.NoError(t, err)
+				assert.NotContains(t, string(data), btcWIF)
+
+				stored, err := ks.Get(key.Id)
+				require.NoError(t, err)
+				assert.Equal(t, key.Address, stored.Address)
+
+				wif, err := ks.Unlock(key.Id, "secret")
+				require.NoError(t, err)
+				assert.Equal(t, btcWIF, wif)
+
+				_, err = ks.Unlock(key.Id, "wrong")
+				assert.ErrorIs(t, err, keystore.ErrInvalidPassphrase)
+			},
+		},
+		{
+			name:       "should encrypt with key encryption key without passphrase",
+			kek:        kek,
+			chain:      keystore.ChainBitcoin,
+			privateKey: btcWIF,
+			expect: func(t *testing.T, ks keystore.Keystore, dir string, key *keystore.Key, err error) {
+				require.NoError(t, err)
+
+				wif, err := ks.Unlock(key.Id, "")
+				require.NoError(t, err)
+				assert.Equal(t, btcWIF, wif)
+
+				_, err = ks.Unlock(key.Id, "secret")
+				assert.ErrorIs(t, err, keystore.ErrInvalidPassphrase)
+			},
+		},
+		{
+			name:       "should require passphrase without key encryption key",
+			chain:      keystore.ChainEthereum,
+			privateKey: ethPrivateKey,
+			expect: func(t *testing.T, ks keystore.Keystore, dir string, key *keystore.Key, err error) {
+				assert.ErrorIs(t, err, keystore.ErrPassphraseRequired)
+			},
+		},
+		{
+			name:       "should reject invalid key",
+			chain:      keystore.ChainBitcoin,
+			privateKey: ethPrivateKey,
+			passphrase: "secret",
+			expect: func(t *testing.T, ks keystore.Keystore, dir string, key *keystore.Key, err error) {
+				require.IsType(t, &errors.Error{}, err)
+				assert.Equal(t, keystore.StatusInvalidKey, err.(*errors.Error).Status)
+			},
+		},
+		{
+			name:       "should import key of other utxo chain",
+			chain:      "litecoin",
+			privateKey: btcWIF,
+			passphrase: "secret",
+			expect: func(t *testing.T, ks keystore.Keystore, dir string, key *keystore.Key, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "litecoin", key.Chain)
+				assert.Equal(t, "test", key.Network)
+				assert.Equal(t, "tltc1qmy63mjadtw8nhzl69ukdepwzsyvv4yex8wxkah", key.Address)
+				assert.NoError(t, key.Allows("litecoin", "test"))
+
+				wif, err := ks.Unlock(key.Id, "secret")
+				require.NoError(t, err)
+				assert.Equal(t, btcWIF, wif)
+			},
+		},
+		{
+			name:       "should reject key of another chain",
+			chain:      "dogecoin",
+			privateKey: btcWIF,
+			passphrase: "secret",
+			expect: func(t *testing.T, ks keystore.Keystore, dir string, key *keystore.Key, err error) {
+				assert.Equal(t, errors.WithMessage(keystore.ErrInvalidKey, "key is not a dogecoin key"), err)
+			},
+		},
+		{
+			name:       "should reject unsupported chain",
+			chain:      "solana",
+			privateKey: btcWIF,
+			passphrase: "secret",
+			expect: func(t *testing.T, ks keystore.Keystore, dir string, key *keystore.Key, err error) {
+				assert.ErrorIs(t, err, keystore.ErrUnsupportedKeyChain)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ks, dir := newKeystore(t, tc.kek)
+			key, err := ks.Import(tc.chain, tc.privateKey, tc.passphrase)
+			tc.expect(t, ks, dir, key, err)
+		})
+	}
+}
+
+func TestKeystore_Tampered(t *testing.T) {
+	ks, dir := newKeystore(t, nil)
+	key, err := ks.Import(keystore.ChainBitcoin, btcWIF, "secret")
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, key.Id+".json")
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, []byte(strings.Replace(string(data), `"network":"test"`, `"network":"main"`, 1)), 0600))
+
+	_, err = ks.Unlock(key.Id, "secret")
+	assert.ErrorIs(t, err, keystore.ErrInvalidPassphrase)
+}
+
+func TestKeystore_List(t *testing.T) {
+	ks, _ := newKeystore(t, nil)
+	keys, err := ks.List()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	first, err := ks.Import(keystore.ChainBitcoin, btcWIF, "secret")
+	require.NoError(t, err)
+	second, err := ks.Import(keystore.ChainEthereum, ethPrivateKey, "secret")
+	require.NoError(t, err)
+
+	keys, err = ks.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	ids := []string{keys[0].Id, keys[1].Id}
+	assert.ElementsMatch(t, []string{first.Id, second.Id}, ids)
+
+	_, err = ks.Get("missing")
+	assert.ErrorIs(t, err, keystore.ErrKeyNotFound)
+	_, err = ks.Get("00000000-0000-0000-0000-000000000000")
+	assert.ErrorIs(t, err, keystore.ErrKeyNotFound)
+}
+
+func TestKey_Allows(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     keystore.Key
+		chain   string
+		network string
+		err     error
+	}{
+		{name: "should allow any network when unset", key: keystore.Key{Chain: keystore.ChainEthereum}, chain: keystore.ChainEthereum, network: "sepolia"},
+		{name: "should allow key network", key: keystore.Key{Chain: keystore.ChainBitcoin, Network: "test"}, chain: keystore.ChainBitcoin, network: "test"},
+		{name: "should reject other network", key: keystore.Key{Id: "k1", Chain: keystore.ChainBitcoin, Network: "test"}, chain: keystore.ChainBitcoin, network: "main", err: errors.WithMessage(keystore.ErrKeyMismatch, "key k1 belongs to the test network")},
+		{name: "should reject other chain", key: keystore.Key{Id: "k1", Chain: keystore.ChainBitcoin}, chain: keystore.ChainEthereum, err: errors.WithMessage(keystore.ErrKeyMismatch, "key k1 belongs to bitcoin")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.err, tc.key.Allows(tc.chain, tc.network))
+		})
+	}
+}
+
+func TestKeystore_FilePermissions(t *testing.T) {
+	ks, dir := newKeystore(t, nil)
+	key, err := ks.Import(keystore.ChainEthereum, ethPrivateKey, "secret")
+	require.NoError(t, err)
+
+	info, err := os.Stat(filepath.Join(dir, key.Id+".json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
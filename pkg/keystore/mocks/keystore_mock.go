@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keystore.go
+
+// Package mock_keystore is a generated GoMock package.
+package mock_keystore
+
+import (
+	keystore "nn-blockchain-api/pkg/keystore"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockKeystore is a mock of Keystore interface.
+type MockKeystore struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeystoreMockRecorder
+}
+
+// MockKeystoreMockRecorder is the mock recorder for MockKeystore.
+type MockKeystoreMockRecorder struct {
+	mock *MockKeystore
+}
+
+// NewMockKeystore creates a new mock instance.
+func NewMockKeystore(ctrl *gomock.Controller) *MockKeystore {
+	mock := &MockKeystore{ctrl: ctrl}
+	mock.recorder = &MockKeystoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeystore) EXPECT() *MockKeystoreMockRecorder {
+	return m.recorder
+}
+
+// AllowsRawKeys mocks base method.
+func (m *MockKeystore) AllowsRawKeys() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowsRawKeys")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AllowsRawKeys indicates an expected call of AllowsRawKeys.
+func (mr *MockKeystoreMockRecorder) AllowsRawKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowsRawKeys", reflect.TypeOf((*MockKeystore)(nil).AllowsRawKeys))
+}
+
+// Get mocks base method.
+func (m *MockKeystore) Get(id string) (*keystore.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*keystore.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockKeystoreMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeystore)(nil).Get), id)
+}
+
+// Import mocks base method.
+func (m *MockKeystore) Import(chain, privateKey, passphrase string) (*keystore.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", chain, privateKey, passphrase)
+	ret0, _ := ret[0].(*keystore.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockKeystoreMockRecorder) Import(chain, privateKey, passphrase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockKeystore)(nil).Import), chain, privateKey, passphrase)
+}
+
+// List mocks base method.
+func (m *MockKeystore) List() ([]*keystore.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]*keystore.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockKeystoreMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeystore)(nil).List))
+}
+
+// Unlock mocks base method.
+func (m *MockKeystore) Unlock(id, passphrase string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", id, passphrase)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockKeystoreMockRecorder) Unlock(id, passphrase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockKeystore)(nil).Unlock), id, passphrase)
+}
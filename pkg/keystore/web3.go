@@ -0,0 +1,42 @@
+package keystore
+
+import (
+	"crypto/ecdsa"
+	gErrors "errors"
+	"nn-blockchain-api/pkg/errors"
+
+	ethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+const web3Version = 3
+
+// web3Key is the Web3 Secret Storage v3 document geth and most Ethereum wallets read and
+// write, kept as the raw JSON go-ethereum encrypts and decrypts.
+type web3Key []byte
+
+func encryptWeb3(id string, private *ecdsa.PrivateKey, passphrase string, scryptN, scryptP int) (web3Key, error) {
+	keyId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethkeystore.EncryptKey(&ethkeystore.Key{
+		Id:         keyId,
+		Address:    crypto.PubkeyToAddress(private.PublicKey),
+		PrivateKey: private,
+	}, passphrase, scryptN, scryptP)
+}
+
+// decryptWeb3 accepts both KDFs of the specification so keys exported by other wallets can be imported.
+func decryptWeb3(key web3Key, passphrase string) ([]byte, error) {
+	decrypted, err := ethkeystore.DecryptKey(key, passphrase)
+	if gErrors.Is(err, ethkeystore.ErrDecrypt) {
+		return nil, ErrInvalidPassphrase
+	}
+	if err != nil {
+		return nil, errors.WithMessage(ErrInvalidKeyFile, err.Error())
+	}
+	return crypto.FromECDSA(decrypted.PrivateKey), nil
+}
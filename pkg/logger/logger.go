@@ -3,15 +3,19 @@ package logger
 import (
 	"errors"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 //go:generate mockgen -source=logger.go -destination=mocks/logger_mock.go
 type Logger interface {
 	SetupZapLogger() (*zap.SugaredLogger, error)
+	// SetLevel changes the level of every logger set up so far and of those to come.
+	SetLevel(level string) error
 }
 
 type logger struct {
 	appEnv string
+	level  zap.AtomicLevel
 }
 
 func NewLogger(appEnv string) (Logger, error) {
@@ -19,26 +23,36 @@ func NewLogger(appEnv string) (Logger, error) {
 		return nil, errors.New("[logger] invalid app env")
 	}
 
-	return &logger{appEnv: appEnv}, nil
+	return &logger{appEnv: appEnv, level: zap.NewAtomicLevelAt(zap.DebugLevel)}, nil
 }
 
 func (l *logger) SetupZapLogger() (*zap.SugaredLogger, error) {
 	loggerConfig := NewLoggerConfig()
 
+	var config zap.Config
 	switch l.appEnv {
 	case "production":
-		logger, err := loggerConfig.GetProductionConfig().Config.Build()
-		if err != nil {
-			return nil, err
-		}
-		return logger.Sugar(), nil
+		config = loggerConfig.GetProductionConfig().Config
 	case "development":
-		logger, err := loggerConfig.GetDevelopmentConfig().Config.Build()
-		if err != nil {
-			return nil, err
-		}
-		return logger.Sugar(), nil
+		config = loggerConfig.GetDevelopmentConfig().Config
+	default:
+		return nil, errors.New("[logger] incorrect app env")
 	}
 
-	return nil, errors.New("[logger] incorrect app env")
+	config.Level = l.level
+	logger, err := config.Build()
+	if err != nil {
+		return nil, err
+	}
+	return logger.Sugar(), nil
+}
+
+func (l *logger) SetLevel(level string) error {
+	var parsed zapcore.Level
+	if err := parsed.UnmarshalText([]byte(level)); err != nil {
+		return errors.New("[logger] invalid level " + level)
+	}
+
+	l.level.SetLevel(parsed)
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 	"nn-blockchain-api/pkg/logger"
 	"testing"
 )
@@ -45,3 +46,19 @@ func TestNewLogger(t *testing.T) {
 		})
 	}
 }
+
+func TestLogger_SetLevel(t *testing.T) {
+	l, err := logger.NewLogger("development")
+	assert.Nil(t, err)
+
+	zapLogger, err := l.SetupZapLogger()
+	assert.Nil(t, err)
+	assert.True(t, zapLogger.Desugar().Core().Enabled(zap.DebugLevel))
+
+	assert.Nil(t, l.SetLevel("warn"))
+	assert.False(t, zapLogger.Desugar().Core().Enabled(zap.InfoLevel))
+	assert.True(t, zapLogger.Desugar().Core().Enabled(zap.WarnLevel))
+
+	assert.EqualError(t, l.SetLevel("verbose"), "[logger] invalid level verbose")
+	assert.True(t, zapLogger.Desugar().Core().Enabled(zap.WarnLevel))
+}
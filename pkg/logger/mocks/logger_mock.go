@@ -34,6 +34,20 @@ func (m *MockLogger) EXPECT() *MockLoggerMockRecorder {
 	return m.recorder
 }
 
+// SetLevel mocks base method.
+func (m *MockLogger) SetLevel(level string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLevel", level)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLevel indicates an expected call of SetLevel.
+func (mr *MockLoggerMockRecorder) SetLevel(level interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLevel", reflect.TypeOf((*MockLogger)(nil).SetLevel), level)
+}
+
 // SetupZapLogger mocks base method.
 func (m *MockLogger) SetupZapLogger() (*zap.SugaredLogger, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "nn_blockchain_api"
+
+var (
+	Registry = prometheus.NewRegistry()
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by route, method and status.",
+	}, []string{"route", "method", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by route, method and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "requests_total",
+		Help:      "Upstream JSON-RPC calls by chain, network, method and result code.",
+	}, []string{"chain", "network", "method", "code"})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Upstream JSON-RPC latency by chain, network and method.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"chain", "network", "method"})
+
+	nodeHeight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "node",
+		Name:      "height",
+		Help:      "Node block height (current) and best known header height (highest).",
+	}, []string{"chain", "network", "kind"})
+
+	broadcasts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tx",
+		Name:      "broadcasts_total",
+		Help:      "Transaction broadcasts by chain, network and result.",
+	}, []string{"chain", "network", "result"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests, httpDuration,
+		rpcRequests, rpcDuration,
+		nodeHeight,
+		broadcasts,
+	)
+}
+
+func ObserveHTTP(route, method string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(route, method, code).Inc()
+	httpDuration.WithLabelValues(route, method, code).Observe(duration.Seconds())
+}
+
+// ObserveRPC records an upstream call, code is "ok", the JSON-RPC error code,
+// "http_<status>" or "transport_error". The network must be one the chain is served on,
+// so the label set stays bounded by the configuration.
+func ObserveRPC(chain, network, method, code string, duration time.Duration) {
+	rpcRequests.WithLabelValues(chain, network, method, code).Inc()
+	rpcDuration.WithLabelValues(chain, network, method).Observe(duration.Seconds())
+}
+
+func SetNodeHeight(chain, network string, current, highest float64) {
+	nodeHeight.WithLabelValues(chain, network, "current").Set(current)
+	nodeHeight.WithLabelValues(chain, network, "highest").Set(highest)
+}
+
+// ObserveBroadcast records a broadcast on a network the chain is served on.
+func ObserveBroadcast(chain, network string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	broadcasts.WithLabelValues(chain, network, result).Inc()
+}
@@ -0,0 +1,69 @@
+package metrics_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/pkg/metrics"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func scrape(t *testing.T) string {
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, err := ioutil.ReadAll(rec.Body)
+	assert.Nil(t, err)
+
+	return string(body)
+}
+
+func TestRPCMethod(t *testing.T) {
+	assert.Equal(t, "getblockchaininfo", metrics.RPCMethod([]byte(`{"jsonrpc":"2.0","method":"getblockchaininfo","params":[]}`)))
+	assert.Equal(t, "unknown", metrics.RPCMethod([]byte(`not json`)))
+}
+
+func TestRPCCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		expect string
+	}{
+		{name: "should return ok", status: 200, body: `{"result":1,"error":null}`, expect: metrics.CodeOK},
+		{name: "should return rpc error code", status: 500, body: `{"result":null,"error":{"code":-25,"message":"missing inputs"}}`, expect: "-25"},
+		{name: "should return http status", status: 401, body: ``, expect: "http_401"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, metrics.RPCCode(tc.status, []byte(tc.body)))
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	router := chi.NewRouter()
+	router.Use(metrics.Middleware)
+	router.Route("/api/v1/bitcoin", func(r chi.Router) {
+		r.Post("/status", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/bitcoin/status", nil))
+
+	assert.Contains(t, scrape(t), `nn_blockchain_api_http_requests_total{method="POST",route="/api/v1/bitcoin/status",status="418"} 1`)
+}
+
+func TestSetNodeHeight(t *testing.T) {
+	metrics.SetNodeHeight("bitcoin", "test", 100, 120)
+	metrics.ObserveBroadcast("bitcoin", "test", nil)
+
+	body := scrape(t)
+	assert.Contains(t, body, `nn_blockchain_api_node_height{chain="bitcoin",kind="highest",network="test"} 120`)
+	assert.Contains(t, body, `nn_blockchain_api_tx_broadcasts_total{chain="bitcoin",network="test",result="success"} 1`)
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// HeightSource reports the current and highest known block heights of a node.
+type HeightSource struct {
+	Chain   string
+	Network string
+	Fetch   func(ctx context.Context) (current, highest float64, err error)
+}
+
+// PollHeights refreshes node height gauges every interval until ctx is done.
+func PollHeights(ctx context.Context, interval time.Duration, log *zap.SugaredLogger, sources ...HeightSource) {
+	poll := func() {
+		for _, source := range sources {
+			fetchCtx, cancel := context.WithTimeout(ctx, interval)
+			current, highest, err := source.Fetch(fetchCtx)
+			cancel()
+
+			if err != nil {
+				log.Warnf("failed to poll %s %s node height: %v", source.Chain, source.Network, err)
+				continue
+			}
+			SetNodeHeight(source.Chain, source.Network, current, highest)
+		}
+	}
+
+	poll()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			poll()
+		}
+	}
+}
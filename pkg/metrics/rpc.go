@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	CodeOK             = "ok"
+	CodeTransportError = "transport_error"
+)
+
+// RPCMethod extracts the method name from a JSON-RPC request body.
+func RPCMethod(body []byte) string {
+	var request struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil || request.Method == "" {
+		return "unknown"
+	}
+
+	return request.Method
+}
+
+// RPCCode classifies a JSON-RPC response by its error code or HTTP status.
+func RPCCode(status int, body []byte) string {
+	var response struct {
+		Error *struct {
+			Code int64 `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &response); err == nil && response.Error != nil {
+		return strconv.FormatInt(response.Error.Code, 10)
+	}
+	if status >= 300 {
+		return "http_" + strconv.Itoa(status)
+	}
+
+	return CodeOK
+}
@@ -0,0 +1,105 @@
+package networks
+
+import (
+	gErrors "errors"
+	"fmt"
+	"nn-blockchain-api/pkg/errors"
+	"regexp"
+	"sort"
+)
+
+// Network names a network of a chain, requests carry it in their network field.
+type Network string
+
+const (
+	Main   Network = "main"
+	Test   Network = "test"
+	Signet Network = "signet"
+)
+
+// NamePattern is the syntax of network names. Which networks exist is up to the
+// configuration, such as a sepolia network of an EVM chain.
+const NamePattern = "^[a-z][a-z0-9-]{0,31}$"
+
+var namePattern = regexp.MustCompile(NamePattern)
+
+// Parse returns the network of a name, names that cannot name a network are an
+// invalid_network error. Whether a chain is served on it is up to the chain.
+func Parse(name string) (Network, error) {
+	if !namePattern.MatchString(name) {
+		return "", errors.NewInvalid(errors.StatusInvalidNetwork, fmt.Sprintf("invalid network %q", name))
+	}
+	return Network(name), nil
+}
+
+// Info describes a network of a chain this deployment serves. Endpoints and credentials
+// stay with the rpc clients.
+type Info struct {
+	Chain    string  `json:"chain"`
+	Network  Network `json:"network"`
+	Currency string  `json:"currency"`
+	// ChainId is the EIP-155 chain id of EVM networks.
+	ChainId int64 `json:"chain_id,omitempty"`
+	// Params names the address parameters of UTXO networks.
+	Params string `json:"params,omitempty"`
+}
+
+// Registry holds the networks of every served chain, it is built from configuration at
+// start-up and read-only afterwards.
+type Registry struct {
+	infos []Info
+}
+
+func NewRegistry(infos ...Info) (*Registry, error) {
+	seen := map[string]bool{}
+	for _, info := range infos {
+		if info.Chain == "" {
+			return nil, gErrors.New("invalid chain")
+		}
+		if _, err := Parse(string(info.Network)); err != nil {
+			return nil, fmt.Errorf("chain %s: invalid network %q", info.Chain, info.Network)
+		}
+		key := info.Chain + "/" + string(info.Network)
+		if seen[key] {
+			return nil, fmt.Errorf("chain %s: duplicate %s network", info.Chain, info.Network)
+		}
+		seen[key] = true
+	}
+
+	sorted := append([]Info(nil), infos...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Chain != sorted[j].Chain {
+			return sorted[i].Chain < sorted[j].Chain
+		}
+		return sorted[i].Network < sorted[j].Network
+	})
+	return &Registry{infos: sorted}, nil
+}
+
+// Networks returns the served networks ordered by chain and network.
+func (r *Registry) Networks() []Info {
+	return append([]Info(nil), r.infos...)
+}
+
+// Names returns the networks at least one chain is served on, in order.
+func (r *Registry) Names() []string {
+	return r.distinct(func(info Info) string { return string(info.Network) })
+}
+
+// Chains returns the served chains in order.
+func (r *Registry) Chains() []string {
+	return r.distinct(func(info Info) string { return info.Chain })
+}
+
+func (r *Registry) distinct(field func(Info) string) []string {
+	seen := map[string]bool{}
+	var values []string
+	for _, info := range r.infos {
+		if value := field(info); !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
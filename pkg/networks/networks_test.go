@@ -0,0 +1,85 @@
+package networks_test
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/networks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	network, err := networks.Parse("main")
+	assert.Nil(t, err)
+	assert.Equal(t, networks.Main, network)
+
+	network, err = networks.Parse("sepolia")
+	assert.Nil(t, err)
+	assert.Equal(t, networks.Network("sepolia"), network)
+
+	for _, name := range []string{"Main", "main net", "-main", ""} {
+		_, err := networks.Parse(name)
+		assert.Equal(t, http.StatusBadRequest, errors.HTTPCode(err), name)
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	tests := []struct {
+		name  string
+		infos []networks.Info
+		err   string
+	}{
+		{
+			name:  "should accept networks of several chains",
+			infos: []networks.Info{{Chain: "bitcoin", Network: networks.Main}, {Chain: "bitcoin", Network: networks.Test}, {Chain: "ethereum", Network: networks.Main}},
+		},
+		{
+			name:  "should refuse a network without chain",
+			infos: []networks.Info{{Network: networks.Main}},
+			err:   "invalid chain",
+		},
+		{
+			name:  "should refuse invalid networks",
+			infos: []networks.Info{{Chain: "bitcoin", Network: "Main"}},
+			err:   `chain bitcoin: invalid network "Main"`,
+		},
+		{
+			name:  "should refuse duplicate networks",
+			infos: []networks.Info{{Chain: "bitcoin", Network: networks.Main}, {Chain: "bitcoin", Network: networks.Main}},
+			err:   "chain bitcoin: duplicate main network",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			registry, err := networks.NewRegistry(tc.infos...)
+			if tc.err != "" {
+				assert.Nil(t, registry)
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.NotNil(t, registry)
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry, err := networks.NewRegistry(
+		networks.Info{Chain: "polygon", Network: networks.Main, ChainId: 137},
+		networks.Info{Chain: "bitcoin", Network: networks.Test},
+		networks.Info{Chain: "bitcoin", Network: networks.Main},
+		networks.Info{Chain: "polygon", Network: "amoy", ChainId: 80002},
+	)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []networks.Info{
+		{Chain: "bitcoin", Network: networks.Main},
+		{Chain: "bitcoin", Network: networks.Test},
+		{Chain: "polygon", Network: "amoy", ChainId: 80002},
+		{Chain: "polygon", Network: networks.Main, ChainId: 137},
+	}, registry.Networks())
+	assert.Equal(t, []string{"amoy", "main", "test"}, registry.Names())
+	assert.Equal(t, []string{"bitcoin", "polygon"}, registry.Chains())
+}
@@ -0,0 +1,164 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"nn-blockchain-api/pkg/params"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GenerateClient renders Go types mirroring the request and response DTOs of the groups
+// and one Client method per route. The output relies on a hand-written Client.do, which
+// sends the request of a GET route as its path and query parameters. Path parameters of
+// a group prefix become string arguments of its methods.
+func GenerateClient(generator, pkg string, groups ...Group) ([]byte, error) {
+	g := &goGenerator{types: map[string]string{}}
+
+	var methods bytes.Buffer
+	for _, group := range groups {
+		for _, route := range group.Routes {
+			g.method(&methods, group, route)
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by %s. DO NOT EDIT.\n\npackage %s\n\n", generator, pkg)
+	out.WriteString("import (\n\t\"context\"\n")
+	if g.usesURL {
+		out.WriteString("\t\"net/url\"\n")
+	}
+	if g.usesTime {
+		out.WriteString("\t\"time\"\n")
+	}
+	out.WriteString(")\n\n")
+
+	names := make([]string, 0, len(g.types))
+	for name := range g.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		out.WriteString(g.types[name])
+	}
+
+	out.Write(methods.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+type goGenerator struct {
+	types    map[string]string
+	usesTime bool
+	usesURL  bool
+}
+
+func (g *goGenerator) method(w *bytes.Buffer, group Group, route Route) {
+	name := OperationID(group, route)
+	path := group.Prefix + route.Path
+
+	params := "ctx context.Context"
+	pathExpr := strconv.Quote(path)
+	if names := PrefixParams(group.Prefix); len(names) != 0 {
+		for _, name := range names {
+			params += ", " + name + " string"
+		}
+		pathExpr = g.pathExpr(group.Prefix, route.Path)
+	}
+	body := "nil"
+	if route.Request != nil {
+		params += ", req *" + g.typeExpr(reflect.TypeOf(route.Request))
+		body = "req"
+	}
+
+	respType := g.typeExpr(reflect.TypeOf(route.Response))
+	result, ret := respType, "resp"
+	if t := reflect.TypeOf(route.Response); t.Kind() == reflect.Struct {
+		result, ret = "*"+respType, "&resp"
+	}
+
+	fmt.Fprintf(w, "// %s calls %s %s.\n", name, route.Method, path)
+	if route.Summary != "" {
+		fmt.Fprintf(w, "// %s\n", route.Summary)
+	}
+	fmt.Fprintf(w, "func (c *Client) %s(%s) (%s, error) {\n", name, params, result)
+	fmt.Fprintf(w, "\tvar resp %s\n", respType)
+	fmt.Fprintf(w, "\tif err := c.do(ctx, %q, %s, %s, &resp); err != nil {\n\t\treturn %s, err\n\t}\n", route.Method, pathExpr, body, zeroValue(result))
+	fmt.Fprintf(w, "\treturn %s, nil\n}\n\n", ret)
+}
+
+// pathExpr concatenates the escaped prefix parameters into the path, parameters of the
+// route itself are left for Client.do to fill from the request.
+func (g *goGenerator) pathExpr(prefix, path string) string {
+	g.usesURL = true
+
+	var parts []string
+	last := 0
+	for _, match := range pathParam.FindAllStringSubmatchIndex(prefix, -1) {
+		parts = append(parts, strconv.Quote(prefix[last:match[0]]), "url.PathEscape("+prefix[match[2]:match[3]]+")")
+		last = match[1]
+	}
+	parts = append(parts, strconv.Quote(prefix[last:]+path))
+	return strings.Join(parts, " + ")
+}
+
+func (g *goGenerator) typeExpr(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + g.typeExpr(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeExpr(t.Elem())
+	case reflect.Map:
+		return "map[" + g.typeExpr(t.Key()) + "]" + g.typeExpr(t.Elem())
+	case reflect.Interface:
+		return "interface{}"
+	case reflect.Struct:
+		if t == timeType {
+			g.usesTime = true
+			return "time.Time"
+		}
+		if t.Name() == "" {
+			return g.structExpr(t)
+		}
+
+		name := TypeName(t)
+		if _, ok := g.types[name]; !ok {
+			g.types[name] = ""
+			g.types[name] = fmt.Sprintf("type %s %s\n\n", name, g.structExpr(t))
+		}
+		return name
+	}
+
+	// Named scalars such as ratelimit.Class are flattened to their underlying type.
+	return t.Kind().String()
+}
+
+func (g *goGenerator) structExpr(t reflect.Type) string {
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	for _, field := range Fields(t) {
+		tag := field.Tag.Get("json")
+		if tag == "" {
+			tag = field.Name
+		}
+		fmt.Fprintf(&b, "%s %s `json:%q", field.Name, g.typeExpr(field.Type), tag)
+		for _, key := range []string{params.TagPath, params.TagQuery} {
+			if name, ok := field.Tag.Lookup(key); ok {
+				fmt.Fprintf(&b, " %s:%q", key, name)
+			}
+		}
+		b.WriteString("`\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func zeroValue(typ string) string {
+	if strings.HasPrefix(typ, "*") || strings.HasPrefix(typ, "map[") || strings.HasPrefix(typ, "[]") {
+		return "nil"
+	}
+	return typ + "{}"
+}
@@ -0,0 +1,47 @@
+package openapi
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+)
+
+// Handler serves the document as JSON. It is encoded once since routes don't change at runtime.
+func Handler(doc *Document) (http.HandlerFunc, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}, nil
+}
+
+var docsTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@4.15.5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@4.15.5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({url: {{.SpecURL}}, dom_id: "#swagger-ui", persistAuthorization: true});
+    };
+  </script>
+</body>
+</html>
+`))
+
+// DocsHandler serves a Swagger UI page rendering the document at specURL.
+func DocsHandler(title, specURL string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = docsTemplate.Execute(w, struct{ Title, SpecURL string }{title, specURL})
+	}
+}
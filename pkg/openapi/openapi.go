@@ -0,0 +1,217 @@
+package openapi
+
+import (
+	"net/http"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/params"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const Version = "3.0.3"
+
+// Route describes one API endpoint. Request and Response are zero values of the DTOs the
+// handler decodes and encodes; a nil Request marks an endpoint without a body. The
+// Request of a GET route is read from its path and query parameters instead.
+type Route struct {
+	Method   string
+	Path     string
+	Name     string
+	Summary  string
+	Scope    string
+	Request  interface{}
+	Response interface{}
+}
+
+// Group is a set of routes mounted under a common prefix and documented under one tag.
+// The prefix may hold path parameters, such as {chain}, shared by all its routes.
+type Group struct {
+	Tag    string
+	Prefix string
+	Routes []Route
+}
+
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Tags       []Tag                `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type PathItem struct {
+	Get  *Operation `json:"get,omitempty"`
+	Post *Operation `json:"post,omitempty"`
+}
+
+type Operation struct {
+	OperationID   string                `json:"operationId"`
+	Summary       string                `json:"summary,omitempty"`
+	Tags          []string              `json:"tags,omitempty"`
+	Security      []map[string][]string `json:"security,omitempty"`
+	RequiredScope string                `json:"x-required-scope,omitempty"`
+	Parameters    []*Parameter          `json:"parameters,omitempty"`
+	RequestBody   *RequestBody          `json:"requestBody,omitempty"`
+	Responses     map[string]*Response  `json:"responses"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type   string `json:"type"`
+	In     string `json:"in,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Scheme string `json:"scheme,omitempty"`
+}
+
+const (
+	schemaError    = "Error"
+	securityAPIKey = "ApiKeyAuth"
+	securityBearer = "BearerAuth"
+	contentJSON    = "application/json"
+)
+
+// Build documents the groups. Routes that require a scope are secured by the
+// X-API-Key header or a bearer token carrying the same key.
+func Build(info Info, groups ...Group) *Document {
+	schemas := newSchemaRegistry()
+	errorSchema := schemas.schemaFor(reflect.TypeOf(errors.Error{}))
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]*PathItem{},
+		Components: Components{
+			Schemas: schemas.components,
+			SecuritySchemes: map[string]*SecurityScheme{
+				securityAPIKey: {Type: "apiKey", In: "header", Name: "X-API-Key"},
+				securityBearer: {Type: "http", Scheme: "bearer"},
+			},
+		},
+	}
+
+	for _, group := range groups {
+		doc.Tags = append(doc.Tags, Tag{Name: group.Tag})
+
+		for _, route := range group.Routes {
+			op := &Operation{
+				OperationID: OperationID(group, route),
+				Summary:     route.Summary,
+				Tags:        []string{group.Tag},
+				Responses: map[string]*Response{
+					"200": {
+						Description: "OK",
+						Content:     jsonContent(schemas.schemaFor(reflect.TypeOf(route.Response))),
+					},
+					"default": {Description: "Error", Content: jsonContent(errorSchema)},
+				},
+			}
+
+			if route.Scope != "" {
+				op.RequiredScope = route.Scope
+				op.Security = []map[string][]string{{securityAPIKey: {}}, {securityBearer: {}}}
+				for _, code := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests} {
+					op.Responses[strconv.Itoa(code)] = &Response{Description: http.StatusText(code), Content: jsonContent(errorSchema)}
+				}
+			}
+
+			for _, name := range PrefixParams(group.Prefix) {
+				op.Parameters = append(op.Parameters, &Parameter{Name: name, In: params.TagPath, Required: true, Schema: &Schema{Type: "string"}})
+			}
+			if route.Request != nil && route.Method == http.MethodGet {
+				op.Parameters = append(op.Parameters, schemas.parameters(reflect.TypeOf(route.Request))...)
+			} else if route.Request != nil {
+				op.RequestBody = &RequestBody{
+					Required: true,
+					Content:  jsonContent(schemas.schemaFor(reflect.TypeOf(route.Request))),
+				}
+			}
+
+			path := group.Prefix + route.Path
+			item, ok := doc.Paths[path]
+			if !ok {
+				item = &PathItem{}
+				doc.Paths[path] = item
+			}
+			switch route.Method {
+			case http.MethodGet:
+				item.Get = op
+			default:
+				item.Post = op
+			}
+		}
+	}
+
+	sort.Slice(doc.Tags, func(i, j int) bool { return doc.Tags[i].Name < doc.Tags[j].Name })
+
+	return doc
+}
+
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+// PrefixParams names the path parameters of a group prefix.
+func PrefixParams(prefix string) []string {
+	var names []string
+	for _, match := range pathParam.FindAllStringSubmatch(prefix, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// OperationID names an operation after its group tag and route name, e.g. BitcoinCreateRawTransaction.
+func OperationID(group Group, route Route) string {
+	return exportName(group.Tag) + route.Name
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{contentJSON: {Schema: schema}}
+}
+
+func exportName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
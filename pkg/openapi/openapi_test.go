@@ -0,0 +1,137 @@
+package openapi_test
+
+import (
+	"go/format"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/openapi"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type transferDTO struct {
+	From    string  `json:"from" validate:"required,eth_address"`
+	Amount  float64 `json:"amount" validate:"required,gt=0"`
+	Network string  `json:"network" validate:"required,network"`
+	Memo    string  `json:"memo,omitempty"`
+	Inputs  []input `json:"inputs" validate:"dive"`
+	secret  string
+}
+
+type input struct {
+	TxId string `json:"txid" validate:"required,txid"`
+	Vout int64  `json:"vout" validate:"gte=0"`
+}
+
+type txQueryDTO struct {
+	TxId    string `json:"txid" path:"txid" validate:"required,txid"`
+	Network string `json:"network" query:"network" validate:"required,network"`
+	Verbose bool   `json:"verbose" query:"verbose"`
+}
+
+type transferredDTO struct {
+	TxId string `json:"tx_id"`
+}
+
+var groups = []openapi.Group{
+	{
+		Tag:    "chain",
+		Prefix: "/api/v1/chain",
+		Routes: []openapi.Route{
+			{Method: http.MethodPost, Path: "/transfer", Name: "Transfer", Summary: "Send funds.", Scope: "broadcast", Request: transferDTO{}, Response: transferredDTO{}},
+			{Method: http.MethodGet, Path: "/ping", Name: "Ping", Response: map[string]string{}},
+			{Method: http.MethodGet, Path: "/tx/{txid}", Name: "Tx", Scope: "read", Request: txQueryDTO{}, Response: transferredDTO{}},
+		},
+	},
+	{
+		Tag:    "evm",
+		Prefix: "/api/v1/evm/{chain}",
+		Routes: []openapi.Route{
+			{Method: http.MethodGet, Path: "/tx/{txid}", Name: "Tx", Scope: "read", Request: txQueryDTO{}, Response: transferredDTO{}},
+		},
+	},
+}
+
+func TestBuild(t *testing.T) {
+	doc := openapi.Build(openapi.Info{Title: "test", Version: "1"}, groups...)
+
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	assert.Len(t, doc.Paths, 4)
+
+	transfer := doc.Paths["/api/v1/chain/transfer"].Post
+	assert.Equal(t, "ChainTransfer", transfer.OperationID)
+	assert.Equal(t, "broadcast", transfer.RequiredScope)
+	assert.Len(t, transfer.Security, 2)
+	assert.Contains(t, transfer.Responses, "401")
+	assert.Contains(t, transfer.Responses, "429")
+	assert.Equal(t, "#/components/schemas/transfer", transfer.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/transferred", transfer.Responses["200"].Content["application/json"].Schema.Ref)
+
+	ping := doc.Paths["/api/v1/chain/ping"].Get
+	assert.Equal(t, "ChainPing", ping.OperationID)
+	assert.Empty(t, ping.Security)
+	assert.Nil(t, ping.RequestBody)
+	assert.NotContains(t, ping.Responses, "401")
+
+	tx := doc.Paths["/api/v1/chain/tx/{txid}"].Get
+	assert.Nil(t, tx.RequestBody)
+	assert.Equal(t, []*openapi.Parameter{
+		{Name: "txid", In: "path", Required: true, Schema: &openapi.Schema{Type: "string", Pattern: "^[0-9a-fA-F]{64}$"}},
+		{Name: "network", In: "query", Required: true, Schema: &openapi.Schema{Type: "string", Pattern: networks.NamePattern}},
+		{Name: "verbose", In: "query", Schema: &openapi.Schema{Type: "boolean"}},
+	}, tx.Parameters)
+
+	evmTx := doc.Paths["/api/v1/evm/{chain}/tx/{txid}"].Get
+	assert.Equal(t, "EvmTx", evmTx.OperationID)
+	assert.Equal(t, &openapi.Parameter{Name: "chain", In: "path", Required: true, Schema: &openapi.Schema{Type: "string"}}, evmTx.Parameters[0])
+	assert.Equal(t, tx.Parameters, evmTx.Parameters[1:])
+
+	schema := doc.Components.Schemas["transfer"]
+	assert.Equal(t, []string{"from", "amount", "network"}, schema.Required)
+	assert.NotContains(t, schema.Properties, "secret")
+	assert.Equal(t, "^0x[0-9a-fA-F]{40}$", schema.Properties["from"].Pattern)
+	assert.Equal(t, float64(0), *schema.Properties["amount"].Minimum)
+	assert.True(t, schema.Properties["amount"].ExclusiveMinimum)
+	assert.Equal(t, networks.NamePattern, schema.Properties["network"].Pattern)
+	assert.Equal(t, "#/components/schemas/input", schema.Properties["inputs"].Items.Ref)
+
+	item := doc.Components.Schemas["input"]
+	assert.Equal(t, []string{"txid"}, item.Required)
+	assert.Equal(t, "integer", item.Properties["vout"].Type)
+	assert.Equal(t, float64(0), *item.Properties["vout"].Minimum)
+	assert.Contains(t, doc.Components.Schemas, "Error")
+}
+
+func TestHandler(t *testing.T) {
+	handler, err := openapi.Handler(openapi.Build(openapi.Info{Title: "test", Version: "1"}, groups...))
+	assert.Nil(t, err)
+
+	res := httptest.NewRecorder()
+	handler(res, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+	assert.Contains(t, res.Body.String(), `"x-required-scope":"broadcast"`)
+}
+
+func TestGenerateClient(t *testing.T) {
+	src, err := openapi.GenerateClient("test", "client", groups...)
+	assert.Nil(t, err)
+
+	formatted, err := format.Source(src)
+	assert.Nil(t, err)
+	assert.Equal(t, string(formatted), string(src))
+
+	code := string(src)
+	assert.True(t, strings.HasPrefix(code, "// Code generated by test. DO NOT EDIT."))
+	assert.Contains(t, code, "func (c *Client) ChainTransfer(ctx context.Context, req *transfer) (*transferred, error)")
+	assert.Contains(t, code, "func (c *Client) ChainPing(ctx context.Context) (map[string]string, error)")
+	assert.Contains(t, code, "type input struct")
+	assert.Contains(t, code, "TxId    string `json:\"txid\" path:\"txid\"`")
+	assert.Contains(t, code, `c.do(ctx, "GET", "/api/v1/chain/tx/{txid}", req, &resp)`)
+	assert.Contains(t, code, "func (c *Client) EvmTx(ctx context.Context, chain string, req *txQuery) (*transferred, error)")
+	assert.Contains(t, code, `c.do(ctx, "GET", "/api/v1/evm/"+url.PathEscape(chain)+"/tx/{txid}", req, &resp)`)
+}
@@ -0,0 +1,189 @@
+package openapi
+
+import (
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/params"
+	"nn-blockchain-api/pkg/validation"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	ExclusiveMinimum     bool               `json:"exclusiveMinimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// TypeName names a named type in the document and the generated client. DTOs from
+// internal packages are prefixed with their package, since chains reuse DTO names.
+func TypeName(t reflect.Type) string {
+	name := strings.TrimSuffix(t.Name(), "DTO")
+	prefix := exportName(path.Base(t.PkgPath()))
+	if !strings.Contains(t.PkgPath(), "/internal/") || strings.HasPrefix(name, prefix) {
+		return name
+	}
+	return prefix + name
+}
+
+type schemaRegistry struct {
+	components map[string]*Schema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{components: map[string]*Schema{}}
+}
+
+func (r *schemaRegistry) schemaFor(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return r.schemaFor(t.Elem())
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaFor(t.Elem())}
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Struct:
+		if t == timeType {
+			return &Schema{Type: "string", Format: "date-time"}
+		}
+		if t.Name() == "" {
+			return r.objectSchema(t)
+		}
+
+		name := TypeName(t)
+		if _, ok := r.components[name]; !ok {
+			// Registered before recursing so self-referencing types terminate.
+			r.components[name] = &Schema{}
+			*r.components[name] = *r.objectSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + name}
+	}
+
+	return &Schema{}
+}
+
+func (r *schemaRegistry) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for _, field := range Fields(t) {
+		property := r.schemaFor(field.Type)
+		rules := strings.Split(field.Tag.Get("validate"), ",")
+		if applyRules(property, rules) {
+			schema.Required = append(schema.Required, JSONName(field))
+		}
+		schema.Properties[JSONName(field)] = property
+	}
+
+	return schema
+}
+
+// parameters documents the path and query tagged fields of a GET request. Path parameters
+// are always required.
+func (r *schemaRegistry) parameters(t reflect.Type) []*Parameter {
+	var parameters []*Parameter
+	for _, field := range Fields(t) {
+		parameter := &Parameter{Schema: r.schemaFor(field.Type)}
+		required := applyRules(parameter.Schema, strings.Split(field.Tag.Get("validate"), ","))
+		if name, ok := field.Tag.Lookup(params.TagPath); ok {
+			parameter.Name, parameter.In, parameter.Required = name, params.TagPath, true
+		} else if name, ok := field.Tag.Lookup(params.TagQuery); ok {
+			parameter.Name, parameter.In, parameter.Required = name, params.TagQuery, required
+		} else {
+			continue
+		}
+		parameters = append(parameters, parameter)
+	}
+	return parameters
+}
+
+// applyRules narrows a property schema by its validate rules and reports whether it is required.
+func applyRules(schema *Schema, rules []string) bool {
+	required := false
+	for _, rule := range rules {
+		name, param := rule, ""
+		if i := strings.IndexByte(rule, '='); i >= 0 {
+			name, param = rule[:i], rule[i+1:]
+		}
+
+		switch name {
+		case "required":
+			required = true
+		case "gte", "gt":
+			if min, err := strconv.ParseFloat(param, 64); err == nil {
+				schema.Minimum = &min
+				schema.ExclusiveMinimum = name == "gt"
+			}
+		case "oneof":
+			schema.Enum = strings.Fields(param)
+		case "hexadecimal":
+			schema.Pattern = "^(0[xX])?[0-9a-fA-F]+$"
+		case validation.TagNetwork:
+			schema.Pattern = networks.NamePattern
+		case validation.TagHexTx:
+			schema.Pattern = "^([0-9a-fA-F]{2})+$"
+		case validation.TagTxId, validation.TagHexPrivateKey:
+			schema.Pattern = "^[0-9a-fA-F]{64}$"
+		case validation.TagEthAddress:
+			schema.Pattern = "^0x[0-9a-fA-F]{40}$"
+			schema.Format = "eip55-address"
+		case validation.TagBtcAddress:
+			schema.Format = "bitcoin-address"
+		case validation.TagWIF:
+			schema.Format = "wif"
+		case validation.TagMnemonic:
+			schema.Description = "BIP39 mnemonic of 12, 15, 18, 21 or 24 words"
+		}
+	}
+	return required
+}
+
+// Fields lists the exported fields encoding/json serializes.
+func Fields(t reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || JSONName(field) == "-" {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// JSONName is the key encoding/json uses for the field.
+func JSONName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
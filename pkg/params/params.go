@@ -0,0 +1,102 @@
+// Package params decodes path and query parameters of GET requests into DTOs. Fields are
+// selected by `path:"name"` and `query:"name"` tags, the same tags the OpenAPI document
+// and the generated client read.
+package params
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"nn-blockchain-api/pkg/errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	TagPath  = "path"
+	TagQuery = "query"
+)
+
+// Decode fills the tagged fields of the struct dst points to. Absent query parameters
+// keep their zero value, malformed ones are invalid parameter errors.
+func Decode(r *http.Request, dst interface{}) error {
+	v := reflect.ValueOf(dst).Elem()
+	t := v.Type()
+	query := r.URL.Query()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		var value, in string
+		if name, ok := field.Tag.Lookup(TagPath); ok {
+			value, in = chi.URLParam(r, name), "path parameter "+name
+		} else if name, ok := field.Tag.Lookup(TagQuery); ok {
+			value, in = query.Get(name), "query parameter "+name
+		} else {
+			continue
+		}
+		if value == "" {
+			continue
+		}
+
+		if err := set(v.Field(i), value); err != nil {
+			return errors.NewInvalid(errors.StatusInvalidParameter, fmt.Sprintf("invalid %s: %v", in, err))
+		}
+	}
+	return nil
+}
+
+func set(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
+
+// Encode renders the tagged fields of src into the path template and its query string,
+// the inverse of Decode used by clients. Zero query values are left out.
+func Encode(path string, src interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(src))
+	t := v.Type()
+	query := url.Values{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := fmt.Sprint(v.Field(i).Interface())
+
+		if name, ok := field.Tag.Lookup(TagPath); ok {
+			path = strings.ReplaceAll(path, "{"+name+"}", url.PathEscape(value))
+		} else if name, ok := field.Tag.Lookup(TagQuery); ok && !v.Field(i).IsZero() {
+			query.Set(name, value)
+		}
+	}
+
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
+}
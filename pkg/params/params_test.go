@@ -0,0 +1,71 @@
+package params_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/pkg/codes"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/params"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type blockDTO struct {
+	Block   string `path:"block"`
+	Network string `query:"network"`
+	Page    int64  `query:"page"`
+	Verbose bool   `query:"verbose"`
+	Ignored string `json:"ignored"`
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		block   string
+		query   string
+		want    blockDTO
+		wantErr error
+	}{
+		{name: "success", block: "100", query: "network=test&page=2&verbose=true&ignored=x", want: blockDTO{Block: "100", Network: "test", Page: 2, Verbose: true}},
+		{name: "absent query", block: "100", want: blockDTO{Block: "100"}},
+		{name: "invalid integer", block: "100", query: "page=two", wantErr: errors.NewInvalid(errors.StatusInvalidParameter, `invalid query parameter page: strconv.ParseInt: parsing "two": invalid syntax`)},
+		{name: "invalid bool", block: "100", query: "verbose=maybe", wantErr: errors.NewInvalid(errors.StatusInvalidParameter, `invalid query parameter verbose: strconv.ParseBool: parsing "maybe": invalid syntax`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("block", tt.block)
+			r := httptest.NewRequest(http.MethodGet, "/blocks/"+tt.block+"?"+tt.query, nil)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			var got blockDTO
+			err := params.Decode(r, &got)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			} else {
+				assert.Equal(t, codes.Code(codes.BadRequest), err.(*errors.Error).Code)
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		src  interface{}
+		want string
+	}{
+		{name: "path and query", src: &blockDTO{Block: "00ab", Network: "test", Page: 2}, want: "/blocks/00ab?network=test&page=2"},
+		{name: "zero query omitted", src: blockDTO{Block: "100"}, want: "/blocks/100"},
+		{name: "path escaped", src: blockDTO{Block: "a/b"}, want: "/blocks/a%2Fb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, params.Encode("/blocks/{block}", tt.src))
+		})
+	}
+}
@@ -0,0 +1,393 @@
+package policy
+
+import (
+	"context"
+	gErrors "errors"
+	"math/big"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/storage"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ApprovalStatus string
+
+const (
+	ApprovalPending  ApprovalStatus = "pending"
+	ApprovalApproved ApprovalStatus = "approved"
+	ApprovalRejected ApprovalStatus = "rejected"
+	ApprovalUsed     ApprovalStatus = "used"
+	// ApprovalExpired is a pending approval nobody decided on within the approval TTL.
+	ApprovalExpired ApprovalStatus = "expired"
+)
+
+// Approval is a transaction held by a policy until an approver decides on it.
+type Approval struct {
+	Id          string         `json:"id"`
+	Status      ApprovalStatus `json:"status"`
+	Chain       string         `json:"chain"`
+	Network     string         `json:"network"`
+	TxHash      string         `json:"tx_hash"`
+	Subject     string         `json:"subject"`
+	Spend       string         `json:"spend"`
+	Policy      string         `json:"policy"`
+	RequestedBy string         `json:"requested_by,omitempty"`
+	DecidedBy   string         `json:"decided_by,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	ExpiresAt   time.Time      `json:"expires_at"`
+	DecidedAt   *time.Time     `json:"decided_at,omitempty"`
+}
+
+// Reservation is the spend and approval taken by a passing CheckSign.
+type Reservation struct {
+	subject  string
+	day      string
+	spend    *big.Int
+	approval string
+}
+
+//go:generate mockgen -source=engine.go -destination=mocks/engine_mock.go
+type Engine interface {
+	// CheckSign evaluates a transaction about to be signed and reserves its spend against the
+	// daily limits. approvalId redeems an approved hold, requester names who asks for signing.
+	CheckSign(ctx context.Context, tx *Transaction, subject Subject, approvalId, requester string) (*Reservation, error)
+	// Release returns a reservation when signing failed after a passing check.
+	Release(ctx context.Context, reservation *Reservation) error
+	// CheckBroadcast applies the network and destination rules of policies that select no signer.
+	CheckBroadcast(ctx context.Context, tx *Transaction) error
+
+	Policies() []Policy
+	Approvals(ctx context.Context) ([]*Approval, error)
+	Approve(ctx context.Context, id, approver string) (*Approval, error)
+	Reject(ctx context.Context, id, approver string) (*Approval, error)
+}
+
+// engine keeps spend and approvals in the storage, so limits and approvals survive restarts
+// and are shared by the replicas of one database.
+type engine struct {
+	mu          sync.Mutex
+	rules       []*rule
+	store       storage.Storage
+	approvalTTL time.Duration
+	swept       string
+	now         func() time.Time
+}
+
+// NewEngine expires pending approvals nobody decided on within approvalTTL. Policies may
+// only name the chains and networks of the registry.
+func NewEngine(policies []Policy, served *networks.Registry, store storage.Storage, approvalTTL time.Duration) (Engine, error) {
+	if served == nil {
+		return nil, gErrors.New("invalid network registry")
+	}
+	if store == nil {
+		return nil, gErrors.New("invalid storage")
+	}
+	if approvalTTL <= 0 {
+		return nil, gErrors.New("invalid approval ttl")
+	}
+
+	rules := make([]*rule, 0, len(policies))
+	for _, p := range policies {
+		r, err := compile(p, served)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+
+	return &engine{
+		rules:       rules,
+		store:       store,
+		approvalTTL: approvalTTL,
+		now:         time.Now,
+	}, nil
+}
+
+func (e *engine) Policies() []Policy {
+	policies := make([]Policy, 0, len(e.rules))
+	for _, r := range e.rules {
+		policies = append(policies, r.Policy)
+	}
+	return policies
+}
+
+func (e *engine) CheckSign(ctx context.Context, tx *Transaction, subject Subject, approvalId, requester string) (*Reservation, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now().UTC()
+	spend := tx.Spend(subject)
+	reservation := &Reservation{subject: subject.id(), day: now.Format("2006-01-02"), spend: spend}
+	if err := e.sweepLocked(ctx, reservation.day); err != nil {
+		return nil, err
+	}
+
+	var (
+		hold   *rule
+		limits []*rule
+	)
+	for _, r := range e.rules {
+		if !r.applies(tx.Chain, subject) {
+			continue
+		}
+		if err := r.checkDestinations(tx, subject); err != nil {
+			return nil, err
+		}
+		if err := r.checkValue(tx); err != nil {
+			return nil, err
+		}
+		if err := r.checkFee(tx, spend); err != nil {
+			return nil, err
+		}
+		if r.dailyLimit != nil {
+			spent, err := e.spent(ctx, reservation)
+			if err != nil {
+				return nil, err
+			}
+			if err := r.checkLimit(spent, spend); err != nil {
+				return nil, err
+			}
+			limits = append(limits, r)
+		}
+		if hold == nil && r.approvalAbove != nil && spend.Cmp(r.approvalAbove) > 0 {
+			hold = r
+		}
+	}
+
+	if hold != nil {
+		if approvalId == "" {
+			approval := &Approval{
+				Id:          uuid.NewString(),
+				Status:      ApprovalPending,
+				Chain:       tx.Chain,
+				Network:     tx.Network,
+				TxHash:      tx.Hash,
+				Subject:     reservation.subject,
+				Spend:       spend.String(),
+				Policy:      hold.Name,
+				RequestedBy: requester,
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(e.approvalTTL),
+			}
+			if err := e.store.Approvals().Create(ctx, approval.record()); err != nil {
+				return nil, err
+			}
+			return nil, errors.WithMessage(ErrApprovalRequired, "transaction held for approval %s by policy %s", approval.Id, hold.Name)
+		}
+
+		approval, err := e.approval(ctx, approvalId)
+		if err != nil {
+			return nil, err
+		}
+		if approval.TxHash != tx.Hash {
+			return nil, errors.WithMessage(ErrInvalidApproval, "approval %s was granted for another transaction", approval.Id)
+		}
+		if approval.Status != ApprovalApproved {
+			return nil, errors.WithMessage(ErrInvalidApproval, "approval %s is %s", approval.Id, approval.Status)
+		}
+		approval.Status = ApprovalUsed
+		if err := e.store.Approvals().Update(ctx, approval.record()); err != nil {
+			return nil, err
+		}
+		reservation.approval = approval.Id
+	}
+
+	if reservation.subject != "" && spend.Sign() > 0 {
+		// The limits are checked again on the new total, another replica may have spent meanwhile.
+		total, err := e.store.PolicySpends().Add(ctx, reservation.day, reservation.subject, spend)
+		if err != nil {
+			_ = e.releaseApproval(ctx, reservation)
+			return nil, err
+		}
+		spent := new(big.Int).Sub(total, spend)
+		for _, r := range limits {
+			if err := r.checkLimit(spent, spend); err != nil {
+				_ = e.releaseLocked(ctx, reservation)
+				return nil, err
+			}
+		}
+	}
+	return reservation, nil
+}
+
+func (e *engine) Release(ctx context.Context, reservation *Reservation) error {
+	if reservation == nil {
+		return nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.releaseLocked(ctx, reservation)
+}
+
+func (e *engine) CheckBroadcast(_ context.Context, tx *Transaction) error {
+	for _, r := range e.rules {
+		if !r.applies(tx.Chain, Subject{}) {
+			continue
+		}
+		if err := r.checkDestinations(tx, Subject{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *engine) Approvals(ctx context.Context) ([]*Approval, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	records, err := e.store.Approvals().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]*Approval, 0, len(records))
+	for _, record := range records {
+		approval, err := e.expire(ctx, approvalFromRecord(record))
+		if err != nil {
+			return nil, err
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, nil
+}
+
+func (e *engine) Approve(ctx context.Context, id, approver string) (*Approval, error) {
+	return e.decide(ctx, id, approver, ApprovalApproved)
+}
+
+func (e *engine) Reject(ctx context.Context, id, approver string) (*Approval, error) {
+	return e.decide(ctx, id, approver, ApprovalRejected)
+}
+
+func (e *engine) decide(ctx context.Context, id, approver string, status ApprovalStatus) (*Approval, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	approval, err := e.approval(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if approval.Status != ApprovalPending {
+		return nil, errors.WithMessage(ErrInvalidApproval, "approval %s is %s", approval.Id, approval.Status)
+	}
+	if approver != "" && approver == approval.RequestedBy {
+		return nil, errors.WithMessage(ErrInvalidApproval, "approval %s cannot be decided by its requester", approval.Id)
+	}
+
+	now := e.now().UTC()
+	approval.Status, approval.DecidedBy, approval.DecidedAt = status, approver, &now
+	if err := e.store.Approvals().Update(ctx, approval.record()); err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+// approval loads an approval, expiring it when it waited too long for a decision.
+func (e *engine) approval(ctx context.Context, id string) (*Approval, error) {
+	record, err := e.store.Approvals().Get(ctx, id)
+	if gErrors.Is(err, storage.ErrNotFound) {
+		return nil, ErrApprovalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e.expire(ctx, approvalFromRecord(record))
+}
+
+func (e *engine) expire(ctx context.Context, approval *Approval) (*Approval, error) {
+	if approval.Status != ApprovalPending || e.now().Before(approval.ExpiresAt) {
+		return approval, nil
+	}
+
+	approval.Status = ApprovalExpired
+	if err := e.store.Approvals().Update(ctx, approval.record()); err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+func (e *engine) spent(ctx context.Context, r *Reservation) (*big.Int, error) {
+	if r.subject == "" {
+		return new(big.Int), nil
+	}
+	return e.store.PolicySpends().Get(ctx, r.day, r.subject)
+}
+
+func (e *engine) releaseLocked(ctx context.Context, reservation *Reservation) error {
+	if reservation.subject != "" && reservation.spend.Sign() > 0 {
+		if _, err := e.store.PolicySpends().Add(ctx, reservation.day, reservation.subject, new(big.Int).Neg(reservation.spend)); err != nil {
+			return err
+		}
+	}
+	return e.releaseApproval(ctx, reservation)
+}
+
+// releaseApproval lets a used approval sign again.
+func (e *engine) releaseApproval(ctx context.Context, reservation *Reservation) error {
+	if reservation.approval == "" {
+		return nil
+	}
+
+	approval, err := e.approval(ctx, reservation.approval)
+	if err != nil {
+		return err
+	}
+	if approval.Status != ApprovalUsed {
+		return nil
+	}
+	approval.Status = ApprovalApproved
+	return e.store.Approvals().Update(ctx, approval.record())
+}
+
+// sweepLocked drops the spend of past days, once a day.
+func (e *engine) sweepLocked(ctx context.Context, day string) error {
+	if e.swept == day {
+		return nil
+	}
+	if err := e.store.PolicySpends().DeleteBefore(ctx, day); err != nil {
+		return err
+	}
+	e.swept = day
+	return nil
+}
+
+func (a *Approval) record() *storage.Approval {
+	return &storage.Approval{
+		Id:          a.Id,
+		Status:      string(a.Status),
+		Chain:       a.Chain,
+		Network:     a.Network,
+		TxHash:      a.TxHash,
+		Subject:     a.Subject,
+		Spend:       a.Spend,
+		Policy:      a.Policy,
+		RequestedBy: a.RequestedBy,
+		DecidedBy:   a.DecidedBy,
+		CreatedAt:   a.CreatedAt,
+		ExpiresAt:   a.ExpiresAt,
+		DecidedAt:   a.DecidedAt,
+	}
+}
+
+func approvalFromRecord(record *storage.Approval) *Approval {
+	return &Approval{
+		Id:          record.Id,
+		Status:      ApprovalStatus(record.Status),
+		Chain:       record.Chain,
+		Network:     record.Network,
+		TxHash:      record.TxHash,
+		Subject:     record.Subject,
+		Spend:       record.Spend,
+		Policy:      record.Policy,
+		RequestedBy: record.RequestedBy,
+		DecidedBy:   record.DecidedBy,
+		CreatedAt:   record.CreatedAt,
+		ExpiresAt:   record.ExpiresAt,
+		DecidedAt:   record.DecidedAt,
+	}
+}
@@ -0,0 +1,497 @@
+package policy_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	gErrors "errors"
+	"math/big"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/policy"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"nn-blockchain-api/pkg/storage"
+	bolt_storage "nn-blockchain-api/pkg/storage/bolt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	recipient = "tb1qmy63mjadtw8nhzl69ukdepwzsyvv4yex7xygd7"
+	change    = "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"
+	ethTo     = "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"
+	tokenTo   = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+)
+
+// bitcoinTx builds an unsigned testnet transaction with one input paying the outputs.
+func bitcoinTx(t *testing.T, outputs map[string]int64) string {
+	msg := wire.NewMsgTx(wire.TxVersion)
+	msg.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	for _, address := range []string{recipient, change} {
+		amount, ok := outputs[address]
+		if !ok {
+			continue
+		}
+		decoded, err := btcutil.DecodeAddress(address, &chaincfg.TestNet3Params)
+		require.NoError(t, err)
+		script, err := txscript.PayToAddrScript(decoded)
+		require.NoError(t, err)
+		msg.AddTxOut(wire.NewTxOut(amount, script))
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, msg.Serialize(&buf))
+	return hex.EncodeToString(buf.Bytes())
+}
+
+// prevout looks up the single input of bitcoinTx, unknown when amount is negative.
+func prevout(amount int64) policy.Prevout {
+	return func(txid string, vout uint32) (int64, bool, error) {
+		return amount, txid == (chainhash.Hash{1}).String() && vout == 0 && amount >= 0, nil
+	}
+}
+
+func ethereumTx(t *testing.T, value int64, gas uint64, gasPrice int64) string {
+	to := common.HexToAddress(ethTo)
+	data, err := rlp.EncodeToBytes(types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(gasPrice), Gas: gas, To: &to, Value: big.NewInt(value)}))
+	require.NoError(t, err)
+	return hex.EncodeToString(data)
+}
+
+func contractTx(t *testing.T, data []byte) string {
+	to := common.HexToAddress(ethTo)
+	encoded, err := rlp.EncodeToBytes(types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 60000, To: &to, Data: data}))
+	require.NoError(t, err)
+	return hex.EncodeToString(encoded)
+}
+
+// transferData is the calldata of an ERC-20 transfer(recipient, 500).
+func transferData(recipient string) []byte {
+	data := append([]byte{0xa9, 0x05, 0x9c, 0xbb}, common.LeftPadBytes(common.HexToAddress(recipient).Bytes(), 32)...)
+	return append(data, common.LeftPadBytes(big.NewInt(500).Bytes(), 32)...)
+}
+
+func decodeBitcoin(raw, network string, prevout policy.Prevout) (*policy.Transaction, error) {
+	coin := bitcoin_rpc.KnownChains[bitcoin_rpc.DefaultChain]
+	return policy.DecodeUTXO(coin.Name, raw, network, prevout, func(pkScript []byte) string {
+		return coin.ScriptAddress(pkScript, network)
+	})
+}
+
+func TestDecode(t *testing.T) {
+	raw := bitcoinTx(t, map[string]int64{recipient: 70000, change: 29000})
+
+	tx, err := decodeBitcoin(raw, "test", prevout(100000))
+	require.NoError(t, err)
+	require.Len(t, tx.Outputs, 2)
+	assert.Equal(t, recipient, tx.Outputs[0].Address)
+	assert.Equal(t, big.NewInt(1000), tx.Fee)
+	assert.Equal(t, big.NewInt(70000), tx.Spend(policy.Subject{Addresses: []string{change}}))
+
+	tx, err = decodeBitcoin(raw, "test", nil)
+	require.NoError(t, err)
+	assert.Nil(t, tx.Fee)
+
+	tx, err = decodeBitcoin(raw, "test", prevout(-1))
+	require.NoError(t, err)
+	assert.Nil(t, tx.Fee)
+
+	_, err = decodeBitcoin(raw, "test", func(string, uint32) (int64, bool, error) {
+		return 0, false, gErrors.New("node unavailable")
+	})
+	assert.EqualError(t, err, "node unavailable")
+
+	tx, err = policy.DecodeEthereum(ethereumTx(t, 1000000, 21000, 10), "main")
+	require.NoError(t, err)
+	assert.Equal(t, ethTo, tx.Outputs[0].Address)
+	assert.Equal(t, big.NewInt(1000000), tx.Outputs[0].Amount)
+	assert.Equal(t, big.NewInt(210000), tx.Fee)
+
+	tx, err = policy.DecodeEthereum(contractTx(t, transferData(tokenTo)), "main")
+	require.NoError(t, err)
+	assert.False(t, tx.Call)
+	require.Len(t, tx.Outputs, 2)
+	assert.Equal(t, policy.Output{Address: tokenTo, Amount: big.NewInt(500), Token: ethTo}, tx.Outputs[1])
+	assert.Equal(t, big.NewInt(0), tx.Spend(policy.Subject{}))
+
+	tx, err = policy.DecodeEthereum(contractTx(t, []byte{0x09, 0x5e, 0xa7, 0xb3}), "main")
+	require.NoError(t, err)
+	assert.True(t, tx.Call)
+	assert.Len(t, tx.Outputs, 1)
+
+	_, err = policy.DecodeEthereum("zz", "main")
+	require.IsType(t, &errors.Error{}, err)
+	assert.Equal(t, policy.StatusUndecodableTx, err.(*errors.Error).Status)
+}
+
+func newStorage(t *testing.T) storage.Storage {
+	store, err := bolt_storage.NewStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
+
+// newRegistry serves bitcoin and ethereum on main and test.
+func newRegistry(t *testing.T) *networks.Registry {
+	registry, err := networks.NewRegistry(
+		networks.Info{Chain: "bitcoin", Network: networks.Main},
+		networks.Info{Chain: "bitcoin", Network: networks.Test},
+		networks.Info{Chain: "ethereum", Network: networks.Main},
+		networks.Info{Chain: "ethereum", Network: networks.Test},
+	)
+	require.NoError(t, err)
+	return registry
+}
+
+func newEngine(t *testing.T, policies ...policy.Policy) policy.Engine {
+	engine, err := policy.NewEngine(policies, newRegistry(t), newStorage(t), time.Hour)
+	require.NoError(t, err)
+	return engine
+}
+
+func TestNewEngine(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []policy.Policy
+		err      string
+	}{
+		{name: "should accept empty policies"},
+		{name: "should accept policy", policies: []policy.Policy{{Name: "p", Chain: "bitcoin", Networks: []string{"test"}, DailyLimit: "100", MaxFeeRatio: 0.1}}},
+		{name: "should reject unnamed policy", policies: []policy.Policy{{}}, err: "policy without name"},
+		{name: "should reject unknown chain", policies: []policy.Policy{{Name: "p", Chain: "dogecoin"}}, err: "policy p: unsupported chain dogecoin"},
+		{name: "should reject network the chains are not served on", policies: []policy.Policy{{Name: "p", Networks: []string{"signet"}}}, err: "policy p: unsupported network signet"},
+		{name: "should reject unknown network", policies: []policy.Policy{{Name: "p", Networks: []string{"regtest"}}}, err: "policy p: unsupported network regtest"},
+		{name: "should reject invalid amount", policies: []policy.Policy{{Name: "p", DailyLimit: "1.5"}}, err: `policy p: invalid daily limit: "1.5" is not a non-negative integer`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			engine, err := policy.NewEngine(tc.policies, newRegistry(t), newStorage(t), time.Hour)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				assert.Nil(t, engine)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, engine.Policies(), len(tc.policies))
+		})
+	}
+
+	_, err := policy.NewEngine(nil, nil, newStorage(t), time.Hour)
+	assert.EqualError(t, err, "invalid network registry")
+	_, err = policy.NewEngine(nil, newRegistry(t), nil, time.Hour)
+	assert.EqualError(t, err, "invalid storage")
+	_, err = policy.NewEngine(nil, newRegistry(t), newStorage(t), 0)
+	assert.EqualError(t, err, "invalid approval ttl")
+}
+
+func status(t *testing.T, err error) errors.Status {
+	require.IsType(t, &errors.Error{}, err)
+	return err.(*errors.Error).Status
+}
+
+func TestEngine_CheckSign(t *testing.T) {
+	ctx := context.Background()
+	signer := policy.Subject{KeyId: "key", Addresses: []string{change}}
+	tx := func(amount int64) *policy.Transaction {
+		decoded, err := decodeBitcoin(bitcoinTx(t, map[string]int64{recipient: amount, change: 1000}), "test", prevout(amount+2000))
+		require.NoError(t, err)
+		return decoded
+	}
+
+	tests := []struct {
+		name     string
+		policies []policy.Policy
+		check    func(*testing.T, policy.Engine)
+	}{
+		{
+			name: "should allow without policies",
+			check: func(t *testing.T, engine policy.Engine) {
+				_, err := engine.CheckSign(ctx, tx(5000), signer, "", "")
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:     "should restrict network",
+			policies: []policy.Policy{{Name: "mainnet-only", Networks: []string{"main"}}},
+			check: func(t *testing.T, engine policy.Engine) {
+				_, err := engine.CheckSign(ctx, tx(5000), signer, "", "")
+				assert.Equal(t, errors.WithMessage(policy.ErrPolicyDenied, "policy mainnet-only: network test is not allowed"), err)
+			},
+		},
+		{
+			name:     "should apply deny list",
+			policies: []policy.Policy{{Name: "deny", DenyList: []string{strings.ToUpper(recipient)}}},
+			check: func(t *testing.T, engine policy.Engine) {
+				_, err := engine.CheckSign(ctx, tx(5000), signer, "", "")
+				assert.Equal(t, policy.StatusPolicyDenied, status(t, err))
+			},
+		},
+		{
+			name:     "should apply allow list to outputs leaving the signer",
+			policies: []policy.Policy{{Name: "allow", AllowList: []string{recipient}}},
+			check: func(t *testing.T, engine policy.Engine) {
+				_, err := engine.CheckSign(ctx, tx(5000), signer, "", "")
+				assert.NoError(t, err)
+
+				_, err = engine.CheckSign(ctx, tx(5000), policy.Subject{KeyId: "other"}, "", "")
+				assert.Equal(t, errors.WithMessage(policy.ErrPolicyDenied, "policy allow: destination "+change+" is not allow-listed"), err)
+			},
+		},
+		{
+			name:     "should cap fee relative to amount",
+			policies: []policy.Policy{{Name: "fee", MaxFeeRatio: 0.1}},
+			check: func(t *testing.T, engine policy.Engine) {
+				_, err := engine.CheckSign(ctx, tx(20000), signer, "", "")
+				assert.NoError(t, err)
+
+				_, err = engine.CheckSign(ctx, tx(5000), signer, "", "")
+				assert.Equal(t, errors.WithMessage(policy.ErrPolicyDenied, "policy fee: fee 1000 exceeds 0.1 of the amount 5000"), err)
+
+				unknownFee, err := decodeBitcoin(bitcoinTx(t, map[string]int64{recipient: 20000}), "test", nil)
+				require.NoError(t, err)
+				_, err = engine.CheckSign(ctx, unknownFee, signer, "", "")
+				assert.Equal(t, policy.StatusPolicyDenied, status(t, err))
+			},
+		},
+		{
+			name:     "should enforce daily limit per key",
+			policies: []policy.Policy{{Name: "limit", KeyIds: []string{"key"}, DailyLimit: "10000"}},
+			check: func(t *testing.T, engine policy.Engine) {
+				_, err := engine.CheckSign(ctx, tx(6000), signer, "", "")
+				assert.NoError(t, err)
+
+				reservation, err := engine.CheckSign(ctx, tx(4000), signer, "", "")
+				assert.NoError(t, err)
+
+				_, err = engine.CheckSign(ctx, tx(1), signer, "", "")
+				assert.Equal(t, errors.WithMessage(policy.ErrPolicyDenied, "policy limit: daily limit 10000 exceeded, 10000 already spent today"), err)
+
+				// A released reservation frees its spend.
+				require.NoError(t, engine.Release(ctx, reservation))
+				_, err = engine.CheckSign(ctx, tx(4000), signer, "", "")
+				assert.NoError(t, err)
+
+				// Other signers are not selected by the policy.
+				_, err = engine.CheckSign(ctx, tx(50000), policy.Subject{WalletId: "wallet"}, "", "")
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:     "should enforce daily limit per wallet",
+			policies: []policy.Policy{{Name: "limit", WalletIds: []string{"wallet"}, DailyLimit: "10000"}},
+			check: func(t *testing.T, engine policy.Engine) {
+				wallet := policy.Subject{WalletId: "wallet", Addresses: []string{change}}
+				_, err := engine.CheckSign(ctx, tx(10000), wallet, "", "")
+				assert.NoError(t, err)
+
+				_, err = engine.CheckSign(ctx, tx(1), wallet, "", "")
+				assert.Equal(t, policy.StatusPolicyDenied, status(t, err))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(t, newEngine(t, tc.policies...))
+		})
+	}
+}
+
+func TestEngine_Approval(t *testing.T) {
+	ctx := context.Background()
+	engine := newEngine(t, policy.Policy{Name: "review", Chain: "ethereum", ApprovalAbove: "1000"})
+
+	small, err := policy.DecodeEthereum(ethereumTx(t, 1000, 21000, 1), "main")
+	require.NoError(t, err)
+	large, err := policy.DecodeEthereum(ethereumTx(t, 1001, 21000, 1), "main")
+	require.NoError(t, err)
+	signer := policy.Subject{KeyId: "key"}
+
+	_, err = engine.CheckSign(ctx, small, signer, "", "alice")
+	assert.NoError(t, err)
+
+	_, err = engine.CheckSign(ctx, large, signer, "", "alice")
+	assert.Equal(t, policy.StatusApprovalRequired, status(t, err))
+
+	approvals, err := engine.Approvals(ctx)
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	approval := approvals[0]
+	assert.Equal(t, policy.ApprovalPending, approval.Status)
+	assert.Equal(t, "1001", approval.Spend)
+	assert.Equal(t, "key:key", approval.Subject)
+
+	_, err = engine.CheckSign(ctx, large, signer, approval.Id, "alice")
+	assert.Equal(t, errors.WithMessage(policy.ErrInvalidApproval, "approval "+approval.Id+" is pending"), err)
+
+	_, err = engine.Approve(ctx, approval.Id, "alice")
+	assert.Equal(t, errors.WithMessage(policy.ErrInvalidApproval, "approval "+approval.Id+" cannot be decided by its requester"), err)
+
+	decided, err := engine.Approve(ctx, approval.Id, "bob")
+	require.NoError(t, err)
+	assert.Equal(t, policy.ApprovalApproved, decided.Status)
+	assert.Equal(t, "bob", decided.DecidedBy)
+
+	_, err = engine.Reject(ctx, approval.Id, "bob")
+	assert.Equal(t, policy.StatusInvalidApproval, status(t, err))
+
+	other, err := policy.DecodeEthereum(ethereumTx(t, 2000, 21000, 1), "main")
+	require.NoError(t, err)
+	_, err = engine.CheckSign(ctx, other, signer, approval.Id, "alice")
+	assert.Equal(t, errors.WithMessage(policy.ErrInvalidApproval, "approval "+approval.Id+" was granted for another transaction"), err)
+
+	reservation, err := engine.CheckSign(ctx, large, signer, approval.Id, "alice")
+	require.NoError(t, err)
+
+	// An approval signs once, unless signing failed and the reservation was released.
+	_, err = engine.CheckSign(ctx, large, signer, approval.Id, "alice")
+	assert.Equal(t, errors.WithMessage(policy.ErrInvalidApproval, "approval "+approval.Id+" is used"), err)
+	require.NoError(t, engine.Release(ctx, reservation))
+	_, err = engine.CheckSign(ctx, large, signer, approval.Id, "alice")
+	assert.NoError(t, err)
+
+	_, err = engine.CheckSign(ctx, large, signer, "missing", "alice")
+	assert.Equal(t, policy.ErrApprovalNotFound, err)
+	_, err = engine.Approve(ctx, "missing", "bob")
+	assert.Equal(t, policy.ErrApprovalNotFound, err)
+}
+
+func TestEngine_Storage(t *testing.T) {
+	ctx := context.Background()
+	store := newStorage(t)
+	policies := []policy.Policy{{Name: "limit", Chain: "ethereum", DailyLimit: "1500", ApprovalAbove: "1000"}}
+	signer := policy.Subject{KeyId: "key"}
+
+	small, err := policy.DecodeEthereum(ethereumTx(t, 1000, 21000, 1), "main")
+	require.NoError(t, err)
+	large, err := policy.DecodeEthereum(ethereumTx(t, 1001, 21000, 1), "main")
+	require.NoError(t, err)
+
+	engine, err := policy.NewEngine(policies, newRegistry(t), store, time.Hour)
+	require.NoError(t, err)
+	_, err = engine.CheckSign(ctx, large, signer, "", "alice")
+	assert.Equal(t, policy.StatusApprovalRequired, status(t, err))
+	_, err = engine.CheckSign(ctx, small, signer, "", "alice")
+	require.NoError(t, err)
+
+	// Another engine on the same storage, a restart or a replica, sees the spend and the approval.
+	restarted, err := policy.NewEngine(policies, newRegistry(t), store, time.Hour)
+	require.NoError(t, err)
+	_, err = restarted.CheckSign(ctx, small, signer, "", "alice")
+	assert.Equal(t, errors.WithMessage(policy.ErrPolicyDenied, "policy limit: daily limit 1500 exceeded, 1000 already spent today"), err)
+
+	approvals, err := restarted.Approvals(ctx)
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	assert.Equal(t, policy.ApprovalPending, approvals[0].Status)
+	assert.Equal(t, approvals[0].CreatedAt.Add(time.Hour), approvals[0].ExpiresAt)
+}
+
+func TestEngine_ApprovalExpiry(t *testing.T) {
+	ctx := context.Background()
+	engine, err := policy.NewEngine([]policy.Policy{{Name: "review", Chain: "ethereum", ApprovalAbove: "1000"}}, newRegistry(t), newStorage(t), time.Millisecond)
+	require.NoError(t, err)
+
+	large, err := policy.DecodeEthereum(ethereumTx(t, 1001, 21000, 1), "main")
+	require.NoError(t, err)
+	_, err = engine.CheckSign(ctx, large, policy.Subject{KeyId: "key"}, "", "alice")
+	assert.Equal(t, policy.StatusApprovalRequired, status(t, err))
+	time.Sleep(10 * time.Millisecond)
+
+	approvals, err := engine.Approvals(ctx)
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	approval := approvals[0]
+	assert.Equal(t, policy.ApprovalExpired, approval.Status)
+
+	_, err = engine.Approve(ctx, approval.Id, "bob")
+	assert.Equal(t, errors.WithMessage(policy.ErrInvalidApproval, "approval "+approval.Id+" is expired"), err)
+	_, err = engine.CheckSign(ctx, large, policy.Subject{KeyId: "key"}, approval.Id, "alice")
+	assert.Equal(t, errors.WithMessage(policy.ErrInvalidApproval, "approval "+approval.Id+" is expired"), err)
+}
+
+func TestEngine_CheckSignContract(t *testing.T) {
+	ctx := context.Background()
+	signer := policy.Subject{KeyId: "key"}
+	transfer, err := policy.DecodeEthereum(contractTx(t, transferData(tokenTo)), "main")
+	require.NoError(t, err)
+	call, err := policy.DecodeEthereum(contractTx(t, []byte{0x09, 0x5e, 0xa7, 0xb3}), "main")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		policy   policy.Policy
+		tx       *policy.Transaction
+		expected error
+	}{
+		{
+			name:   "should allow a token transfer to allow-listed addresses",
+			policy: policy.Policy{Name: "allow", AllowList: []string{ethTo, tokenTo}},
+			tx:     transfer,
+		},
+		{
+			name:     "should check the token recipient against the allow list",
+			policy:   policy.Policy{Name: "allow", AllowList: []string{ethTo}},
+			tx:       transfer,
+			expected: errors.WithMessage(policy.ErrPolicyDenied, "policy allow: destination "+tokenTo+" is not allow-listed"),
+		},
+		{
+			name:     "should deny a token transfer under a daily limit",
+			policy:   policy.Policy{Name: "limit", DailyLimit: "1000"},
+			tx:       transfer,
+			expected: errors.WithMessage(policy.ErrPolicyDenied, "policy limit: token transfers of "+ethTo+" cannot be checked against value limits"),
+		},
+		{
+			name:     "should deny a contract call under an approval threshold",
+			policy:   policy.Policy{Name: "review", ApprovalAbove: "1000"},
+			tx:       call,
+			expected: errors.WithMessage(policy.ErrPolicyDenied, "policy review: contract calls cannot be checked against value limits"),
+		},
+		{
+			name:   "should allow a contract call without value limits",
+			policy: policy.Policy{Name: "deny", DenyList: []string{tokenTo}},
+			tx:     call,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newEngine(t, tc.policy).CheckSign(ctx, tc.tx, signer, "", "")
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestEngine_CheckBroadcast(t *testing.T) {
+	engine := newEngine(t,
+		policy.Policy{Name: "global", Chain: "ethereum", DenyList: []string{ethTo}},
+		policy.Policy{Name: "scoped", Chain: "ethereum", KeyIds: []string{"key"}, Networks: []string{"test"}},
+	)
+
+	tx, err := policy.DecodeEthereum(ethereumTx(t, 1, 21000, 1), "main")
+	require.NoError(t, err)
+
+	err = engine.CheckBroadcast(context.Background(), tx)
+	assert.Equal(t, errors.WithMessage(policy.ErrPolicyDenied, "policy global: destination "+ethTo+" is deny-listed"), err)
+
+	bitcoin, err := decodeBitcoin(bitcoinTx(t, map[string]int64{recipient: 1}), "main", nil)
+	require.NoError(t, err)
+	assert.NoError(t, engine.CheckBroadcast(context.Background(), bitcoin))
+}
@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: engine.go
+
+// Package mock_policy is a generated GoMock package.
+package mock_policy
+
+import (
+	context "context"
+	policy "nn-blockchain-api/pkg/policy"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEngine is a mock of Engine interface.
+type MockEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockEngineMockRecorder
+}
+
+// MockEngineMockRecorder is the mock recorder for MockEngine.
+type MockEngineMockRecorder struct {
+	mock *MockEngine
+}
+
+// NewMockEngine creates a new mock instance.
+func NewMockEngine(ctrl *gomock.Controller) *MockEngine {
+	mock := &MockEngine{ctrl: ctrl}
+	mock.recorder = &MockEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEngine) EXPECT() *MockEngineMockRecorder {
+	return m.recorder
+}
+
+// Approvals mocks base method.
+func (m *MockEngine) Approvals(ctx context.Context) ([]*policy.Approval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approvals", ctx)
+	ret0, _ := ret[0].([]*policy.Approval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approvals indicates an expected call of Approvals.
+func (mr *MockEngineMockRecorder) Approvals(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approvals", reflect.TypeOf((*MockEngine)(nil).Approvals), ctx)
+}
+
+// Approve mocks base method.
+func (m *MockEngine) Approve(ctx context.Context, id, approver string) (*policy.Approval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, approver)
+	ret0, _ := ret[0].(*policy.Approval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockEngineMockRecorder) Approve(ctx, id, approver interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockEngine)(nil).Approve), ctx, id, approver)
+}
+
+// CheckBroadcast mocks base method.
+func (m *MockEngine) CheckBroadcast(ctx context.Context, tx *policy.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBroadcast", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBroadcast indicates an expected call of CheckBroadcast.
+func (mr *MockEngineMockRecorder) CheckBroadcast(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBroadcast", reflect.TypeOf((*MockEngine)(nil).CheckBroadcast), ctx, tx)
+}
+
+// CheckSign mocks base method.
+func (m *MockEngine) CheckSign(ctx context.Context, tx *policy.Transaction, subject policy.Subject, approvalId, requester string) (*policy.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSign", ctx, tx, subject, approvalId, requester)
+	ret0, _ := ret[0].(*policy.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckSign indicates an expected call of CheckSign.
+func (mr *MockEngineMockRecorder) CheckSign(ctx, tx, subject, approvalId, requester interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSign", reflect.TypeOf((*MockEngine)(nil).CheckSign), ctx, tx, subject, approvalId, requester)
+}
+
+// Policies mocks base method.
+func (m *MockEngine) Policies() []policy.Policy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Policies")
+	ret0, _ := ret[0].([]policy.Policy)
+	return ret0
+}
+
+// Policies indicates an expected call of Policies.
+func (mr *MockEngineMockRecorder) Policies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Policies", reflect.TypeOf((*MockEngine)(nil).Policies))
+}
+
+// Reject mocks base method.
+func (m *MockEngine) Reject(ctx context.Context, id, approver string) (*policy.Approval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id, approver)
+	ret0, _ := ret[0].(*policy.Approval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockEngineMockRecorder) Reject(ctx, id, approver interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockEngine)(nil).Reject), ctx, id, approver)
+}
+
+// Release mocks base method.
+func (m *MockEngine) Release(ctx context.Context, reservation *policy.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockEngineMockRecorder) Release(ctx, reservation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockEngine)(nil).Release), ctx, reservation)
+}
@@ -0,0 +1,205 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"nn-blockchain-api/pkg/codes"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/helpers"
+	"nn-blockchain-api/pkg/networks"
+	"strings"
+)
+
+const (
+	StatusPolicyDenied     errors.Status = "policy_denied"
+	StatusApprovalRequired errors.Status = "approval_required"
+	StatusApprovalNotFound errors.Status = "approval_not_found"
+	StatusInvalidApproval  errors.Status = "invalid_approval"
+	StatusUndecodableTx    errors.Status = "undecodable_tx"
+)
+
+var (
+	ErrPolicyDenied = errors.New(codes.Forbidden, StatusPolicyDenied)
+	// ErrApprovalRequired holds the transaction, the message names the approval to wait for.
+	ErrApprovalRequired = errors.New(codes.Accepted, StatusApprovalRequired)
+	ErrApprovalNotFound = errors.New(codes.NotFound, StatusApprovalNotFound)
+	ErrInvalidApproval  = errors.New(codes.UnprocessableEntity, StatusInvalidApproval)
+	ErrUndecodableTx    = errors.New(codes.BadRequest, StatusUndecodableTx)
+)
+
+// Policy restricts what keys and wallets may sign. Key and wallet ids select the signers
+// it applies to, a policy selecting neither applies to every signer of its chain. Amounts
+// are decimal strings in the smallest unit of the chain, satoshi or wei. ERC-20 transfers
+// are checked against the address lists by recipient and contract, policies with a limit
+// or an approval threshold deny them and any other contract call.
+type Policy struct {
+	Name      string   `json:"name"`
+	Chain     string   `json:"chain,omitempty"`
+	KeyIds    []string `json:"key_ids,omitempty"`
+	WalletIds []string `json:"wallet_ids,omitempty"`
+
+	Networks    []string `json:"networks,omitempty"`
+	AllowList   []string `json:"allow_list,omitempty"`
+	DenyList    []string `json:"deny_list,omitempty"`
+	DailyLimit  string   `json:"daily_limit,omitempty"`
+	MaxFeeRatio float64  `json:"max_fee_ratio,omitempty"`
+	// ApprovalAbove holds transactions spending more than the amount for manual approval,
+	// "0" holds every transaction that spends anything.
+	ApprovalAbove string `json:"approval_above,omitempty"`
+}
+
+// Load reads a JSON array of policies.
+func Load(path string) ([]Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []Policy
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+	return policies, nil
+}
+
+// rule is a validated policy with parsed amounts.
+type rule struct {
+	Policy
+	dailyLimit    *big.Int
+	approvalAbove *big.Int
+	maxFeeRatio   *big.Rat
+}
+
+// compile checks the policy names chains and networks the registry serves.
+func compile(p Policy, served *networks.Registry) (*rule, error) {
+	if p.Name == "" {
+		return nil, fmt.Errorf("policy without name")
+	}
+	if p.Chain != "" && !helpers.ContainsStr(served.Chains(), p.Chain) {
+		return nil, fmt.Errorf("policy %s: unsupported chain %s", p.Name, p.Chain)
+	}
+	for _, network := range p.Networks {
+		if !helpers.ContainsStr(served.Names(), network) {
+			return nil, fmt.Errorf("policy %s: unsupported network %s", p.Name, network)
+		}
+	}
+	if p.MaxFeeRatio < 0 {
+		return nil, fmt.Errorf("policy %s: negative max fee ratio", p.Name)
+	}
+
+	r := &rule{Policy: p}
+	var err error
+	if r.dailyLimit, err = parseAmount(p.DailyLimit); err != nil {
+		return nil, fmt.Errorf("policy %s: invalid daily limit: %w", p.Name, err)
+	}
+	if r.approvalAbove, err = parseAmount(p.ApprovalAbove); err != nil {
+		return nil, fmt.Errorf("policy %s: invalid approval threshold: %w", p.Name, err)
+	}
+	if p.MaxFeeRatio > 0 {
+		r.maxFeeRatio = new(big.Rat).SetFloat64(p.MaxFeeRatio)
+	}
+	return r, nil
+}
+
+// applies reports whether the rule covers the signer, an empty subject is only covered
+// by rules without key and wallet selectors.
+func (r *rule) applies(chain string, subject Subject) bool {
+	if r.Chain != "" && r.Chain != chain {
+		return false
+	}
+	if len(r.KeyIds) == 0 && len(r.WalletIds) == 0 {
+		return true
+	}
+	return (subject.KeyId != "" && helpers.ContainsStr(r.KeyIds, subject.KeyId)) ||
+		(subject.WalletId != "" && helpers.ContainsStr(r.WalletIds, subject.WalletId))
+}
+
+// checkDestinations applies the network restriction and the address lists.
+func (r *rule) checkDestinations(tx *Transaction, subject Subject) error {
+	if len(r.Networks) != 0 && !helpers.ContainsStr(r.Networks, tx.Network) {
+		return r.deny("network %s is not allowed", tx.Network)
+	}
+
+	for _, output := range tx.Outputs {
+		if subject.owns(output.Address) {
+			continue
+		}
+		if containsAddress(r.DenyList, output.Address) {
+			return r.deny("destination %s is deny-listed", output.Address)
+		}
+		if len(r.AllowList) != 0 && !containsAddress(r.AllowList, output.Address) {
+			return r.deny("destination %s is not allow-listed", output.Address)
+		}
+	}
+	return nil
+}
+
+// checkValue denies what value limits cannot measure: contract calls and token transfers,
+// whose amounts are not in the unit of the chain.
+func (r *rule) checkValue(tx *Transaction) error {
+	if r.dailyLimit == nil && r.approvalAbove == nil {
+		return nil
+	}
+	if tx.Call {
+		return r.deny("contract calls cannot be checked against value limits")
+	}
+	for _, output := range tx.Outputs {
+		if output.Token != "" {
+			return r.deny("token transfers of %s cannot be checked against value limits", output.Token)
+		}
+	}
+	return nil
+}
+
+func (r *rule) checkFee(tx *Transaction, spend *big.Int) error {
+	if r.maxFeeRatio == nil {
+		return nil
+	}
+	if tx.Fee == nil {
+		return r.deny("fee cannot be verified without the spent amounts")
+	}
+	if spend.Sign() == 0 {
+		if tx.Fee.Sign() > 0 {
+			return r.deny("fee %s exceeds the cap of a transaction that spends nothing", tx.Fee)
+		}
+		return nil
+	}
+	if new(big.Rat).SetFrac(tx.Fee, spend).Cmp(r.maxFeeRatio) > 0 {
+		return r.deny("fee %s exceeds %g of the amount %s", tx.Fee, r.MaxFeeRatio, spend)
+	}
+	return nil
+}
+
+func (r *rule) checkLimit(spent, spend *big.Int) error {
+	if new(big.Int).Add(spent, spend).Cmp(r.dailyLimit) > 0 {
+		return r.deny("daily limit %s exceeded, %s already spent today", r.dailyLimit, spent)
+	}
+	return nil
+}
+
+func (r *rule) deny(format string, args ...interface{}) error {
+	return errors.WithMessage(ErrPolicyDenied, "policy %s: %s", r.Name, fmt.Sprintf(format, args...))
+}
+
+func parseAmount(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("%q is not a non-negative integer", value)
+	}
+	return amount, nil
+}
+
+// containsAddress compares case-insensitively so Ethereum checksum casing does not matter.
+func containsAddress(list []string, address string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, address) {
+			return true
+		}
+	}
+	return false
+}
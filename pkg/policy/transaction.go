@@ -0,0 +1,209 @@
+package policy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"nn-blockchain-api/pkg/errors"
+	"strings"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	ChainBitcoin  = "bitcoin"
+	ChainEthereum = "ethereum"
+
+	// maxFeeInputs bounds the spent outputs looked up for the fee, larger transactions have none.
+	maxFeeInputs = 100
+)
+
+// Prevout returns the amount in satoshi of the output an input spends, ok is false when
+// the output is unknown or already spent.
+type Prevout func(txid string, vout uint32) (amount int64, ok bool, err error)
+
+// ERC-20 selectors of transfer(address,uint256) and transferFrom(address,address,uint256).
+var (
+	selectorTransfer     = []byte{0xa9, 0x05, 0x9c, 0xbb}
+	selectorTransferFrom = []byte{0x23, 0xb8, 0x72, 0xdd}
+)
+
+// Transaction is what a raw transaction actually moves. Fee is nil when it cannot be
+// computed, e.g. for Bitcoin transactions whose spent outputs are unknown.
+type Transaction struct {
+	Chain   string
+	Network string
+	Hash    string
+	Outputs []Output
+	Fee     *big.Int
+	// Call marks an EVM transaction whose data is not a token transfer, what it moves is unknown.
+	Call bool
+}
+
+// Output is a payment of the native coin, or of the ERC-20 contract Token in its own units.
+type Output struct {
+	Address string
+	Amount  *big.Int
+	Token   string
+}
+
+// Subject identifies the signer, Addresses are its own and are not counted as spend.
+type Subject struct {
+	KeyId     string
+	WalletId  string
+	Addresses []string
+}
+
+// id is the identity spend limits are tracked by.
+func (s Subject) id() string {
+	switch {
+	case s.KeyId != "":
+		return "key:" + s.KeyId
+	case s.WalletId != "":
+		return "wallet:" + s.WalletId
+	case len(s.Addresses) != 0:
+		return "address:" + strings.ToLower(s.Addresses[0])
+	}
+	return ""
+}
+
+func (s Subject) owns(address string) bool {
+	return containsAddress(s.Addresses, address)
+}
+
+// Spend sums the native coin outputs that leave the subject.
+func (t *Transaction) Spend(subject Subject) *big.Int {
+	spend := new(big.Int)
+	for _, output := range t.Outputs {
+		if output.Token == "" && !subject.owns(output.Address) {
+			spend.Add(spend, output.Amount)
+		}
+	}
+	return spend
+}
+
+// DecodeUTXO decodes a raw transaction of a Bitcoin-like chain, address prints the
+// address an output script pays to and is empty for scripts without one. The fee is
+// computed from the spent outputs prevout looks up, a nil prevout leaves it unknown.
+func DecodeUTXO(chain, raw, network string, prevout Prevout, address func(pkScript []byte) string) (*Transaction, error) {
+	data, err := hex.DecodeString(raw)
+	if err != nil {
+		return nil, errors.WithMessage(ErrUndecodableTx, err.Error())
+	}
+	var msg wire.MsgTx
+	if err := msg.Deserialize(bytes.NewReader(data)); err != nil {
+		return nil, errors.WithMessage(ErrUndecodableTx, err.Error())
+	}
+
+	tx := &Transaction{Chain: chain, Network: network, Hash: hash(chain, network, raw)}
+	out := new(big.Int)
+	for _, txOut := range msg.TxOut {
+		output := Output{Address: address(txOut.PkScript), Amount: big.NewInt(txOut.Value)}
+		if output.Address == "" {
+			output.Address = hex.EncodeToString(txOut.PkScript)
+		}
+		tx.Outputs = append(tx.Outputs, output)
+		out.Add(out, output.Amount)
+	}
+
+	if prevout == nil || len(msg.TxIn) == 0 || len(msg.TxIn) > maxFeeInputs {
+		return tx, nil
+	}
+	in := new(big.Int)
+	for _, txIn := range msg.TxIn {
+		amount, ok, err := prevout(txIn.PreviousOutPoint.Hash.String(), txIn.PreviousOutPoint.Index)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return tx, nil
+		}
+		in.Add(in, big.NewInt(amount))
+	}
+	tx.Fee = in.Sub(in, out)
+	return tx, nil
+}
+
+// DecodeEthereum decodes an RLP encoded transaction, the fee is its maximum: gas limit
+// times gas price or fee cap.
+func DecodeEthereum(raw, network string) (*Transaction, error) {
+	return DecodeEVM(ChainEthereum, raw, network)
+}
+
+// DecodeEVM decodes an RLP encoded transaction of an EVM chain, policies of one EVM chain
+// do not apply to the others.
+func DecodeEVM(chain, raw, network string) (*Transaction, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+	if err != nil {
+		return nil, errors.WithMessage(ErrUndecodableTx, err.Error())
+	}
+	msg := new(types.Transaction)
+	if err := rlp.DecodeBytes(data, &msg); err != nil {
+		return nil, errors.WithMessage(ErrUndecodableTx, err.Error())
+	}
+
+	tx := &Transaction{
+		Chain:   chain,
+		Network: network,
+		Hash:    hash(chain, network, raw),
+		Fee:     new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasFeeCap()),
+	}
+	// Contract creations have no destination and are checked by amount only.
+	address := ""
+	if msg.To() != nil {
+		address = msg.To().Hex()
+	}
+	tx.Outputs = []Output{{Address: address, Amount: msg.Value()}}
+
+	if len(msg.Data()) != 0 {
+		transfer, ok := tokenTransfer(msg.Data())
+		if !ok || msg.To() == nil {
+			tx.Call = true
+			return tx, nil
+		}
+		transfer.Token = address
+		tx.Outputs = append(tx.Outputs, transfer)
+	}
+	return tx, nil
+}
+
+// tokenTransfer decodes the recipient and amount of ERC-20 transfer and transferFrom calldata.
+func tokenTransfer(data []byte) (Output, bool) {
+	var args []byte
+	switch {
+	case len(data) == 4+2*32 && bytes.Equal(data[:4], selectorTransfer):
+		args = data[4:]
+	case len(data) == 4+3*32 && bytes.Equal(data[:4], selectorTransferFrom):
+		args = data[4+32:]
+	default:
+		return Output{}, false
+	}
+
+	// The recipient is an address left-padded to a word.
+	if !bytes.Equal(args[:12], make([]byte, 12)) {
+		return Output{}, false
+	}
+	return Output{
+		Address: common.BytesToAddress(args[12:32]).Hex(),
+		Amount:  new(big.Int).SetBytes(args[32:64]),
+	}, true
+}
+
+// hash binds approvals to the exact transaction they were granted for.
+func hash(chain, network, raw string) string {
+	sum := sha256.Sum256([]byte(chain + "|" + network + "|" + strings.ToLower(strings.TrimPrefix(raw, "0x"))))
+	return hex.EncodeToString(sum[:])
+}
+
+func EthereumKeyAddress(privateKey string) (string, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return "", err
+	}
+	return crypto.PubkeyToAddress(key.PublicKey).Hex(), nil
+}
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// UnaryServerInterceptor rate limits gRPC calls by the scope class of their rule, the
+// counterpart of NewGuard. It runs after auth.UnaryServerInterceptor so calls count against
+// their key, methods without a rule are left to the auth interceptor.
+func UnaryServerInterceptor(limiter *Limiter, rules map[string]auth.Rule) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rule, ok := rules[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		class := ClassForScope(rule.Scope)
+
+		decision, err := limiter.Allow(ctx, ClientIDFromContext(ctx), class)
+		if err != nil {
+			return nil, errors.NewInternal(err.Error())
+		}
+
+		md := metadata.MD{}
+		if decision.Limit.Daily > 0 {
+			md.Set("x-quota-limit", strconv.FormatInt(decision.Limit.Daily, 10))
+			md.Set("x-quota-remaining", strconv.FormatInt(remaining(decision), 10))
+		}
+		if !decision.Allowed {
+			md.Set("retry-after", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+		}
+		if md.Len() > 0 {
+			// Fails only outside a server call, such as in tests.
+			_ = grpc.SetHeader(ctx, md)
+		}
+
+		if !decision.Allowed {
+			return nil, errors.NewTooManyRequests("rate limit exceeded for " + string(class))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ClientIDFromContext identifies a gRPC caller by API key, falling back to the peer IP.
+func ClientIDFromContext(ctx context.Context) string {
+	if key, ok := auth.KeyFromContext(ctx); ok {
+		return "key:" + key.Id
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "ip:"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return "ip:" + host
+}
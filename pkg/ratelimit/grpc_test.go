@@ -0,0 +1,53 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/ratelimit"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads:      {Rate: 100, Burst: 100, Daily: 100},
+		ratelimit.ClassBroadcasts: {Rate: 100, Burst: 100, Daily: 1},
+	})
+	assert.Nil(t, err)
+
+	rules := map[string]auth.Rule{
+		"/api.bitcoin.v1.BitcoinService/StatusNode":         {Chain: "bitcoin", Scope: auth.ScopeRead},
+		"/api.bitcoin.v1.BitcoinService/SendRawTransaction": {Chain: "bitcoin", Scope: auth.ScopeBroadcast},
+	}
+	interceptor := ratelimit.UnaryServerInterceptor(limiter, rules)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	limited := auth.WithKey(context.Background(), &auth.Key{Id: "limited"})
+	other := auth.WithKey(context.Background(), &auth.Key{Id: "other"})
+
+	assert.Nil(t, call(limited, "/api.bitcoin.v1.BitcoinService/SendRawTransaction"))
+	err = call(limited, "/api.bitcoin.v1.BitcoinService/SendRawTransaction")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, "rate limit exceeded for broadcasts", status.Convert(err).Message())
+
+	assert.Nil(t, call(limited, "/api.bitcoin.v1.BitcoinService/StatusNode"))
+	assert.Nil(t, call(other, "/api.bitcoin.v1.BitcoinService/SendRawTransaction"))
+	assert.Nil(t, call(limited, "/grpc.health.v1.Health/Check"))
+
+	anonymous := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000}})
+	assert.Nil(t, call(anonymous, "/api.bitcoin.v1.BitcoinService/SendRawTransaction"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(anonymous, "/api.bitcoin.v1.BitcoinService/SendRawTransaction")))
+	assert.Equal(t, "ip:10.0.0.1", ratelimit.ClientIDFromContext(anonymous))
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	gErrors "errors"
+	"math"
+	"net"
+	"net/http"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/respond"
+	"strconv"
+)
+
+type guard struct {
+	next    auth.Guard
+	limiter *Limiter
+}
+
+// NewGuard wraps an auth guard so every guarded route is also rate limited by its scope class.
+func NewGuard(next auth.Guard, limiter *Limiter) (auth.Guard, error) {
+	if next == nil {
+		return nil, gErrors.New("invalid guard")
+	}
+	if limiter == nil {
+		return nil, gErrors.New("invalid rate limiter")
+	}
+
+	return &guard{next: next, limiter: limiter}, nil
+}
+
+func (g *guard) Require(chain string, scope auth.Scope) func(http.Handler) http.Handler {
+	authenticate := g.next.Require(chain, scope)
+	class := ClassForScope(scope)
+
+	return func(next http.Handler) http.Handler {
+		return authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			decision, err := g.limiter.Allow(r.Context(), ClientID(r), class)
+			if err != nil {
+				respond.Respond(w, http.StatusInternalServerError, errors.NewInternal(err.Error()))
+				return
+			}
+
+			if decision.Limit.Daily > 0 {
+				w.Header().Set("X-Quota-Limit", strconv.FormatInt(decision.Limit.Daily, 10))
+				w.Header().Set("X-Quota-Remaining", strconv.FormatInt(remaining(decision), 10))
+			}
+
+			if !decision.Allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+				respond.Respond(w, http.StatusTooManyRequests, errors.NewTooManyRequests("rate limit exceeded for "+string(class)))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		}))
+	}
+}
+
+// ClientID identifies the caller by API key, falling back to the remote IP.
+func ClientID(r *http.Request) string {
+	if key, ok := auth.KeyFromContext(r.Context()); ok {
+		return "key:" + key.Id
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	return "ip:" + host
+}
+
+func remaining(decision *Decision) int64 {
+	if decision.Used >= decision.Limit.Daily {
+		return 0
+	}
+	return decision.Limit.Daily - decision.Used
+}
@@ -0,0 +1,127 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Decision describes whether a request may proceed and, if not, when to retry.
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+	Limit      Limit
+	Used       int64
+}
+
+type Usage struct {
+	Class     Class     `json:"class"`
+	Used      int64     `json:"used"`
+	Limit     int64     `json:"limit"`
+	Remaining int64     `json:"remaining"`
+	ResetAt   time.Time `json:"reset_at"`
+}
+
+type Limiter struct {
+	store Store
+	now   func() time.Time
+
+	mu     sync.RWMutex
+	limits map[Class]Limit
+}
+
+func NewLimiter(store Store, limits map[Class]Limit) (*Limiter, error) {
+	if store == nil {
+		return nil, errors.New("invalid rate limit store")
+	}
+
+	return &Limiter{store: store, limits: limits, now: time.Now}, nil
+}
+
+// SetLimits replaces the limits of every class, e.g. on a config reload. Buckets and daily
+// counters carry over.
+func (l *Limiter) SetLimits(limits map[Class]Limit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limits = limits
+}
+
+func (l *Limiter) limit(class Class) Limit {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.limits[class]
+}
+
+// Allow counts the request against the daily quota, then takes a token from the client's
+// bucket for the class. The count is incremented before the quota is checked so concurrent
+// requests cannot pass on the same count, refused requests are taken back.
+func (l *Limiter) Allow(ctx context.Context, client string, class Class) (*Decision, error) {
+	limit := l.limit(class)
+	now := l.now().UTC()
+
+	quotaKey := quotaKey(client, class, now)
+	used, err := l.store.Increment(ctx, quotaKey, nextDay(now).Sub(now))
+	if err != nil {
+		return nil, err
+	}
+
+	if limit.Daily > 0 && used > limit.Daily {
+		return l.refuse(ctx, quotaKey, limit, used, nextDay(now).Sub(now))
+	}
+
+	if limit.Rate > 0 && limit.Burst > 0 {
+		allowed, wait, err := l.store.Take(ctx, bucketKey(client, class), limit, now)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return l.refuse(ctx, quotaKey, limit, used, wait)
+		}
+	}
+
+	return &Decision{Allowed: true, Limit: limit, Used: used}, nil
+}
+
+// refuse takes back the count of a refused request.
+func (l *Limiter) refuse(ctx context.Context, quotaKey string, limit Limit, used int64, retryAfter time.Duration) (*Decision, error) {
+	if err := l.store.Decrement(ctx, quotaKey); err != nil {
+		return nil, err
+	}
+
+	return &Decision{Allowed: false, RetryAfter: retryAfter, Limit: limit, Used: used - 1}, nil
+}
+
+// Usage returns today's quota counters of the client for every route class.
+func (l *Limiter) Usage(ctx context.Context, client string) ([]*Usage, error) {
+	now := l.now().UTC()
+
+	usage := make([]*Usage, 0, len(Classes))
+	for _, class := range Classes {
+		used, err := l.store.Count(ctx, quotaKey(client, class, now))
+		if err != nil {
+			return nil, err
+		}
+
+		item := &Usage{Class: class, Used: used, Limit: l.limit(class).Daily, ResetAt: nextDay(now)}
+		if item.Limit > 0 && item.Limit > used {
+			item.Remaining = item.Limit - used
+		}
+		usage = append(usage, item)
+	}
+
+	return usage, nil
+}
+
+func bucketKey(client string, class Class) string {
+	return "bucket:" + string(class) + ":" + client
+}
+
+func quotaKey(client string, class Class, now time.Time) string {
+	return "quota:" + string(class) + ":" + client + ":" + now.Format("2006-01-02")
+}
+
+func nextDay(now time.Time) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,225 @@
+package ratelimit_test
+
+import (
+	"context"
+	gErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/pkg/auth"
+	"nn-blockchain-api/pkg/ratelimit"
+	mock_ratelimit "nn-blockchain-api/pkg/ratelimit/mocks"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLimiter(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(nil, nil)
+	assert.Nil(t, limiter)
+	assert.EqualError(t, err, "invalid rate limit store")
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads:      {Rate: 0.001, Burst: 2, Daily: 100},
+		ratelimit.ClassBroadcasts: {Rate: 100, Burst: 100, Daily: 1},
+	})
+	assert.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		decision, err := limiter.Allow(ctx, "client", ratelimit.ClassReads)
+		assert.Nil(t, err)
+		assert.True(t, decision.Allowed)
+	}
+
+	decision, err := limiter.Allow(ctx, "client", ratelimit.ClassReads)
+	assert.Nil(t, err)
+	assert.False(t, decision.Allowed)
+	assert.True(t, decision.RetryAfter > 0)
+
+	decision, err = limiter.Allow(ctx, "another", ratelimit.ClassReads)
+	assert.Nil(t, err)
+	assert.True(t, decision.Allowed)
+
+	decision, err = limiter.Allow(ctx, "client", ratelimit.ClassBroadcasts)
+	assert.Nil(t, err)
+	assert.True(t, decision.Allowed)
+
+	decision, err = limiter.Allow(ctx, "client", ratelimit.ClassBroadcasts)
+	assert.Nil(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, int64(1), decision.Used)
+
+	decision, err = limiter.Allow(ctx, "client", ratelimit.ClassBuilds)
+	assert.Nil(t, err)
+	assert.True(t, decision.Allowed)
+
+	usage, err := limiter.Usage(ctx, "client")
+	assert.Nil(t, err)
+	assert.Len(t, usage, 3)
+	assert.Equal(t, ratelimit.ClassReads, usage[0].Class)
+	assert.Equal(t, int64(2), usage[0].Used)
+	assert.Equal(t, int64(98), usage[0].Remaining)
+	assert.Equal(t, int64(1), usage[1].Used)
+	assert.Equal(t, int64(0), usage[1].Limit)
+	assert.Equal(t, int64(0), usage[2].Remaining)
+}
+
+func TestLimiter_AllowStoreError(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := mock_ratelimit.NewMockStore(controller)
+	store.EXPECT().Increment(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), gErrors.New("connection refused"))
+
+	limiter, err := ratelimit.NewLimiter(store, map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads: {Rate: 1, Burst: 1, Daily: 1},
+	})
+	assert.Nil(t, err)
+
+	decision, err := limiter.Allow(context.Background(), "client", ratelimit.ClassReads)
+	assert.Nil(t, decision)
+	assert.EqualError(t, err, "connection refused")
+}
+
+func TestLimiter_AllowConcurrent(t *testing.T) {
+	ctx := context.Background()
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassBroadcasts: {Daily: 10},
+	})
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	var allowed int64
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			decision, err := limiter.Allow(ctx, "client", ratelimit.ClassBroadcasts)
+			assert.Nil(t, err)
+			if decision.Allowed {
+				atomic.AddInt64(&allowed, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(10), allowed)
+
+	usage, err := limiter.Usage(ctx, "client")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), usage[2].Used)
+}
+
+func TestLimiter_AllowRefusedNotCounted(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := mock_ratelimit.NewMockStore(controller)
+	gomock.InOrder(
+		store.EXPECT().Increment(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil),
+		store.EXPECT().Take(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, time.Second, nil),
+		store.EXPECT().Decrement(gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	limiter, err := ratelimit.NewLimiter(store, map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads: {Rate: 1, Burst: 1, Daily: 5},
+	})
+	assert.Nil(t, err)
+
+	decision, err := limiter.Allow(context.Background(), "client", ratelimit.ClassReads)
+	assert.Nil(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, time.Second, decision.RetryAfter)
+	assert.Equal(t, int64(0), decision.Used)
+}
+
+func TestLimiter_SetLimits(t *testing.T) {
+	ctx := context.Background()
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads: {Daily: 1},
+	})
+	assert.Nil(t, err)
+
+	decision, err := limiter.Allow(ctx, "client", ratelimit.ClassReads)
+	assert.Nil(t, err)
+	assert.True(t, decision.Allowed)
+
+	decision, err = limiter.Allow(ctx, "client", ratelimit.ClassReads)
+	assert.Nil(t, err)
+	assert.False(t, decision.Allowed)
+
+	limiter.SetLimits(map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassReads: {Daily: 3},
+	})
+
+	decision, err = limiter.Allow(ctx, "client", ratelimit.ClassReads)
+	assert.Nil(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, int64(2), decision.Used)
+
+	usage, err := limiter.Usage(ctx, "client")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), usage[0].Limit)
+	assert.Equal(t, int64(1), usage[0].Remaining)
+}
+
+func TestGuard_Require(t *testing.T) {
+	keys, err := auth.NewConfigKeyStore([]string{"client:" + auth.HashKey("client") + ":*"})
+	assert.Nil(t, err)
+	authGuard, err := auth.NewGuard(keys, true)
+	assert.Nil(t, err)
+
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), map[ratelimit.Class]ratelimit.Limit{
+		ratelimit.ClassBroadcasts: {Rate: 0.001, Burst: 1, Daily: 10},
+	})
+	assert.Nil(t, err)
+
+	guard, err := ratelimit.NewGuard(authGuard, limiter)
+	assert.Nil(t, err)
+
+	handler := guard.Require("bitcoin", auth.ScopeBroadcast)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	serve := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"network":"test"}`))
+		if key != "" {
+			req.Header.Set(auth.HeaderAPIKey, key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, serve("").Code)
+
+	rec := serve("client")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "10", rec.Header().Get("X-Quota-Limit"))
+	assert.Equal(t, "9", rec.Header().Get("X-Quota-Remaining"))
+
+	rec = serve("client")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+}
+
+func TestNewGuard(t *testing.T) {
+	guard, err := ratelimit.NewGuard(nil, nil)
+	assert.Nil(t, guard)
+	assert.EqualError(t, err, "invalid guard")
+}
+
+func TestClientID(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	assert.Equal(t, "ip:10.0.0.1", ratelimit.ClientID(req))
+
+	req = req.WithContext(auth.WithKey(req.Context(), &auth.Key{Id: "ci"}))
+	assert.Equal(t, "key:ci", ratelimit.ClientID(req))
+}
@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time
+}
+
+type counter struct {
+	value   int64
+	expires time.Time
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	counters  map[string]*counter
+	lastSweep time.Time
+}
+
+// NewMemoryStore keeps buckets and counters in process, suitable for a single replica.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets:  make(map[string]*bucket),
+		counters: make(map[string]*counter),
+	}
+}
+
+func (s *memoryStore) Take(_ context.Context, key string, limit Limit, now time.Time) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		s.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.updated).Seconds()*limit.Rate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+		return false, wait, nil
+	}
+
+	b.tokens--
+	b.full = now.Add(time.Duration((float64(limit.Burst) - b.tokens) / limit.Rate * float64(time.Second)))
+
+	return true, 0, nil
+}
+
+func (s *memoryStore) Increment(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.expires) {
+		c = &counter{expires: now.Add(ttl)}
+		s.counters[key] = c
+	}
+	c.value++
+
+	return c.value, nil
+}
+
+func (s *memoryStore) Decrement(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.counters[key]
+	if ok && time.Now().Before(c.expires) && c.value > 0 {
+		c.value--
+	}
+
+	return nil
+}
+
+func (s *memoryStore) Count(_ context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.counters[key]
+	if !ok || !time.Now().Before(c.expires) {
+		return 0, nil
+	}
+
+	return c.value, nil
+}
+
+// sweep drops refilled buckets and expired counters so idle clients don't pile up.
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if now.After(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+	for key, c := range s.counters {
+		if !now.Before(c.expires) {
+			delete(s.counters, key)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"context"
+	"nn-blockchain-api/pkg/auth"
+	"time"
+)
+
+type Class string
+
+const (
+	ClassReads      Class = "reads"
+	ClassBuilds     Class = "builds"
+	ClassBroadcasts Class = "broadcasts"
+)
+
+var Classes = []Class{ClassReads, ClassBuilds, ClassBroadcasts}
+
+// Limit is a token bucket refilled with Rate tokens per second up to Burst,
+// and a Daily request quota. Zero values disable the corresponding check.
+type Limit struct {
+	Rate  float64
+	Burst int
+	Daily int64
+}
+
+func ClassForScope(scope auth.Scope) Class {
+	switch scope {
+	case auth.ScopeBroadcast:
+		return ClassBroadcasts
+	case auth.ScopeBuild, auth.ScopeSign:
+		return ClassBuilds
+	default:
+		return ClassReads
+	}
+}
+
+//go:generate mockgen -source=ratelimit.go -destination=mocks/ratelimit_mock.go
+type Store interface {
+	// Take removes a token from the bucket and reports how long to wait when it is empty.
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (bool, time.Duration, error)
+	// Increment bumps a counter that expires after ttl and returns the new value.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	// Decrement takes back an increment, counters that expired in between are left alone.
+	Decrement(ctx context.Context, key string) error
+	Count(ctx context.Context, key string) (int64, error)
+}
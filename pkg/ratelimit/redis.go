@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// takeScript refills and takes from a bucket atomically, returning the wait in milliseconds.
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+if tokens == nil then
+	tokens = burst
+	updated = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - updated) / 1000 * rate)
+
+local wait = 0
+if tokens < 1 then
+	wait = math.ceil((1 - tokens) / rate * 1000)
+else
+	tokens = tokens - 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated", tostring(now))
+redis.call("PEXPIRE", KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1000)
+
+return wait
+`)
+
+// decrementScript decrements a counter that still exists, DECR alone would recreate an
+// expired one without its expiry.
+var decrementScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("DECR", KEYS[1])
+end
+return 0
+`)
+
+type redisStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisStore shares buckets and counters between replicas through Redis.
+func NewRedisStore(client redis.UniversalClient, prefix string) (Store, error) {
+	if client == nil {
+		return nil, errors.New("invalid redis client")
+	}
+
+	return &redisStore{client: client, prefix: prefix}, nil
+}
+
+func (s *redisStore) Take(ctx context.Context, key string, limit Limit, now time.Time) (bool, time.Duration, error) {
+	wait, err := takeScript.Run(ctx, s.client, []string{s.prefix + key}, limit.Rate, limit.Burst, now.UnixNano()/int64(time.Millisecond)).Int64()
+	if err != nil {
+		return false, 0, err
+	}
+	if wait > 0 {
+		return false, time.Duration(wait) * time.Millisecond, nil
+	}
+
+	return true, 0, nil
+}
+
+func (s *redisStore) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, s.prefix+key)
+		pipe.Expire(ctx, s.prefix+key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+func (s *redisStore) Decrement(ctx context.Context, key string) error {
+	return decrementScript.Run(ctx, s.client, []string{s.prefix + key}).Err()
+}
+
+func (s *redisStore) Count(ctx context.Context, key string) (int64, error) {
+	count, err := s.client.Get(ctx, s.prefix+key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+
+	return count, err
+}
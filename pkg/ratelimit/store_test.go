@@ -0,0 +1,97 @@
+package ratelimit_test
+
+import (
+	"context"
+	"nn-blockchain-api/pkg/ratelimit"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRedisStore(t *testing.T) ratelimit.Store {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	store, err := ratelimit.NewRedisStore(client, "test:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return store
+}
+
+func TestStore(t *testing.T) {
+	stores := map[string]func(*testing.T) ratelimit.Store{
+		"memory": func(*testing.T) ratelimit.Store { return ratelimit.NewMemoryStore() },
+		"redis":  newRedisStore,
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("should refill bucket", func(t *testing.T) {
+				store := newStore(t)
+				ctx := context.Background()
+				limit := ratelimit.Limit{Rate: 1, Burst: 2}
+				now := time.Now()
+
+				for i := 0; i < 2; i++ {
+					allowed, _, err := store.Take(ctx, "bucket", limit, now)
+					assert.Nil(t, err)
+					assert.True(t, allowed)
+				}
+
+				allowed, wait, err := store.Take(ctx, "bucket", limit, now)
+				assert.Nil(t, err)
+				assert.False(t, allowed)
+				assert.InDelta(t, time.Second, wait, float64(10*time.Millisecond))
+
+				allowed, _, err = store.Take(ctx, "bucket", limit, now.Add(time.Second))
+				assert.Nil(t, err)
+				assert.True(t, allowed)
+
+				allowed, _, err = store.Take(ctx, "other", limit, now)
+				assert.Nil(t, err)
+				assert.True(t, allowed)
+			})
+
+			t.Run("should count", func(t *testing.T) {
+				store := newStore(t)
+				ctx := context.Background()
+
+				count, err := store.Count(ctx, "counter")
+				assert.Nil(t, err)
+				assert.Equal(t, int64(0), count)
+
+				for i := int64(1); i <= 3; i++ {
+					count, err = store.Increment(ctx, "counter", time.Hour)
+					assert.Nil(t, err)
+					assert.Equal(t, i, count)
+				}
+
+				count, err = store.Count(ctx, "counter")
+				assert.Nil(t, err)
+				assert.Equal(t, int64(3), count)
+
+				assert.Nil(t, store.Decrement(ctx, "counter"))
+				count, err = store.Count(ctx, "counter")
+				assert.Nil(t, err)
+				assert.Equal(t, int64(2), count)
+
+				assert.Nil(t, store.Decrement(ctx, "missing"))
+				count, err = store.Count(ctx, "missing")
+				assert.Nil(t, err)
+				assert.Equal(t, int64(0), count)
+			})
+		})
+	}
+}
+
+func TestNewRedisStore(t *testing.T) {
+	store, err := ratelimit.NewRedisStore(nil, "")
+	assert.Nil(t, store)
+	assert.EqualError(t, err, "invalid redis client")
+}
@@ -0,0 +1,102 @@
+package bitcoin_rpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+const cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// CashAddr version bytes of 160 bit hashes.
+const (
+	cashAddrP2PKH byte = 0x00
+	cashAddrP2SH  byte = 0x08
+)
+
+// encodeCashAddr encodes a hash160 as a Bitcoin Cash address, e.g. bitcoincash:qp...
+func encodeCashAddr(prefix string, version byte, hash []byte) (string, error) {
+	data, err := bech32.ConvertBits(append([]byte{version}, hash...), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	checksum := cashAddrPolymod(append(append(cashAddrPrefix(prefix), data...), make([]byte, 8)...))
+	for i := 0; i < 8; i++ {
+		data = append(data, byte(checksum>>(5*(7-i)))&0x1f)
+	}
+
+	var address strings.Builder
+	address.WriteString(prefix + ":")
+	for _, value := range data {
+		address.WriteByte(cashAddrCharset[value])
+	}
+	return address.String(), nil
+}
+
+// decodeCashAddr returns the version byte and hash160 of a CashAddr address, the prefix
+// may be left out.
+func decodeCashAddr(address, prefix string) (byte, []byte, error) {
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return 0, nil, fmt.Errorf("cashaddr %s mixes upper and lower case", address)
+	}
+	address = strings.ToLower(address)
+	if i := strings.LastIndexByte(address, ':'); i >= 0 {
+		if address[:i] != prefix {
+			return 0, nil, fmt.Errorf("cashaddr prefix %s, expected %s", address[:i], prefix)
+		}
+		address = address[i+1:]
+	}
+	if len(address) <= 8 {
+		return 0, nil, fmt.Errorf("cashaddr too short")
+	}
+
+	data := make([]byte, len(address))
+	for i := range address {
+		value := strings.IndexByte(cashAddrCharset, address[i])
+		if value < 0 {
+			return 0, nil, fmt.Errorf("invalid cashaddr character %q", address[i])
+		}
+		data[i] = byte(value)
+	}
+	if cashAddrPolymod(append(cashAddrPrefix(prefix), data...)) != 0 {
+		return 0, nil, fmt.Errorf("invalid cashaddr checksum")
+	}
+
+	payload, err := bech32.ConvertBits(data[:len(data)-8], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	// Only 160 bit hashes, size bits 0, are in use.
+	if len(payload) != 21 || payload[0]&0x07 != 0 {
+		return 0, nil, fmt.Errorf("unsupported cashaddr hash size")
+	}
+	return payload[0], payload[1:], nil
+}
+
+// cashAddrPrefix is the prefix as it enters the checksum, the lower five bits of each
+// character followed by the separator.
+func cashAddrPrefix(prefix string) []byte {
+	values := make([]byte, len(prefix)+1)
+	for i := range prefix {
+		values[i] = prefix[i] & 0x1f
+	}
+	return values
+}
+
+func cashAddrPolymod(values []byte) uint64 {
+	generators := [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+
+	c := uint64(1)
+	for _, value := range values {
+		top := c >> 35
+		c = (c&0x07ffffffff)<<5 ^ uint64(value)
+		for i, generator := range generators {
+			if top>>i&1 == 1 {
+				c ^= generator
+			}
+		}
+	}
+	return c ^ 1
+}
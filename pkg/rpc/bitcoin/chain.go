@@ -0,0 +1,221 @@
+package bitcoin_rpc
+
+import (
+	"fmt"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/networks"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	DefaultChain     = "bitcoin"
+	ChainLitecoin    = "litecoin"
+	ChainDogecoin    = "dogecoin"
+	ChainBitcoinCash = "bitcoincash"
+)
+
+// Chain is a UTXO coin whose node speaks the Bitcoin Core JSON-RPC dialect. Amounts are in
+// its smallest unit, 1e-8 of a coin for all known chains.
+type Chain struct {
+	Name     string
+	Currency string
+	// SegWit chains have witness outputs, bech32 addresses and p2wsh multisig.
+	SegWit bool
+	// CashAddr chains print addresses in the CashAddr format and accept legacy ones too.
+	CashAddr bool
+	// SmartFee nodes estimate with estimatesmartfee in coins per kvB, the others with
+	// estimatefee in coins per kB.
+	SmartFee bool
+	// MinFeeRate and MaxFeeRate bound the estimate in coins per kB, MinFeeRate stands in
+	// when the node has no estimate.
+	MinFeeRate float64
+	MaxFeeRate float64
+	// LegacySigning nodes only have signrawtransaction, which takes the keys after the prevtxs.
+	LegacySigning bool
+	// Networks are keyed by network name, KnownChains has main and test and a configured
+	// chain only the networks it is served on.
+	Networks map[networks.Network]Network
+}
+
+type Network struct {
+	Params *chaincfg.Params
+	// CashAddrPrefix is the human readable part of CashAddr addresses.
+	CashAddrPrefix string
+
+	Endpoint string
+	// Fallbacks are tried in order when the node at Endpoint is unreachable or fails without
+	// a JSON-RPC error. They share User and Password unless their URL carries credentials.
+	Fallbacks []string
+	User      string
+	Password  string
+}
+
+// Endpoints returns the endpoint followed by the fallbacks.
+func (n Network) Endpoints() []string {
+	return append([]string{n.Endpoint}, n.Fallbacks...)
+}
+
+var (
+	litecoinMainNet = newParams(chaincfg.MainNetParams, "litecoin", 0xdbb6c0fb, 0x30, 0x32, 0xb0, "ltc")
+	litecoinTestNet = newParams(chaincfg.TestNet3Params, "litecoin-testnet4", 0xf1c8d2fd, 0x6f, 0x3a, 0xef, "tltc")
+)
+
+// Bech32 addresses are only recognised for registered networks.
+func init() {
+	for _, params := range []*chaincfg.Params{litecoinMainNet, litecoinTestNet} {
+		if err := chaincfg.Register(params); err != nil {
+			panic(err)
+		}
+	}
+}
+
+var KnownChains = map[string]Chain{
+	DefaultChain: {Name: DefaultChain, Currency: "BTC", SegWit: true, SmartFee: true, MaxFeeRate: 0.05, Networks: map[networks.Network]Network{
+		"main": {Params: &chaincfg.MainNetParams}, "test": {Params: &chaincfg.TestNet3Params}, "signet": {Params: &chaincfg.SigNetParams},
+	}},
+	ChainLitecoin: {Name: ChainLitecoin, Currency: "LTC", SegWit: true, SmartFee: true, MinFeeRate: 0.00001, MaxFeeRate: 0.01, Networks: map[networks.Network]Network{
+		"main": {Params: litecoinMainNet}, "test": {Params: litecoinTestNet},
+	}},
+	ChainDogecoin: {Name: ChainDogecoin, Currency: "DOGE", SmartFee: true, MinFeeRate: 0.01, MaxFeeRate: 1, LegacySigning: true, Networks: map[networks.Network]Network{
+		"main": {Params: newParams(chaincfg.MainNetParams, "dogecoin", 0xc0c0c0c0, 0x1e, 0x16, 0x9e, "")},
+		"test": {Params: newParams(chaincfg.TestNet3Params, "dogecoin-testnet", 0xdcb7c1fc, 0x71, 0xc4, 0xf1, "")},
+	}},
+	ChainBitcoinCash: {Name: ChainBitcoinCash, Currency: "BCH", CashAddr: true, MinFeeRate: 0.00001, MaxFeeRate: 0.001, Networks: map[networks.Network]Network{
+		"main": {Params: newParams(chaincfg.MainNetParams, "bitcoincash", 0xe8f3e1e3, 0x00, 0x05, 0x80, ""), CashAddrPrefix: "bitcoincash"},
+		"test": {Params: newParams(chaincfg.TestNet3Params, "bitcoincash-testnet", 0xf4f3e5f4, 0x6f, 0xc4, 0xef, ""), CashAddrPrefix: "bchtest"},
+	}},
+}
+
+// newParams derives the address parameters of a Bitcoin fork, an empty hrp disables
+// bech32 addresses.
+func newParams(base chaincfg.Params, name string, net wire.BitcoinNet, pubKeyHash, scriptHash, privateKey byte, hrp string) *chaincfg.Params {
+	base.Name = name
+	base.Net = net
+	base.PubKeyHashAddrID = pubKeyHash
+	base.ScriptHashAddrID = scriptHash
+	base.PrivateKeyID = privateKey
+	base.Bech32HRPSegwit = hrp
+	return &base
+}
+
+// Network returns the settings of a network, networks the chain has not got are an
+// invalid_network error.
+func (c Chain) Network(network string) (Network, error) {
+	settings, ok := c.Networks[networks.Network(network)]
+	if !ok {
+		return Network{}, errors.NewInvalid(errors.StatusInvalidNetwork, fmt.Sprintf("%s has no network %q", c.Name, network))
+	}
+	return settings, nil
+}
+
+func (c Chain) Params(network string) (*chaincfg.Params, error) {
+	settings, err := c.Network(network)
+	if err != nil {
+		return nil, err
+	}
+	return settings.Params, nil
+}
+
+// DecodeAddress decodes a legacy, bech32 or CashAddr address and checks it belongs to the network.
+func (c Chain) DecodeAddress(address, network string) (btcutil.Address, error) {
+	settings, err := c.Network(network)
+	if err != nil {
+		return nil, err
+	}
+	if c.CashAddr {
+		if version, hash, err := decodeCashAddr(address, settings.CashAddrPrefix); err == nil {
+			switch version {
+			case cashAddrP2PKH:
+				return btcutil.NewAddressPubKeyHash(hash, settings.Params)
+			case cashAddrP2SH:
+				return btcutil.NewAddressScriptHashFromHash(hash, settings.Params)
+			}
+			return nil, errors.NewInvalid(errors.StatusInvalidAddress, "unsupported cashaddr type")
+		}
+	}
+
+	decoded, err := btcutil.DecodeAddress(address, settings.Params)
+	if err != nil {
+		return nil, errors.NewInvalid(errors.StatusInvalidAddress, err.Error())
+	}
+	if !decoded.IsForNet(settings.Params) {
+		return nil, errors.NewInvalid(errors.StatusInvalidAddress, "address "+address+" is not a "+c.Name+" "+network+" address")
+	}
+	return decoded, nil
+}
+
+// EncodeAddress prints an address the way the chain does, CashAddr for Bitcoin Cash.
+func (c Chain) EncodeAddress(address btcutil.Address, network string) string {
+	if c.CashAddr {
+		prefix := c.Networks[networks.Network(network)].CashAddrPrefix
+		var encoded string
+		switch address := address.(type) {
+		case *btcutil.AddressPubKeyHash:
+			encoded, _ = encodeCashAddr(prefix, cashAddrP2PKH, address.ScriptAddress())
+		case *btcutil.AddressScriptHash:
+			encoded, _ = encodeCashAddr(prefix, cashAddrP2SH, address.ScriptAddress())
+		}
+		if encoded != "" {
+			return encoded
+		}
+	}
+	return address.EncodeAddress()
+}
+
+func (c Chain) PayToAddress(address, network string) ([]byte, error) {
+	decoded, err := c.DecodeAddress(address, network)
+	if err != nil {
+		return nil, err
+	}
+	return txscript.PayToAddrScript(decoded)
+}
+
+// ScriptAddress returns the address an output script pays to, empty for non-standard
+// and bare multisig scripts.
+func (c Chain) ScriptAddress(pkScript []byte, network string) string {
+	params, err := c.Params(network)
+	if err != nil {
+		return ""
+	}
+	_, addresses, _, err := txscript.ExtractPkScriptAddrs(pkScript, params)
+	if err != nil || len(addresses) != 1 {
+		return ""
+	}
+	return c.EncodeAddress(addresses[0], network)
+}
+
+// KeyAddresses returns the P2PKH and, on segwit chains, P2WPKH addresses of a WIF key.
+func (c Chain) KeyAddresses(wif, network string) ([]string, error) {
+	decoded, err := btcutil.DecodeWIF(wif)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := c.Params(network)
+	if err != nil {
+		return nil, err
+	}
+	pubKeyHash := btcutil.Hash160(decoded.SerializePubKey())
+	legacy, err := btcutil.NewAddressPubKeyHash(pubKeyHash, params)
+	if err != nil {
+		return nil, err
+	}
+	addresses := []string{c.EncodeAddress(legacy, network)}
+	if c.SegWit {
+		segwit, err := btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, params)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, segwit.EncodeAddress())
+	}
+	return addresses, nil
+}
+
+// ToCoins converts an amount in the smallest unit to coins.
+func (c Chain) ToCoins(amount int64) float64 {
+	return btcutil.Amount(amount).ToBTC()
+}
@@ -0,0 +1,157 @@
+package bitcoin_rpc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	mock_bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin/mocks"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_GetCurrentFee(t *testing.T) {
+	tests := []struct {
+		name     string
+		chain    string
+		request  bitcoin_rpc.BaseRequest
+		response string
+		want     float64
+	}{
+		{
+			name:     "bitcoin smart fee",
+			chain:    "bitcoin",
+			request:  bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "estimatesmartfee", Params: []interface{}{2}},
+			response: `{"result":{"feerate":0.00020000,"blocks":2},"error":null,"id":null}`,
+			want:     0.0002,
+		},
+		{
+			name:     "litecoin estimate above the cap",
+			chain:    "litecoin",
+			request:  bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "estimatesmartfee", Params: []interface{}{2}},
+			response: `{"result":{"feerate":0.50000000,"blocks":2},"error":null,"id":null}`,
+			want:     0.01,
+		},
+		{
+			name:     "dogecoin without an estimate",
+			chain:    "dogecoin",
+			request:  bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "estimatesmartfee", Params: []interface{}{2}},
+			response: `{"result":{"errors":["Insufficient data or no feerate found"],"blocks":0},"error":null,"id":null}`,
+			want:     0.01,
+		},
+		{
+			name:     "bitcoin cash estimatefee",
+			chain:    "bitcoincash",
+			request:  bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "estimatefee", Params: []interface{}{}},
+			response: `{"result":0.00001000,"error":null,"id":null}`,
+			want:     0.00001,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			svc := newChainService(t, controller, tt.chain, tt.request, tt.response)
+
+			fee, err := svc.GetCurrentFee(context.Background(), "main")
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, *fee)
+		})
+	}
+}
+
+func TestService_SignTransaction_Legacy(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	svc := newChainService(t, controller, "dogecoin",
+		bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "signrawtransaction", Params: []interface{}{"0200", bitcoin_rpc.UTXO(nil), []string{"key"}}},
+		`{"result":{"hex":"0201","complete":true},"error":null,"id":null}`)
+
+	signed, err := svc.SignTransaction(context.Background(), "0200", "key", nil, "main")
+	assert.Nil(t, err)
+	assert.Equal(t, "0201", signed)
+}
+
+func TestService_CreateTransaction_Chains(t *testing.T) {
+	utxos := bitcoin_rpc.UTXO{{TxId: txid, Vout: 0, Amount: 100000000}}
+
+	tests := []struct {
+		name    string
+		chain   string
+		to      string
+		from    string
+		scripts []string
+	}{
+		{
+			name:    "litecoin bech32",
+			chain:   "litecoin",
+			to:      "ltc1qw6syq5aa5z5ghkj3w7ux59wrk204txrnp208rt",
+			from:    "LW3ByJXVHpiJsuy3u2sdieFQkXHtuk93Yi",
+			scripts: []string{"001476a04053bda0a88bda5177b86a15c3b29f559873", "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		},
+		{
+			name:    "bitcoin cash cashaddr",
+			chain:   "bitcoincash",
+			to:      "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq",
+			from:    "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+			scripts: []string{"a91476a04053bda0a88bda5177b86a15c3b29f55987387", "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			request := bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "estimatesmartfee", Params: []interface{}{2}}
+			response := `{"result":{"feerate":0.00010000,"blocks":2},"error":null,"id":null}`
+			if !bitcoin_rpc.KnownChains[tt.chain].SmartFee {
+				request = bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "estimatefee", Params: []interface{}{}}
+				response = `{"result":0.00010000,"error":null,"id":null}`
+			}
+			svc := newChainService(t, controller, tt.chain, request, response)
+
+			raw, _, err := svc.CreateTransaction(context.Background(), utxos, tt.from, tt.to, 50000000, "main")
+			assert.Nil(t, err)
+
+			data, err := hex.DecodeString(*raw)
+			assert.Nil(t, err)
+			var tx wire.MsgTx
+			assert.Nil(t, tx.Deserialize(bytes.NewReader(data)))
+			assert.Len(t, tx.TxOut, len(tt.scripts))
+			for i, script := range tt.scripts {
+				assert.Equal(t, script, hex.EncodeToString(tx.TxOut[i].PkScript))
+			}
+		})
+	}
+}
+
+func TestService_CreateMultisig_NoSegWit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	svc, err := bitcoin_rpc.NewService(mock_bitcoin_rpc.NewMockClient(controller), bitcoin_rpc.KnownChains["dogecoin"])
+	assert.Nil(t, err)
+
+	_, err = svc.CreateMultisig(context.Background(), 1, []string{"02ab"}, bitcoin_rpc.ScriptTypeP2WSH, "main")
+	assert.Equal(t, errors.NewInvalid(errors.StatusInvalidParameter, "multisig needs segwit, which dogecoin does not have"), err)
+}
+
+// newChainService answers one request of a chain with a response recorded from its node.
+func newChainService(t *testing.T, controller *gomock.Controller, chain string, request bitcoin_rpc.BaseRequest, response string) bitcoin_rpc.Service {
+	client := mock_bitcoin_rpc.NewMockClient(controller)
+	client.EXPECT().EncodeBaseRequest(request).Return(&bytes.Buffer{}, nil)
+	client.EXPECT().Send(gomock.Any(), gomock.Any(), "", "main").
+		Return(&http.Response{Body: ioutil.NopCloser(bytes.NewBufferString(response))}, nil)
+
+	svc, err := bitcoin_rpc.NewService(client, bitcoin_rpc.KnownChains[chain])
+	assert.Nil(t, err)
+	return svc
+}
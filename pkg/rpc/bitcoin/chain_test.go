@@ -0,0 +1,78 @@
+package bitcoin_rpc_test
+
+import (
+	"encoding/hex"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain_DecodeAddress(t *testing.T) {
+	// All addresses pay to the hash160 76a04053bda0a88bda5177b86a15c3b29f559873.
+	tests := []struct {
+		name    string
+		chain   string
+		network string
+		address string
+		script  string
+		wantErr bool
+	}{
+		{name: "bitcoin p2pkh", chain: "bitcoin", network: "main", address: "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", script: "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		{name: "bitcoin testnet address on main", chain: "bitcoin", network: "main", address: "tb1qw6syq5aa5z5ghkj3w7ux59wrk204txrn0swsqg", wantErr: true},
+		{name: "litecoin p2pkh", chain: "litecoin", network: "main", address: "LW3ByJXVHpiJsuy3u2sdieFQkXHtuk93Yi", script: "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		{name: "litecoin p2sh", chain: "litecoin", network: "main", address: "MJiPwX84iBe4WnFDwsYGgtnz1XonPhUqhf", script: "a91476a04053bda0a88bda5177b86a15c3b29f55987387"},
+		{name: "litecoin bech32", chain: "litecoin", network: "main", address: "ltc1qw6syq5aa5z5ghkj3w7ux59wrk204txrnp208rt", script: "001476a04053bda0a88bda5177b86a15c3b29f559873"},
+		{name: "litecoin testnet bech32", chain: "litecoin", network: "test", address: "tltc1qw6syq5aa5z5ghkj3w7ux59wrk204txrnkcvwsp", script: "001476a04053bda0a88bda5177b86a15c3b29f559873"},
+		{name: "bitcoin address on litecoin", chain: "litecoin", network: "main", address: "bc1qw6syq5aa5z5ghkj3w7ux59wrk204txrn9k4rmm", wantErr: true},
+		{name: "dogecoin p2pkh", chain: "dogecoin", network: "main", address: "DFxLFMAJWaNYA7TVTUstzPMFRSevAwTSLq", script: "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		{name: "dogecoin testnet p2pkh", chain: "dogecoin", network: "test", address: "nf1PyMuDSYqG362gVJXMEnwYfK3DByPa6E", script: "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		{name: "bech32 on dogecoin", chain: "dogecoin", network: "main", address: "bc1qw6syq5aa5z5ghkj3w7ux59wrk204txrn9k4rmm", wantErr: true},
+		{name: "bitcoin cash cashaddr", chain: "bitcoincash", network: "main", address: "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", script: "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		{name: "bitcoin cash cashaddr without prefix", chain: "bitcoincash", network: "main", address: "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", script: "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		{name: "bitcoin cash p2sh cashaddr", chain: "bitcoincash", network: "main", address: "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq", script: "a91476a04053bda0a88bda5177b86a15c3b29f55987387"},
+		{name: "bitcoin cash testnet cashaddr", chain: "bitcoincash", network: "test", address: "bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvqcw003ap", script: "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		{name: "bitcoin cash legacy", chain: "bitcoincash", network: "main", address: "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", script: "76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac"},
+		{name: "bitcoin cash bad checksum", chain: "bitcoincash", network: "main", address: "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6b", wantErr: true},
+		{name: "bitcoin cash mainnet address on test", chain: "bitcoincash", network: "test", address: "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script, err := bitcoin_rpc.KnownChains[tt.chain].PayToAddress(tt.address, tt.network)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.script, hex.EncodeToString(script))
+		})
+	}
+}
+
+func TestChain_ScriptAddress(t *testing.T) {
+	script, err := hex.DecodeString("76a91476a04053bda0a88bda5177b86a15c3b29f55987388ac")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu", bitcoin_rpc.KnownChains["bitcoin"].ScriptAddress(script, "main"))
+	assert.Equal(t, "LW3ByJXVHpiJsuy3u2sdieFQkXHtuk93Yi", bitcoin_rpc.KnownChains["litecoin"].ScriptAddress(script, "main"))
+	assert.Equal(t, "nf1PyMuDSYqG362gVJXMEnwYfK3DByPa6E", bitcoin_rpc.KnownChains["dogecoin"].ScriptAddress(script, "test"))
+	assert.Equal(t, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", bitcoin_rpc.KnownChains["bitcoincash"].ScriptAddress(script, "main"))
+	assert.Equal(t, "", bitcoin_rpc.KnownChains["bitcoin"].ScriptAddress([]byte{0x6a}, "main"))
+}
+
+func TestChain_KeyAddresses(t *testing.T) {
+	// The WIF of private key 1, whose public key hashes to 751e76e8199196d454941c45d1b3a323f1433bd6.
+	wif := "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn"
+
+	addresses, err := bitcoin_rpc.KnownChains["bitcoin"].KeyAddresses(wif, "main")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"}, addresses)
+
+	addresses, err = bitcoin_rpc.KnownChains["bitcoincash"].KeyAddresses(wif, "main")
+	assert.Nil(t, err)
+	assert.Len(t, addresses, 1)
+	assert.Regexp(t, "^bitcoincash:q", addresses[0])
+
+	_, err = bitcoin_rpc.KnownChains["dogecoin"].KeyAddresses("not a key", "main")
+	assert.NotNil(t, err)
+}
@@ -1,8 +1,16 @@
 package bitcoin_rpc_test
 
 import (
+	"context"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/metrics"
+	"nn-blockchain-api/pkg/networks"
 	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
 	"testing"
 )
@@ -36,7 +44,7 @@ func TestNewClient(t *testing.T) {
 			},
 		},
 		{
-			name:                  "should return invalid bitcoin rpc testnet endpoint",
+			name:                  "should return invalid bitcoin rpc test endpoint",
 			btcRpcEndpointTestNet: "",
 			btcRpcEndpointMainNet: btcRpcMain,
 			btcUser:               btcRpcUser,
@@ -44,11 +52,11 @@ func TestNewClient(t *testing.T) {
 			expect: func(t *testing.T, c bitcoin_rpc.Client, err error) {
 				assert.NotNil(t, err)
 				assert.Nil(t, c)
-				assert.EqualError(t, err, "invalid bitcoin rpc testnet endpoint")
+				assert.EqualError(t, err, "invalid bitcoin rpc test endpoint")
 			},
 		},
 		{
-			name:                  "should return invalid bitcoin rpc mainnet endpoint",
+			name:                  "should return invalid bitcoin rpc main endpoint",
 			btcRpcEndpointTestNet: btcRpcTest,
 			btcRpcEndpointMainNet: "",
 			btcUser:               btcRpcUser,
@@ -56,7 +64,7 @@ func TestNewClient(t *testing.T) {
 			expect: func(t *testing.T, c bitcoin_rpc.Client, err error) {
 				assert.NotNil(t, err)
 				assert.Nil(t, c)
-				assert.EqualError(t, err, "invalid bitcoin rpc mainnet endpoint")
+				assert.EqualError(t, err, "invalid bitcoin rpc main endpoint")
 			},
 		},
 		{
@@ -68,7 +76,7 @@ func TestNewClient(t *testing.T) {
 			expect: func(t *testing.T, c bitcoin_rpc.Client, err error) {
 				assert.NotNil(t, err)
 				assert.Nil(t, c)
-				assert.EqualError(t, err, "invalid bitcoin rpc user")
+				assert.Contains(t, []string{"invalid bitcoin rpc test user", "invalid bitcoin rpc main user"}, err.Error())
 			},
 		},
 		{
@@ -80,15 +88,179 @@ func TestNewClient(t *testing.T) {
 			expect: func(t *testing.T, c bitcoin_rpc.Client, err error) {
 				assert.NotNil(t, err)
 				assert.Nil(t, c)
-				assert.EqualError(t, err, "invalid bitcoin rpc password")
+				assert.Contains(t, []string{"invalid bitcoin rpc test password", "invalid bitcoin rpc main password"}, err.Error())
 			},
 		},
 	}
 
+	t.Run("should return invalid bitcoin networks", func(t *testing.T) {
+		chain := bitcoin_rpc.KnownChains["bitcoin"]
+		chain.Networks = nil
+		svc, err := bitcoin_rpc.NewClient(chain)
+		assert.Nil(t, svc)
+		assert.EqualError(t, err, "invalid bitcoin networks")
+	})
+
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := bitcoin_rpc.NewClient(tc.btcRpcEndpointTestNet, tc.btcRpcEndpointMainNet, tc.btcUser, tc.btcPassword)
+			svc, err := bitcoin_rpc.NewClient(newChain(tc.btcRpcEndpointTestNet, tc.btcRpcEndpointMainNet, tc.btcUser, tc.btcPassword))
 			tc.expect(t, svc, err)
 		})
 	}
 }
+
+func TestClient_Send(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "password", password)
+		assert.Equal(t, "/wallet/wallet", r.URL.Path)
+
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"result":null,"error":{"code":-18,"message":"Requested wallet does not exist or is not loaded"}}`))
+	}))
+	defer server.Close()
+
+	client, err := bitcoin_rpc.NewClient(newChain(server.URL, server.URL, "user", "password"))
+	assert.Nil(t, err)
+
+	body, err := client.EncodeBaseRequest(bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "getwalletinfo", Params: []interface{}{}})
+	assert.Nil(t, err)
+
+	response, err := client.Send(context.Background(), body, "wallet", "test")
+	assert.Nil(t, err)
+	defer response.Body.Close()
+
+	data, err := ioutil.ReadAll(response.Body)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "Requested wallet does not exist")
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, rec.Body.String(), `nn_blockchain_api_rpc_requests_total{chain="bitcoin",code="-18",method="getwalletinfo",network="test"} 1`)
+}
+
+func TestClient_Send_Network(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	defer server.Close()
+
+	chain := newChain(server.URL, server.URL, "user", "password")
+	delete(chain.Networks, networks.Test)
+	client, err := bitcoin_rpc.NewClient(chain)
+	assert.Nil(t, err)
+
+	for _, network := range []string{"mainnet", "test", ""} {
+		body, err := client.EncodeBaseRequest(bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "getblockchaininfo", Params: []interface{}{}})
+		assert.Nil(t, err)
+
+		_, err = client.Send(context.Background(), body, "", network)
+		assert.Equal(t, http.StatusBadRequest, errors.HTTPCode(err), network)
+	}
+}
+
+func TestClient_Send_Fallbacks(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	var hits int
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "fallback", user)
+		assert.Equal(t, "secret", password)
+		assert.Equal(t, "/wallet/wallet", r.URL.Path)
+
+		_, _ = w.Write([]byte(`{"result":{"walletname":"wallet"},"error":null}`))
+	}))
+	defer up.Close()
+
+	fallback, err := url.Parse(up.URL)
+	assert.Nil(t, err)
+	fallback.User = url.UserPassword("fallback", "secret")
+
+	chain := newChain(down.URL, down.URL, "user", "password")
+	test := chain.Networks[networks.Test]
+	test.Fallbacks = []string{fallback.String()}
+	chain.Networks[networks.Test] = test
+	client, err := bitcoin_rpc.NewClient(chain)
+	assert.Nil(t, err)
+
+	body, err := client.EncodeBaseRequest(bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "getwalletinfo", Params: []interface{}{}})
+	assert.Nil(t, err)
+	response, err := client.Send(context.Background(), body, "wallet", "test")
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, 1, hits)
+
+	body, err = client.EncodeBaseRequest(bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "getwalletinfo", Params: []interface{}{}})
+	assert.Nil(t, err)
+	_, err = client.Send(context.Background(), body, "wallet", "main")
+	assert.True(t, errors.IsNodeUnavailable(err))
+}
+
+func TestClient_SetCredentials(t *testing.T) {
+	var users []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		users = append(users, user+":"+password)
+
+		_, _ = w.Write([]byte(`{"result":{"walletname":"wallet"},"error":null}`))
+	}))
+	defer server.Close()
+
+	chain := newChain(server.URL, server.URL, "user", "password")
+	client, err := bitcoin_rpc.NewClient(chain)
+	assert.Nil(t, err)
+
+	send := func() {
+		body, err := client.EncodeBaseRequest(bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "getwalletinfo", Params: []interface{}{}})
+		assert.Nil(t, err)
+		response, err := client.Send(context.Background(), body, "wallet", "test")
+		assert.Nil(t, err)
+		response.Body.Close()
+	}
+
+	send()
+	assert.Nil(t, client.SetCredentials("test", "rotated", "secret"))
+	send()
+	assert.Equal(t, []string{"user:password", "rotated:secret"}, users)
+	assert.Equal(t, "user", chain.Networks[networks.Test].User)
+
+	assert.EqualError(t, client.SetCredentials("test", "rotated", ""), "invalid bitcoin rpc test credentials")
+	assert.EqualError(t, client.SetCredentials("regtest", "rotated", "secret"), `code: 400; status: invalid_network; message: bitcoin has no network "regtest"`)
+}
+
+// newChain serves bitcoin on both networks with shared credentials.
+func newChain(endpointTest, endpointMain, user, password string) bitcoin_rpc.Chain {
+	chain := bitcoin_rpc.KnownChains["bitcoin"]
+	test, main := chain.Networks[networks.Test], chain.Networks[networks.Main]
+	test.Endpoint, test.User, test.Password = endpointTest, user, password
+	main.Endpoint, main.User, main.Password = endpointMain, user, password
+	chain.Networks = map[networks.Network]bitcoin_rpc.Network{networks.Test: test, networks.Main: main}
+	return chain
+}
+
+func TestClient_Send_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	parsed, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	parsed.User = url.UserPassword("user", "hunter2")
+	endpoint := parsed.String()
+	server.Close()
+
+	client, err := bitcoin_rpc.NewClient(newChain(endpoint, endpoint, "user", "password"))
+	assert.Nil(t, err)
+
+	body, err := client.EncodeBaseRequest(bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "getwalletinfo", Params: []interface{}{}})
+	assert.Nil(t, err)
+	_, err = client.Send(context.Background(), body, "wallet", "test")
+	assert.Equal(t, errors.NewNodeUnavailable("bitcoin test node unreachable"), err)
+	assert.NotContains(t, err.Error(), "hunter2")
+}
@@ -0,0 +1,348 @@
+package bitcoin_rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"nn-blockchain-api/pkg/errors"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+const (
+	DescriptorWPKH    = "wpkh"
+	DescriptorTR      = "tr"
+	DescriptorSHWSH   = "sh(wsh(multi))"
+	DescriptorAddress = "addr"
+
+	checksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	// maxMultisigKeys is the limit of multi() inside wsh().
+	maxMultisigKeys = 20
+)
+
+// Descriptor is a parsed watch-only output descriptor, Body is the descriptor without
+// its checksum.
+type Descriptor struct {
+	Type     string
+	Body     string
+	Checksum string
+	Required int
+	Keys     []string
+	Ranged   bool
+}
+
+// ParseDescriptor validates the descriptor forms the API imports: wpkh(KEY), tr(KEY),
+// sh(wsh(multi(k,KEY,...))) and its sortedmulti variant, and addr(ADDRESS). Keys are hex
+// public keys or extended public keys of the network with an optional origin and a
+// derivation path that may end in a /* wildcard. Private keys are refused.
+func ParseDescriptor(chain Chain, descriptor, network string) (*Descriptor, error) {
+	body, checksum := descriptor, ""
+	if i := strings.LastIndexByte(descriptor, '#'); i >= 0 {
+		body, checksum = descriptor[:i], descriptor[i+1:]
+		if len(checksum) != 8 || strings.Trim(checksum, checksumCharset) != "" {
+			return nil, fmt.Errorf("invalid descriptor checksum %q", checksum)
+		}
+	}
+	d := &Descriptor{Body: body, Checksum: checksum}
+
+	switch {
+	case unwrap(body, "wpkh") != nil:
+		d.Type = DescriptorWPKH
+		d.Keys = []string{*unwrap(body, "wpkh")}
+	case unwrap(body, "tr") != nil:
+		d.Type = DescriptorTR
+		d.Keys = []string{*unwrap(body, "tr")}
+		if strings.Contains(d.Keys[0], ",") {
+			return nil, fmt.Errorf("taproot script trees are not supported")
+		}
+	case unwrap(body, "sh") != nil && unwrap(*unwrap(body, "sh"), "wsh") != nil:
+		script := *unwrap(*unwrap(body, "sh"), "wsh")
+		args := unwrap(script, "multi")
+		if args == nil {
+			args = unwrap(script, "sortedmulti")
+		}
+		if args == nil {
+			return nil, fmt.Errorf("sh(wsh()) descriptors must wrap multi or sortedmulti")
+		}
+		parts := strings.Split(*args, ",")
+		required, err := strconv.Atoi(parts[0])
+		if err != nil || required < 1 || required > len(parts)-1 || len(parts)-1 > maxMultisigKeys {
+			return nil, fmt.Errorf("invalid multisig threshold %s of %d keys", parts[0], len(parts)-1)
+		}
+		d.Type, d.Required, d.Keys = DescriptorSHWSH, required, parts[1:]
+	case unwrap(body, "addr") != nil:
+		address := *unwrap(body, "addr")
+		if _, err := chain.DecodeAddress(address, network); err != nil {
+			return nil, fmt.Errorf("invalid %s network address %s", network, address)
+		}
+		d.Type = DescriptorAddress
+		return d, nil
+	default:
+		return nil, fmt.Errorf("unsupported descriptor %s", body)
+	}
+
+	params, err := chain.Params(network)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range d.Keys {
+		ranged, err := parseDescriptorKey(key, d.Type == DescriptorTR, params, network)
+		if err != nil {
+			return nil, err
+		}
+		d.Ranged = d.Ranged || ranged
+	}
+	return d, nil
+}
+
+// String is the descriptor with its checksum when known.
+func (d *Descriptor) String() string {
+	if d.Checksum == "" {
+		return d.Body
+	}
+	return d.Body + "#" + d.Checksum
+}
+
+// unwrap returns the arguments of fn(...) when s is exactly such a call.
+func unwrap(s, fn string) *string {
+	if !strings.HasPrefix(s, fn+"(") || !strings.HasSuffix(s, ")") {
+		return nil
+	}
+	args := s[len(fn)+1 : len(s)-1]
+	return &args
+}
+
+// parseDescriptorKey validates a key expression and reports whether it ends in a wildcard.
+func parseDescriptorKey(key string, xOnly bool, params *chaincfg.Params, network string) (bool, error) {
+	if strings.HasPrefix(key, "[") {
+		end := strings.IndexByte(key, ']')
+		if end < 0 {
+			return false, fmt.Errorf("unterminated key origin in %s", key)
+		}
+		origin := strings.Split(key[1:end], "/")
+		if fingerprint, err := hex.DecodeString(origin[0]); err != nil || len(fingerprint) != 4 {
+			return false, fmt.Errorf("invalid key origin fingerprint in %s", key)
+		}
+		if err := parsePath(origin[1:], true); err != nil {
+			return false, fmt.Errorf("invalid key origin in %s: %v", key, err)
+		}
+		key = key[end+1:]
+	}
+
+	parts := strings.Split(key, "/")
+	if data, err := hex.DecodeString(parts[0]); err == nil {
+		if len(parts) > 1 {
+			return false, fmt.Errorf("public key %s cannot be derived", parts[0])
+		}
+		if xOnly && len(data) == 32 {
+			data = append([]byte{0x02}, data...)
+		}
+		if len(data) != 33 {
+			return false, fmt.Errorf("public key %s is not compressed", parts[0])
+		}
+		if _, err := btcec.ParsePubKey(data, btcec.S256()); err != nil {
+			return false, fmt.Errorf("invalid public key %s", parts[0])
+		}
+		return false, nil
+	}
+	if _, err := btcutil.DecodeWIF(parts[0]); err == nil {
+		return false, fmt.Errorf("descriptors with private keys are not accepted")
+	}
+
+	extended, err := hdkeychain.NewKeyFromString(parts[0])
+	if err != nil {
+		return false, fmt.Errorf("invalid key %s", parts[0])
+	}
+	if extended.IsPrivate() {
+		return false, fmt.Errorf("descriptors with private keys are not accepted")
+	}
+	if !extended.IsForNet(params) {
+		return false, fmt.Errorf("key %s does not belong to the %s network", parts[0], network)
+	}
+
+	path, ranged := parts[1:], false
+	if last := len(path) - 1; last >= 0 && strings.HasPrefix(path[last], "*") {
+		if path[last] != "*" {
+			return false, fmt.Errorf("hardened wildcards need private keys")
+		}
+		path, ranged = path[:last], true
+	}
+	if err := parsePath(path, false); err != nil {
+		return false, fmt.Errorf("invalid derivation of %s: %v", parts[0], err)
+	}
+	return ranged, nil
+}
+
+func parsePath(path []string, hardened bool) error {
+	for _, step := range path {
+		index := strings.TrimRight(step, "'h")
+		if len(step)-len(index) > 1 {
+			return fmt.Errorf("invalid step %s", step)
+		}
+		if index != step && !hardened {
+			return fmt.Errorf("hardened step %s needs the private key", step)
+		}
+		if _, err := strconv.ParseUint(index, 10, 31); err != nil {
+			return fmt.Errorf("invalid step %s", step)
+		}
+	}
+	return nil
+}
+
+type DescriptorInfo struct {
+	Descriptor     string `json:"descriptor"`
+	Checksum       string `json:"checksum"`
+	IsRange        bool   `json:"isrange"`
+	IsSolvable     bool   `json:"issolvable"`
+	HasPrivateKeys bool   `json:"hasprivatekeys"`
+}
+
+// DescriptorImport is one request of importdescriptors. Timestamp is "now" or the unix
+// time to rescan from, Range is only set for ranged descriptors.
+type DescriptorImport struct {
+	Desc      string      `json:"desc"`
+	Timestamp interface{} `json:"timestamp"`
+	Range     []int64     `json:"range,omitempty"`
+	Active    bool        `json:"active,omitempty"`
+	Internal  bool        `json:"internal,omitempty"`
+	Label     string      `json:"label,omitempty"`
+}
+
+type DescriptorImportResult struct {
+	Success  bool     `json:"success"`
+	Warnings []string `json:"warnings"`
+	Error    *struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (s *service) GetDescriptorInfo(ctx context.Context, descriptor, network string) (*DescriptorInfo, error) {
+	msg := struct {
+		Result DescriptorInfo `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "getdescriptorinfo",
+		Params:  []interface{}{descriptor},
+	}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Error.Message != "" {
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return &msg.Result, nil
+}
+
+// ImportDescriptors imports into a descriptor wallet, the node reports success per request.
+func (s *service) ImportDescriptors(ctx context.Context, walletId string, requests []DescriptorImport, network string) ([]DescriptorImportResult, error) {
+	msg := struct {
+		Result []DescriptorImportResult `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "importdescriptors",
+		Params:  []interface{}{requests},
+	}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, walletId, network)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Error.Message != "" {
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return msg.Result, nil
+}
+
+// DeriveAddresses derives the addresses of a descriptor with checksum, rng is the
+// [begin, end] range of ranged descriptors and nil otherwise.
+func (s *service) DeriveAddresses(ctx context.Context, descriptor string, rng []int64, network string) ([]string, error) {
+	msg := struct {
+		Result []string `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	params := []interface{}{descriptor}
+	if rng != nil {
+		params = append(params, rng)
+	}
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "deriveaddresses",
+		Params:  params,
+	}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Error.Message != "" {
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return msg.Result, nil
+}
@@ -0,0 +1,93 @@
+package bitcoin_rpc_test
+
+import (
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// BIP32 test vector 1 on the test network.
+	tpub   = "tpubD6NzVbkrYhZ4XgiXtGrdW5XDAPFCL9h7we1vwNCpn8tGbBcgfVYjXyhWo4E1xkh56hjod1RhGjxbaTLV3X4FyWuejifB9jusQ46QzG87VKp"
+	tprv   = "tprv8ZgxMBicQKsPeDgjzdC36fs6bMjGApWDNLR9erAXMs5skhMv36j9MV5ecvfavji5khqjWaWSFhN3YcCUUdiKH6isR4Pwy3U5y5egddBr16m"
+	pubKey = "0330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c"
+)
+
+func TestParseDescriptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		descriptor string
+		expect     *bitcoin_rpc.Descriptor
+	}{
+		{
+			name:       "should parse ranged wpkh with origin",
+			descriptor: "wpkh([d34db33f/84'/1'/0']" + tpub + "/0/*)#qwertyuu",
+			expect: &bitcoin_rpc.Descriptor{
+				Type:     bitcoin_rpc.DescriptorWPKH,
+				Body:     "wpkh([d34db33f/84'/1'/0']" + tpub + "/0/*)",
+				Checksum: "qwertyuu",
+				Keys:     []string{"[d34db33f/84'/1'/0']" + tpub + "/0/*"},
+				Ranged:   true,
+			},
+		},
+		{
+			name:       "should parse taproot x-only key",
+			descriptor: "tr(" + pubKey[2:] + ")",
+			expect:     &bitcoin_rpc.Descriptor{Type: bitcoin_rpc.DescriptorTR, Body: "tr(" + pubKey[2:] + ")", Keys: []string{pubKey[2:]}},
+		},
+		{
+			name:       "should parse nested multisig",
+			descriptor: "sh(wsh(sortedmulti(2," + pubKey + "," + tpub + "/1/*)))",
+			expect: &bitcoin_rpc.Descriptor{
+				Type:     bitcoin_rpc.DescriptorSHWSH,
+				Body:     "sh(wsh(sortedmulti(2," + pubKey + "," + tpub + "/1/*)))",
+				Required: 2,
+				Keys:     []string{pubKey, tpub + "/1/*"},
+				Ranged:   true,
+			},
+		},
+		{
+			name:       "should parse address",
+			descriptor: "addr(tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx)",
+			expect:     &bitcoin_rpc.Descriptor{Type: bitcoin_rpc.DescriptorAddress, Body: "addr(tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx)"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			descriptor, err := bitcoin_rpc.ParseDescriptor(bitcoin_rpc.KnownChains["bitcoin"], tc.descriptor, "test")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, descriptor)
+			assert.Equal(t, tc.descriptor, descriptor.String())
+		})
+	}
+}
+
+func TestParseDescriptor_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		descriptor string
+		err        string
+	}{
+		{name: "should reject bad checksum", descriptor: "wpkh(" + pubKey + ")#abc", err: `invalid descriptor checksum "abc"`},
+		{name: "should reject unsupported type", descriptor: "pkh(" + pubKey + ")", err: "unsupported descriptor pkh(" + pubKey + ")"},
+		{name: "should reject private keys", descriptor: "wpkh(" + tprv + "/0/*)", err: "descriptors with private keys are not accepted"},
+		{name: "should reject keys of another network", descriptor: "wpkh(xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8)", err: "key xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8 does not belong to the test network"},
+		{name: "should reject hardened wildcard", descriptor: "wpkh(" + tpub + "/0/*')", err: "hardened wildcards need private keys"},
+		{name: "should reject hardened derivation", descriptor: "wpkh(" + tpub + "/0'/*)", err: "invalid derivation of " + tpub + ": hardened step 0' needs the private key"},
+		{name: "should reject uncompressed key", descriptor: "wpkh(" + pubKey[2:] + ")", err: "public key " + pubKey[2:] + " is not compressed"},
+		{name: "should reject threshold above keys", descriptor: "sh(wsh(multi(3," + pubKey + "," + tpub + ")))", err: "invalid multisig threshold 3 of 2 keys"},
+		{name: "should reject bare wsh", descriptor: "sh(wsh(pk(" + pubKey + ")))", err: "sh(wsh()) descriptors must wrap multi or sortedmulti"},
+		{name: "should reject script trees", descriptor: "tr(" + pubKey + ",pk(" + pubKey + "))", err: "taproot script trees are not supported"},
+		{name: "should reject address of another network", descriptor: "addr(bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4)", err: "invalid test network address bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := bitcoin_rpc.ParseDescriptor(bitcoin_rpc.KnownChains["bitcoin"], tc.descriptor, "test")
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
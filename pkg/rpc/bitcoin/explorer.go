@@ -0,0 +1,224 @@
+package bitcoin_rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"nn-blockchain-api/pkg/errors"
+)
+
+// Block is getblock at verbosity 1, transactions are listed by txid.
+type Block struct {
+	BlockHeader
+	StrippedSize int64    `json:"strippedsize"`
+	Size         int64    `json:"size"`
+	Weight       int64    `json:"weight"`
+	Tx           []string `json:"tx"`
+}
+
+type ScriptPubKey struct {
+	Asm     string `json:"asm"`
+	Hex     string `json:"hex"`
+	Address string `json:"address,omitempty"`
+	Type    string `json:"type"`
+}
+
+type TxInput struct {
+	Txid      string `json:"txid,omitempty"`
+	Vout      int64  `json:"vout"`
+	Coinbase  string `json:"coinbase,omitempty"`
+	ScriptSig struct {
+		Asm string `json:"asm"`
+		Hex string `json:"hex"`
+	} `json:"scriptSig"`
+	TxInWitness []string `json:"txinwitness,omitempty"`
+	Sequence    int64    `json:"sequence"`
+}
+
+type TxOutput struct {
+	Value        float64      `json:"value"`
+	N            int64        `json:"n"`
+	ScriptPubKey ScriptPubKey `json:"scriptPubKey"`
+}
+
+// Transaction is the verbose getrawtransaction result, the block fields are empty for
+// mempool transactions.
+type Transaction struct {
+	Txid          string     `json:"txid"`
+	Hash          string     `json:"hash"`
+	Version       int64      `json:"version"`
+	Size          int64      `json:"size"`
+	Vsize         int64      `json:"vsize"`
+	Weight        int64      `json:"weight"`
+	Locktime      int64      `json:"locktime"`
+	Vin           []TxInput  `json:"vin"`
+	Vout          []TxOutput `json:"vout"`
+	Hex           string     `json:"hex"`
+	BlockHash     string     `json:"blockhash,omitempty"`
+	Confirmations int64      `json:"confirmations,omitempty"`
+	Time          int64      `json:"time,omitempty"`
+	BlockTime     int64      `json:"blocktime,omitempty"`
+}
+
+type MempoolInfo struct {
+	Loaded        bool    `json:"loaded"`
+	Size          int64   `json:"size"`
+	Bytes         int64   `json:"bytes"`
+	Usage         int64   `json:"usage"`
+	MaxMempool    int64   `json:"maxmempool"`
+	MempoolMinFee float64 `json:"mempoolminfee"`
+	MinRelayTxFee float64 `json:"minrelaytxfee"`
+}
+
+// MempoolAcceptResult is a testmempoolaccept verdict, Fees only set for accepted transactions.
+type MempoolAcceptResult struct {
+	TxId         string `json:"txid"`
+	WTxId        string `json:"wtxid"`
+	Allowed      bool   `json:"allowed"`
+	Vsize        int64  `json:"vsize"`
+	RejectReason string `json:"reject-reason"`
+	Fees         TxFees `json:"fees"`
+}
+
+type TxFees struct {
+	Base float64 `json:"base"`
+}
+
+type MempoolEntry struct {
+	Vsize           int64 `json:"vsize"`
+	Weight          int64 `json:"weight"`
+	Time            int64 `json:"time"`
+	Height          int64 `json:"height"`
+	DescendantCount int64 `json:"descendantcount"`
+	DescendantSize  int64 `json:"descendantsize"`
+	AncestorCount   int64 `json:"ancestorcount"`
+	AncestorSize    int64 `json:"ancestorsize"`
+	Fees            struct {
+		Base       float64 `json:"base"`
+		Modified   float64 `json:"modified"`
+		Ancestor   float64 `json:"ancestor"`
+		Descendant float64 `json:"descendant"`
+	} `json:"fees"`
+	Depends          []string `json:"depends"`
+	SpentBy          []string `json:"spentby"`
+	Bip125Replacable bool     `json:"bip125-replaceable"`
+}
+
+type TxOut struct {
+	BestBlock     string       `json:"bestblock"`
+	Confirmations int64        `json:"confirmations"`
+	Value         float64      `json:"value"`
+	ScriptPubKey  ScriptPubKey `json:"scriptPubKey"`
+	Coinbase      bool         `json:"coinbase"`
+}
+
+func (s *service) GetBlockHash(ctx context.Context, height int64, network string) (string, error) {
+	var hash string
+	if err := s.call(ctx, "getblockhash", []interface{}{height}, network, &hash); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+func (s *service) GetBlock(ctx context.Context, hash, network string) (*Block, error) {
+	var block Block
+	if err := s.call(ctx, "getblock", []interface{}{hash, 1}, network, &block); err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// GetRawTransaction needs a node with -txindex for confirmed transactions outside its wallets.
+func (s *service) GetRawTransaction(ctx context.Context, txid, network string) (string, error) {
+	var tx string
+	if err := s.call(ctx, "getrawtransaction", []interface{}{txid, false}, network, &tx); err != nil {
+		return "", err
+	}
+	return tx, nil
+}
+
+func (s *service) GetTransaction(ctx context.Context, txid, network string) (*Transaction, error) {
+	var tx Transaction
+	if err := s.call(ctx, "getrawtransaction", []interface{}{txid, true}, network, &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func (s *service) MempoolInfo(ctx context.Context, network string) (*MempoolInfo, error) {
+	var info MempoolInfo
+	if err := s.call(ctx, "getmempoolinfo", []interface{}{}, network, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// TestMempoolAccept checks a signed transaction against consensus and mempool policy
+// without broadcasting it.
+func (s *service) TestMempoolAccept(ctx context.Context, signedTx, network string) (*MempoolAcceptResult, error) {
+	var results []MempoolAcceptResult
+	if err := s.call(ctx, "testmempoolaccept", []interface{}{[]string{signedTx}}, network, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("testmempoolaccept returned no result")
+	}
+	return &results[0], nil
+}
+
+func (s *service) MempoolEntry(ctx context.Context, txid, network string) (*MempoolEntry, error) {
+	var entry MempoolEntry
+	if err := s.call(ctx, "getmempoolentry", []interface{}{txid}, network, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetTxOut returns nil when the output is spent or does not exist.
+func (s *service) GetTxOut(ctx context.Context, txid string, vout int64, includeMempool bool, network string) (*TxOut, error) {
+	var out *TxOut
+	if err := s.call(ctx, "gettxout", []interface{}{txid, vout, includeMempool}, network, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// call runs a node method outside of any wallet and decodes its result into result.
+func (s *service) call(ctx context.Context, method string, params []interface{}, network string, result interface{}) error {
+	msg := struct {
+		Result interface{} `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{Result: result}
+
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  method,
+		Params:  params,
+	}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return err
+	}
+
+	if msg.Error.Message != "" {
+		return errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package bitcoin_rpc_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"nn-blockchain-api/pkg/errors"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	mock_bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const txid = "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"
+
+func TestService_GetTxOut(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     *bitcoin_rpc.TxOut
+		wantErr  error
+	}{
+		{
+			name:     "unspent",
+			response: `{"result":{"bestblock":"00ff","confirmations":3,"value":0.5,"scriptPubKey":{"hex":"0014ab","type":"witness_v0_keyhash"},"coinbase":false},"error":null}`,
+			want:     &bitcoin_rpc.TxOut{BestBlock: "00ff", Confirmations: 3, Value: 0.5, ScriptPubKey: bitcoin_rpc.ScriptPubKey{Hex: "0014ab", Type: "witness_v0_keyhash"}},
+		},
+		{name: "spent", response: `{"result":null,"error":null}`},
+		{name: "node error", response: `{"result":null,"error":{"code":-8,"message":"txid must be of length 64"}}`, wantErr: errors.FromBitcoinRPC(-8, "txid must be of length 64")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			client := mock_bitcoin_rpc.NewMockClient(controller)
+			client.EXPECT().EncodeBaseRequest(bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "gettxout", Params: []interface{}{txid, int64(1), true}}).
+				Return(&bytes.Buffer{}, nil)
+			client.EXPECT().Send(gomock.Any(), gomock.Any(), "", "test").
+				Return(&http.Response{Body: ioutil.NopCloser(bytes.NewBufferString(tt.response))}, nil)
+
+			svc, err := bitcoin_rpc.NewService(client, bitcoin_rpc.KnownChains["bitcoin"])
+			assert.Nil(t, err)
+
+			out, err := svc.GetTxOut(context.Background(), txid, 1, true, "test")
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, out)
+		})
+	}
+}
+
+func TestService_TestMempoolAccept(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     *bitcoin_rpc.MempoolAcceptResult
+		wantErr  string
+	}{
+		{
+			name:     "allowed",
+			response: `{"result":[{"txid":"` + txid + `","wtxid":"` + txid + `","allowed":true,"vsize":141,"fees":{"base":0.00001410}}],"error":null}`,
+			want:     &bitcoin_rpc.MempoolAcceptResult{TxId: txid, WTxId: txid, Allowed: true, Vsize: 141, Fees: bitcoin_rpc.TxFees{Base: 0.0000141}},
+		},
+		{
+			name:     "rejected",
+			response: `{"result":[{"txid":"` + txid + `","wtxid":"` + txid + `","allowed":false,"reject-reason":"min relay fee not met"}],"error":null}`,
+			want:     &bitcoin_rpc.MempoolAcceptResult{TxId: txid, WTxId: txid, RejectReason: "min relay fee not met"},
+		},
+		{name: "no result", response: `{"result":[],"error":null}`, wantErr: "testmempoolaccept returned no result"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			client := mock_bitcoin_rpc.NewMockClient(controller)
+			client.EXPECT().EncodeBaseRequest(bitcoin_rpc.BaseRequest{JsonRpc: "2.0", Method: "testmempoolaccept", Params: []interface{}{[]string{"0200"}}}).
+				Return(&bytes.Buffer{}, nil)
+			client.EXPECT().Send(gomock.Any(), gomock.Any(), "", "test").
+				Return(&http.Response{Body: ioutil.NopCloser(bytes.NewBufferString(tt.response))}, nil)
+
+			svc, err := bitcoin_rpc.NewService(client, bitcoin_rpc.KnownChains["bitcoin"])
+			assert.Nil(t, err)
+
+			result, err := svc.TestMempoolAccept(context.Background(), "0200", "test")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
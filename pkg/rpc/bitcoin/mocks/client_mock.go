@@ -38,6 +38,18 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockClient) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClient)(nil).Close))
+}
+
 // EncodeBaseRequest mocks base method.
 func (m *MockClient) EncodeBaseRequest(request bitcoin_rpc.BaseRequest) (*bytes.Buffer, error) {
 	m.ctrl.T.Helper()
@@ -67,3 +79,17 @@ func (mr *MockClientMockRecorder) Send(ctx, body, walletId, network interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockClient)(nil).Send), ctx, body, walletId, network)
 }
+
+// SetCredentials mocks base method.
+func (m *MockClient) SetCredentials(network, user, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCredentials", network, user, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCredentials indicates an expected call of SetCredentials.
+func (mr *MockClientMockRecorder) SetCredentials(network, user, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredentials", reflect.TypeOf((*MockClient)(nil).SetCredentials), network, user, password)
+}
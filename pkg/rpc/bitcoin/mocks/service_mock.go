@@ -35,6 +35,67 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// BlockHeader mocks base method.
+func (m *MockService) BlockHeader(ctx context.Context, hash, network string) (*bitcoin_rpc.BlockHeader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockHeader", ctx, hash, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.BlockHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockHeader indicates an expected call of BlockHeader.
+func (mr *MockServiceMockRecorder) BlockHeader(ctx, hash, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockHeader", reflect.TypeOf((*MockService)(nil).BlockHeader), ctx, hash, network)
+}
+
+// CombineTransactions mocks base method.
+func (m *MockService) CombineTransactions(ctx context.Context, txs []string, network string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CombineTransactions", ctx, txs, network)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CombineTransactions indicates an expected call of CombineTransactions.
+func (mr *MockServiceMockRecorder) CombineTransactions(ctx, txs, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CombineTransactions", reflect.TypeOf((*MockService)(nil).CombineTransactions), ctx, txs, network)
+}
+
+// CreateMultisig mocks base method.
+func (m *MockService) CreateMultisig(ctx context.Context, required int, pubKeys []string, scriptType, network string) (*bitcoin_rpc.Multisig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultisig", ctx, required, pubKeys, scriptType, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.Multisig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMultisig indicates an expected call of CreateMultisig.
+func (mr *MockServiceMockRecorder) CreateMultisig(ctx, required, pubKeys, scriptType, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultisig", reflect.TypeOf((*MockService)(nil).CreateMultisig), ctx, required, pubKeys, scriptType, network)
+}
+
+// CreateMultisigTransaction mocks base method.
+func (m *MockService) CreateMultisigTransaction(ctx context.Context, utxos bitcoin_rpc.UTXO, script bitcoin_rpc.MultisigScript, toAddress, changeAddress string, amount int64, network string) (*string, *float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultisigTransaction", ctx, utxos, script, toAddress, changeAddress, amount, network)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(*float64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateMultisigTransaction indicates an expected call of CreateMultisigTransaction.
+func (mr *MockServiceMockRecorder) CreateMultisigTransaction(ctx, utxos, script, toAddress, changeAddress, amount, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultisigTransaction", reflect.TypeOf((*MockService)(nil).CreateMultisigTransaction), ctx, utxos, script, toAddress, changeAddress, amount, network)
+}
+
 // CreateTransaction mocks base method.
 func (m *MockService) CreateTransaction(ctx context.Context, utxos bitcoin_rpc.UTXO, fromAddress, toAddress string, amount int64, network string) (*string, *float64, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +142,21 @@ func (mr *MockServiceMockRecorder) DecodeTransaction(ctx, tx, network interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeTransaction", reflect.TypeOf((*MockService)(nil).DecodeTransaction), ctx, tx, network)
 }
 
+// DeriveAddresses mocks base method.
+func (m *MockService) DeriveAddresses(ctx context.Context, descriptor string, rng []int64, network string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeriveAddresses", ctx, descriptor, rng, network)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveAddresses indicates an expected call of DeriveAddresses.
+func (mr *MockServiceMockRecorder) DeriveAddresses(ctx, descriptor, rng, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveAddresses", reflect.TypeOf((*MockService)(nil).DeriveAddresses), ctx, descriptor, rng, network)
+}
+
 // FundForTransaction mocks base method.
 func (m *MockService) FundForTransaction(ctx context.Context, createdTx, changeAddress, network string) (string, *float64, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +173,36 @@ func (mr *MockServiceMockRecorder) FundForTransaction(ctx, createdTx, changeAddr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundForTransaction", reflect.TypeOf((*MockService)(nil).FundForTransaction), ctx, createdTx, changeAddress, network)
 }
 
+// GetBlock mocks base method.
+func (m *MockService) GetBlock(ctx context.Context, hash, network string) (*bitcoin_rpc.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlock", ctx, hash, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlock indicates an expected call of GetBlock.
+func (mr *MockServiceMockRecorder) GetBlock(ctx, hash, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockService)(nil).GetBlock), ctx, hash, network)
+}
+
+// GetBlockHash mocks base method.
+func (m *MockService) GetBlockHash(ctx context.Context, height int64, network string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockHash", ctx, height, network)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHash indicates an expected call of GetBlockHash.
+func (mr *MockServiceMockRecorder) GetBlockHash(ctx, height, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHash", reflect.TypeOf((*MockService)(nil).GetBlockHash), ctx, height, network)
+}
+
 // GetCurrentFee mocks base method.
 func (m *MockService) GetCurrentFee(ctx context.Context, network string) (*float64, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +218,66 @@ func (mr *MockServiceMockRecorder) GetCurrentFee(ctx, network interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentFee", reflect.TypeOf((*MockService)(nil).GetCurrentFee), ctx, network)
 }
 
+// GetDescriptorInfo mocks base method.
+func (m *MockService) GetDescriptorInfo(ctx context.Context, descriptor, network string) (*bitcoin_rpc.DescriptorInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDescriptorInfo", ctx, descriptor, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.DescriptorInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDescriptorInfo indicates an expected call of GetDescriptorInfo.
+func (mr *MockServiceMockRecorder) GetDescriptorInfo(ctx, descriptor, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDescriptorInfo", reflect.TypeOf((*MockService)(nil).GetDescriptorInfo), ctx, descriptor, network)
+}
+
+// GetRawTransaction mocks base method.
+func (m *MockService) GetRawTransaction(ctx context.Context, txid, network string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRawTransaction", ctx, txid, network)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRawTransaction indicates an expected call of GetRawTransaction.
+func (mr *MockServiceMockRecorder) GetRawTransaction(ctx, txid, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRawTransaction", reflect.TypeOf((*MockService)(nil).GetRawTransaction), ctx, txid, network)
+}
+
+// GetTransaction mocks base method.
+func (m *MockService) GetTransaction(ctx context.Context, txid, network string) (*bitcoin_rpc.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, txid, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MockServiceMockRecorder) GetTransaction(ctx, txid, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockService)(nil).GetTransaction), ctx, txid, network)
+}
+
+// GetTxOut mocks base method.
+func (m *MockService) GetTxOut(ctx context.Context, txid string, vout int64, includeMempool bool, network string) (*bitcoin_rpc.TxOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxOut", ctx, txid, vout, includeMempool, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.TxOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxOut indicates an expected call of GetTxOut.
+func (mr *MockServiceMockRecorder) GetTxOut(ctx, txid, vout, includeMempool, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxOut", reflect.TypeOf((*MockService)(nil).GetTxOut), ctx, txid, vout, includeMempool, network)
+}
+
 // ImportAddress mocks base method.
 func (m *MockService) ImportAddress(ctx context.Context, address, walletId, network string) error {
 	m.ctrl.T.Helper()
@@ -126,6 +292,21 @@ func (mr *MockServiceMockRecorder) ImportAddress(ctx, address, walletId, network
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAddress", reflect.TypeOf((*MockService)(nil).ImportAddress), ctx, address, walletId, network)
 }
 
+// ImportDescriptors mocks base method.
+func (m *MockService) ImportDescriptors(ctx context.Context, walletId string, requests []bitcoin_rpc.DescriptorImport, network string) ([]bitcoin_rpc.DescriptorImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportDescriptors", ctx, walletId, requests, network)
+	ret0, _ := ret[0].([]bitcoin_rpc.DescriptorImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportDescriptors indicates an expected call of ImportDescriptors.
+func (mr *MockServiceMockRecorder) ImportDescriptors(ctx, walletId, requests, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportDescriptors", reflect.TypeOf((*MockService)(nil).ImportDescriptors), ctx, walletId, requests, network)
+}
+
 // ListUnspent mocks base method.
 func (m *MockService) ListUnspent(ctx context.Context, address, walletId, network string) ([]*bitcoin_rpc.Unspent, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +336,51 @@ func (mr *MockServiceMockRecorder) LoadWallet(ctx, walletId, network interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadWallet", reflect.TypeOf((*MockService)(nil).LoadWallet), ctx, walletId, network)
 }
 
+// MempoolEntry mocks base method.
+func (m *MockService) MempoolEntry(ctx context.Context, txid, network string) (*bitcoin_rpc.MempoolEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MempoolEntry", ctx, txid, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.MempoolEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MempoolEntry indicates an expected call of MempoolEntry.
+func (mr *MockServiceMockRecorder) MempoolEntry(ctx, txid, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MempoolEntry", reflect.TypeOf((*MockService)(nil).MempoolEntry), ctx, txid, network)
+}
+
+// MempoolInfo mocks base method.
+func (m *MockService) MempoolInfo(ctx context.Context, network string) (*bitcoin_rpc.MempoolInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MempoolInfo", ctx, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.MempoolInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MempoolInfo indicates an expected call of MempoolInfo.
+func (mr *MockServiceMockRecorder) MempoolInfo(ctx, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MempoolInfo", reflect.TypeOf((*MockService)(nil).MempoolInfo), ctx, network)
+}
+
+// NetworkInfo mocks base method.
+func (m *MockService) NetworkInfo(ctx context.Context, network string) (*bitcoin_rpc.NetworkInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkInfo", ctx, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.NetworkInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkInfo indicates an expected call of NetworkInfo.
+func (mr *MockServiceMockRecorder) NetworkInfo(ctx, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkInfo", reflect.TypeOf((*MockService)(nil).NetworkInfo), ctx, network)
+}
+
 // RescanWallet mocks base method.
 func (m *MockService) RescanWallet(ctx context.Context, walletId, network string) error {
 	m.ctrl.T.Helper()
@@ -184,6 +410,22 @@ func (mr *MockServiceMockRecorder) SendTransaction(ctx, signedTx, network interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransaction", reflect.TypeOf((*MockService)(nil).SendTransaction), ctx, signedTx, network)
 }
 
+// SignMultisigTransaction mocks base method.
+func (m *MockService) SignMultisigTransaction(ctx context.Context, tx string, privateKeys []string, prevTxs []bitcoin_rpc.PrevTx, network string) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignMultisigTransaction", ctx, tx, privateKeys, prevTxs, network)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SignMultisigTransaction indicates an expected call of SignMultisigTransaction.
+func (mr *MockServiceMockRecorder) SignMultisigTransaction(ctx, tx, privateKeys, prevTxs, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMultisigTransaction", reflect.TypeOf((*MockService)(nil).SignMultisigTransaction), ctx, tx, privateKeys, prevTxs, network)
+}
+
 // SignTransaction mocks base method.
 func (m *MockService) SignTransaction(ctx context.Context, tx, privateKey string, utxos bitcoin_rpc.UTXO, network string) (string, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +456,21 @@ func (mr *MockServiceMockRecorder) Status(ctx, network interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockService)(nil).Status), ctx, network)
 }
 
+// TestMempoolAccept mocks base method.
+func (m *MockService) TestMempoolAccept(ctx context.Context, signedTx, network string) (*bitcoin_rpc.MempoolAcceptResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestMempoolAccept", ctx, signedTx, network)
+	ret0, _ := ret[0].(*bitcoin_rpc.MempoolAcceptResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestMempoolAccept indicates an expected call of TestMempoolAccept.
+func (mr *MockServiceMockRecorder) TestMempoolAccept(ctx, signedTx, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestMempoolAccept", reflect.TypeOf((*MockService)(nil).TestMempoolAccept), ctx, signedTx, network)
+}
+
 // WalletInfo mocks base method.
 func (m *MockService) WalletInfo(ctx context.Context, walletId, network string) (*bitcoin_rpc.Info, error) {
 	m.ctrl.T.Helper()
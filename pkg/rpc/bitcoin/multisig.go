@@ -0,0 +1,383 @@
+package bitcoin_rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"nn-blockchain-api/pkg/errors"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	ScriptTypeP2WSH     = "p2wsh"
+	ScriptTypeP2SHP2WSH = "p2sh-p2wsh"
+
+	// dustLimit is the smallest change output worth creating, smaller change goes to the fee.
+	dustLimit = 546
+)
+
+// addressTypes maps script types to the address_type argument of createmultisig.
+var addressTypes = map[string]string{
+	ScriptTypeP2WSH:     "bech32",
+	ScriptTypeP2SHP2WSH: "p2sh-segwit",
+}
+
+// Multisig is the result of createmultisig, RedeemScript holds the multisig script itself,
+// which segwit outputs use as their witness script.
+type Multisig struct {
+	Address      string `json:"address"`
+	RedeemScript string `json:"redeemScript"`
+	Descriptor   string `json:"descriptor"`
+}
+
+// PrevTx describes a spent output to signrawtransactionwithkey, Amount is in BTC.
+type PrevTx struct {
+	TxId          string  `json:"txid"`
+	Vout          int64   `json:"vout"`
+	ScriptPubKey  string  `json:"scriptPubKey"`
+	RedeemScript  string  `json:"redeemScript,omitempty"`
+	WitnessScript string  `json:"witnessScript,omitempty"`
+	Amount        float64 `json:"amount"`
+}
+
+// MultisigScript is an m-of-n witness script and how its outputs wrap it.
+type MultisigScript struct {
+	WitnessScript string
+	ScriptType    string
+}
+
+// Stats returns the required signatures and the number of keys of the script.
+func (m MultisigScript) Stats() (int, int, error) {
+	script, err := hex.DecodeString(m.WitnessScript)
+	if err != nil {
+		return 0, 0, err
+	}
+	if txscript.GetScriptClass(script) != txscript.MultiSigTy {
+		return 0, 0, fmt.Errorf("witness script is not a multisig script")
+	}
+	keys, required, err := txscript.CalcMultiSigStats(script)
+	if err != nil {
+		return 0, 0, err
+	}
+	return required, keys, nil
+}
+
+// RedeemScript is the P2SH redeem script of p2sh-p2wsh outputs, empty for native p2wsh.
+func (m MultisigScript) RedeemScript() (string, error) {
+	witnessProgram, err := m.witnessProgram()
+	if err != nil {
+		return "", err
+	}
+	if m.ScriptType == ScriptTypeP2WSH {
+		return "", nil
+	}
+	return hex.EncodeToString(witnessProgram), nil
+}
+
+func (m MultisigScript) ScriptPubKey() (string, error) {
+	witnessProgram, err := m.witnessProgram()
+	if err != nil {
+		return "", err
+	}
+	if m.ScriptType == ScriptTypeP2WSH {
+		return hex.EncodeToString(witnessProgram), nil
+	}
+
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).AddData(btcutil.Hash160(witnessProgram)).AddOp(txscript.OP_EQUAL).
+		Script()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(script), nil
+}
+
+func (m MultisigScript) Address(chain Chain, network string) (string, error) {
+	witnessProgram, err := m.witnessProgram()
+	if err != nil {
+		return "", err
+	}
+
+	params, err := chain.Params(network)
+	if err != nil {
+		return "", err
+	}
+	var address btcutil.Address
+	if m.ScriptType == ScriptTypeP2WSH {
+		address, err = btcutil.NewAddressWitnessScriptHash(witnessProgram[2:], params)
+	} else {
+		address, err = btcutil.NewAddressScriptHash(witnessProgram, params)
+	}
+	if err != nil {
+		return "", err
+	}
+	return address.EncodeAddress(), nil
+}
+
+// PrevTxs describes utxos paying to the script so signers can sign without the node knowing them.
+func (m MultisigScript) PrevTxs(utxos UTXO) ([]PrevTx, error) {
+	scriptPubKey, err := m.ScriptPubKey()
+	if err != nil {
+		return nil, err
+	}
+	redeemScript, err := m.RedeemScript()
+	if err != nil {
+		return nil, err
+	}
+
+	prevTxs := make([]PrevTx, 0, len(utxos))
+	for _, utxo := range utxos {
+		prevTxs = append(prevTxs, PrevTx{
+			TxId:          utxo.TxId,
+			Vout:          utxo.Vout,
+			ScriptPubKey:  scriptPubKey,
+			RedeemScript:  redeemScript,
+			WitnessScript: m.WitnessScript,
+			Amount:        btcutil.Amount(utxo.Amount).ToBTC(),
+		})
+	}
+	return prevTxs, nil
+}
+
+// vsize estimates the virtual size of an input spending the script with all signatures.
+func (m MultisigScript) vsize() (int64, error) {
+	required, _, err := m.Stats()
+	if err != nil {
+		return 0, err
+	}
+
+	// outpoint, sequence and the script length byte, plus the redeem script push when nested
+	base := int64(41)
+	if m.ScriptType == ScriptTypeP2SHP2WSH {
+		base += 35
+	}
+	// item count, the empty CHECKMULTISIG dummy, DER signatures and the witness script
+	witness := int64(2 + required*73 + 3 + len(m.WitnessScript)/2)
+	return base + (witness+3)/4, nil
+}
+
+func (m MultisigScript) witnessProgram() ([]byte, error) {
+	if _, ok := addressTypes[m.ScriptType]; !ok {
+		return nil, fmt.Errorf("unsupported script type %s", m.ScriptType)
+	}
+	if _, _, err := m.Stats(); err != nil {
+		return nil, err
+	}
+
+	script, _ := hex.DecodeString(m.WitnessScript)
+	hash := sha256.Sum256(script)
+	return txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(hash[:]).Script()
+}
+
+func (s *service) CreateMultisig(ctx context.Context, required int, pubKeys []string, scriptType, network string) (*Multisig, error) {
+	addressType, ok := addressTypes[scriptType]
+	if !ok {
+		return nil, errors.NewInvalid(errors.StatusInvalidParameter, "unsupported script type "+scriptType)
+	}
+	if err := s.requireSegWit(); err != nil {
+		return nil, err
+	}
+
+	msg := struct {
+		Result Multisig `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "createmultisig",
+		Params:  []interface{}{required, pubKeys, addressType},
+	}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Error.Message != "" {
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return &msg.Result, nil
+}
+
+// CreateMultisigTransaction spends every given utxo of the script to toAddress and returns
+// the rest to changeAddress, the fee is estimated for a fully signed transaction.
+func (s *service) CreateMultisigTransaction(ctx context.Context, utxos UTXO, script MultisigScript, toAddress, changeAddress string, amount int64, network string) (*string, *float64, error) {
+	if err := s.requireSegWit(); err != nil {
+		return nil, nil, err
+	}
+
+	feeRate, err := s.getCurrentFeeRate(ctx, network)
+	if err != nil {
+		return nil, nil, err
+	}
+	inputSize, err := script.vsize()
+	if err != nil {
+		return nil, nil, errors.NewInvalid(errors.StatusInvalidParameter, err.Error())
+	}
+
+	tx := wire.NewMsgTx(2)
+	total := int64(0)
+	for _, utxo := range utxos {
+		hash, err := chainhash.NewHashFromStr(utxo.TxId)
+		if err != nil {
+			return nil, nil, err
+		}
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, uint32(utxo.Vout)), nil, nil))
+		total += utxo.Amount
+	}
+
+	destScript, err := s.chain.PayToAddress(toAddress, network)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx.AddTxOut(wire.NewTxOut(amount, destScript))
+
+	changeScript, err := s.chain.PayToAddress(changeAddress, network)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// version, locktime, counts and the segwit marker, then outputs of up to 43 bytes
+	size := 11 + int64(len(tx.TxIn))*inputSize + 2*43
+	fee := new(big.Int).Mul(feeRate, big.NewInt(size)).Int64()
+
+	change := total - amount - fee
+	if change < 0 {
+		return nil, nil, errors.NewRejected(errors.StatusInsufficientFunds, "your balance too low for this transaction")
+	}
+	if change >= dustLimit {
+		tx.AddTxOut(wire.NewTxOut(change, changeScript))
+	} else {
+		fee += change
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
+	if err := tx.Serialize(buf); err != nil {
+		return nil, nil, err
+	}
+
+	createdTx := hex.EncodeToString(buf.Bytes())
+	btcFee := s.chain.ToCoins(fee)
+	return &createdTx, &btcFee, nil
+}
+
+// SignMultisigTransaction adds the signatures of the keys to those already in the transaction.
+// Unlike SignTransaction an incomplete result is not an error, other co-signers finish it.
+func (s *service) SignMultisigTransaction(ctx context.Context, tx string, privateKeys []string, prevTxs []PrevTx, network string) (string, bool, error) {
+	if privateKeys == nil {
+		privateKeys = []string{}
+	}
+
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "signrawtransactionwithkey",
+		Params:  []interface{}{tx, privateKeys, prevTxs},
+	}
+
+	msg := struct {
+		Result struct {
+			Hex      string `json:"hex"`
+			Complete bool   `json:"complete"`
+		} `json:"result"`
+		Error struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return "", false, err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return "", false, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return "", false, err
+	}
+
+	if msg.Error.Message != "" {
+		return "", false, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return msg.Result.Hex, msg.Result.Complete, nil
+}
+
+// CombineTransactions merges the signatures of partially signed copies of one transaction.
+func (s *service) CombineTransactions(ctx context.Context, txs []string, network string) (string, error) {
+	msg := struct {
+		Result string `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "combinerawtransaction",
+		Params:  []interface{}{txs},
+	}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return "", err
+	}
+
+	if msg.Error.Message != "" {
+		return "", errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return msg.Result, nil
+}
+
+// requireSegWit refuses multisig on chains without witness scripts.
+func (s *service) requireSegWit() error {
+	if !s.chain.SegWit {
+		return errors.NewInvalid(errors.StatusInvalidParameter, "multisig needs segwit, which "+s.chain.Name+" does not have")
+	}
+	return nil
+}
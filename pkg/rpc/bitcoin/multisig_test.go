@@ -0,0 +1,82 @@
+package bitcoin_rpc_test
+
+import (
+	"encoding/hex"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func witnessScript(t *testing.T) string {
+	builder := txscript.NewScriptBuilder().AddInt64(2)
+	for _, key := range []string{
+		"0330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c",
+		"03e775fd51f0dfb8cd865d9ff1cca2a158cf651fe997fdc9fee9c1d3b5e995ea77",
+		"025d3c4e4eb1d9c3a0e1a6d4d8a1c7b0bd2dd9c96c3e5d1f0b13c7b0f1e4f1b2c3",
+	} {
+		pub, err := hex.DecodeString(key)
+		require.NoError(t, err)
+		builder.AddData(pub)
+	}
+	script, err := builder.AddInt64(3).AddOp(txscript.OP_CHECKMULTISIG).Script()
+	require.NoError(t, err)
+	return hex.EncodeToString(script)
+}
+
+func TestMultisigScript(t *testing.T) {
+	ws := witnessScript(t)
+
+	tests := []struct {
+		name       string
+		scriptType string
+		prefix     string
+		redeem     bool
+	}{
+		{name: "should wrap in p2wsh", scriptType: bitcoin_rpc.ScriptTypeP2WSH, prefix: "tb1q"},
+		{name: "should wrap in p2sh-p2wsh", scriptType: bitcoin_rpc.ScriptTypeP2SHP2WSH, prefix: "2", redeem: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			script := bitcoin_rpc.MultisigScript{WitnessScript: ws, ScriptType: tc.scriptType}
+
+			required, keys, err := script.Stats()
+			require.NoError(t, err)
+			assert.Equal(t, 2, required)
+			assert.Equal(t, 3, keys)
+
+			address, err := script.Address(bitcoin_rpc.KnownChains["bitcoin"], "test")
+			require.NoError(t, err)
+			assert.Equal(t, tc.prefix, address[:len(tc.prefix)])
+
+			decoded, err := btcutil.DecodeAddress(address, &chaincfg.TestNet3Params)
+			require.NoError(t, err)
+			expected, err := txscript.PayToAddrScript(decoded)
+			require.NoError(t, err)
+			scriptPubKey, err := script.ScriptPubKey()
+			require.NoError(t, err)
+			assert.Equal(t, hex.EncodeToString(expected), scriptPubKey)
+
+			redeemScript, err := script.RedeemScript()
+			require.NoError(t, err)
+			assert.Equal(t, tc.redeem, redeemScript != "")
+
+			prevTxs, err := script.PrevTxs(bitcoin_rpc.UTXO{{TxId: "txid", Vout: 1, Amount: 150000}})
+			require.NoError(t, err)
+			assert.Equal(t, []bitcoin_rpc.PrevTx{{TxId: "txid", Vout: 1, ScriptPubKey: scriptPubKey, RedeemScript: redeemScript, WitnessScript: ws, Amount: 0.0015}}, prevTxs)
+		})
+	}
+}
+
+func TestMultisigScript_Invalid(t *testing.T) {
+	_, _, err := bitcoin_rpc.MultisigScript{WitnessScript: "76a914", ScriptType: bitcoin_rpc.ScriptTypeP2WSH}.Stats()
+	assert.EqualError(t, err, "witness script is not a multisig script")
+
+	_, err = bitcoin_rpc.MultisigScript{WitnessScript: witnessScript(t), ScriptType: "p2tr"}.Address(bitcoin_rpc.KnownChains["bitcoin"], "test")
+	assert.EqualError(t, err, "unsupported script type p2tr")
+}
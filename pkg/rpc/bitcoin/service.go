@@ -5,21 +5,21 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
-	"errors"
+	gErrors "errors"
 	"math/big"
+	"nn-blockchain-api/pkg/errors"
 	"time"
 
-	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
-	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
-	"github.com/btcsuite/btcutil"
 	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=service.go -destination=mocks/service_mock.go
 type Service interface {
 	Status(ctx context.Context, network string) (*StatusNode, error)
+	NetworkInfo(ctx context.Context, network string) (*NetworkInfo, error)
+	BlockHeader(ctx context.Context, hash string, network string) (*BlockHeader, error)
 
 	GetCurrentFee(ctx context.Context, network string) (*float64, error)
 
@@ -29,6 +29,12 @@ type Service interface {
 	FundForTransaction(ctx context.Context, createdTx, changeAddress, network string) (string, *float64, error)
 	SignTransaction(ctx context.Context, tx, privateKey string, utxos UTXO, network string) (string, error)
 	SendTransaction(ctx context.Context, signedTx, network string) (string, error)
+	TestMempoolAccept(ctx context.Context, signedTx, network string) (*MempoolAcceptResult, error)
+
+	CreateMultisig(ctx context.Context, required int, pubKeys []string, scriptType, network string) (*Multisig, error)
+	CreateMultisigTransaction(ctx context.Context, utxos UTXO, script MultisigScript, toAddress, changeAddress string, amount int64, network string) (*string, *float64, error)
+	SignMultisigTransaction(ctx context.Context, tx string, privateKeys []string, prevTxs []PrevTx, network string) (string, bool, error)
+	CombineTransactions(ctx context.Context, txs []string, network string) (string, error)
 
 	WalletInfo(ctx context.Context, walletId, network string) (*Info, error)
 	CreateWallet(ctx context.Context, network string) (string, error)
@@ -36,17 +42,33 @@ type Service interface {
 	ImportAddress(ctx context.Context, address, walletId, network string) error
 	RescanWallet(ctx context.Context, walletId, network string) error
 	ListUnspent(ctx context.Context, address, walletId, network string) ([]*Unspent, error)
+
+	GetDescriptorInfo(ctx context.Context, descriptor, network string) (*DescriptorInfo, error)
+	ImportDescriptors(ctx context.Context, walletId string, requests []DescriptorImport, network string) ([]DescriptorImportResult, error)
+	DeriveAddresses(ctx context.Context, descriptor string, rng []int64, network string) ([]string, error)
+
+	GetBlockHash(ctx context.Context, height int64, network string) (string, error)
+	GetBlock(ctx context.Context, hash, network string) (*Block, error)
+	GetRawTransaction(ctx context.Context, txid, network string) (string, error)
+	GetTransaction(ctx context.Context, txid, network string) (*Transaction, error)
+	MempoolInfo(ctx context.Context, network string) (*MempoolInfo, error)
+	MempoolEntry(ctx context.Context, txid, network string) (*MempoolEntry, error)
+	GetTxOut(ctx context.Context, txid string, vout int64, includeMempool bool, network string) (*TxOut, error)
 }
 
 type service struct {
 	btcClient Client
+	chain     Chain
 }
 
-func NewService(btcClient Client) (Service, error) {
+func NewService(btcClient Client, chain Chain) (Service, error) {
 	if btcClient == nil {
-		return nil, errors.New("invalid bitcoin rpc client")
+		return nil, gErrors.New("invalid bitcoin rpc client")
 	}
-	return &service{btcClient: btcClient}, nil
+	if chain.Name == "" || len(chain.Networks) == 0 {
+		return nil, gErrors.New("invalid chain")
+	}
+	return &service{btcClient: btcClient, chain: chain}, nil
 }
 
 func (s *service) Status(ctx context.Context, network string) (*StatusNode, error) {
@@ -82,13 +104,97 @@ func (s *service) Status(ctx context.Context, network string) (*StatusNode, erro
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return &msg.Result, nil
+}
+
+func (s *service) NetworkInfo(ctx context.Context, network string) (*NetworkInfo, error) {
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "getnetworkinfo",
+		Params:  []interface{}{},
+	}
+
+	msg := struct {
+		Result NetworkInfo `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Error.Message != "" {
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return &msg.Result, nil
+}
+
+func (s *service) BlockHeader(ctx context.Context, hash string, network string) (*BlockHeader, error) {
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "getblockheader",
+		Params:  []interface{}{hash, true},
+	}
+
+	msg := struct {
+		Result BlockHeader `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Error.Message != "" {
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return &msg.Result, nil
 }
 
+// GetCurrentFee returns the fee rate in coins per kB, or kvB on segwit chains, bounded
+// by the fee rates of the chain.
 func (s *service) GetCurrentFee(ctx context.Context, network string) (*float64, error) {
+	if !s.chain.SmartFee {
+		return s.estimateFee(ctx, network)
+	}
+
 	req := BaseRequest{
 		JsonRpc: "2.0",
 		Method:  "estimatesmartfee",
@@ -101,6 +207,7 @@ func (s *service) GetCurrentFee(ctx context.Context, network string) (*float64,
 			Blocks  int64   `json:"blocks"`
 		}
 		Error struct {
+			Code    int64  `json:"code"`
 			Message string `json:"message"`
 		} `json:"error"`
 	}{}
@@ -123,35 +230,72 @@ func (s *service) GetCurrentFee(ctx context.Context, network string) (*float64,
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	fee := s.boundFee(msg.Result.Feerate)
+	return &fee, nil
+}
+
+// estimateFee asks nodes without smart estimation, which answer -1 without enough data.
+func (s *service) estimateFee(ctx context.Context, network string) (*float64, error) {
+	req := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "estimatefee",
+		Params:  []interface{}{},
+	}
+
+	msg := struct {
+		Result float64 `json:"result"`
+		Error  struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	body, err := s.btcClient.EncodeBaseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.btcClient.Send(ctx, body, "", network)
+	if err != nil {
+		return nil, err
 	}
 
-	var fee float64
-	fee = msg.Result.Feerate
-	// sanity check
-	if fee > 0.05 {
-		fee = 0.1
-	} else if fee < 0 {
-		fee = 0
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return nil, err
 	}
-	//fmt.Printf("fee: %f\n", fee)
 
-	if fee == 0 {
-		return &fee, err
+	if msg.Error.Message != "" {
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
+	fee := s.boundFee(msg.Result)
 	return &fee, nil
 }
 
+// boundFee is a sanity check of the node estimate.
+func (s *service) boundFee(fee float64) float64 {
+	if fee > s.chain.MaxFeeRate {
+		return s.chain.MaxFeeRate
+	}
+	if fee < s.chain.MinFeeRate {
+		return s.chain.MinFeeRate
+	}
+	return fee
+}
+
 func (s *service) getCurrentFeeRate(ctx context.Context, network string) (*big.Int, error) {
 	fee, err := s.GetCurrentFee(ctx, network)
 	if err != nil {
 		return nil, err
 	}
 
-	// convert to satoshis to bytes
-	// feeRate := big.NewInt(int64(msg.Result * 1.0E8))
-	// convert to satoshis to kb
+	// convert coins per kB to satoshis per byte
 	feeRate := big.NewInt(int64(*fee * 1.0e5))
 
 	//fmt.Printf("fee rate: %s\n", feeRate)
@@ -160,8 +304,6 @@ func (s *service) getCurrentFeeRate(ctx context.Context, network string) (*big.I
 }
 
 func (s *service) CreateTransaction(ctx context.Context, utxos UTXO, fromAddress, toAddress string, amount int64, network string) (*string, *float64, error) {
-	chainParams := &chaincfg.TestNet3Params
-
 	// Get fee
 	feeRate, err := s.getCurrentFeeRate(ctx, network)
 	if err != nil {
@@ -217,12 +359,7 @@ func (s *service) CreateTransaction(ctx context.Context, utxos UTXO, fromAddress
 	}
 
 	// create the transaction outputs
-	destAddress, err := btcutil.DecodeAddress(toAddress, chainParams)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	destScript, err := txscript.PayToAddrScript(destAddress)
+	destScript, err := s.chain.PayToAddress(toAddress, network)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -256,13 +393,7 @@ func (s *service) CreateTransaction(ctx context.Context, utxos UTXO, fromAddress
 		//tx.AddTxOut(feeOutput)
 
 		// our change address
-		changeSendToAddress, err := btcutil.DecodeAddress(fromAddress, chainParams)
-		if err != nil {
-			//svc.log.WithContext(ctx).Errorf(err.Error())
-			return nil, nil, err
-		}
-
-		changeSendToScript, err := txscript.PayToAddrScript(changeSendToAddress)
+		changeSendToScript, err := s.chain.PayToAddress(fromAddress, network)
 		if err != nil {
 			//svc.log.WithContext(ctx).Errorf(err.Error())
 			return nil, nil, err
@@ -281,7 +412,7 @@ func (s *service) CreateTransaction(ctx context.Context, utxos UTXO, fromAddress
 	if (amount - totalFee.Int64()) >= sourceUtxosAmount.Int64() {
 		//log.Fatal(errors.New("your balance too low for this transaction"))
 		//svc.log.WithContext(ctx).Errorf("your balance too low for this transaction")
-		return nil, nil, errors.New("your balance too low for this transaction")
+		return nil, nil, errors.NewRejected(errors.StatusInsufficientFunds, "your balance too low for this transaction")
 	}
 
 	//log.Printf("%-18s %s\n", "total fee:", totalFee)
@@ -299,7 +430,7 @@ func (s *service) CreateTransaction(ctx context.Context, utxos UTXO, fromAddress
 	}
 
 	createdTx := hex.EncodeToString(notSignedTxBuf.Bytes())
-	btcAmountFee := btcutil.Amount(totalFee.Int64()).ToBTC()
+	btcAmountFee := s.chain.ToCoins(totalFee.Int64())
 	return &createdTx, &btcAmountFee, nil
 }
 
@@ -345,6 +476,7 @@ func (s *service) DecodeTransaction(ctx context.Context, tx string, network stri
 	msg := struct {
 		Result DecodedTx `json:"result"`
 		Error  struct {
+			Code    int64  `json:"code"`
 			Message string `json:"message"`
 		} `json:"error"`
 	}{}
@@ -373,7 +505,7 @@ func (s *service) DecodeTransaction(ctx context.Context, tx string, network stri
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return &DecodedTx{
@@ -409,6 +541,7 @@ func (s *service) FundForTransaction(ctx context.Context, createdTx, changeAddre
 			Fee float64 `json:"fee"`
 		} `json:"result"`
 		Error struct {
+			Code    int64  `json:"code"`
 			Message string `json:"message"`
 		} `json:"error"`
 	}{}
@@ -431,7 +564,7 @@ func (s *service) FundForTransaction(ctx context.Context, createdTx, changeAddre
 	}
 
 	if msg.Error.Message != "" {
-		return "", nil, errors.New(msg.Error.Message)
+		return "", nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return msg.Result.Hex, &msg.Result.Fee, nil
@@ -444,6 +577,10 @@ func (s *service) SignTransaction(ctx context.Context, tx, privateKey string, ut
 		Method:  "signrawtransactionwithkey",
 		Params:  []interface{}{tx, privateKeyArray, utxos},
 	}
+	if s.chain.LegacySigning {
+		req.Method = "signrawtransaction"
+		req.Params = []interface{}{tx, utxos, privateKeyArray}
+	}
 
 	msg := struct {
 		Result struct {
@@ -474,11 +611,11 @@ func (s *service) SignTransaction(ctx context.Context, tx, privateKey string, ut
 	}
 
 	if msg.Error.Message != "" {
-		return "", errors.New(msg.Error.Message)
+		return "", errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	if !msg.Result.Complete {
-		return "", errors.New("signing transaction not complete. Please try again")
+		return "", errors.NewRejected(errors.StatusSigningIncomplete, "signing transaction not complete. Please try again")
 	}
 
 	return msg.Result.Hex, nil
@@ -488,6 +625,7 @@ func (s *service) SendTransaction(ctx context.Context, signedTx, network string)
 	msg := struct {
 		Result string `json:"result"`
 		Error  struct {
+			Code    int64  `json:"code"`
 			Message string `json:"message"`
 		} `json:"error"`
 	}{}
@@ -516,7 +654,7 @@ func (s *service) SendTransaction(ctx context.Context, signedTx, network string)
 	}
 
 	if msg.Error.Message != "" {
-		return "", errors.New(msg.Error.Message)
+		return "", errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return msg.Result, nil
@@ -526,6 +664,7 @@ func (s *service) WalletInfo(ctx context.Context, walletId, network string) (*In
 	msg := struct {
 		Result Info `json:"result"`
 		Error  struct {
+			Code    int64  `json:"code"`
 			Message string `json:"message"`
 		} `json:"error"`
 	}{}
@@ -554,7 +693,7 @@ func (s *service) WalletInfo(ctx context.Context, walletId, network string) (*In
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return &msg.Result, nil
@@ -601,11 +740,11 @@ func (s *service) CreateWallet(ctx context.Context, network string) (string, err
 	}
 
 	if msg.Result.Warning != "" {
-		return "", errors.New(msg.Result.Warning)
+		return "", gErrors.New(msg.Result.Warning)
 	}
 
 	if msg.Error.Message != "" {
-		return "", errors.New(msg.Error.Message)
+		return "", errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return msg.Result.Name, nil
@@ -618,6 +757,7 @@ func (s *service) LoadWallet(ctx context.Context, walletId, network string) erro
 			Warning string `json:"warning"`
 		} `json:"result"`
 		Error struct {
+			Code    int64  `json:"code"`
 			Message string `json:"message"`
 		} `json:"error"`
 	}{}
@@ -646,11 +786,11 @@ func (s *service) LoadWallet(ctx context.Context, walletId, network string) erro
 	}
 
 	if msg.Result.Warning != "" {
-		return errors.New(msg.Result.Warning)
+		return gErrors.New(msg.Result.Warning)
 	}
 
 	if msg.Error.Message != "" {
-		return errors.New(msg.Error.Message)
+		return errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return nil
@@ -660,6 +800,7 @@ func (s *service) ImportAddress(ctx context.Context, address, walletId, network
 	msg := struct {
 		Result string `json:"result"`
 		Error  struct {
+			Code    int64  `json:"code"`
 			Message string `json:"message"`
 		} `json:"error"`
 	}{}
@@ -688,7 +829,7 @@ func (s *service) ImportAddress(ctx context.Context, address, walletId, network
 	}
 
 	if msg.Error.Message != "" {
-		return errors.New(msg.Error.Message)
+		return errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return nil
@@ -752,6 +893,7 @@ func (s *service) ListUnspent(ctx context.Context, address, walletId, network st
 	msg := struct {
 		Result []*Unspent `json:"result"`
 		Error  struct {
+			Code    int64  `json:"code"`
 			Message string `json:"message"`
 		} `json:"error"`
 	}{}
@@ -780,7 +922,7 @@ func (s *service) ListUnspent(ctx context.Context, address, walletId, network st
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromBitcoinRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return msg.Result, nil
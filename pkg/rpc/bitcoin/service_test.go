@@ -15,11 +15,13 @@ func TestNewService(t *testing.T) {
 	tests := []struct {
 		name      string
 		btcClient bitcoin_rpc.Client
+		chain     bitcoin_rpc.Chain
 		expect    func(*testing.T, bitcoin_rpc.Service, error)
 	}{
 		{
 			name:      "should return bitcoin rpc service",
 			btcClient: mock_bitcoin_rpc.NewMockClient(controller),
+			chain:     bitcoin_rpc.KnownChains["bitcoin"],
 			expect: func(t *testing.T, s bitcoin_rpc.Service, err error) {
 				assert.NotNil(t, s)
 				assert.Nil(t, err)
@@ -28,17 +30,26 @@ func TestNewService(t *testing.T) {
 		{
 			name:      "should return invalid bitcoin rpc client",
 			btcClient: nil,
+			chain:     bitcoin_rpc.KnownChains["bitcoin"],
 			expect: func(t *testing.T, s bitcoin_rpc.Service, err error) {
 				assert.NotNil(t, err)
 				assert.Nil(t, s)
 				assert.EqualError(t, err, "invalid bitcoin rpc client")
 			},
 		},
+		{
+			name:      "should return invalid chain",
+			btcClient: mock_bitcoin_rpc.NewMockClient(controller),
+			expect: func(t *testing.T, s bitcoin_rpc.Service, err error) {
+				assert.Nil(t, s)
+				assert.EqualError(t, err, "invalid chain")
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := bitcoin_rpc.NewService(tc.btcClient)
+			svc, err := bitcoin_rpc.NewService(tc.btcClient, tc.chain)
 			tc.expect(t, svc, err)
 		})
 	}
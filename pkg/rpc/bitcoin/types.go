@@ -17,6 +17,8 @@ type StatusNode struct {
 	Blocks               interface{} `json:"blocks"`
 	Headers              interface{} `json:"headers"`
 	Verificationprogress interface{} `json:"verificationprogress"`
+	Bestblockhash        string      `json:"bestblockhash"`
+	Initialblockdownload bool        `json:"initialblockdownload"`
 	Softforks            struct {
 		Bip34 struct {
 			Type   string      `json:"type"`
@@ -60,6 +62,34 @@ type StatusNode struct {
 	Warnings string `json:"warnings"`
 }
 
+type NetworkInfo struct {
+	Version         int64  `json:"version"`
+	Subversion      string `json:"subversion"`
+	Connections     int64  `json:"connections"`
+	ConnectionsIn   int64  `json:"connections_in"`
+	ConnectionsOut  int64  `json:"connections_out"`
+	NetworkActive   bool   `json:"networkactive"`
+	Warnings        string `json:"warnings"`
+	ProtocolVersion int64  `json:"protocolversion"`
+}
+
+type BlockHeader struct {
+	Hash              string  `json:"hash"`
+	Confirmations     int64   `json:"confirmations"`
+	Height            int64   `json:"height"`
+	Version           int64   `json:"version"`
+	MerkleRoot        string  `json:"merkleroot"`
+	Time              int64   `json:"time"`
+	MedianTime        int64   `json:"mediantime"`
+	Nonce             int64   `json:"nonce"`
+	Bits              string  `json:"bits"`
+	Difficulty        float64 `json:"difficulty"`
+	Chainwork         string  `json:"chainwork"`
+	NTx               int64   `json:"nTx"`
+	PreviousBlockHash string  `json:"previousblockhash,omitempty"`
+	NextBlockHash     string  `json:"nextblockhash,omitempty"`
+}
+
 type UnspentList struct {
 	TxId         string `json:"txid"`
 	Vout         int64  `json:"vout"`
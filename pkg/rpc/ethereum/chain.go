@@ -0,0 +1,205 @@
+package ethereum_rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/networks"
+	"regexp"
+	"sort"
+)
+
+const (
+	// DefaultChain is the chain served by the ETH_RPC_ENDPOINT_* settings.
+	DefaultChain = "ethereum"
+
+	// RollupOPStack chains charge an L1 data fee on top of the L2 execution fee.
+	RollupOPStack = "op-stack"
+	// RollupArbitrum chains fold the L1 cost into the gas estimate.
+	RollupArbitrum = "arbitrum"
+)
+
+var chainName = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// Chain is an EVM chain the API talks to, Networks holds the networks it is served on.
+// EIP1559 is nil on a chain that leaves it to the known chain of its name.
+type Chain struct {
+	Name     string                       `json:"name"`
+	Currency string                       `json:"currency"`
+	Decimals int                          `json:"decimals"`
+	EIP1559  *bool                        `json:"eip1559,omitempty"`
+	Rollup   string                       `json:"rollup,omitempty"`
+	Networks map[networks.Network]Network `json:"networks"`
+}
+
+type Network struct {
+	ChainId  int64  `json:"chain_id"`
+	Endpoint string `json:"endpoint"`
+	// Fallbacks are tried in order when the node at Endpoint is unreachable or fails without
+	// a JSON-RPC error.
+	Fallbacks []string `json:"fallbacks,omitempty"`
+}
+
+// Endpoints returns the endpoint followed by the fallbacks.
+func (n Network) Endpoints() []string {
+	return append([]string{n.Endpoint}, n.Fallbacks...)
+}
+
+// KnownChains are the chains a chains file may enable by name and endpoints only.
+var KnownChains = map[string]Chain{
+	DefaultChain: {Name: DefaultChain, Currency: "ETH", Decimals: 18, EIP1559: enabled(true), Networks: map[networks.Network]Network{
+		"main": {ChainId: 1}, "test": {ChainId: 11155111},
+	}},
+	"polygon": {Name: "polygon", Currency: "POL", Decimals: 18, EIP1559: enabled(true), Networks: map[networks.Network]Network{
+		"main": {ChainId: 137}, "test": {ChainId: 80002},
+	}},
+	"bsc": {Name: "bsc", Currency: "BNB", Decimals: 18, Networks: map[networks.Network]Network{
+		"main": {ChainId: 56}, "test": {ChainId: 97},
+	}},
+	"arbitrum": {Name: "arbitrum", Currency: "ETH", Decimals: 18, EIP1559: enabled(true), Rollup: RollupArbitrum, Networks: map[networks.Network]Network{
+		"main": {ChainId: 42161}, "test": {ChainId: 421614},
+	}},
+	"optimism": {Name: "optimism", Currency: "ETH", Decimals: 18, EIP1559: enabled(true), Rollup: RollupOPStack, Networks: map[networks.Network]Network{
+		"main": {ChainId: 10}, "test": {ChainId: 11155420},
+	}},
+	"base": {Name: "base", Currency: "ETH", Decimals: 18, EIP1559: enabled(true), Rollup: RollupOPStack, Networks: map[networks.Network]Network{
+		"main": {ChainId: 8453}, "test": {ChainId: 84532},
+	}},
+}
+
+// DynamicFees reports whether the chain prices gas with EIP-1559 fee caps.
+func (c Chain) DynamicFees() bool {
+	return c.EIP1559 != nil && *c.EIP1559
+}
+
+func enabled(v bool) *bool {
+	return &v
+}
+
+// Validate checks a chain is complete, it needs a network and every network a chain id and
+// an endpoint.
+func (c Chain) Validate() error {
+	if !chainName.MatchString(c.Name) {
+		return fmt.Errorf("invalid chain name %q", c.Name)
+	}
+	if c.Decimals <= 0 || c.Decimals > 36 {
+		return fmt.Errorf("chain %s: invalid decimals %d", c.Name, c.Decimals)
+	}
+	if c.Rollup != "" && c.Rollup != RollupOPStack && c.Rollup != RollupArbitrum {
+		return fmt.Errorf("chain %s: unsupported rollup %s", c.Name, c.Rollup)
+	}
+	if len(c.Networks) == 0 {
+		return fmt.Errorf("chain %s: no networks", c.Name)
+	}
+	for name, network := range c.Networks {
+		if _, err := networks.Parse(string(name)); err != nil {
+			return fmt.Errorf("chain %s: invalid network %q", c.Name, name)
+		}
+		if network.ChainId <= 0 {
+			return fmt.Errorf("chain %s: invalid %s chain id %d", c.Name, name, network.ChainId)
+		}
+		if network.Endpoint == "" {
+			return fmt.Errorf("chain %s: missing %s endpoint", c.Name, name)
+		}
+		for _, fallback := range network.Fallbacks {
+			if fallback == "" {
+				return fmt.Errorf("chain %s: empty %s fallback endpoint", c.Name, name)
+			}
+		}
+	}
+	return nil
+}
+
+// Network returns the settings of a network, networks the chain is not served on are an
+// invalid_network error.
+func (c Chain) Network(network string) (Network, error) {
+	settings, ok := c.Networks[networks.Network(network)]
+	if !ok {
+		return Network{}, errors.NewInvalid(errors.StatusInvalidNetwork, fmt.Sprintf("%s has no network %q", c.Name, network))
+	}
+	return settings, nil
+}
+
+// LoadChains reads a JSON array of chains. Known chains only need the endpoints of the
+// networks they are served on, the other fields default to the KnownChains entry of the
+// same name.
+func LoadChains(path string) ([]Chain, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var chains []Chain
+	if err := json.Unmarshal(data, &chains); err != nil {
+		return nil, fmt.Errorf("invalid chains file %s: %w", path, err)
+	}
+	for i, chain := range chains {
+		chains[i] = WithDefaults(chain)
+	}
+	return chains, nil
+}
+
+// WithDefaults fills the fields a chain leaves empty from the known chain of its name.
+func WithDefaults(chain Chain) Chain {
+	known, ok := KnownChains[chain.Name]
+	if !ok {
+		return chain
+	}
+	if chain.Currency == "" {
+		chain.Currency = known.Currency
+	}
+	if chain.Decimals == 0 {
+		chain.Decimals = known.Decimals
+	}
+	if chain.EIP1559 == nil {
+		chain.EIP1559 = known.EIP1559
+	}
+	if chain.Rollup == "" {
+		chain.Rollup = known.Rollup
+	}
+
+	configured := map[networks.Network]Network{}
+	for name, network := range chain.Networks {
+		if network.ChainId == 0 {
+			network.ChainId = known.Networks[name].ChainId
+		}
+		configured[name] = network
+	}
+	chain.Networks = configured
+	return chain
+}
+
+// Registry is the set of configured chains, a deployment may serve none.
+type Registry struct {
+	chains map[string]Chain
+}
+
+func NewRegistry(chains ...Chain) (*Registry, error) {
+	registry := &Registry{chains: map[string]Chain{}}
+	for _, chain := range chains {
+		if err := chain.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := registry.chains[chain.Name]; ok {
+			return nil, fmt.Errorf("duplicate chain %s", chain.Name)
+		}
+		registry.chains[chain.Name] = chain
+	}
+	return registry, nil
+}
+
+func (r *Registry) Chain(name string) (Chain, bool) {
+	chain, ok := r.chains[name]
+	return chain, ok
+}
+
+// Chains lists the chains by name.
+func (r *Registry) Chains() []Chain {
+	chains := make([]Chain, 0, len(r.chains))
+	for _, chain := range r.chains {
+		chains = append(chains, chain)
+	}
+	sort.Slice(chains, func(i, j int) bool { return chains[i].Name < chains[j].Name })
+	return chains
+}
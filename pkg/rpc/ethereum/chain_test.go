@@ -0,0 +1,131 @@
+package ethereum_rpc_test
+
+import (
+	"io/ioutil"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/networks"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadChains(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`[
+		{"name": "base", "networks": {"main": {"endpoint": "http://base-main"}, "test": {"endpoint": "http://base-test"}}},
+		{"name": "devnet", "currency": "DEV", "decimals": 18, "networks": {"main": {"chain_id": 7, "endpoint": "http://dev"}, "test": {"chain_id": 8, "endpoint": "http://dev"}}},
+		{"name": "polygon", "eip1559": false, "networks": {"main": {"endpoint": "http://polygon-main"}}}
+	]`), 0600))
+
+	chains, err := ethereum_rpc.LoadChains(path)
+	require.NoError(t, err)
+	eip1559 := true
+	assert.Equal(t, ethereum_rpc.Chain{
+		Name:     "base",
+		Currency: "ETH",
+		Decimals: 18,
+		EIP1559:  &eip1559,
+		Rollup:   ethereum_rpc.RollupOPStack,
+		Networks: map[networks.Network]ethereum_rpc.Network{
+			"main": {ChainId: 8453, Endpoint: "http://base-main"},
+			"test": {ChainId: 84532, Endpoint: "http://base-test"},
+		},
+	}, chains[0])
+	assert.Equal(t, "DEV", chains[1].Currency)
+	assert.True(t, chains[0].DynamicFees())
+	assert.False(t, chains[1].DynamicFees())
+	assert.False(t, chains[2].DynamicFees())
+
+	registry, err := ethereum_rpc.NewRegistry(chains[:2]...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"base", "devnet"}, []string{registry.Chains()[0].Name, registry.Chains()[1].Name})
+	_, ok := registry.Chain("polygon")
+	assert.False(t, ok)
+}
+
+func TestLoadChains_OneNetwork(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`[{"name": "polygon", "networks": {"main": {"endpoint": "http://polygon-main"}}}]`), 0600))
+
+	chains, err := ethereum_rpc.LoadChains(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[networks.Network]ethereum_rpc.Network{
+		networks.Main: {ChainId: 137, Endpoint: "http://polygon-main"},
+	}, chains[0].Networks)
+
+	_, err = chains[0].Network("test")
+	assert.Equal(t, errors.NewInvalid(errors.StatusInvalidNetwork, `polygon has no network "test"`), err)
+}
+
+func TestNewRegistry(t *testing.T) {
+	served := map[networks.Network]ethereum_rpc.Network{"main": {ChainId: 1, Endpoint: "http://main"}, "test": {ChainId: 5, Endpoint: "http://test"}}
+
+	tests := []struct {
+		name   string
+		chains []ethereum_rpc.Chain
+		err    string
+	}{
+		{
+			name:   "should accept complete chains",
+			chains: []ethereum_rpc.Chain{{Name: "ethereum", Decimals: 18, Networks: served}},
+		},
+		{
+			name:   "should accept chains served on one network",
+			chains: []ethereum_rpc.Chain{{Name: "polygon", Decimals: 18, Networks: map[networks.Network]ethereum_rpc.Network{"main": {ChainId: 137, Endpoint: "http://main"}}}},
+		},
+		{
+			name:   "should refuse chains without networks",
+			chains: []ethereum_rpc.Chain{{Name: "polygon", Decimals: 18}},
+			err:    "chain polygon: no networks",
+		},
+		{
+			name:   "should refuse invalid network names",
+			chains: []ethereum_rpc.Chain{{Name: "polygon", Decimals: 18, Networks: map[networks.Network]ethereum_rpc.Network{"Main": {ChainId: 137, Endpoint: "http://main"}}}},
+			err:    `chain polygon: invalid network "Main"`,
+		},
+		{
+			name:   "should refuse duplicate chains",
+			chains: []ethereum_rpc.Chain{{Name: "ethereum", Decimals: 18, Networks: served}, {Name: "ethereum", Decimals: 18, Networks: served}},
+			err:    "duplicate chain ethereum",
+		},
+		{
+			name:   "should refuse missing endpoint",
+			chains: []ethereum_rpc.Chain{{Name: "polygon", Decimals: 18, Networks: map[networks.Network]ethereum_rpc.Network{"main": {ChainId: 137, Endpoint: "http://main"}, "test": {ChainId: 80002}}}},
+			err:    "chain polygon: missing test endpoint",
+		},
+		{
+			name:   "should refuse names unfit for a path",
+			chains: []ethereum_rpc.Chain{{Name: "BNB Chain", Decimals: 18, Networks: served}},
+			err:    `invalid chain name "BNB Chain"`,
+		},
+		{
+			name:   "should refuse unknown rollup",
+			chains: []ethereum_rpc.Chain{{Name: "zk", Decimals: 18, Rollup: "zk-stack", Networks: served}},
+			err:    "chain zk: unsupported rollup zk-stack",
+		},
+		{
+			name: "should accept a deployment without EVM chains",
+		},
+		{
+			name:   "should refuse empty fallback endpoint",
+			chains: []ethereum_rpc.Chain{{Name: "polygon", Decimals: 18, Networks: map[networks.Network]ethereum_rpc.Network{"main": {ChainId: 137, Endpoint: "http://main", Fallbacks: []string{""}}}}},
+			err:    "chain polygon: empty main fallback endpoint",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			registry, err := ethereum_rpc.NewRegistry(tc.chains...)
+			if tc.err != "" {
+				assert.Nil(t, registry)
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.NotNil(t, registry)
+		})
+	}
+}
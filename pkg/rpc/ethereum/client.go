@@ -4,64 +4,182 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
+	gErrors "errors"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/metrics"
+	"nn-blockchain-api/pkg/networks"
+	"nn-blockchain-api/pkg/tracing"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:generate mockgen -source=client.go -destination=mocks/client_mock.go
 type Client interface {
 	Send(ctx context.Context, body io.Reader, network string) (*http.Response, error)
 	EncodeBaseRequest(request interface{}) (*bytes.Buffer, error)
+	// SetEndpoints replaces the endpoint and fallbacks of a network, which carry the node
+	// credentials or API keys in their URLs. Requests that are already sent keep the previous ones.
+	SetEndpoints(network string, endpoints []string) error
+	// Close releases idle connections held by the transport.
+	Close()
 }
 
 type client struct {
-	ethRpcEndpointTestNet string
-	ethRpcEndpointMainNet string
+	// mu guards the networks of chain, their endpoints rotate while requests are sent.
+	mu    sync.RWMutex
+	chain Chain
+
+	httpClient *http.Client
 }
 
-func NewClient(ethRpcEndpointTestNet string, ethRpcEndpointMainNet string) (Client, error) {
-	if ethRpcEndpointTestNet == "" {
-		return nil, errors.New("invalid ethereum rpc testnet endpoint")
+// NewClient talks to the endpoint of every network of the chain.
+func NewClient(chain Chain) (Client, error) {
+	if chain.Name == "" {
+		return nil, gErrors.New("invalid chain")
+	}
+	if len(chain.Networks) == 0 {
+		return nil, gErrors.New("invalid " + chain.Name + " networks")
+	}
+	for name, network := range chain.Networks {
+		if network.Endpoint == "" {
+			return nil, gErrors.New("invalid " + chain.Name + " rpc " + string(name) + " endpoint")
+		}
+		for _, fallback := range network.Fallbacks {
+			if fallback == "" {
+				return nil, gErrors.New("invalid " + chain.Name + " rpc " + string(name) + " fallback endpoint")
+			}
+		}
 	}
-	if ethRpcEndpointMainNet == "" {
-		return nil, errors.New("invalid ethereum rpc mainnet endpoint")
+
+	// The networks are copied so rotating endpoints does not write to the caller's chain.
+	nodes := make(map[networks.Network]Network, len(chain.Networks))
+	for name, network := range chain.Networks {
+		nodes[name] = network
 	}
+	chain.Networks = nodes
 
 	return &client{
-		ethRpcEndpointTestNet: ethRpcEndpointTestNet,
-		ethRpcEndpointMainNet: ethRpcEndpointMainNet,
+		chain:      chain,
+		httpClient: &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()},
 	}, nil
 }
 
 func (c *client) Send(ctx context.Context, body io.Reader, network string) (*http.Response, error) {
-	var endPoint string
-
-	if network == "main" {
-		endPoint = c.ethRpcEndpointMainNet
-	} else {
-		endPoint = c.ethRpcEndpointTestNet
+	c.mu.RLock()
+	node, err := c.chain.Network(network)
+	c.mu.RUnlock()
+	if err != nil {
+		return nil, err
 	}
 
-	client := &http.Client{}
-	req, err := http.NewRequestWithContext(ctx, "POST", endPoint, body)
+	payload, err := ioutil.ReadAll(body)
 	if err != nil {
 		return nil, err
 	}
+	method := metrics.RPCMethod(payload)
+
+	ctx, span := tracing.Tracer().Start(ctx, "ethereum_rpc "+method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.RPCSystemKey.String("jsonrpc"),
+		semconv.RPCMethodKey.String(method),
+		attribute.String("chain", c.chain.Name),
+		attribute.String("network", network),
+	))
+	defer span.End()
+
+	// The next endpoint of the pool is tried while nodes are unavailable.
+	for _, endPoint := range node.Endpoints() {
+		var resp *http.Response
+		resp, err = c.post(ctx, span, endPoint, payload, method, network)
+		if err == nil || !errors.IsNodeUnavailable(err) {
+			return resp, err
+		}
+	}
+	return nil, err
+}
+
+func (c *client) post(ctx context.Context, span trace.Span, endPoint string, payload []byte, method, network string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endPoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.WithoutURL(err)
+	}
 
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
 
-	resp, err := client.Do(req)
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		metrics.ObserveRPC(c.chain.Name, network, method, metrics.CodeTransportError, time.Since(start))
+		// The cause is recorded without the URL, the returned error is fixed so neither
+		// responses nor logs repeat the endpoint.
+		tracing.RecordError(span, errors.WithoutURL(err))
+		return nil, errors.NewNodeUnavailable(fmt.Sprintf("%s %s node unreachable", c.chain.Name, network))
+	}
+
+	// The body is buffered so the JSON-RPC error code can be recorded before callers decode it.
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		metrics.ObserveRPC(c.chain.Name, network, method, metrics.CodeTransportError, time.Since(start))
+		tracing.RecordError(span, err)
 		return nil, err
 	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	code := metrics.RPCCode(resp.StatusCode, data)
+	metrics.ObserveRPC(c.chain.Name, network, method, code, time.Since(start))
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode), attribute.String("rpc.jsonrpc.code", code))
+	if code != metrics.CodeOK {
+		span.SetStatus(codes.Error, "rpc error "+code)
+	}
+	// Non JSON-RPC failures (bad credentials, proxies, overloaded nodes) carry no error object to map.
+	if code == "http_"+strconv.Itoa(resp.StatusCode) {
+		return nil, errors.NewNodeUnavailable(fmt.Sprintf("%s node responded with HTTP %d", c.chain.Name, resp.StatusCode))
+	}
 
 	//defer resp.Body.Close()
 
 	return resp, nil
 }
 
+func (c *client) SetEndpoints(network string, endpoints []string) error {
+	if len(endpoints) == 0 {
+		return gErrors.New("invalid " + c.chain.Name + " rpc " + network + " endpoint")
+	}
+	for _, endpoint := range endpoints {
+		if endpoint == "" {
+			return gErrors.New("invalid " + c.chain.Name + " rpc " + network + " endpoint")
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	node, err := c.chain.Network(network)
+	if err != nil {
+		return err
+	}
+	node.Endpoint, node.Fallbacks = endpoints[0], endpoints[1:]
+	c.chain.Networks[networks.Network(network)] = node
+	return nil
+}
+
+func (c *client) Close() {
+	c.httpClient.CloseIdleConnections()
+}
+
 func (c *client) EncodeBaseRequest(request interface{}) (*bytes.Buffer, error) {
 	data, err := json.Marshal(request)
 	if err != nil {
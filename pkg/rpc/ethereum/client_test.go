@@ -1,8 +1,13 @@
 package ethereum_rpc_test
 
 import (
+	"context"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/pkg/errors"
+	"nn-blockchain-api/pkg/networks"
 	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
 	"testing"
 )
@@ -30,31 +35,133 @@ func TestNewClient(t *testing.T) {
 			},
 		},
 		{
-			name:                  "should return invalid ethereum rpc testnet endpoint",
+			name:                  "should return invalid ethereum rpc test endpoint",
 			ethRpcEndpointTestNet: "",
 			ethRpcEndpointMainNet: ethRpcMain,
 			expect: func(t *testing.T, c ethereum_rpc.Client, err error) {
 				assert.NotNil(t, err)
 				assert.Nil(t, c)
-				assert.EqualError(t, err, "invalid ethereum rpc testnet endpoint")
+				assert.EqualError(t, err, "invalid ethereum rpc test endpoint")
 			},
 		},
 		{
-			name:                  "should return invalid ethereum rpc mainnet endpoint",
+			name:                  "should return invalid ethereum rpc main endpoint",
 			ethRpcEndpointTestNet: ethRpcTest,
 			ethRpcEndpointMainNet: "",
 			expect: func(t *testing.T, c ethereum_rpc.Client, err error) {
 				assert.NotNil(t, err)
 				assert.Nil(t, c)
-				assert.EqualError(t, err, "invalid ethereum rpc mainnet endpoint")
+				assert.EqualError(t, err, "invalid ethereum rpc main endpoint")
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := ethereum_rpc.NewClient(tc.ethRpcEndpointTestNet, tc.ethRpcEndpointMainNet)
+			svc, err := ethereum_rpc.NewClient(ethereum_rpc.Chain{Name: "ethereum", Networks: map[networks.Network]ethereum_rpc.Network{
+				"test": {ChainId: 5, Endpoint: tc.ethRpcEndpointTestNet},
+				"main": {ChainId: 1, Endpoint: tc.ethRpcEndpointMainNet},
+			}})
 			tc.expect(t, svc, err)
 		})
 	}
 }
+
+func TestClient_Send_Network(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	defer server.Close()
+
+	client, err := ethereum_rpc.NewClient(ethereum_rpc.Chain{Name: "ethereum", Networks: map[networks.Network]ethereum_rpc.Network{
+		networks.Main: {ChainId: 1, Endpoint: server.URL},
+	}})
+	assert.Nil(t, err)
+
+	for _, network := range []string{"mainnet", "test", ""} {
+		body, err := client.EncodeBaseRequest(ethereum_rpc.BaseRequest{JsonRpc: "2.0", Method: "eth_blockNumber", Params: []interface{}{}})
+		assert.Nil(t, err)
+
+		_, err = client.Send(context.Background(), body, network)
+		assert.Equal(t, http.StatusBadRequest, errors.HTTPCode(err), network)
+	}
+}
+
+func TestClient_Send_Fallbacks(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+	}))
+	defer up.Close()
+
+	client, err := ethereum_rpc.NewClient(ethereum_rpc.Chain{Name: "ethereum", Networks: map[networks.Network]ethereum_rpc.Network{
+		networks.Main: {ChainId: 1, Endpoint: down.URL, Fallbacks: []string{down.URL, up.URL}},
+		networks.Test: {ChainId: 11155111, Endpoint: down.URL},
+	}})
+	assert.Nil(t, err)
+
+	body, err := client.EncodeBaseRequest(ethereum_rpc.BaseRequest{JsonRpc: "2.0", Method: "eth_blockNumber", Params: []interface{}{}})
+	assert.Nil(t, err)
+	response, err := client.Send(context.Background(), body, "main")
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	body, err = client.EncodeBaseRequest(ethereum_rpc.BaseRequest{JsonRpc: "2.0", Method: "eth_blockNumber", Params: []interface{}{}})
+	assert.Nil(t, err)
+	_, err = client.Send(context.Background(), body, "test")
+	assert.True(t, errors.IsNodeUnavailable(err))
+}
+
+func TestClient_SetEndpoints(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+	}))
+	defer server.Close()
+
+	chain := ethereum_rpc.Chain{Name: "ethereum", Networks: map[networks.Network]ethereum_rpc.Network{
+		networks.Test: {ChainId: 11155111, Endpoint: server.URL + "/v3/key"},
+	}}
+	client, err := ethereum_rpc.NewClient(chain)
+	assert.Nil(t, err)
+
+	send := func() {
+		body, err := client.EncodeBaseRequest(ethereum_rpc.BaseRequest{JsonRpc: "2.0", Method: "eth_blockNumber", Params: []interface{}{}})
+		assert.Nil(t, err)
+		response, err := client.Send(context.Background(), body, "test")
+		assert.Nil(t, err)
+		response.Body.Close()
+	}
+
+	send()
+	assert.Nil(t, client.SetEndpoints("test", []string{server.URL + "/v3/rotated"}))
+	send()
+	assert.Equal(t, []string{"/v3/key", "/v3/rotated"}, paths)
+	assert.Equal(t, server.URL+"/v3/key", chain.Networks[networks.Test].Endpoint)
+
+	assert.EqualError(t, client.SetEndpoints("test", nil), "invalid ethereum rpc test endpoint")
+	assert.EqualError(t, client.SetEndpoints("main", []string{server.URL}), `code: 400; status: invalid_network; message: ethereum has no network "main"`)
+}
+
+func TestClient_Send_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	endpoint := server.URL + "/v3/apikey"
+	server.Close()
+
+	client, err := ethereum_rpc.NewClient(ethereum_rpc.Chain{Name: "ethereum", Networks: map[networks.Network]ethereum_rpc.Network{
+		networks.Main: {ChainId: 1, Endpoint: endpoint},
+	}})
+	assert.Nil(t, err)
+
+	body, err := client.EncodeBaseRequest(ethereum_rpc.BaseRequest{JsonRpc: "2.0", Method: "eth_blockNumber", Params: []interface{}{}})
+	assert.Nil(t, err)
+	_, err = client.Send(context.Background(), body, "main")
+	assert.Equal(t, errors.NewNodeUnavailable("ethereum main node unreachable"), err)
+	assert.NotContains(t, err.Error(), "apikey")
+}
@@ -0,0 +1,459 @@
+package ethereum_rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"nn-blockchain-api/pkg/errors"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/google/uuid"
+)
+
+// Output is a decoded return value of a contract method, Value is JSON friendly as the
+// arguments of an Event.
+type Output struct {
+	Name  string
+	Type  string
+	Value interface{}
+}
+
+var panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+// ParseABI parses a JSON ABI, or a fragment of one, that declares the methods to call.
+// A single entry may be passed without the enclosing array.
+func ParseABI(fragment string) (*abi.ABI, error) {
+	contract, err := parseABI(fragment)
+	if err != nil {
+		return nil, err
+	}
+	if len(contract.Methods) == 0 {
+		return nil, fmt.Errorf("abi declares no methods")
+	}
+	return contract, nil
+}
+
+// Method looks a method up by name, or by signature such as transfer(address,uint256)
+// to pick one of several overloads.
+func Method(contract *abi.ABI, name string) (*abi.Method, error) {
+	if method, ok := contract.Methods[name]; ok {
+		return &method, nil
+	}
+	for _, method := range contract.Methods {
+		if method.Sig == strings.ReplaceAll(name, " ", "") {
+			return &method, nil
+		}
+	}
+	return nil, fmt.Errorf("abi declares no method %s", name)
+}
+
+// PackCall encodes the calldata of a method call. Args are JSON values in input order:
+// integers as numbers or decimal or hex strings, addresses and byte strings as hex,
+// arrays as arrays and tuples as objects keyed by component name or as arrays.
+func PackCall(method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != len(method.Inputs) {
+		return nil, fmt.Errorf("%s takes %d arguments, got %d", method.Sig, len(method.Inputs), len(args))
+	}
+
+	values := make([]interface{}, len(args))
+	for i, input := range method.Inputs {
+		value, err := abiValue(input.Type, args[i])
+		if err != nil {
+			name := input.Name
+			if name == "" {
+				name = fmt.Sprint(i)
+			}
+			return nil, fmt.Errorf("invalid argument %s: %v", name, err)
+		}
+		values[i] = value.Interface()
+	}
+
+	packed, err := method.Inputs.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed encode %s arguments: %v", method.Sig, err)
+	}
+	return append(append([]byte{}, method.ID...), packed...), nil
+}
+
+// UnpackOutputs decodes the return data of a method call.
+func UnpackOutputs(method *abi.Method, data []byte) ([]Output, error) {
+	values, err := method.Outputs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed decode %s output: %v", method.Sig, err)
+	}
+
+	outputs := make([]Output, len(values))
+	for i, value := range values {
+		outputs[i] = Output{
+			Name:  method.Outputs[i].Name,
+			Type:  method.Outputs[i].Type.String(),
+			Value: jsonValue(reflect.ValueOf(value)),
+		}
+	}
+	return outputs, nil
+}
+
+// RevertReason decodes the data of a reverted call: an Error(string) message, a
+// Panic(uint256) code or one of the custom errors the ABI declares. It returns an empty
+// string for data it cannot decode.
+func RevertReason(contract *abi.ABI, data []byte) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if len(data) < 4 {
+		return ""
+	}
+	if bytes.Equal(data[:4], panicSelector) && len(data) == 36 {
+		return fmt.Sprintf("panic: 0x%x", new(big.Int).SetBytes(data[4:]))
+	}
+	if contract == nil {
+		return ""
+	}
+	for _, e := range contract.Errors {
+		if !bytes.Equal(data[:4], e.ID[:4]) {
+			continue
+		}
+		unpacked, err := e.Unpack(data)
+		if err != nil {
+			return ""
+		}
+		values := unpacked.([]interface{})
+		args := make([]string, len(values))
+		for i, value := range values {
+			args[i] = fmt.Sprint(jsonValue(reflect.ValueOf(value)))
+		}
+		return fmt.Sprintf("%s(%s)", e.Name, strings.Join(args, ", "))
+	}
+	return ""
+}
+
+// abiValue converts a JSON value into the Go type the abi package packs for t.
+func abiValue(t abi.Type, v interface{}) (reflect.Value, error) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := bigValue(v)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if !fits(t, n) {
+			return reflect.Value{}, fmt.Errorf("%v overflows %s", n, t)
+		}
+		if t.GetType() == bigIntType {
+			return reflect.ValueOf(n), nil
+		}
+		value := reflect.New(t.GetType()).Elem()
+		if t.T == abi.UintTy {
+			value.SetUint(n.Uint64())
+		} else {
+			value.SetInt(n.Int64())
+		}
+		return value, nil
+	case abi.BoolTy:
+		b, ok := v.(bool)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected bool, got %v", v)
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		s, ok := v.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected string, got %v", v)
+		}
+		return reflect.ValueOf(s), nil
+	case abi.AddressTy:
+		s, ok := v.(string)
+		if !ok || !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("expected address, got %v", v)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BytesTy, abi.FixedBytesTy:
+		s, ok := v.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected hex string, got %v", v)
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid hex %s: %v", s, err)
+		}
+		if t.T == abi.BytesTy {
+			return reflect.ValueOf(b), nil
+		}
+		if len(b) != t.Size {
+			return reflect.Value{}, fmt.Errorf("expected %d bytes, got %d", t.Size, len(b))
+		}
+		value := reflect.New(t.GetType()).Elem()
+		reflect.Copy(value, reflect.ValueOf(b))
+		return value, nil
+	case abi.SliceTy, abi.ArrayTy:
+		items, ok := v.([]interface{})
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected array, got %v", v)
+		}
+		var value reflect.Value
+		if t.T == abi.SliceTy {
+			value = reflect.MakeSlice(t.GetType(), len(items), len(items))
+		} else {
+			if len(items) != t.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d items, got %d", t.Size, len(items))
+			}
+			value = reflect.New(t.GetType()).Elem()
+		}
+		for i, item := range items {
+			elem, err := abiValue(*t.Elem, item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("item %d: %v", i, err)
+			}
+			value.Index(i).Set(elem)
+		}
+		return value, nil
+	case abi.TupleTy:
+		items := make([]interface{}, len(t.TupleElems))
+		switch fields := v.(type) {
+		case map[string]interface{}:
+			for i, name := range t.TupleRawNames {
+				field, ok := fields[name]
+				if !ok {
+					return reflect.Value{}, fmt.Errorf("missing field %s", name)
+				}
+				items[i] = field
+			}
+		case []interface{}:
+			if len(fields) != len(items) {
+				return reflect.Value{}, fmt.Errorf("expected %d fields, got %d", len(items), len(fields))
+			}
+			copy(items, fields)
+		default:
+			return reflect.Value{}, fmt.Errorf("expected object, got %v", v)
+		}
+		value := reflect.New(t.TupleType).Elem()
+		for i, item := range items {
+			field, err := abiValue(*t.TupleElems[i], item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("field %s: %v", t.TupleRawNames[i], err)
+			}
+			value.Field(i).Set(field)
+		}
+		return value, nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported type %s", t)
+}
+
+// bigValue accepts integers as decimal or hex strings and as JSON numbers. Numbers past
+// 2^53 lose precision when decoded as float64, so they must be passed as strings.
+func bigValue(v interface{}) (*big.Int, error) {
+	switch n := v.(type) {
+	case string:
+		value, ok := new(big.Int).SetString(n, 10)
+		if strings.HasPrefix(n, "0x") || strings.HasPrefix(n, "-0x") {
+			value, ok = new(big.Int).SetString(strings.Replace(n, "0x", "", 1), 16)
+		}
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", n)
+		}
+		return value, nil
+	case json.Number:
+		value, ok := new(big.Int).SetString(n.String(), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", n)
+		}
+		return value, nil
+	case float64:
+		if n != math.Trunc(n) || math.Abs(n) > 1<<53 {
+			return nil, fmt.Errorf("invalid integer %v, pass large integers as strings", n)
+		}
+		return big.NewInt(int64(n)), nil
+	}
+	return nil, fmt.Errorf("expected integer, got %v", v)
+}
+
+func fits(t abi.Type, n *big.Int) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	return n.Cmp(limit) < 0 && n.Cmp(new(big.Int).Neg(limit)) >= 0
+}
+
+// CallMsg is an eth_call message, Value is in wei. An empty To calls contract creation.
+type CallMsg struct {
+	From  string          `json:"from,omitempty"`
+	To    string          `json:"to,omitempty"`
+	Gas   *hexutil.Uint64 `json:"gas,omitempty"`
+	Data  hexutil.Bytes   `json:"data,omitempty"`
+	Value *hexutil.Big    `json:"value,omitempty"`
+}
+
+// CallResult is the outcome of an eth_call. A revert is a result rather than an error, so
+// its data can be decoded with the ABI of the contract.
+type CallResult struct {
+	Output     []byte
+	Reverted   bool
+	Message    string
+	RevertData []byte
+}
+
+// UnsignedTransaction is an RLP encoded transaction, as hex, for SignTransaction. GasPrice
+// is the fee cap of EIP-1559 transactions, L1Fee is only set on OP-stack chains.
+type UnsignedTransaction struct {
+	Tx        string
+	Fee       float64
+	Nonce     uint64
+	Gas       uint64
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	L1Fee     *big.Int
+}
+
+// Call runs msg against the state at a hex block number or tag.
+func (s *service) Call(ctx context.Context, msg CallMsg, block string, network string) (*CallResult, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	request := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "eth_call",
+		Params:  []interface{}{msg, block},
+		Id:      id.String(),
+	}
+
+	response := struct {
+		JsonRpc string        `json:"jsonrpc"`
+		Id      string        `json:"id"`
+		Result  hexutil.Bytes `json:"result"`
+		Error   struct {
+			Code    int64       `json:"code"`
+			Message string      `json:"message"`
+			Data    interface{} `json:"data"`
+		} `json:"error"`
+	}{}
+
+	body, err := s.ethClient.EncodeBaseRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.ethClient.Send(ctx, body, network)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	if response.Error.Message != "" {
+		err := errors.FromEthereumRPC(response.Error.Code, response.Error.Message)
+		if typed, ok := err.(*errors.Error); !ok || typed.Status != errors.StatusExecutionReverted {
+			return nil, err
+		}
+		result := &CallResult{Reverted: true, Message: response.Error.Message}
+		if data, ok := response.Error.Data.(string); ok {
+			result.RevertData, _ = hexutil.Decode(data)
+		}
+		return result, nil
+	}
+
+	return &CallResult{Output: response.Result}, nil
+}
+
+// BuildTransaction builds an unsigned transaction sending value wei and data to toAddress,
+// with the pending nonce of fromAddress and the estimated gas. The transaction is EIP-1559
+// on chains that support it and legacy otherwise, the fee is its maximum plus the L1 data
+// fee on OP-stack chains.
+func (s *service) BuildTransaction(ctx context.Context, fromAddress, toAddress string, data []byte, value *big.Int, network string) (*UnsignedTransaction, error) {
+	nonce, err := s.PendingNonceAt(ctx, fromAddress, network)
+	if err != nil {
+		return nil, err
+	}
+
+	decodeNonce, err := hexutil.DecodeUint64(*nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, err := s.SuggestGasPrice(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	decodeGasPrice, err := hexutil.DecodeBig(*gasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	gas, err := s.EstimateGas(ctx, fromAddress, toAddress, string(data), value, decodeGasPrice, network)
+	if err != nil {
+		return nil, err
+	}
+
+	decodeGas, err := hexutil.DecodeUint64(*gas)
+	if err != nil {
+		return nil, err
+	}
+
+	toEthAddress := common.HexToAddress(toAddress)
+
+	unsigned := &UnsignedTransaction{Nonce: decodeNonce, Gas: decodeGas, GasPrice: decodeGasPrice}
+	var tx *types.Transaction
+	if s.chain.DynamicFees() {
+		chainID, err := s.ChainId(ctx, network)
+		if err != nil {
+			return nil, err
+		}
+		unsigned.GasPrice, unsigned.GasTipCap, err = s.suggestFeeCaps(ctx, network)
+		if err != nil {
+			return nil, err
+		}
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     decodeNonce,
+			GasTipCap: unsigned.GasTipCap,
+			GasFeeCap: unsigned.GasPrice,
+			Gas:       decodeGas,
+			To:        &toEthAddress,
+			Value:     value,
+			Data:      data,
+		})
+	} else {
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    decodeNonce,
+			GasPrice: decodeGasPrice,
+			Gas:      decodeGas,
+			To:       &toEthAddress,
+			Value:    value,
+			Data:     data,
+		})
+	}
+
+	fee := new(big.Int).Mul(unsigned.GasPrice, new(big.Int).SetUint64(decodeGas))
+	if s.chain.Rollup == RollupOPStack {
+		if unsigned.L1Fee, err = s.l1Fee(ctx, tx, network); err != nil {
+			return nil, err
+		}
+		fee.Add(fee, unsigned.L1Fee)
+	}
+
+	txBytes, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	unsigned.Tx = hex.EncodeToString(txBytes)
+	unsigned.Fee = ToDecimal(fee, s.chain.Decimals).InexactFloat64()
+	return unsigned, nil
+}
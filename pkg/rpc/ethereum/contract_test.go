@@ -0,0 +1,137 @@
+package ethereum_rpc_test
+
+import (
+	"encoding/json"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	tokenABI = `[
+		{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+		{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"},
+		{"inputs":[{"components":[{"name":"id","type":"uint8"},{"name":"tag","type":"bytes4"}],"name":"order","type":"tuple"},{"name":"flags","type":"bool[2]"}],"name":"submit","outputs":[],"stateMutability":"payable","type":"function"},
+		{"inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}],"name":"InsufficientBalance","type":"error"}
+	]`
+	recipient = "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+)
+
+func TestParseABI(t *testing.T) {
+	_, err := ethereum_rpc.ParseABI(transferABI)
+	assert.EqualError(t, err, "abi declares no methods")
+
+	contract, err := ethereum_rpc.ParseABI(tokenABI)
+	assert.Nil(t, err)
+
+	method, err := ethereum_rpc.Method(contract, "transfer(address, uint256)")
+	assert.Nil(t, err)
+	assert.Equal(t, "transfer", method.Name)
+
+	_, err = ethereum_rpc.Method(contract, "approve")
+	assert.EqualError(t, err, "abi declares no method approve")
+}
+
+func TestPackCall(t *testing.T) {
+	contract, err := ethereum_rpc.ParseABI(tokenABI)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name    string
+		method  string
+		args    string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "transfer with decimal string",
+			method: "transfer",
+			args:   `["` + recipient + `", "1000000000000000000000"]`,
+			want:   "0xa9059cbb000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d35900000000000000000000000000000000000000000000003635c9adc5dea00000",
+		},
+		{
+			name:   "transfer with hex string",
+			method: "transfer",
+			args:   `["` + recipient + `", "0x3635c9adc5dea00000"]`,
+			want:   "0xa9059cbb000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d35900000000000000000000000000000000000000000000003635c9adc5dea00000",
+		},
+		{
+			name:   "tuple and fixed array",
+			method: "submit",
+			args:   `[{"id": 7, "tag": "0xdeadbeef"}, [true, false]]`,
+			want:   "0x3537132f" + strings.Repeat("0", 62) + "07" + "deadbeef" + strings.Repeat("0", 56) + strings.Repeat("0", 63) + "1" + strings.Repeat("0", 64),
+		},
+		{name: "argument count", method: "transfer", args: `["` + recipient + `"]`, wantErr: "transfer(address,uint256) takes 2 arguments, got 1"},
+		{name: "invalid address", method: "transfer", args: `["0x01", 1]`, wantErr: "invalid argument to: expected address, got 0x01"},
+		{name: "negative amount", method: "transfer", args: `["` + recipient + `", -1]`, wantErr: "invalid argument amount: -1 overflows uint256"},
+		{name: "imprecise number", method: "transfer", args: `["` + recipient + `", 1e21]`, wantErr: "invalid argument amount: invalid integer 1e+21, pass large integers as strings"},
+		{name: "overflowing tuple field", method: "submit", args: `[[256, "0xdeadbeef"], [true, false]]`, wantErr: "invalid argument order: field id: 256 overflows uint8"},
+		{name: "short fixed array", method: "submit", args: `[[1, "0xdeadbeef"], [true]]`, wantErr: "invalid argument flags: expected 2 items, got 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method, err := ethereum_rpc.Method(contract, tt.method)
+			assert.Nil(t, err)
+			var args []interface{}
+			assert.Nil(t, json.Unmarshal([]byte(tt.args), &args))
+
+			data, err := ethereum_rpc.PackCall(method, args)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, hexutil.Encode(data))
+		})
+	}
+}
+
+func TestUnpackOutputs(t *testing.T) {
+	contract, err := ethereum_rpc.ParseABI(tokenABI)
+	assert.Nil(t, err)
+	method, err := ethereum_rpc.Method(contract, "balanceOf")
+	assert.Nil(t, err)
+
+	outputs, err := ethereum_rpc.UnpackOutputs(method, hexutil.MustDecode(valueData))
+	assert.Nil(t, err)
+	assert.Equal(t, []ethereum_rpc.Output{{Name: "balance", Type: "uint256", Value: "1000000000000000000000"}}, outputs)
+
+	_, err = ethereum_rpc.UnpackOutputs(method, nil)
+	assert.EqualError(t, err, "failed decode balanceOf(address) output: abi: attempting to unmarshall an empty string while arguments are expected")
+}
+
+func TestRevertReason(t *testing.T) {
+	contract, err := ethereum_rpc.ParseABI(tokenABI)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "error string",
+			data: "0x08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000012" +
+				"696e73756666696369656e742066756e64730000000000000000000000000000",
+			want: "insufficient funds",
+		},
+		{name: "panic", data: "0x4e487b71" + strings.Repeat("0", 62) + "11", want: "panic: 0x11"},
+		{
+			name: "custom error",
+			data: "0xcf479181" + strings.Repeat("0", 63) + "1" + strings.Repeat("0", 63) + "2",
+			want: "InsufficientBalance(1, 2)",
+		},
+		{name: "unknown", data: "0x12345678"},
+		{name: "empty", data: "0x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ethereum_rpc.RevertReason(contract, hexutil.MustDecode(tt.data)))
+		})
+	}
+}
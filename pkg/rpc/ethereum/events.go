@@ -0,0 +1,130 @@
+package ethereum_rpc
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Event is a log decoded with the ABI of the event that emitted it. Args hold JSON
+// friendly values: integers wider than 64 bits as decimal strings, addresses, hashes and
+// byte strings as hex. Indexed strings, bytes and arrays are only known by their hash.
+type Event struct {
+	Name      string
+	Signature string
+	Args      map[string]interface{}
+}
+
+// ParseEventABI parses a JSON ABI, or a fragment of one, that declares the events to
+// decode. A single entry may be passed without the enclosing array.
+func ParseEventABI(fragment string) (*abi.ABI, error) {
+	contract, err := parseABI(fragment)
+	if err != nil {
+		return nil, err
+	}
+	if len(contract.Events) == 0 {
+		return nil, fmt.Errorf("abi declares no events")
+	}
+	return contract, nil
+}
+
+func parseABI(fragment string) (*abi.ABI, error) {
+	fragment = strings.TrimSpace(fragment)
+	if strings.HasPrefix(fragment, "{") {
+		fragment = "[" + fragment + "]"
+	}
+	contract, err := abi.JSON(strings.NewReader(fragment))
+	if err != nil {
+		return nil, fmt.Errorf("invalid abi: %v", err)
+	}
+	return &contract, nil
+}
+
+// DecodeEvent decodes a log emitted by one of the events of contract. It returns nil
+// when the log is anonymous or comes from an event the ABI does not declare.
+func DecodeEvent(contract *abi.ABI, log *Log) (*Event, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil
+	}
+	event, err := contract.EventByID(common.HexToHash(log.Topics[0]))
+	if err != nil {
+		return nil, nil
+	}
+
+	args := make(map[string]interface{})
+	data, err := hexutil.Decode(log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log data: %v", err)
+	}
+	if err := event.Inputs.NonIndexed().UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("failed decode %s data: %v", event.Name, err)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	topics := make([]common.Hash, 0, len(log.Topics)-1)
+	for _, topic := range log.Topics[1:] {
+		topics = append(topics, common.HexToHash(topic))
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, topics); err != nil {
+		return nil, fmt.Errorf("failed decode %s topics: %v", event.Name, err)
+	}
+
+	for name, value := range args {
+		args[name] = jsonValue(reflect.ValueOf(value))
+	}
+	return &Event{Name: event.Name, Signature: event.Sig, Args: args}, nil
+}
+
+var (
+	bigIntType  = reflect.TypeOf(&big.Int{})
+	addressType = reflect.TypeOf(common.Address{})
+	hashType    = reflect.TypeOf(common.Hash{})
+)
+
+// jsonValue converts a value unpacked by the abi package into one that encodes to JSON
+// without losing precision.
+func jsonValue(v reflect.Value) interface{} {
+	switch v.Type() {
+	case bigIntType:
+		return v.Interface().(*big.Int).String()
+	case addressType:
+		return v.Interface().(common.Address).Hex()
+	case hashType:
+		return v.Interface().(common.Hash).Hex()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = jsonValue(v.Index(i))
+		}
+		return items
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Tag.Get("json")
+			if name == "" {
+				name = v.Type().Field(i).Name
+			}
+			fields[name] = jsonValue(v.Field(i))
+		}
+		return fields
+	}
+	return v.Interface()
+}
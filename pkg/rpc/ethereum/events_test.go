@@ -0,0 +1,85 @@
+package ethereum_rpc_test
+
+import (
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	transferABI   = `{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}`
+	transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	fromTopic     = "0x0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	toTopic       = "0x000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d359"
+	// valueData is 10^21, beyond the range of a float64 integer.
+	valueData = "0x00000000000000000000000000000000000000000000003635c9adc5dea00000"
+)
+
+func TestParseEventABI(t *testing.T) {
+	tests := []struct {
+		name    string
+		abi     string
+		wantErr string
+	}{
+		{name: "single entry", abi: transferABI},
+		{name: "array", abi: "[" + transferABI + "]"},
+		{name: "no events", abi: `[{"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"type":"function"}]`, wantErr: "abi declares no events"},
+		{name: "invalid json", abi: "[{", wantErr: "invalid abi: unexpected EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contract, err := ethereum_rpc.ParseEventABI(tt.abi)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Contains(t, contract.Events, "Transfer")
+		})
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	contract, err := ethereum_rpc.ParseEventABI(transferABI)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name    string
+		log     ethereum_rpc.Log
+		want    *ethereum_rpc.Event
+		wantErr string
+	}{
+		{
+			name: "transfer",
+			log:  ethereum_rpc.Log{Topics: []string{transferTopic, fromTopic, toTopic}, Data: valueData},
+			want: &ethereum_rpc.Event{
+				Name:      "Transfer",
+				Signature: "Transfer(address,address,uint256)",
+				Args: map[string]interface{}{
+					"from":  "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+					"to":    "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+					"value": "1000000000000000000000",
+				},
+			},
+		},
+		{name: "unknown event", log: ethereum_rpc.Log{Topics: []string{fromTopic}, Data: "0x"}},
+		{name: "anonymous", log: ethereum_rpc.Log{Data: valueData}},
+		{
+			name:    "erc721 transfer",
+			log:     ethereum_rpc.Log{Topics: []string{transferTopic, fromTopic, toTopic, fromTopic}, Data: "0x"},
+			wantErr: "failed decode Transfer data: abi: attempting to unmarshall an empty string while arguments are expected",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := ethereum_rpc.DecodeEvent(contract, &tt.log)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, event)
+		})
+	}
+}
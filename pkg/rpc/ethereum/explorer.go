@@ -0,0 +1,145 @@
+package ethereum_rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"nn-blockchain-api/pkg/errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+)
+
+// Block is an eth_getBlockBy* result. Transactions holds hashes, or the transactions
+// themselves when they were requested in full.
+type Block struct {
+	BlockHeader
+	Size             string          `json:"size"`
+	StateRoot        string          `json:"stateRoot"`
+	TransactionsRoot string          `json:"transactionsRoot"`
+	ReceiptsRoot     string          `json:"receiptsRoot"`
+	ExtraData        string          `json:"extraData"`
+	Transactions     json.RawMessage `json:"transactions"`
+	Uncles           []string        `json:"uncles"`
+}
+
+func (b *Block) TransactionHashes() ([]string, error) {
+	var hashes []string
+	if err := json.Unmarshal(b.Transactions, &hashes); err != nil {
+		return nil, fmt.Errorf("block %s has no transaction hashes: %v", b.Hash, err)
+	}
+	return hashes, nil
+}
+
+func (b *Block) FullTransactions() ([]TransactionByHashResponse, error) {
+	var txs []TransactionByHashResponse
+	if err := json.Unmarshal(b.Transactions, &txs); err != nil {
+		return nil, fmt.Errorf("block %s has no full transactions: %v", b.Hash, err)
+	}
+	return txs, nil
+}
+
+// LogFilter is an eth_getLogs filter over a block range given as hex quantities. A nil
+// entry of Topics matches any topic at its position.
+type LogFilter struct {
+	FromBlock string     `json:"fromBlock"`
+	ToBlock   string     `json:"toBlock"`
+	Addresses []string   `json:"address,omitempty"`
+	Topics    [][]string `json:"topics,omitempty"`
+}
+
+type Log struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+// BlockByNumber returns the block at a hex number or tag, nil when the chain has no such block yet.
+func (s *service) BlockByNumber(ctx context.Context, number string, full bool, network string) (*Block, error) {
+	var block *Block
+	if err := s.call(ctx, "eth_getBlockByNumber", []interface{}{number, full}, network, &block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// BlockByHash returns nil when the node does not know the block.
+func (s *service) BlockByHash(ctx context.Context, hash string, full bool, network string) (*Block, error) {
+	var block *Block
+	if err := s.call(ctx, "eth_getBlockByHash", []interface{}{hash, full}, network, &block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// BalanceAt returns the balance of account in wei at a hex block number or tag.
+func (s *service) BalanceAt(ctx context.Context, account string, block string, network string) (*big.Int, error) {
+	var balance hexutil.Big
+	if err := s.call(ctx, "eth_getBalance", []interface{}{account, block}, network, &balance); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&balance), nil
+}
+
+func (s *service) GetLogs(ctx context.Context, filter LogFilter, network string) ([]Log, error) {
+	var logs []Log
+	if err := s.call(ctx, "eth_getLogs", []interface{}{filter}, network, &logs); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// call runs a JSON-RPC method and decodes its result into result.
+func (s *service) call(ctx context.Context, method string, params []interface{}, network string, result interface{}) error {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return err
+	}
+
+	request := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  method,
+		Params:  params,
+		Id:      id.String(),
+	}
+
+	msg := struct {
+		JsonRpc string      `json:"jsonrpc"`
+		Id      string      `json:"id"`
+		Result  interface{} `json:"result"`
+		Error   struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{Result: result}
+
+	body, err := s.ethClient.EncodeBaseRequest(request)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.ethClient.Send(ctx, body, network)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return err
+	}
+
+	if msg.Error.Message != "" {
+		return errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return nil
+}
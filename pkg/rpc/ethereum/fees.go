@@ -0,0 +1,86 @@
+package ethereum_rpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// gasPriceOracle is the OP-stack predeploy that quotes the L1 data fee of a transaction.
+const gasPriceOracle = "0x420000000000000000000000000000000000000F"
+
+var gasPriceOracleABI = func() abi.ABI {
+	contract, err := abi.JSON(strings.NewReader(`[{"inputs":[{"name":"_data","type":"bytes"}],"name":"getL1Fee","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`))
+	if err != nil {
+		panic(err)
+	}
+	return contract
+}()
+
+func (s *service) SuggestGasTipCap(ctx context.Context, network string) (*big.Int, error) {
+	var tip hexutil.Big
+	if err := s.call(ctx, "eth_maxPriorityFeePerGas", []interface{}{}, network, &tip); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&tip), nil
+}
+
+// suggestFeeCaps returns a fee cap that survives the base fee doubling, the most it can
+// grow over six full blocks, and the suggested tip.
+func (s *service) suggestFeeCaps(ctx context.Context, network string) (*big.Int, *big.Int, error) {
+	tip, err := s.SuggestGasTipCap(ctx, network)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := s.HeaderByNumber(ctx, "latest", network)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header.BaseFeePerGas == "" {
+		return nil, nil, fmt.Errorf("%s %s network has no base fee, it does not support EIP-1559", s.chain.Name, network)
+	}
+	baseFee, err := hexutil.DecodeBig(header.BaseFeePerGas)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+	return feeCap, tip, nil
+}
+
+// l1Fee asks the gas price oracle what posting the transaction to L1 costs. The oracle
+// accounts for the signature the unsigned transaction does not carry yet.
+func (s *service) l1Fee(ctx context.Context, tx *types.Transaction, network string) (*big.Int, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	data, err := gasPriceOracleABI.Pack("getL1Fee", raw)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.Call(ctx, CallMsg{To: gasPriceOracle, Data: data}, "latest", network)
+	if err != nil {
+		return nil, err
+	}
+	if result.Reverted {
+		return nil, fmt.Errorf("gas price oracle reverted: %s", result.Message)
+	}
+
+	values, err := gasPriceOracleABI.Unpack("getL1Fee", result.Output)
+	if err != nil {
+		return nil, err
+	}
+	fee, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("gas price oracle returned %T", values[0])
+	}
+	return fee, nil
+}
@@ -0,0 +1,133 @@
+package ethereum_rpc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"nn-blockchain-api/pkg/networks"
+	ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum"
+	mock_ethereum_rpc "nn-blockchain-api/pkg/rpc/ethereum/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newNode answers JSON-RPC methods with the results, keyed by method name. eth_chainId
+// defaults to the chain id of the main network.
+func newNode(t *testing.T, controller *gomock.Controller, chain string, node map[string]interface{}) ethereum_rpc.Service {
+	results := map[string]interface{}{"eth_chainId": hexutil.EncodeBig(big.NewInt(ethereum_rpc.KnownChains[chain].Networks[networks.Main].ChainId))}
+	for method, result := range node {
+		results[method] = result
+	}
+
+	client := mock_ethereum_rpc.NewMockClient(controller)
+	client.EXPECT().EncodeBaseRequest(gomock.Any()).DoAndReturn(func(request interface{}) (*bytes.Buffer, error) {
+		data, err := json.Marshal(request)
+		return bytes.NewBuffer(data), err
+	}).AnyTimes()
+	client.EXPECT().Send(gomock.Any(), gomock.Any(), "main").DoAndReturn(func(_ context.Context, body io.Reader, _ string) (*http.Response, error) {
+		var request ethereum_rpc.BaseRequest
+		require.NoError(t, json.NewDecoder(body).Decode(&request))
+		result, ok := results[request.Method]
+		require.True(t, ok, "unexpected call of %s", request.Method)
+		data, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": request.Id, "result": result})
+		require.NoError(t, err)
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(data))}, nil
+	}).AnyTimes()
+
+	svc, err := ethereum_rpc.NewService(client, ethereum_rpc.KnownChains[chain])
+	require.NoError(t, err)
+	return svc
+}
+
+func TestService_BuildTransaction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	from := "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+	to := "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+	node := map[string]interface{}{
+		"eth_getTransactionCount":  "0x3",
+		"eth_gasPrice":             "0x3b9aca00",
+		"eth_estimateGas":          "0x5208",
+		"eth_maxPriorityFeePerGas": "0x5f5e100",
+		"eth_getBlockByNumber":     map[string]interface{}{"number": "0x10", "baseFeePerGas": "0x3b9aca00"},
+		// getL1Fee returns 0.0001 ETH.
+		"eth_call": "0x00000000000000000000000000000000000000000000000000005af3107a4000",
+	}
+
+	tests := []struct {
+		name   string
+		chain  string
+		expect func(t *testing.T, tx *ethereum_rpc.UnsignedTransaction, decoded *types.Transaction)
+	}{
+		{
+			name:  "should build legacy transaction without EIP-1559",
+			chain: "bsc",
+			expect: func(t *testing.T, tx *ethereum_rpc.UnsignedTransaction, decoded *types.Transaction) {
+				assert.Equal(t, uint8(types.LegacyTxType), decoded.Type())
+				assert.Equal(t, big.NewInt(1000000000), tx.GasPrice)
+				assert.Nil(t, tx.L1Fee)
+				assert.Equal(t, 0.000021, tx.Fee)
+			},
+		},
+		{
+			name:  "should build dynamic fee transaction",
+			chain: "polygon",
+			expect: func(t *testing.T, tx *ethereum_rpc.UnsignedTransaction, decoded *types.Transaction) {
+				assert.Equal(t, uint8(types.DynamicFeeTxType), decoded.Type())
+				assert.Equal(t, big.NewInt(137), decoded.ChainId())
+				assert.Equal(t, big.NewInt(2100000000), decoded.GasFeeCap())
+				assert.Equal(t, big.NewInt(100000000), decoded.GasTipCap())
+				assert.Equal(t, 0.0000441, tx.Fee)
+			},
+		},
+		{
+			name:  "should add the L1 data fee on OP-stack chains",
+			chain: "optimism",
+			expect: func(t *testing.T, tx *ethereum_rpc.UnsignedTransaction, decoded *types.Transaction) {
+				assert.Equal(t, big.NewInt(100000000000000), tx.L1Fee)
+				assert.Equal(t, 0.0001441, tx.Fee)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newNode(t, controller, tc.chain, node)
+			tx, err := svc.BuildTransaction(context.Background(), from, to, nil, big.NewInt(1), "main")
+			require.NoError(t, err)
+			assert.Equal(t, uint64(3), tx.Nonce)
+			assert.Equal(t, uint64(21000), tx.Gas)
+
+			raw, err := hex.DecodeString(tx.Tx)
+			require.NoError(t, err)
+			decoded := new(types.Transaction)
+			require.NoError(t, rlp.DecodeBytes(raw, &decoded))
+			tc.expect(t, tx, decoded)
+		})
+	}
+}
+
+func TestService_ChainId(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	chainID, err := newNode(t, controller, "polygon", nil).ChainId(context.Background(), "main")
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(137), chainID)
+
+	// An endpoint of another chain is refused instead of signing for it.
+	_, err = newNode(t, controller, "polygon", map[string]interface{}{"eth_chainId": "0x1"}).ChainId(context.Background(), "main")
+	assert.EqualError(t, err, "polygon main node is on chain 1, the configured chain id is 137")
+}
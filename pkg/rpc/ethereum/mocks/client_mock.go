@@ -37,6 +37,18 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockClient) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClient)(nil).Close))
+}
+
 // EncodeBaseRequest mocks base method.
 func (m *MockClient) EncodeBaseRequest(request interface{}) (*bytes.Buffer, error) {
 	m.ctrl.T.Helper()
@@ -66,3 +78,17 @@ func (mr *MockClientMockRecorder) Send(ctx, body, network interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockClient)(nil).Send), ctx, body, network)
 }
+
+// SetEndpoints mocks base method.
+func (m *MockClient) SetEndpoints(network string, endpoints []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEndpoints", network, endpoints)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEndpoints indicates an expected call of SetEndpoints.
+func (mr *MockClientMockRecorder) SetEndpoints(network, endpoints interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEndpoints", reflect.TypeOf((*MockClient)(nil).SetEndpoints), network, endpoints)
+}
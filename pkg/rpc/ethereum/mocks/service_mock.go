@@ -36,6 +36,111 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// BalanceAt mocks base method.
+func (m *MockService) BalanceAt(ctx context.Context, account, block, network string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAt", ctx, account, block, network)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAt indicates an expected call of BalanceAt.
+func (mr *MockServiceMockRecorder) BalanceAt(ctx, account, block, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockService)(nil).BalanceAt), ctx, account, block, network)
+}
+
+// BlockByHash mocks base method.
+func (m *MockService) BlockByHash(ctx context.Context, hash string, full bool, network string) (*ethereum_rpc.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockByHash", ctx, hash, full, network)
+	ret0, _ := ret[0].(*ethereum_rpc.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockByHash indicates an expected call of BlockByHash.
+func (mr *MockServiceMockRecorder) BlockByHash(ctx, hash, full, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockByHash", reflect.TypeOf((*MockService)(nil).BlockByHash), ctx, hash, full, network)
+}
+
+// BlockByNumber mocks base method.
+func (m *MockService) BlockByNumber(ctx context.Context, number string, full bool, network string) (*ethereum_rpc.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockByNumber", ctx, number, full, network)
+	ret0, _ := ret[0].(*ethereum_rpc.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockByNumber indicates an expected call of BlockByNumber.
+func (mr *MockServiceMockRecorder) BlockByNumber(ctx, number, full, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockByNumber", reflect.TypeOf((*MockService)(nil).BlockByNumber), ctx, number, full, network)
+}
+
+// BlockNumber mocks base method.
+func (m *MockService) BlockNumber(ctx context.Context, network string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockNumber", ctx, network)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockNumber indicates an expected call of BlockNumber.
+func (mr *MockServiceMockRecorder) BlockNumber(ctx, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockService)(nil).BlockNumber), ctx, network)
+}
+
+// BuildTransaction mocks base method.
+func (m *MockService) BuildTransaction(ctx context.Context, fromAddress, toAddress string, data []byte, value *big.Int, network string) (*ethereum_rpc.UnsignedTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildTransaction", ctx, fromAddress, toAddress, data, value, network)
+	ret0, _ := ret[0].(*ethereum_rpc.UnsignedTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildTransaction indicates an expected call of BuildTransaction.
+func (mr *MockServiceMockRecorder) BuildTransaction(ctx, fromAddress, toAddress, data, value, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildTransaction", reflect.TypeOf((*MockService)(nil).BuildTransaction), ctx, fromAddress, toAddress, data, value, network)
+}
+
+// Call mocks base method.
+func (m *MockService) Call(ctx context.Context, msg ethereum_rpc.CallMsg, block, network string) (*ethereum_rpc.CallResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Call", ctx, msg, block, network)
+	ret0, _ := ret[0].(*ethereum_rpc.CallResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockServiceMockRecorder) Call(ctx, msg, block, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockService)(nil).Call), ctx, msg, block, network)
+}
+
+// ChainId mocks base method.
+func (m *MockService) ChainId(ctx context.Context, network string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainId", ctx, network)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainId indicates an expected call of ChainId.
+func (mr *MockServiceMockRecorder) ChainId(ctx, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainId", reflect.TypeOf((*MockService)(nil).ChainId), ctx, network)
+}
+
 // CreateTransaction mocks base method.
 func (m *MockService) CreateTransaction(ctx context.Context, fromAddress, toAddress string, amount float64, network string) (*string, *float64, error) {
 	m.ctrl.T.Helper()
@@ -67,19 +172,19 @@ func (mr *MockServiceMockRecorder) EstimateGas(ctx, fromAddress, toAddress, data
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockService)(nil).EstimateGas), ctx, fromAddress, toAddress, data, value, gasPrice, network)
 }
 
-// GetNetworkId mocks base method.
-func (m *MockService) GetNetworkId(ctx context.Context, network string) (*big.Int, error) {
+// GetLogs mocks base method.
+func (m *MockService) GetLogs(ctx context.Context, filter ethereum_rpc.LogFilter, network string) ([]ethereum_rpc.Log, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNetworkId", ctx, network)
-	ret0, _ := ret[0].(*big.Int)
+	ret := m.ctrl.Call(m, "GetLogs", ctx, filter, network)
+	ret0, _ := ret[0].([]ethereum_rpc.Log)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNetworkId indicates an expected call of GetNetworkId.
-func (mr *MockServiceMockRecorder) GetNetworkId(ctx, network interface{}) *gomock.Call {
+// GetLogs indicates an expected call of GetLogs.
+func (mr *MockServiceMockRecorder) GetLogs(ctx, filter, network interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkId", reflect.TypeOf((*MockService)(nil).GetNetworkId), ctx, network)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockService)(nil).GetLogs), ctx, filter, network)
 }
 
 // GetTransactionByHash mocks base method.
@@ -97,6 +202,36 @@ func (mr *MockServiceMockRecorder) GetTransactionByHash(ctx, tx, network interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByHash", reflect.TypeOf((*MockService)(nil).GetTransactionByHash), ctx, tx, network)
 }
 
+// HeaderByNumber mocks base method.
+func (m *MockService) HeaderByNumber(ctx context.Context, number, network string) (*ethereum_rpc.BlockHeader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeaderByNumber", ctx, number, network)
+	ret0, _ := ret[0].(*ethereum_rpc.BlockHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeaderByNumber indicates an expected call of HeaderByNumber.
+func (mr *MockServiceMockRecorder) HeaderByNumber(ctx, number, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByNumber", reflect.TypeOf((*MockService)(nil).HeaderByNumber), ctx, number, network)
+}
+
+// PeerCount mocks base method.
+func (m *MockService) PeerCount(ctx context.Context, network string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeerCount", ctx, network)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeerCount indicates an expected call of PeerCount.
+func (mr *MockServiceMockRecorder) PeerCount(ctx, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeerCount", reflect.TypeOf((*MockService)(nil).PeerCount), ctx, network)
+}
+
 // PendingNonceAt mocks base method.
 func (m *MockService) PendingNonceAt(ctx context.Context, account, network string) (*string, error) {
 	m.ctrl.T.Helper()
@@ -171,3 +306,18 @@ func (mr *MockServiceMockRecorder) SuggestGasPrice(ctx, network interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrice", reflect.TypeOf((*MockService)(nil).SuggestGasPrice), ctx, network)
 }
+
+// SuggestGasTipCap mocks base method.
+func (m *MockService) SuggestGasTipCap(ctx context.Context, network string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestGasTipCap", ctx, network)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasTipCap indicates an expected call of SuggestGasTipCap.
+func (mr *MockServiceMockRecorder) SuggestGasTipCap(ctx, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasTipCap", reflect.TypeOf((*MockService)(nil).SuggestGasTipCap), ctx, network)
+}
@@ -4,9 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
-	"errors"
+	gErrors "errors"
 	"fmt"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -14,34 +13,54 @@ import (
 	"github.com/google/uuid"
 	"io/ioutil"
 	"math/big"
+	"nn-blockchain-api/pkg/errors"
+	"sync"
 )
 
 //go:generate mockgen -source=service.go -destination=mocks/service_mock.go
 type Service interface {
 	Status(ctx context.Context, network string) (*StatusNodeResponse, error)
+	BlockNumber(ctx context.Context, network string) (uint64, error)
+	HeaderByNumber(ctx context.Context, number string, network string) (*BlockHeader, error)
+	PeerCount(ctx context.Context, network string) (uint64, error)
+	BlockByNumber(ctx context.Context, number string, full bool, network string) (*Block, error)
+	BlockByHash(ctx context.Context, hash string, full bool, network string) (*Block, error)
+	GetLogs(ctx context.Context, filter LogFilter, network string) ([]Log, error)
+	Call(ctx context.Context, msg CallMsg, block string, network string) (*CallResult, error)
 
 	PendingNonceAt(ctx context.Context, account string, network string) (*string, error)
+	BalanceAt(ctx context.Context, account string, block string, network string) (*big.Int, error)
 	SuggestGasPrice(ctx context.Context, network string) (*string, error)
+	SuggestGasTipCap(ctx context.Context, network string) (*big.Int, error)
 	EstimateGas(ctx context.Context, fromAddress, toAddress, data string, value, gasPrice *big.Int, network string) (*string, error)
 
-	GetNetworkId(ctx context.Context, network string) (*big.Int, error)
+	// ChainId returns the configured chain id of the network, once it checked the node reports
+	// the same through eth_chainId. Transactions are built, signed and simulated for it.
+	ChainId(ctx context.Context, network string) (*big.Int, error)
 	GetTransactionByHash(ctx context.Context, tx string, network string) (*TransactionByHashResponse, error)
 
 	CreateTransaction(ctx context.Context, fromAddress, toAddress string, amount float64, network string) (*string, *float64, error)
+	BuildTransaction(ctx context.Context, fromAddress, toAddress string, data []byte, value *big.Int, network string) (*UnsignedTransaction, error)
 	SignTransaction(ctx context.Context, tx, privateKey string, network string) (*string, error)
 	SendTransaction(ctx context.Context, signedTx, network string) (*string, error)
 }
 
 type service struct {
 	ethClient Client
+	chain     Chain
+	// verified holds the networks whose node reported the configured chain id.
+	verified sync.Map
 }
 
-func NewService(ethClient Client) (Service, error) {
+func NewService(ethClient Client, chain Chain) (Service, error) {
 	if ethClient == nil {
-		return nil, errors.New("invalid ethereum rpc client")
+		return nil, gErrors.New("invalid ethereum rpc client")
+	}
+	if chain.Name == "" || chain.Decimals <= 0 {
+		return nil, gErrors.New("invalid chain")
 	}
 
-	return &service{ethClient: ethClient}, nil
+	return &service{ethClient: ethClient, chain: chain}, nil
 }
 
 func (s *service) Status(ctx context.Context, network string) (*StatusNodeResponse, error) {
@@ -90,7 +109,7 @@ func (s *service) Status(ctx context.Context, network string) (*StatusNodeRespon
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	var result *StatusNodeResponse
@@ -114,6 +133,152 @@ func (s *service) Status(ctx context.Context, network string) (*StatusNodeRespon
 	return result, nil
 }
 
+func (s *service) BlockNumber(ctx context.Context, network string) (uint64, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return 0, err
+	}
+
+	request := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "eth_blockNumber",
+		Params:  []interface{}{},
+		Id:      id.String(),
+	}
+
+	msg := struct {
+		JsonRpc string `json:"jsonrpc"`
+		Id      string `json:"id"`
+		Result  string `json:"result"`
+		Error   struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	body, err := s.ethClient.EncodeBaseRequest(request)
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := s.ethClient.Send(ctx, body, network)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return 0, err
+	}
+
+	if msg.Error.Message != "" {
+		return 0, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return hexutil.DecodeUint64(msg.Result)
+}
+
+// HeaderByNumber returns the block header at a hex number or tag such as "latest".
+func (s *service) HeaderByNumber(ctx context.Context, number string, network string) (*BlockHeader, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	request := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "eth_getBlockByNumber",
+		Params:  []interface{}{number, false},
+		Id:      id.String(),
+	}
+
+	msg := struct {
+		JsonRpc string       `json:"jsonrpc"`
+		Id      string       `json:"id"`
+		Result  *BlockHeader `json:"result"`
+		Error   struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	body, err := s.ethClient.EncodeBaseRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.ethClient.Send(ctx, body, network)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Error.Message != "" {
+		return nil, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	if msg.Result == nil {
+		return nil, fmt.Errorf("block %s not found", number)
+	}
+
+	return msg.Result, nil
+}
+
+func (s *service) PeerCount(ctx context.Context, network string) (uint64, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return 0, err
+	}
+
+	request := BaseRequest{
+		JsonRpc: "2.0",
+		Method:  "net_peerCount",
+		Params:  []interface{}{},
+		Id:      id.String(),
+	}
+
+	msg := struct {
+		JsonRpc string `json:"jsonrpc"`
+		Id      string `json:"id"`
+		Result  string `json:"result"`
+		Error   struct {
+			Code    int64  `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	body, err := s.ethClient.EncodeBaseRequest(request)
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := s.ethClient.Send(ctx, body, network)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&msg)
+	if err != nil {
+		return 0, err
+	}
+
+	if msg.Error.Message != "" {
+		return 0, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
+	}
+
+	return hexutil.DecodeUint64(msg.Result)
+}
+
 func (s *service) PendingNonceAt(ctx context.Context, account string, network string) (*string, error) {
 	id, err := uuid.NewUUID()
 	if err != nil {
@@ -160,7 +325,7 @@ func (s *service) PendingNonceAt(ctx context.Context, account string, network st
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return &msg.Result, nil
@@ -212,7 +377,7 @@ func (s *service) SuggestGasPrice(ctx context.Context, network string) (*string,
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return &msg.Result, nil
@@ -275,13 +440,22 @@ func (s *service) EstimateGas(ctx context.Context, fromAddress, toAddress, data
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return &msg.Result, nil
 }
 
-func (s *service) GetNetworkId(ctx context.Context, network string) (*big.Int, error) {
+func (s *service) ChainId(ctx context.Context, network string) (*big.Int, error) {
+	settings, err := s.chain.Network(network)
+	if err != nil {
+		return nil, err
+	}
+	chainID := big.NewInt(settings.ChainId)
+	if _, ok := s.verified.Load(network); ok {
+		return chainID, nil
+	}
+
 	id, err := uuid.NewUUID()
 	if err != nil {
 		return nil, err
@@ -289,7 +463,7 @@ func (s *service) GetNetworkId(ctx context.Context, network string) (*big.Int, e
 
 	request := BaseRequest{
 		JsonRpc: "2.0",
-		Method:  "net_version",
+		Method:  "eth_chainId",
 		Params:  []interface{}{},
 		Id:      id.String(),
 	}
@@ -327,16 +501,20 @@ func (s *service) GetNetworkId(ctx context.Context, network string) (*big.Int, e
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
 	}
 
-	version := new(big.Int)
-
-	if _, ok := version.SetString(msg.Result, 10); !ok {
-		return nil, fmt.Errorf("invalid net_version result %q", msg.Result)
+	reported, err := hexutil.DecodeBig(msg.Result)
+	if err != nil {
+		return nil, fmt.Errorf("invalid eth_chainId result %q", msg.Result)
+	}
+	// A swapped or misconfigured endpoint must not get transactions signed for another chain.
+	if reported.Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("%s %s node is on chain %v, the configured chain id is %v", s.chain.Name, network, reported, chainID)
 	}
 
-	return version, nil
+	s.verified.Store(network, true)
+	return chainID, nil
 }
 
 func (s *service) GetTransactionByHash(ctx context.Context, tx string, network string) (*TransactionByHashResponse, error) {
@@ -385,91 +563,43 @@ func (s *service) GetTransactionByHash(ctx context.Context, tx string, network s
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return &msg.Result, nil
 }
 
 func (s *service) CreateTransaction(ctx context.Context, fromAddress, toAddress string, amount float64, network string) (*string, *float64, error) {
-	nonce, err := s.PendingNonceAt(ctx, fromAddress, network)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	decodeNonce, err := hexutil.DecodeUint64(*nonce)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	value := big.NewInt(ToWei(amount, 18).Int64()) // in wei (1 ethereum)
-
-	gasPrice, err := s.SuggestGasPrice(ctx, network)
-	if err != nil {
-		return nil, nil, err
-	}
+	value := ToWei(amount, s.chain.Decimals)
 
-	decodeGasPrice, err := hexutil.DecodeBig(*gasPrice)
+	tx, err := s.BuildTransaction(ctx, fromAddress, toAddress, nil, value, network)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	gas, err := s.EstimateGas(ctx, fromAddress, toAddress, "", value, decodeGasPrice, network)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	decodeGas, err := hexutil.DecodeUint64(*gas)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	toEthAddress := common.HexToAddress(toAddress)
-	var data []byte
-
-	fee := new(big.Int)
-	fee.SetUint64(decodeGasPrice.Uint64() * decodeGas)
-	floatFee := ToDecimal(fee, 18).InexactFloat64()
-
-	tx := types.NewTx(&types.LegacyTx{
-		Nonce:    decodeNonce,
-		GasPrice: decodeGasPrice,
-		Gas:      decodeGas,
-		To:       &toEthAddress,
-		Value:    value,
-		Data:     data,
-	})
-
-	txBytes, err := rlp.EncodeToBytes(tx)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	rawTxHex := hex.EncodeToString(txBytes)
-
-	return &rawTxHex, &floatFee, nil
+	return &tx.Tx, &tx.Fee, nil
 }
 
 func (s *service) SignTransaction(ctx context.Context, tx, privateKey string, network string) (*string, error) {
-	chainID, err := s.GetNetworkId(ctx, network)
+	chainID, err := s.ChainId(ctx, network)
 	if err != nil {
 		return nil, err
 	}
 
 	privateEthKey, err := crypto.HexToECDSA(privateKey)
 	if err != nil {
-		return nil, err
+		return nil, errors.NewInvalid(errors.StatusInvalidPrivateKey, err.Error())
 	}
 
 	newTx := new(types.Transaction)
 	rawTxBytes, err := hex.DecodeString(tx)
 	if err != nil {
-		return nil, err
+		return nil, errors.NewInvalid(errors.StatusInvalidTxEncoding, err.Error())
 	}
 
 	err = rlp.DecodeBytes(rawTxBytes, &newTx)
 	if err != nil {
-		return nil, err
+		return nil, errors.NewInvalid(errors.StatusInvalidTxEncoding, err.Error())
 	}
 
 	signedTx, err := types.SignTx(newTx, types.NewLondonSigner(chainID), privateEthKey)
@@ -533,7 +663,7 @@ func (s *service) SendTransaction(ctx context.Context, signedTx, network string)
 	}
 
 	if msg.Error.Message != "" {
-		return nil, errors.New(msg.Error.Message)
+		return nil, errors.FromEthereumRPC(msg.Error.Code, msg.Error.Message)
 	}
 
 	return &msg.Result, nil
@@ -38,7 +38,7 @@ func TestNewService(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			svc, err := ethereum_rpc.NewService(tc.ethClient)
+			svc, err := ethereum_rpc.NewService(tc.ethClient, ethereum_rpc.KnownChains["ethereum"])
 			tc.expect(t, svc, err)
 		})
 	}
@@ -38,6 +38,17 @@ type StatusNodeResponse struct {
 	SyncMessage         string `json:"sync_message,omitempty"`
 }
 
+type BlockHeader struct {
+	Number        string `json:"number"`
+	Hash          string `json:"hash"`
+	ParentHash    string `json:"parentHash"`
+	Timestamp     string `json:"timestamp"`
+	Miner         string `json:"miner"`
+	GasLimit      string `json:"gasLimit"`
+	GasUsed       string `json:"gasUsed"`
+	BaseFeePerGas string `json:"baseFeePerGas,omitempty"`
+}
+
 type TransactionByHashResponse struct {
 	BlockHash        string `json:"blockHash"`
 	BlockNumber      string `json:"blockNumber"`
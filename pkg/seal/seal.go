@@ -0,0 +1,122 @@
+package seal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	Cipher = "aes-256-gcm"
+	KDF    = "scrypt"
+
+	scryptR    = 8
+	derivedLen = 32
+)
+
+var (
+	// ErrInvalid is sealed data that is malformed or uses another cipher or KDF.
+	ErrInvalid = errors.New("invalid sealed data")
+	// ErrDecrypt is sealed data the passphrase does not open.
+	ErrDecrypt = errors.New("could not decrypt sealed data")
+)
+
+// Crypto is the crypto section the keystore envelopes and the local secrets files share:
+// AES-256-GCM with a key scrypt derives from a passphrase.
+type Crypto struct {
+	Cipher     string `json:"cipher"`
+	CipherText string `json:"ciphertext"`
+	Nonce      string `json:"nonce"`
+	KDF        string `json:"kdf"`
+	KDFParams  Params `json:"kdfparams"`
+}
+
+type Params struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// Seal encrypts plainText, additionalData is authenticated but not encrypted and must be
+// passed to Open again.
+func Seal(plainText, additionalData []byte, passphrase string, scryptN, scryptP int) (*Crypto, error) {
+	salt, err := random(32)
+	if err != nil {
+		return nil, err
+	}
+	params := Params{N: scryptN, R: scryptR, P: scryptP, DKLen: derivedLen, Salt: hex.EncodeToString(salt)}
+
+	aead, err := newAEAD(params, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := random(aead.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Crypto{
+		Cipher:     Cipher,
+		CipherText: hex.EncodeToString(aead.Seal(nil, nonce, plainText, additionalData)),
+		Nonce:      hex.EncodeToString(nonce),
+		KDF:        KDF,
+		KDFParams:  params,
+	}, nil
+}
+
+func Open(c *Crypto, additionalData []byte, passphrase string) ([]byte, error) {
+	if c.Cipher != Cipher || c.KDF != KDF {
+		return nil, ErrInvalid
+	}
+
+	aead, err := newAEAD(c.KDFParams, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(c.Nonce)
+	if err != nil || len(nonce) != aead.NonceSize() {
+		return nil, ErrInvalid
+	}
+	cipherText, err := hex.DecodeString(c.CipherText)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+
+	plainText, err := aead.Open(nil, nonce, cipherText, additionalData)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plainText, nil
+}
+
+func newAEAD(params Params, passphrase string) (cipher.AEAD, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil || params.DKLen != derivedLen {
+		return nil, ErrInvalid
+	}
+	derived, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func random(n int) ([]byte, error) {
+	out := make([]byte, n)
+	if _, err := io.ReadFull(rand.Reader, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
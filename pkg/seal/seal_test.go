@@ -0,0 +1,36 @@
+package seal_test
+
+import (
+	"nn-blockchain-api/pkg/seal"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeal(t *testing.T) {
+	sealed, err := seal.Seal([]byte("secret"), []byte("id"), "passphrase", 1<<12, 6)
+	require.NoError(t, err)
+	assert.Equal(t, seal.Cipher, sealed.Cipher)
+	assert.Equal(t, seal.KDF, sealed.KDF)
+	assert.NotContains(t, sealed.CipherText, "secret")
+
+	plainText, err := seal.Open(sealed, []byte("id"), "passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), plainText)
+
+	_, err = seal.Open(sealed, []byte("id"), "wrong")
+	assert.Equal(t, seal.ErrDecrypt, err)
+	_, err = seal.Open(sealed, []byte("other"), "passphrase")
+	assert.Equal(t, seal.ErrDecrypt, err)
+
+	tampered := *sealed
+	tampered.KDF = "pbkdf2"
+	_, err = seal.Open(&tampered, []byte("id"), "passphrase")
+	assert.Equal(t, seal.ErrInvalid, err)
+
+	tampered = *sealed
+	tampered.Nonce = "zz"
+	_, err = seal.Open(&tampered, []byte("id"), "passphrase")
+	assert.Equal(t, seal.ErrInvalid, err)
+}
@@ -0,0 +1,114 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"nn-blockchain-api/pkg/seal"
+	"sync"
+)
+
+const localVersion = 1
+
+var (
+	ErrInvalidSecretsFile = errors.New("invalid secrets file")
+	ErrInvalidPassphrase  = errors.New("invalid secrets passphrase")
+)
+
+// localFile seals a JSON object of secret names to values with a key derived from a
+// passphrase, sealed the same way as the keys of the keystore.
+type localFile struct {
+	Version int         `json:"version"`
+	Crypto  seal.Crypto `json:"crypto"`
+}
+
+type localProvider struct {
+	path       string
+	passphrase string
+
+	once    sync.Once
+	secrets map[string]string
+	err     error
+}
+
+// NewLocalProvider reads secrets from a file WriteLocalFile sealed. The file is opened on
+// the first secret asked for, so an unused file costs no key derivation.
+func NewLocalProvider(path, passphrase string) (Provider, error) {
+	if path == "" {
+		return nil, errors.New("invalid secrets file path")
+	}
+	if passphrase == "" {
+		return nil, ErrInvalidPassphrase
+	}
+
+	return &localProvider{path: path, passphrase: passphrase}, nil
+}
+
+func (p *localProvider) Secret(_ context.Context, name string) (string, error) {
+	p.once.Do(func() {
+		p.secrets, p.err = ReadLocalFile(p.path, p.passphrase)
+	})
+	if p.err != nil {
+		return "", p.err
+	}
+
+	secret, ok := p.secrets[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return secret, nil
+}
+
+// WriteLocalFile seals secrets to path, readable by the owner only.
+func WriteLocalFile(path string, secrets map[string]string, passphrase string, scryptN, scryptP int) error {
+	if passphrase == "" {
+		return ErrInvalidPassphrase
+	}
+	plainText, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	sealed, err := seal.Seal(plainText, nil, passphrase, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(localFile{Version: localVersion, Crypto: *sealed}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+// ReadLocalFile opens the secrets of a file WriteLocalFile sealed.
+func ReadLocalFile(path, passphrase string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file localFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, ErrInvalidSecretsFile
+	}
+	if file.Version != localVersion {
+		return nil, ErrInvalidSecretsFile
+	}
+
+	plainText, err := seal.Open(&file.Crypto, nil, passphrase)
+	switch {
+	case errors.Is(err, seal.ErrInvalid):
+		return nil, ErrInvalidSecretsFile
+	case errors.Is(err, seal.ErrDecrypt):
+		return nil, ErrInvalidPassphrase
+	case err != nil:
+		return nil, err
+	}
+	var secrets map[string]string
+	if err := json.Unmarshal(plainText, &secrets); err != nil {
+		return nil, ErrInvalidSecretsFile
+	}
+	return secrets, nil
+}
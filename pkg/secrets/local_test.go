@@ -0,0 +1,54 @@
+package secrets_test
+
+import (
+	"context"
+	"io/ioutil"
+	"nn-blockchain-api/pkg/keystore"
+	"nn-blockchain-api/pkg/secrets"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLocalProvider(t *testing.T) {
+	provider, err := secrets.NewLocalProvider("", "passphrase")
+	assert.Nil(t, provider)
+	assert.EqualError(t, err, "invalid secrets file path")
+
+	provider, err = secrets.NewLocalProvider("secrets.json", "")
+	assert.Nil(t, provider)
+	assert.ErrorIs(t, err, secrets.ErrInvalidPassphrase)
+}
+
+func TestLocalProvider_Secret(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "secrets.json")
+	err := secrets.WriteLocalFile(path, map[string]string{"btc_rpc_password": "hunter2"}, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	assert.Nil(t, err)
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "hunter2")
+
+	provider, err := secrets.NewLocalProvider(path, "passphrase")
+	assert.Nil(t, err)
+	secret, err := provider.Secret(ctx, "btc_rpc_password")
+	assert.Nil(t, err)
+	assert.Equal(t, "hunter2", secret)
+	_, err = provider.Secret(ctx, "ltc_rpc_password")
+	assert.ErrorIs(t, err, secrets.ErrNotFound)
+
+	provider, err = secrets.NewLocalProvider(path, "wrong")
+	assert.Nil(t, err)
+	_, err = provider.Secret(ctx, "btc_rpc_password")
+	assert.ErrorIs(t, err, secrets.ErrInvalidPassphrase)
+
+	tampered := filepath.Join(t.TempDir(), "tampered.json")
+	assert.Nil(t, ioutil.WriteFile(tampered, []byte(strings.Replace(string(data), `"version": 1`, `"version": 2`, 1)), 0600))
+	provider, err = secrets.NewLocalProvider(tampered, "passphrase")
+	assert.Nil(t, err)
+	_, err = provider.Secret(ctx, "btc_rpc_password")
+	assert.ErrorIs(t, err, secrets.ErrInvalidSecretsFile)
+}
@@ -0,0 +1,101 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Prefix marks a setting that references a secret as secret:<provider>:<name>, for example
+// secret:vault:nn/bitcoin#password or secret:file:/run/secrets/btc_rpc_password.
+const Prefix = "secret:"
+
+var ErrNotFound = errors.New("secret not found")
+
+//go:generate mockgen -source=secrets.go -destination=mocks/secrets_mock.go
+type Provider interface {
+	// Secret returns the value of a secret, ErrNotFound when the provider has none by that name.
+	Secret(ctx context.Context, name string) (string, error)
+}
+
+// Resolver resolves references by the provider they name. Every reference is fetched once
+// for the lifetime of the resolver, a refresh takes a new one.
+type Resolver struct {
+	providers map[string]Provider
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+func NewResolver(providers map[string]Provider) (*Resolver, error) {
+	for name, provider := range providers {
+		if name == "" || provider == nil {
+			return nil, fmt.Errorf("invalid %q secrets provider", name)
+		}
+	}
+
+	return &Resolver{providers: providers, cache: map[string]string{}}, nil
+}
+
+// IsReference reports whether a setting references a secret.
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, Prefix)
+}
+
+// Resolve returns the secret a value references and any other value unchanged. Errors
+// name the reference, never the secret.
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	if !IsReference(value) {
+		return value, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if secret, ok := r.cache[value]; ok {
+		return secret, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(value, Prefix), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid secret reference %q, expected %s<provider>:<name>", value, Prefix)
+	}
+	provider, ok := r.providers[parts[0]]
+	if !ok {
+		return "", fmt.Errorf("secret %s: unknown provider %q", value, parts[0])
+	}
+
+	secret, err := provider.Secret(ctx, parts[1])
+	if err != nil {
+		return "", fmt.Errorf("secret %s: %w", value, err)
+	}
+	r.cache[value] = secret
+	return secret, nil
+}
+
+type fileProvider struct{}
+
+// NewFileProvider reads every secret from the file its name is the path of, the way
+// Docker and Kubernetes mount them.
+func NewFileProvider() Provider {
+	return fileProvider{}
+}
+
+func (fileProvider) Secret(_ context.Context, name string) (string, error) {
+	return ReadFile(name)
+}
+
+// ReadFile reads a secret file without the line break editors and echo leave at its end.
+func ReadFile(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
@@ -0,0 +1,102 @@
+package secrets_test
+
+import (
+	"context"
+	gErrors "errors"
+	"io/ioutil"
+	"nn-blockchain-api/pkg/secrets"
+	mock_secrets "nn-blockchain-api/pkg/secrets/mocks"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewResolver(t *testing.T) {
+	resolver, err := secrets.NewResolver(map[string]secrets.Provider{"vault": nil})
+	assert.Nil(t, resolver)
+	assert.EqualError(t, err, `invalid "vault" secrets provider`)
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+	provider := mock_secrets.NewMockProvider(controller)
+	provider.EXPECT().Secret(ctx, "nn/bitcoin#password").Return("hunter2", nil).Times(1)
+	provider.EXPECT().Secret(ctx, "nn/bitcoin#user").Return("", secrets.ErrNotFound)
+	provider.EXPECT().Secret(ctx, "nn/down#user").Return("", gErrors.New("connection refused"))
+
+	resolver, err := secrets.NewResolver(map[string]secrets.Provider{"vault": provider})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name          string
+		value         string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "should return a plain value unchanged",
+			value:    "http://localhost:18332",
+			expected: "http://localhost:18332",
+		},
+		{
+			name:     "should resolve a reference",
+			value:    "secret:vault:nn/bitcoin#password",
+			expected: "hunter2",
+		},
+		{
+			name:     "should resolve a reference once",
+			value:    "secret:vault:nn/bitcoin#password",
+			expected: "hunter2",
+		},
+		{
+			name:          "should return error for a missing secret",
+			value:         "secret:vault:nn/bitcoin#user",
+			expectedError: "secret secret:vault:nn/bitcoin#user: secret not found",
+		},
+		{
+			name:          "should return the provider error",
+			value:         "secret:vault:nn/down#user",
+			expectedError: "secret secret:vault:nn/down#user: connection refused",
+		},
+		{
+			name:          "should return error for an unknown provider",
+			value:         "secret:aws:password",
+			expectedError: `secret secret:aws:password: unknown provider "aws"`,
+		},
+		{
+			name:          "should return error for a reference without name",
+			value:         "secret:vault",
+			expectedError: `invalid secret reference "secret:vault", expected secret:<provider>:<name>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, err := resolver.Resolve(ctx, tt.value)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, secret)
+		})
+	}
+}
+
+func TestFileProvider_Secret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "btc_rpc_password")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("hunter2\n"), 0600))
+
+	provider := secrets.NewFileProvider()
+	secret, err := provider.Secret(context.Background(), path)
+	assert.Nil(t, err)
+	assert.Equal(t, "hunter2", secret)
+
+	_, err = provider.Secret(context.Background(), path+".missing")
+	assert.ErrorIs(t, err, secrets.ErrNotFound)
+}
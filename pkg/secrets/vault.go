@@ -0,0 +1,92 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const vaultTimeout = 10 * time.Second
+
+type vaultProvider struct {
+	addr  string
+	token string
+	mount string
+
+	httpClient *http.Client
+}
+
+// NewVaultProvider reads secrets from the KV version 2 engine mounted at mount. Names are
+// path#field, the field of the latest version of the secret at path.
+func NewVaultProvider(addr, token, mount string) (Provider, error) {
+	parsed, err := url.Parse(addr)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, errors.New("invalid vault address")
+	}
+	if token == "" {
+		return nil, errors.New("invalid vault token")
+	}
+	if mount == "" {
+		return nil, errors.New("invalid vault mount")
+	}
+
+	return &vaultProvider{
+		addr:       strings.TrimRight(addr, "/"),
+		token:      token,
+		mount:      strings.Trim(mount, "/"),
+		httpClient: &http.Client{Timeout: vaultTimeout},
+	}, nil
+}
+
+func (p *vaultProvider) Secret(ctx context.Context, name string) (string, error) {
+	parts := strings.SplitN(name, "#", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid vault secret %q, expected path#field", name)
+	}
+	path, field := strings.Trim(parts[0], "/"), parts[1]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.addr+"/v1/"+p.mount+"/data/"+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", ErrNotFound
+	default:
+		return "", fmt.Errorf("vault responded with HTTP %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("invalid vault response: %w", err)
+	}
+
+	value, ok := body.Data.Data[field]
+	if !ok {
+		return "", ErrNotFound
+	}
+	secret, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("vault secret %s field %s is not a string", path, field)
+	}
+	return secret, nil
+}
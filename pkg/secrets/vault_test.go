@@ -0,0 +1,137 @@
+package secrets_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/pkg/secrets"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVaultProvider(t *testing.T) {
+	tests := []struct {
+		name          string
+		addr          string
+		token         string
+		mount         string
+		expectedError string
+	}{
+		{
+			name:          "should return error for an invalid address",
+			addr:          "localhost:8200",
+			token:         "token",
+			mount:         "secret",
+			expectedError: "invalid vault address",
+		},
+		{
+			name:          "should return error without token",
+			addr:          "http://localhost:8200",
+			mount:         "secret",
+			expectedError: "invalid vault token",
+		},
+		{
+			name:          "should return error without mount",
+			addr:          "http://localhost:8200",
+			token:         "token",
+			expectedError: "invalid vault mount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := secrets.NewVaultProvider(tt.addr, tt.token, tt.mount)
+			assert.Nil(t, provider)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestVaultProvider_Secret(t *testing.T) {
+	// The stand-in answers like the KV version 2 engine of a Vault server.
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/nn/bitcoin":
+			_, _ = w.Write([]byte(`{"data":{"data":{"user":"bitcoin","password":"hunter2","port":8332},"metadata":{"version":3}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer vault.Close()
+
+	provider, err := secrets.NewVaultProvider(vault.URL+"/", "token", "secret")
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name          string
+		provider      secrets.Provider
+		secret        string
+		expected      string
+		expectedError string
+		notFound      bool
+	}{
+		{
+			name:     "should return the field of a secret",
+			provider: provider,
+			secret:   "nn/bitcoin#password",
+			expected: "hunter2",
+		},
+		{
+			name:     "should return not found for a missing field",
+			provider: provider,
+			secret:   "nn/bitcoin#token",
+			notFound: true,
+		},
+		{
+			name:     "should return not found for a missing path",
+			provider: provider,
+			secret:   "nn/litecoin#password",
+			notFound: true,
+		},
+		{
+			name:          "should return error for a field that is not a string",
+			provider:      provider,
+			secret:        "nn/bitcoin#port",
+			expectedError: "vault secret nn/bitcoin field port is not a string",
+		},
+		{
+			name:          "should return error for a name without field",
+			provider:      provider,
+			secret:        "nn/bitcoin",
+			expectedError: `invalid vault secret "nn/bitcoin", expected path#field`,
+		},
+		{
+			name: "should return error for a denied token",
+			provider: func() secrets.Provider {
+				denied, err := secrets.NewVaultProvider(vault.URL, "expired", "secret")
+				assert.Nil(t, err)
+				return denied
+			}(),
+			secret:        "nn/bitcoin#password",
+			expectedError: "vault responded with HTTP 403",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, err := tt.provider.Secret(context.Background(), tt.secret)
+			switch {
+			case tt.notFound:
+				assert.ErrorIs(t, err, secrets.ErrNotFound)
+			case tt.expectedError != "":
+				assert.EqualError(t, err, tt.expectedError)
+			default:
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expected, secret)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	gErrors "errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	Addr              string
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	ShutdownTimeout   time.Duration
+
+	// TLSCertFile and TLSKeyFile enable HTTPS; TLSClientCAFile additionally requires
+	// clients to present a certificate signed by one of its CAs (mTLS).
+	TLSCertFile     string
+	TLSKeyFile      string
+	TLSClientCAFile string
+}
+
+// Server runs the HTTP API together with its background workers and shuts both down
+// once the run context is cancelled.
+type Server struct {
+	http    *http.Server
+	cfg     Config
+	logger  *zap.SugaredLogger
+	workers sync.WaitGroup
+}
+
+func New(cfg Config, handler http.Handler, logger *zap.SugaredLogger) (*Server, error) {
+	if handler == nil {
+		return nil, gErrors.New("invalid handler")
+	}
+	if logger == nil {
+		return nil, gErrors.New("invalid logger")
+	}
+	if (cfg.TLSCertFile == "") != (cfg.TLSKeyFile == "") {
+		return nil, gErrors.New("invalid tls config: both cert and key files are required")
+	}
+	if cfg.TLSClientCAFile != "" && cfg.TLSCertFile == "" {
+		return nil, gErrors.New("invalid tls config: client ca requires cert and key files")
+	}
+
+	tlsConfig, err := NewTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		http: &http.Server{
+			Addr:              cfg.Addr,
+			Handler:           handler,
+			TLSConfig:         tlsConfig,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+			ErrorLog:          zap.NewStdLog(logger.Desugar()),
+		},
+		cfg:    cfg,
+		logger: logger,
+	}, nil
+}
+
+// Go runs a background worker whose context is cancelled on shutdown; Run waits for it to return.
+func (s *Server) Go(ctx context.Context, worker func(ctx context.Context)) {
+	s.workers.Add(1)
+	go func() {
+		defer s.workers.Done()
+		worker(ctx)
+	}()
+}
+
+// Run listens on the configured address until ctx is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.cfg.Addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, listener)
+}
+
+// Serve accepts connections on listener until ctx is cancelled, then stops accepting new
+// connections and waits up to ShutdownTimeout for in-flight requests and workers to finish.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	secure := s.http.TLSConfig != nil
+
+	serveErr := make(chan error, 1)
+	go func() {
+		if secure {
+			serveErr <- s.http.ServeTLS(listener, "", "")
+		} else {
+			serveErr <- s.http.Serve(listener)
+		}
+	}()
+
+	s.logger.Infof("HTTP server listening on %s (tls: %t)", listener.Addr(), secure)
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.logger.Info("shutting down HTTP server")
+
+	shutdownCtx := context.Background()
+	if s.cfg.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, s.cfg.ShutdownTimeout)
+		defer cancel()
+	}
+
+	err := s.http.Shutdown(shutdownCtx)
+	if err != nil {
+		s.logger.Errorf("failed to drain in-flight requests: %v", err)
+		_ = s.http.Close()
+	}
+
+	workersDone := make(chan struct{})
+	go func() {
+		s.workers.Wait()
+		close(workersDone)
+	}()
+
+	select {
+	case <-workersDone:
+	case <-shutdownCtx.Done():
+		return shutdownCtx.Err()
+	}
+
+	return err
+}
+
+// NewTLSConfig loads the server certificate, a nil config means plain text. With a client
+// CA file clients must present a certificate signed by it.
+func NewTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		data, err := ioutil.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, gErrors.New("invalid tls client ca file")
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
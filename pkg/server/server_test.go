@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	handler := http.NewServeMux()
+	logger := zap.NewNop().Sugar()
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		handler http.Handler
+		logger  *zap.SugaredLogger
+		err     string
+	}{
+		{
+			name:   "should return invalid handler",
+			logger: logger,
+			err:    "invalid handler",
+		},
+		{
+			name:    "should return invalid logger",
+			handler: handler,
+			err:     "invalid logger",
+		},
+		{
+			name:    "should return missing tls key",
+			cfg:     Config{TLSCertFile: "server.crt"},
+			handler: handler,
+			logger:  logger,
+			err:     "invalid tls config: both cert and key files are required",
+		},
+		{
+			name:    "should return client ca without server cert",
+			cfg:     Config{TLSClientCAFile: "ca.crt"},
+			handler: handler,
+			logger:  logger,
+			err:     "invalid tls config: client ca requires cert and key files",
+		},
+		{
+			name:    "should create plain server",
+			handler: handler,
+			logger:  logger,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, err := New(tc.cfg, tc.handler, tc.logger)
+			if tc.err != "" {
+				assert.Nil(t, srv)
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.NotNil(t, srv)
+		})
+	}
+}
+
+func TestServer_ServeDrainsInFlight(t *testing.T) {
+	started := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(100 * time.Millisecond)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	srv, err := New(Config{ShutdownTimeout: 5 * time.Second}, handler, zap.NewNop().Sugar())
+	assert.Nil(t, err)
+
+	workerStopped := false
+	ctx, cancel := context.WithCancel(context.Background())
+	srv.Go(ctx, func(ctx context.Context) {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		workerStopped = true
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(ctx, listener) }()
+
+	response := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + listener.Addr().String())
+		if err != nil {
+			response <- 0
+			return
+		}
+		resp.Body.Close()
+		response <- resp.StatusCode
+	}()
+
+	<-started
+	cancel()
+
+	assert.Equal(t, http.StatusAccepted, <-response)
+	assert.Nil(t, <-served)
+	assert.True(t, workerStopped)
+}
+
+func TestServer_ServeShutdownTimeout(t *testing.T) {
+	srv, err := New(Config{ShutdownTimeout: 20 * time.Millisecond}, http.NewServeMux(), zap.NewNop().Sugar())
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv.Go(ctx, func(ctx context.Context) {
+		time.Sleep(time.Second)
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	cancel()
+	assert.Equal(t, context.DeadlineExceeded, srv.Serve(ctx, listener))
+}
+
+func TestServer_ServeMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+
+	caCert, caKey := newCertificate(t, nil, nil, true)
+	serverCert, serverKey := newCertificate(t, caCert, caKey, false)
+	clientCert, clientKey := newCertificate(t, caCert, caKey, false)
+
+	cfg := Config{
+		TLSCertFile:     writePEM(t, dir, "server.crt", "CERTIFICATE", serverCert.Raw),
+		TLSKeyFile:      writeKey(t, dir, "server.key", serverKey),
+		TLSClientCAFile: writePEM(t, dir, "ca.crt", "CERTIFICATE", caCert.Raw),
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	srv, err := New(cfg, handler, zap.NewNop().Sugar())
+	assert.Nil(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(ctx, listener) }()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	url := "https://" + listener.Addr().String()
+
+	anonymous := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots}}}
+	_, err = anonymous.Get(url)
+	assert.NotNil(t, err)
+
+	authenticated := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+		RootCAs: roots,
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{clientCert.Raw},
+			PrivateKey:  clientKey,
+		}},
+	}}}
+	resp, err := authenticated.Get(url)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	cancel()
+	assert.Nil(t, <-served)
+}
+
+func newCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, ca bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "nn-blockchain-api"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  ca,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.Nil(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+
+	return cert, key
+}
+
+func writeKey(t *testing.T, dir, name string, key *ecdsa.PrivateKey) string {
+	der, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	return writePEM(t, dir, name, "EC PRIVATE KEY", der)
+}
+
+func writePEM(t *testing.T, dir, name, kind string, der []byte) string {
+	path := filepath.Join(dir, name)
+	assert.Nil(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: kind, Bytes: der}), 0600))
+
+	return path
+}
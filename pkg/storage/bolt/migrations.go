@@ -0,0 +1,70 @@
+package bolt_storage
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	bucketMeta            = []byte("meta")
+	bucketWallets         = []byte("wallets")
+	bucketAddresses       = []byte("addresses")
+	bucketTransactions    = []byte("transactions")
+	bucketTransactionsRaw = []byte("transactions_raw")
+	bucketAudit           = []byte("audit")
+	bucketAPIKeys         = []byte("api_keys")
+	bucketPolicySpends    = []byte("policy_spends")
+	bucketApprovals       = []byte("approvals")
+
+	keySchemaVersion = []byte("schema_version")
+)
+
+type migration func(tx *bolt.Tx) error
+
+// migrations are applied in order, the index+1 is the schema version they produce.
+var migrations = []migration{
+	createBuckets(bucketWallets, bucketAddresses, bucketTransactions, bucketTransactionsRaw, bucketAudit),
+	createBuckets(bucketAPIKeys),
+	createBuckets(bucketPolicySpends, bucketApprovals),
+}
+
+func createBuckets(names ...[]byte) migration {
+	return func(tx *bolt.Tx) error {
+		for _, name := range names {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return fmt.Errorf("create bucket %s: %w", name, err)
+			}
+		}
+		return nil
+	}
+}
+
+func migrate(db *bolt.DB) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		meta, err := tx.CreateBucketIfNotExists(bucketMeta)
+		if err != nil {
+			return err
+		}
+
+		var version uint64
+		if raw := meta.Get(keySchemaVersion); raw != nil {
+			version = binary.BigEndian.Uint64(raw)
+		}
+
+		for idx := version; idx < uint64(len(migrations)); idx++ {
+			if err := migrations[idx](tx); err != nil {
+				return fmt.Errorf("migration %d: %w", idx+1, err)
+			}
+		}
+
+		return meta.Put(keySchemaVersion, itob(uint64(len(migrations))))
+	})
+}
+
+func itob(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
@@ -0,0 +1,426 @@
+package bolt_storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"nn-blockchain-api/pkg/storage"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var errAlreadyExists = errors.New("record already exists")
+
+type walletRepository struct {
+	db *bolt.DB
+}
+
+func (r *walletRepository) Create(_ context.Context, wallet *storage.Wallet) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketWallets)
+		if bucket.Get([]byte(wallet.Id)) != nil {
+			return errAlreadyExists
+		}
+		return put(bucket, wallet.Id, wallet)
+	})
+}
+
+func (r *walletRepository) Get(_ context.Context, id string) (*storage.Wallet, error) {
+	var wallet storage.Wallet
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return get(tx.Bucket(bucketWallets), id, &wallet)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wallet, nil
+}
+
+func (r *walletRepository) List(_ context.Context, filter storage.Filter) ([]*storage.Wallet, error) {
+	var result []*storage.Wallet
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketWallets).ForEach(func(_, v []byte) error {
+			var wallet storage.Wallet
+			if err := json.Unmarshal(v, &wallet); err != nil {
+				return err
+			}
+			if filter.Match(wallet.Chain, wallet.Network) {
+				result = append(result, &wallet)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+
+	return result, nil
+}
+
+type addressRepository struct {
+	db *bolt.DB
+}
+
+func (r *addressRepository) Create(_ context.Context, address *storage.Address) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return put(tx.Bucket(bucketAddresses), address.Address, address)
+	})
+}
+
+func (r *addressRepository) Get(_ context.Context, address string) (*storage.Address, error) {
+	var result storage.Address
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return get(tx.Bucket(bucketAddresses), address, &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (r *addressRepository) ListByWallet(_ context.Context, walletId string) ([]*storage.Address, error) {
+	var result []*storage.Address
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketAddresses).ForEach(func(_, v []byte) error {
+			var address storage.Address
+			if err := json.Unmarshal(v, &address); err != nil {
+				return err
+			}
+			if address.WalletId == walletId {
+				result = append(result, &address)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+
+	return result, nil
+}
+
+type transactionRepository struct {
+	db *bolt.DB
+}
+
+func (r *transactionRepository) Create(_ context.Context, transaction *storage.Transaction) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketTransactions)
+		if bucket.Get([]byte(transaction.Id)) != nil {
+			return errAlreadyExists
+		}
+		if err := put(bucket, transaction.Id, transaction); err != nil {
+			return err
+		}
+		return indexRaw(tx, transaction)
+	})
+}
+
+func (r *transactionRepository) Update(_ context.Context, transaction *storage.Transaction) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketTransactions)
+		if bucket.Get([]byte(transaction.Id)) == nil {
+			return storage.ErrNotFound
+		}
+		if err := put(bucket, transaction.Id, transaction); err != nil {
+			return err
+		}
+		return indexRaw(tx, transaction)
+	})
+}
+
+func (r *transactionRepository) Get(_ context.Context, id string) (*storage.Transaction, error) {
+	var transaction storage.Transaction
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return get(tx.Bucket(bucketTransactions), id, &transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+func (r *transactionRepository) FindByRaw(_ context.Context, raw string) (*storage.Transaction, error) {
+	var transaction storage.Transaction
+	err := r.db.View(func(tx *bolt.Tx) error {
+		id := tx.Bucket(bucketTransactionsRaw).Get([]byte(storage.RawHash(raw)))
+		if id == nil {
+			return storage.ErrNotFound
+		}
+		return get(tx.Bucket(bucketTransactions), string(id), &transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+func (r *transactionRepository) List(_ context.Context, filter storage.Filter) ([]*storage.Transaction, error) {
+	var result []*storage.Transaction
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketTransactions).ForEach(func(_, v []byte) error {
+			var transaction storage.Transaction
+			if err := json.Unmarshal(v, &transaction); err != nil {
+				return err
+			}
+			if !filter.Match(transaction.Chain, transaction.Network) {
+				return nil
+			}
+			if filter.Status != "" && filter.Status != transaction.Status {
+				return nil
+			}
+			result = append(result, &transaction)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+
+	return result, nil
+}
+
+func indexRaw(tx *bolt.Tx, transaction *storage.Transaction) error {
+	index := tx.Bucket(bucketTransactionsRaw)
+	for _, raw := range []string{transaction.RawTx, transaction.SignedTx} {
+		if raw == "" {
+			continue
+		}
+		if err := index.Put([]byte(storage.RawHash(raw)), []byte(transaction.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type auditRepository struct {
+	db *bolt.DB
+}
+
+func (r *auditRepository) Append(_ context.Context, record *storage.AuditRecord) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketAudit)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(itob(seq), data)
+	})
+}
+
+// List returns the newest records first.
+func (r *auditRepository) List(_ context.Context, filter storage.Filter) ([]*storage.AuditRecord, error) {
+	var result []*storage.AuditRecord
+	err := r.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(bucketAudit).Cursor()
+		for k, v := cursor.Last(); k != nil && !limit(len(result), filter.Limit); k, v = cursor.Prev() {
+			var record storage.AuditRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return err
+			}
+			if filter.Match(record.Chain, record.Network) {
+				result = append(result, &record)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type apiKeyRepository struct {
+	db *bolt.DB
+}
+
+func (r *apiKeyRepository) Create(_ context.Context, key *storage.APIKey) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketAPIKeys)
+		if bucket.Get([]byte(key.Hash)) != nil {
+			return errAlreadyExists
+		}
+		return put(bucket, key.Hash, key)
+	})
+}
+
+func (r *apiKeyRepository) GetByHash(_ context.Context, hash string) (*storage.APIKey, error) {
+	var key storage.APIKey
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return get(tx.Bucket(bucketAPIKeys), hash, &key)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (r *apiKeyRepository) List(_ context.Context) ([]*storage.APIKey, error) {
+	var result []*storage.APIKey
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketAPIKeys).ForEach(func(_, v []byte) error {
+			var key storage.APIKey
+			if err := json.Unmarshal(v, &key); err != nil {
+				return err
+			}
+			result = append(result, &key)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+
+	return result, nil
+}
+
+// policySpendRepository keeps amounts as decimal strings under day|subject keys, which
+// sort by day.
+type policySpendRepository struct {
+	db *bolt.DB
+}
+
+func (r *policySpendRepository) Add(_ context.Context, day, subject string, amount *big.Int) (*big.Int, error) {
+	total := new(big.Int)
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketPolicySpends)
+		key := []byte(day + "|" + subject)
+		if err := parseAmount(bucket.Get(key), total); err != nil {
+			return err
+		}
+		total.Add(total, amount)
+		return bucket.Put(key, []byte(total.String()))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return total, nil
+}
+
+func (r *policySpendRepository) Get(_ context.Context, day, subject string) (*big.Int, error) {
+	total := new(big.Int)
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return parseAmount(tx.Bucket(bucketPolicySpends).Get([]byte(day+"|"+subject)), total)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return total, nil
+}
+
+func (r *policySpendRepository) DeleteBefore(_ context.Context, day string) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketPolicySpends)
+
+		var keys [][]byte
+		cursor := bucket.Cursor()
+		for k, _ := cursor.First(); k != nil && string(k) < day; k, _ = cursor.Next() {
+			keys = append(keys, k)
+		}
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func parseAmount(data []byte, amount *big.Int) error {
+	if data == nil {
+		return nil
+	}
+	if _, ok := amount.SetString(string(data), 10); !ok {
+		return fmt.Errorf("invalid amount %q", data)
+	}
+	return nil
+}
+
+type approvalRepository struct {
+	db *bolt.DB
+}
+
+func (r *approvalRepository) Create(_ context.Context, approval *storage.Approval) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketApprovals)
+		if bucket.Get([]byte(approval.Id)) != nil {
+			return errAlreadyExists
+		}
+		return put(bucket, approval.Id, approval)
+	})
+}
+
+func (r *approvalRepository) Update(_ context.Context, approval *storage.Approval) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketApprovals)
+		if bucket.Get([]byte(approval.Id)) == nil {
+			return storage.ErrNotFound
+		}
+		return put(bucket, approval.Id, approval)
+	})
+}
+
+func (r *approvalRepository) Get(_ context.Context, id string) (*storage.Approval, error) {
+	var approval storage.Approval
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return get(tx.Bucket(bucketApprovals), id, &approval)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &approval, nil
+}
+
+func (r *approvalRepository) List(_ context.Context) ([]*storage.Approval, error) {
+	var result []*storage.Approval
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketApprovals).ForEach(func(_, v []byte) error {
+			var approval storage.Approval
+			if err := json.Unmarshal(v, &approval); err != nil {
+				return err
+			}
+			result = append(result, &approval)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package bolt_storage
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"nn-blockchain-api/pkg/storage"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+type store struct {
+	db *bolt.DB
+
+	wallets      *walletRepository
+	addresses    *addressRepository
+	transactions *transactionRepository
+	audit        *auditRepository
+	apiKeys      *apiKeyRepository
+	policySpends *policySpendRepository
+	approvals    *approvalRepository
+}
+
+func NewStorage(path string) (storage.Storage, error) {
+	if path == "" {
+		return nil, errors.New("invalid bolt storage path")
+	}
+
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrate(db); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return &store{
+		db:           db,
+		wallets:      &walletRepository{db: db},
+		addresses:    &addressRepository{db: db},
+		transactions: &transactionRepository{db: db},
+		audit:        &auditRepository{db: db},
+		apiKeys:      &apiKeyRepository{db: db},
+		policySpends: &policySpendRepository{db: db},
+		approvals:    &approvalRepository{db: db},
+	}, nil
+}
+
+func (s *store) Wallets() storage.WalletRepository {
+	return s.wallets
+}
+
+func (s *store) Addresses() storage.AddressRepository {
+	return s.addresses
+}
+
+func (s *store) Transactions() storage.TransactionRepository {
+	return s.transactions
+}
+
+func (s *store) Audit() storage.AuditRepository {
+	return s.audit
+}
+
+func (s *store) APIKeys() storage.APIKeyRepository {
+	return s.apiKeys
+}
+
+func (s *store) PolicySpends() storage.PolicySpendRepository {
+	return s.policySpends
+}
+
+func (s *store) Approvals() storage.ApprovalRepository {
+	return s.approvals
+}
+
+func (s *store) Close() error {
+	return s.db.Close()
+}
+
+func put(bucket *bolt.Bucket, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put([]byte(key), data)
+}
+
+func get(bucket *bolt.Bucket, key string, value interface{}) error {
+	data := bucket.Get([]byte(key))
+	if data == nil {
+		return storage.ErrNotFound
+	}
+
+	return json.Unmarshal(data, value)
+}
+
+func limit(n, max int) bool {
+	return max > 0 && n >= max
+}
@@ -0,0 +1,267 @@
+package bolt_storage_test
+
+import (
+	"context"
+	gErrors "errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"nn-blockchain-api/pkg/storage"
+	bolt_storage "nn-blockchain-api/pkg/storage/bolt"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newStorage(t *testing.T) storage.Storage {
+	store, err := bolt_storage.NewStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
+
+func TestNewStorage(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		expect func(*testing.T, storage.Storage, error)
+	}{
+		{
+			name: "should return bolt storage",
+			path: filepath.Join(t.TempDir(), "nested", "test.db"),
+			expect: func(t *testing.T, s storage.Storage, err error) {
+				assert.NotNil(t, s)
+				assert.Nil(t, err)
+				assert.Nil(t, s.Close())
+			},
+		},
+		{
+			name: "should return invalid bolt storage path",
+			path: "",
+			expect: func(t *testing.T, s storage.Storage, err error) {
+				assert.Nil(t, s)
+				assert.NotNil(t, err)
+				assert.EqualError(t, err, "invalid bolt storage path")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := bolt_storage.NewStorage(tc.path)
+			tc.expect(t, s, err)
+		})
+	}
+}
+
+func TestNewStorage_Reopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	ctx := context.Background()
+
+	store, err := bolt_storage.NewStorage(path)
+	assert.Nil(t, err)
+	assert.Nil(t, store.Wallets().Create(ctx, &storage.Wallet{Id: "wallet", Chain: "bitcoin", Network: "test"}))
+	assert.Nil(t, store.Close())
+
+	store, err = bolt_storage.NewStorage(path)
+	assert.Nil(t, err)
+	defer store.Close()
+
+	wallet, err := store.Wallets().Get(ctx, "wallet")
+	assert.Nil(t, err)
+	assert.Equal(t, "bitcoin", wallet.Chain)
+}
+
+func TestWalletRepository(t *testing.T) {
+	store := newStorage(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	assert.Nil(t, store.Wallets().Create(ctx, &storage.Wallet{Id: "first", Chain: "bitcoin", Network: "test", CreatedAt: now}))
+	assert.Nil(t, store.Wallets().Create(ctx, &storage.Wallet{Id: "second", Chain: "bitcoin", Network: "main", CreatedAt: now.Add(time.Second)}))
+	assert.NotNil(t, store.Wallets().Create(ctx, &storage.Wallet{Id: "first"}))
+
+	wallet, err := store.Wallets().Get(ctx, "first")
+	assert.Nil(t, err)
+	assert.Equal(t, "test", wallet.Network)
+
+	_, err = store.Wallets().Get(ctx, "missing")
+	assert.True(t, gErrors.Is(err, storage.ErrNotFound))
+
+	wallets, err := store.Wallets().List(ctx, storage.Filter{Chain: "bitcoin"})
+	assert.Nil(t, err)
+	assert.Len(t, wallets, 2)
+	assert.Equal(t, "second", wallets[0].Id)
+
+	wallets, err = store.Wallets().List(ctx, storage.Filter{Network: "test"})
+	assert.Nil(t, err)
+	assert.Len(t, wallets, 1)
+}
+
+func TestAddressRepository(t *testing.T) {
+	store := newStorage(t)
+	ctx := context.Background()
+
+	assert.Nil(t, store.Addresses().Create(ctx, &storage.Address{Address: "addr1", WalletId: "wallet", Chain: "bitcoin", Network: "test"}))
+	assert.Nil(t, store.Addresses().Create(ctx, &storage.Address{Address: "addr2", WalletId: "wallet", Chain: "bitcoin", Network: "test"}))
+	assert.Nil(t, store.Addresses().Create(ctx, &storage.Address{Address: "addr3", WalletId: "other", Chain: "bitcoin", Network: "test"}))
+
+	address, err := store.Addresses().Get(ctx, "addr1")
+	assert.Nil(t, err)
+	assert.Equal(t, "wallet", address.WalletId)
+
+	addresses, err := store.Addresses().ListByWallet(ctx, "wallet")
+	assert.Nil(t, err)
+	assert.Len(t, addresses, 2)
+}
+
+func TestTransactionRepository(t *testing.T) {
+	store := newStorage(t)
+	ctx := context.Background()
+
+	tx := &storage.Transaction{Id: "tx", Chain: "ethereum", Network: "test", Status: storage.TxStatusCreated, RawTx: "aabb"}
+	assert.Nil(t, store.Transactions().Create(ctx, tx))
+
+	found, err := store.Transactions().FindByRaw(ctx, "0xAABB")
+	assert.Nil(t, err)
+	assert.Equal(t, "tx", found.Id)
+
+	tx.Status = storage.TxStatusSigned
+	tx.SignedTx = "ccdd"
+	assert.Nil(t, store.Transactions().Update(ctx, tx))
+
+	found, err = store.Transactions().FindByRaw(ctx, "ccdd")
+	assert.Nil(t, err)
+	assert.Equal(t, storage.TxStatusSigned, found.Status)
+
+	err = store.Transactions().Update(ctx, &storage.Transaction{Id: "missing"})
+	assert.True(t, gErrors.Is(err, storage.ErrNotFound))
+
+	list, err := store.Transactions().List(ctx, storage.Filter{Status: storage.TxStatusSigned})
+	assert.Nil(t, err)
+	assert.Len(t, list, 1)
+
+	list, err = store.Transactions().List(ctx, storage.Filter{Status: storage.TxStatusSent})
+	assert.Nil(t, err)
+	assert.Len(t, list, 0)
+}
+
+func TestAuditRepository(t *testing.T) {
+	store := newStorage(t)
+	ctx := context.Background()
+
+	for _, action := range []string{"first", "second", "third"} {
+		assert.Nil(t, store.Audit().Append(ctx, &storage.AuditRecord{Id: action, Action: action, Chain: "bitcoin"}))
+	}
+
+	records, err := store.Audit().List(ctx, storage.Filter{Limit: 2})
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "third", records[0].Action)
+	assert.Equal(t, "second", records[1].Action)
+}
+
+func TestTransactionLifecycle(t *testing.T) {
+	store := newStorage(t)
+	ctx := context.Background()
+
+	created, err := storage.RecordCreatedTx(ctx, store, "bitcoin", "test", "raw", 0.0001)
+	assert.Nil(t, err)
+	assert.Equal(t, storage.TxStatusCreated, created.Status)
+
+	signed, err := storage.RecordSignedTx(ctx, store, "bitcoin", "test", "raw", "signed")
+	assert.Nil(t, err)
+	assert.Equal(t, created.Id, signed.Id)
+	assert.Equal(t, storage.TxStatusSigned, signed.Status)
+
+	sent, err := storage.RecordSentTx(ctx, store, "bitcoin", "test", "signed", "txid", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, created.Id, sent.Id)
+	assert.Equal(t, storage.TxStatusSent, sent.Status)
+	assert.Equal(t, "txid", sent.TxId)
+
+	failed, err := storage.RecordSentTx(ctx, store, "bitcoin", "test", "unknown", "", gErrors.New("missing inputs"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, created.Id, failed.Id)
+	assert.Equal(t, storage.TxStatusFailed, failed.Status)
+	assert.Equal(t, "missing inputs", failed.Error)
+
+	records, err := store.Audit().List(ctx, storage.Filter{})
+	assert.Nil(t, err)
+	assert.Len(t, records, 4)
+}
+
+func TestAPIKeyRepository(t *testing.T) {
+	store := newStorage(t)
+	ctx := context.Background()
+
+	key := &storage.APIKey{Id: "key", Name: "ci", Hash: "hash", Scopes: []string{"read"}, Networks: []string{"test"}}
+	assert.Nil(t, store.APIKeys().Create(ctx, key))
+	assert.NotNil(t, store.APIKeys().Create(ctx, key))
+
+	found, err := store.APIKeys().GetByHash(ctx, "hash")
+	assert.Nil(t, err)
+	assert.Equal(t, "ci", found.Name)
+	assert.Equal(t, []string{"read"}, found.Scopes)
+
+	_, err = store.APIKeys().GetByHash(ctx, "missing")
+	assert.True(t, gErrors.Is(err, storage.ErrNotFound))
+
+	keys, err := store.APIKeys().List(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, keys, 1)
+}
+
+func TestPolicySpendRepository(t *testing.T) {
+	store := newStorage(t)
+	ctx := context.Background()
+
+	total, err := store.PolicySpends().Add(ctx, "2026-01-01", "key:a", big.NewInt(700))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(700), total)
+	total, err = store.PolicySpends().Add(ctx, "2026-01-01", "key:a", big.NewInt(-200))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(500), total)
+	_, err = store.PolicySpends().Add(ctx, "2026-01-02", "key:a", big.NewInt(100))
+	assert.Nil(t, err)
+
+	spent, err := store.PolicySpends().Get(ctx, "2026-01-01", "key:b")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, spent.Sign())
+
+	assert.Nil(t, store.PolicySpends().DeleteBefore(ctx, "2026-01-02"))
+	spent, err = store.PolicySpends().Get(ctx, "2026-01-01", "key:a")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, spent.Sign())
+	spent, err = store.PolicySpends().Get(ctx, "2026-01-02", "key:a")
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), spent)
+}
+
+func TestApprovalRepository(t *testing.T) {
+	store := newStorage(t)
+	ctx := context.Background()
+
+	approval := &storage.Approval{Id: "approval", Status: "pending", Chain: "bitcoin", CreatedAt: time.Now().UTC()}
+	assert.Nil(t, store.Approvals().Create(ctx, approval))
+	assert.NotNil(t, store.Approvals().Create(ctx, approval))
+
+	approval.Status = "approved"
+	assert.Nil(t, store.Approvals().Update(ctx, approval))
+	assert.True(t, gErrors.Is(store.Approvals().Update(ctx, &storage.Approval{Id: "missing"}), storage.ErrNotFound))
+
+	found, err := store.Approvals().Get(ctx, "approval")
+	assert.Nil(t, err)
+	assert.Equal(t, "approved", found.Status)
+
+	_, err = store.Approvals().Get(ctx, "missing")
+	assert.True(t, gErrors.Is(err, storage.ErrNotFound))
+
+	approvals, err := store.Approvals().List(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, approvals, 1)
+}
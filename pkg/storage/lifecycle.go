@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ActionWalletCreated   = "wallet_created"
+	ActionAddressImported = "address_imported"
+	ActionTxCreated       = "tx_created"
+	ActionTxSigned        = "tx_signed"
+	ActionTxSent          = "tx_sent"
+	ActionTxFailed        = "tx_failed"
+)
+
+func RecordWallet(ctx context.Context, s Storage, wallet *Wallet) error {
+	if wallet.CreatedAt.IsZero() {
+		wallet.CreatedAt = time.Now().UTC()
+	}
+
+	if err := s.Wallets().Create(ctx, wallet); err != nil {
+		return err
+	}
+
+	return audit(ctx, s, ActionWalletCreated, wallet.Chain, wallet.Network, wallet.Id, "")
+}
+
+func RecordAddress(ctx context.Context, s Storage, address *Address) error {
+	if address.CreatedAt.IsZero() {
+		address.CreatedAt = time.Now().UTC()
+	}
+
+	if err := s.Addresses().Create(ctx, address); err != nil {
+		return err
+	}
+
+	return audit(ctx, s, ActionAddressImported, address.Chain, address.Network, address.Address, address.WalletId)
+}
+
+func RecordCreatedTx(ctx context.Context, s Storage, chain, network, raw string, fee float64) (*Transaction, error) {
+	now := time.Now().UTC()
+	tx := &Transaction{
+		Id:        uuid.NewString(),
+		Chain:     chain,
+		Network:   network,
+		Status:    TxStatusCreated,
+		RawTx:     raw,
+		Fee:       fee,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.Transactions().Create(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return tx, audit(ctx, s, ActionTxCreated, chain, network, tx.Id, "")
+}
+
+func RecordSignedTx(ctx context.Context, s Storage, chain, network, raw, signed string) (*Transaction, error) {
+	tx, err := findOrCreate(ctx, s, chain, network, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Status = TxStatusSigned
+	tx.SignedTx = signed
+	tx.UpdatedAt = time.Now().UTC()
+
+	if err := s.Transactions().Update(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return tx, audit(ctx, s, ActionTxSigned, chain, network, tx.Id, "")
+}
+
+// RecordSentTx marks a signed transaction as broadcast, or as failed when sendErr is set.
+func RecordSentTx(ctx context.Context, s Storage, chain, network, signed, txId string, sendErr error) (*Transaction, error) {
+	tx, err := findOrCreate(ctx, s, chain, network, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	action := ActionTxSent
+	if tx.SignedTx == "" {
+		tx.SignedTx = signed
+	}
+	if sendErr != nil {
+		action = ActionTxFailed
+		tx.Status = TxStatusFailed
+		tx.Error = sendErr.Error()
+	} else {
+		tx.Status = TxStatusSent
+		tx.TxId = txId
+		tx.Error = ""
+	}
+	tx.UpdatedAt = time.Now().UTC()
+
+	if err := s.Transactions().Update(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return tx, audit(ctx, s, action, chain, network, tx.Id, tx.Error)
+}
+
+func findOrCreate(ctx context.Context, s Storage, chain, network, raw string) (*Transaction, error) {
+	tx, err := s.Transactions().FindByRaw(ctx, raw)
+	if err == nil {
+		return tx, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	tx = &Transaction{
+		Id:        uuid.NewString(),
+		Chain:     chain,
+		Network:   network,
+		RawTx:     raw,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.Transactions().Create(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func audit(ctx context.Context, s Storage, action, chain, network, subject, details string) error {
+	return s.Audit().Append(ctx, &AuditRecord{
+		Id:        uuid.NewString(),
+		Action:    action,
+		Chain:     chain,
+		Network:   network,
+		Subject:   subject,
+		Details:   details,
+		CreatedAt: time.Now().UTC(),
+	})
+}
@@ -0,0 +1,646 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: storage.go
+
+// Package mock_storage is a generated GoMock package.
+package mock_storage
+
+import (
+	context "context"
+	big "math/big"
+	storage "nn-blockchain-api/pkg/storage"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// APIKeys mocks base method.
+func (m *MockStorage) APIKeys() storage.APIKeyRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeys")
+	ret0, _ := ret[0].(storage.APIKeyRepository)
+	return ret0
+}
+
+// APIKeys indicates an expected call of APIKeys.
+func (mr *MockStorageMockRecorder) APIKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeys", reflect.TypeOf((*MockStorage)(nil).APIKeys))
+}
+
+// Addresses mocks base method.
+func (m *MockStorage) Addresses() storage.AddressRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Addresses")
+	ret0, _ := ret[0].(storage.AddressRepository)
+	return ret0
+}
+
+// Addresses indicates an expected call of Addresses.
+func (mr *MockStorageMockRecorder) Addresses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Addresses", reflect.TypeOf((*MockStorage)(nil).Addresses))
+}
+
+// Approvals mocks base method.
+func (m *MockStorage) Approvals() storage.ApprovalRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approvals")
+	ret0, _ := ret[0].(storage.ApprovalRepository)
+	return ret0
+}
+
+// Approvals indicates an expected call of Approvals.
+func (mr *MockStorageMockRecorder) Approvals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approvals", reflect.TypeOf((*MockStorage)(nil).Approvals))
+}
+
+// Audit mocks base method.
+func (m *MockStorage) Audit() storage.AuditRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit")
+	ret0, _ := ret[0].(storage.AuditRepository)
+	return ret0
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockStorageMockRecorder) Audit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockStorage)(nil).Audit))
+}
+
+// Close mocks base method.
+func (m *MockStorage) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStorageMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorage)(nil).Close))
+}
+
+// PolicySpends mocks base method.
+func (m *MockStorage) PolicySpends() storage.PolicySpendRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PolicySpends")
+	ret0, _ := ret[0].(storage.PolicySpendRepository)
+	return ret0
+}
+
+// PolicySpends indicates an expected call of PolicySpends.
+func (mr *MockStorageMockRecorder) PolicySpends() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PolicySpends", reflect.TypeOf((*MockStorage)(nil).PolicySpends))
+}
+
+// Transactions mocks base method.
+func (m *MockStorage) Transactions() storage.TransactionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transactions")
+	ret0, _ := ret[0].(storage.TransactionRepository)
+	return ret0
+}
+
+// Transactions indicates an expected call of Transactions.
+func (mr *MockStorageMockRecorder) Transactions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transactions", reflect.TypeOf((*MockStorage)(nil).Transactions))
+}
+
+// Wallets mocks base method.
+func (m *MockStorage) Wallets() storage.WalletRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wallets")
+	ret0, _ := ret[0].(storage.WalletRepository)
+	return ret0
+}
+
+// Wallets indicates an expected call of Wallets.
+func (mr *MockStorageMockRecorder) Wallets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wallets", reflect.TypeOf((*MockStorage)(nil).Wallets))
+}
+
+// MockWalletRepository is a mock of WalletRepository interface.
+type MockWalletRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletRepositoryMockRecorder
+}
+
+// MockWalletRepositoryMockRecorder is the mock recorder for MockWalletRepository.
+type MockWalletRepositoryMockRecorder struct {
+	mock *MockWalletRepository
+}
+
+// NewMockWalletRepository creates a new mock instance.
+func NewMockWalletRepository(ctrl *gomock.Controller) *MockWalletRepository {
+	mock := &MockWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletRepository) EXPECT() *MockWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWalletRepository) Create(ctx context.Context, wallet *storage.Wallet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, wallet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWalletRepositoryMockRecorder) Create(ctx, wallet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWalletRepository)(nil).Create), ctx, wallet)
+}
+
+// Get mocks base method.
+func (m *MockWalletRepository) Get(ctx context.Context, id string) (*storage.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*storage.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWalletRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWalletRepository)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockWalletRepository) List(ctx context.Context, filter storage.Filter) ([]*storage.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*storage.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWalletRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWalletRepository)(nil).List), ctx, filter)
+}
+
+// MockAddressRepository is a mock of AddressRepository interface.
+type MockAddressRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressRepositoryMockRecorder
+}
+
+// MockAddressRepositoryMockRecorder is the mock recorder for MockAddressRepository.
+type MockAddressRepositoryMockRecorder struct {
+	mock *MockAddressRepository
+}
+
+// NewMockAddressRepository creates a new mock instance.
+func NewMockAddressRepository(ctrl *gomock.Controller) *MockAddressRepository {
+	mock := &MockAddressRepository{ctrl: ctrl}
+	mock.recorder = &MockAddressRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddressRepository) EXPECT() *MockAddressRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAddressRepository) Create(ctx context.Context, address *storage.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAddressRepositoryMockRecorder) Create(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAddressRepository)(nil).Create), ctx, address)
+}
+
+// Get mocks base method.
+func (m *MockAddressRepository) Get(ctx context.Context, address string) (*storage.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, address)
+	ret0, _ := ret[0].(*storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAddressRepositoryMockRecorder) Get(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAddressRepository)(nil).Get), ctx, address)
+}
+
+// ListByWallet mocks base method.
+func (m *MockAddressRepository) ListByWallet(ctx context.Context, walletId string) ([]*storage.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByWallet", ctx, walletId)
+	ret0, _ := ret[0].([]*storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByWallet indicates an expected call of ListByWallet.
+func (mr *MockAddressRepositoryMockRecorder) ListByWallet(ctx, walletId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWallet", reflect.TypeOf((*MockAddressRepository)(nil).ListByWallet), ctx, walletId)
+}
+
+// MockTransactionRepository is a mock of TransactionRepository interface.
+type MockTransactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionRepositoryMockRecorder
+}
+
+// MockTransactionRepositoryMockRecorder is the mock recorder for MockTransactionRepository.
+type MockTransactionRepositoryMockRecorder struct {
+	mock *MockTransactionRepository
+}
+
+// NewMockTransactionRepository creates a new mock instance.
+func NewMockTransactionRepository(ctrl *gomock.Controller) *MockTransactionRepository {
+	mock := &MockTransactionRepository{ctrl: ctrl}
+	mock.recorder = &MockTransactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionRepository) EXPECT() *MockTransactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransactionRepository) Create(ctx context.Context, tx *storage.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransactionRepositoryMockRecorder) Create(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionRepository)(nil).Create), ctx, tx)
+}
+
+// FindByRaw mocks base method.
+func (m *MockTransactionRepository) FindByRaw(ctx context.Context, raw string) (*storage.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRaw", ctx, raw)
+	ret0, _ := ret[0].(*storage.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRaw indicates an expected call of FindByRaw.
+func (mr *MockTransactionRepositoryMockRecorder) FindByRaw(ctx, raw interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRaw", reflect.TypeOf((*MockTransactionRepository)(nil).FindByRaw), ctx, raw)
+}
+
+// Get mocks base method.
+func (m *MockTransactionRepository) Get(ctx context.Context, id string) (*storage.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*storage.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTransactionRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransactionRepository)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockTransactionRepository) List(ctx context.Context, filter storage.Filter) ([]*storage.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*storage.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTransactionRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTransactionRepository)(nil).List), ctx, filter)
+}
+
+// Update mocks base method.
+func (m *MockTransactionRepository) Update(ctx context.Context, tx *storage.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTransactionRepositoryMockRecorder) Update(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransactionRepository)(nil).Update), ctx, tx)
+}
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockAuditRepository) Append(ctx context.Context, record *storage.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockAuditRepositoryMockRecorder) Append(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockAuditRepository)(nil).Append), ctx, record)
+}
+
+// List mocks base method.
+func (m *MockAuditRepository) List(ctx context.Context, filter storage.Filter) ([]*storage.AuditRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*storage.AuditRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditRepository)(nil).List), ctx, filter)
+}
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyRepository) Create(ctx context.Context, key *storage.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyRepositoryMockRecorder) Create(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyRepository)(nil).Create), ctx, key)
+}
+
+// GetByHash mocks base method.
+func (m *MockAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*storage.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", ctx, hash)
+	ret0, _ := ret[0].(*storage.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockAPIKeyRepositoryMockRecorder) GetByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockAPIKeyRepository)(nil).GetByHash), ctx, hash)
+}
+
+// List mocks base method.
+func (m *MockAPIKeyRepository) List(ctx context.Context) ([]*storage.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*storage.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAPIKeyRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIKeyRepository)(nil).List), ctx)
+}
+
+// MockPolicySpendRepository is a mock of PolicySpendRepository interface.
+type MockPolicySpendRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicySpendRepositoryMockRecorder
+}
+
+// MockPolicySpendRepositoryMockRecorder is the mock recorder for MockPolicySpendRepository.
+type MockPolicySpendRepositoryMockRecorder struct {
+	mock *MockPolicySpendRepository
+}
+
+// NewMockPolicySpendRepository creates a new mock instance.
+func NewMockPolicySpendRepository(ctrl *gomock.Controller) *MockPolicySpendRepository {
+	mock := &MockPolicySpendRepository{ctrl: ctrl}
+	mock.recorder = &MockPolicySpendRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicySpendRepository) EXPECT() *MockPolicySpendRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockPolicySpendRepository) Add(ctx context.Context, day, subject string, amount *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, day, subject, amount)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockPolicySpendRepositoryMockRecorder) Add(ctx, day, subject, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockPolicySpendRepository)(nil).Add), ctx, day, subject, amount)
+}
+
+// DeleteBefore mocks base method.
+func (m *MockPolicySpendRepository) DeleteBefore(ctx context.Context, day string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBefore", ctx, day)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBefore indicates an expected call of DeleteBefore.
+func (mr *MockPolicySpendRepositoryMockRecorder) DeleteBefore(ctx, day interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBefore", reflect.TypeOf((*MockPolicySpendRepository)(nil).DeleteBefore), ctx, day)
+}
+
+// Get mocks base method.
+func (m *MockPolicySpendRepository) Get(ctx context.Context, day, subject string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, day, subject)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPolicySpendRepositoryMockRecorder) Get(ctx, day, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPolicySpendRepository)(nil).Get), ctx, day, subject)
+}
+
+// MockApprovalRepository is a mock of ApprovalRepository interface.
+type MockApprovalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockApprovalRepositoryMockRecorder
+}
+
+// MockApprovalRepositoryMockRecorder is the mock recorder for MockApprovalRepository.
+type MockApprovalRepositoryMockRecorder struct {
+	mock *MockApprovalRepository
+}
+
+// NewMockApprovalRepository creates a new mock instance.
+func NewMockApprovalRepository(ctrl *gomock.Controller) *MockApprovalRepository {
+	mock := &MockApprovalRepository{ctrl: ctrl}
+	mock.recorder = &MockApprovalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApprovalRepository) EXPECT() *MockApprovalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockApprovalRepository) Create(ctx context.Context, approval *storage.Approval) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, approval)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockApprovalRepositoryMockRecorder) Create(ctx, approval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockApprovalRepository)(nil).Create), ctx, approval)
+}
+
+// Get mocks base method.
+func (m *MockApprovalRepository) Get(ctx context.Context, id string) (*storage.Approval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*storage.Approval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockApprovalRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockApprovalRepository)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockApprovalRepository) List(ctx context.Context) ([]*storage.Approval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*storage.Approval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockApprovalRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockApprovalRepository)(nil).List), ctx)
+}
+
+// Update mocks base method.
+func (m *MockApprovalRepository) Update(ctx context.Context, approval *storage.Approval) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, approval)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockApprovalRepositoryMockRecorder) Update(ctx, approval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockApprovalRepository)(nil).Update), ctx, approval)
+}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+type TxStatus string
+
+const (
+	TxStatusCreated TxStatus = "created"
+	TxStatusSigned  TxStatus = "signed"
+	TxStatusSent    TxStatus = "sent"
+	TxStatusFailed  TxStatus = "failed"
+)
+
+type Wallet struct {
+	Id        string    `json:"id"`
+	Chain     string    `json:"chain"`
+	Network   string    `json:"network"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Address struct {
+	Address   string    `json:"address"`
+	WalletId  string    `json:"wallet_id"`
+	Chain     string    `json:"chain"`
+	Network   string    `json:"network"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Transaction struct {
+	Id        string    `json:"id"`
+	Chain     string    `json:"chain"`
+	Network   string    `json:"network"`
+	Status    TxStatus  `json:"status"`
+	RawTx     string    `json:"raw_tx"`
+	SignedTx  string    `json:"signed_tx,omitempty"`
+	TxId      string    `json:"tx_id,omitempty"`
+	Fee       float64   `json:"fee"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type AuditRecord struct {
+	Id        string    `json:"id"`
+	Action    string    `json:"action"`
+	Chain     string    `json:"chain"`
+	Network   string    `json:"network"`
+	Subject   string    `json:"subject"`
+	Details   string    `json:"details,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// APIKey never holds the raw key, only its sha256 hash.
+type APIKey struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"`
+	Scopes    []string  `json:"scopes"`
+	Chains    []string  `json:"chains"`
+	Networks  []string  `json:"networks"`
+	Revoked   bool      `json:"revoked"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Approval is a transaction held by a signing policy, Spend is a decimal string in the
+// smallest unit of the chain.
+type Approval struct {
+	Id          string     `json:"id"`
+	Status      string     `json:"status"`
+	Chain       string     `json:"chain"`
+	Network     string     `json:"network"`
+	TxHash      string     `json:"tx_hash"`
+	Subject     string     `json:"subject"`
+	Spend       string     `json:"spend"`
+	Policy      string     `json:"policy"`
+	RequestedBy string     `json:"requested_by,omitempty"`
+	DecidedBy   string     `json:"decided_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+}
+
+// Filter narrows list queries, empty fields match everything.
+type Filter struct {
+	Chain   string
+	Network string
+	Status  TxStatus
+	Limit   int
+}
+
+func (f Filter) Match(chain, network string) bool {
+	if f.Chain != "" && f.Chain != chain {
+		return false
+	}
+	if f.Network != "" && f.Network != network {
+		return false
+	}
+	return true
+}
+
+// RawHash is the index key used to find a transaction by its hex body.
+func RawHash(raw string) string {
+	sum := sha256.Sum256([]byte(strings.TrimPrefix(strings.ToLower(raw), "0x")))
+	return hex.EncodeToString(sum[:])
+}
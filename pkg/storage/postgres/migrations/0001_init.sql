@@ -0,0 +1,46 @@
+CREATE TABLE IF NOT EXISTS wallets (
+    id         TEXT PRIMARY KEY,
+    chain      TEXT        NOT NULL,
+    network    TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS addresses (
+    address    TEXT PRIMARY KEY,
+    wallet_id  TEXT        NOT NULL,
+    chain      TEXT        NOT NULL,
+    network    TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS addresses_wallet_id_idx ON addresses (wallet_id);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id              TEXT PRIMARY KEY,
+    chain           TEXT             NOT NULL,
+    network         TEXT             NOT NULL,
+    status          TEXT             NOT NULL,
+    raw_tx          TEXT             NOT NULL,
+    raw_tx_hash     TEXT             NOT NULL,
+    signed_tx       TEXT             NOT NULL DEFAULT '',
+    signed_tx_hash  TEXT             NOT NULL DEFAULT '',
+    tx_id           TEXT             NOT NULL DEFAULT '',
+    fee             DOUBLE PRECISION NOT NULL DEFAULT 0,
+    error           TEXT             NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ      NOT NULL,
+    updated_at      TIMESTAMPTZ      NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS transactions_raw_tx_hash_idx ON transactions (raw_tx_hash);
+CREATE INDEX IF NOT EXISTS transactions_signed_tx_hash_idx ON transactions (signed_tx_hash);
+
+CREATE TABLE IF NOT EXISTS audit_records (
+    seq        BIGSERIAL PRIMARY KEY,
+    id         TEXT        NOT NULL UNIQUE,
+    action     TEXT        NOT NULL,
+    chain      TEXT        NOT NULL,
+    network    TEXT        NOT NULL,
+    subject    TEXT        NOT NULL,
+    details    TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL
+);
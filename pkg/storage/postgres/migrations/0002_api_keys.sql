@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+    id         TEXT PRIMARY KEY,
+    name       TEXT        NOT NULL,
+    hash       TEXT        NOT NULL UNIQUE,
+    scopes     TEXT        NOT NULL DEFAULT '',
+    chains     TEXT        NOT NULL DEFAULT '',
+    networks   TEXT        NOT NULL DEFAULT '',
+    revoked    BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL
+);
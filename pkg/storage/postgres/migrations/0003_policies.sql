@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS policy_spends (
+    day     TEXT           NOT NULL,
+    subject TEXT           NOT NULL,
+    amount  NUMERIC(78, 0) NOT NULL,
+    PRIMARY KEY (day, subject)
+);
+
+CREATE TABLE IF NOT EXISTS approvals (
+    id           TEXT PRIMARY KEY,
+    status       TEXT        NOT NULL,
+    chain        TEXT        NOT NULL,
+    network      TEXT        NOT NULL,
+    tx_hash      TEXT        NOT NULL,
+    subject      TEXT        NOT NULL,
+    spend        TEXT        NOT NULL,
+    policy       TEXT        NOT NULL,
+    requested_by TEXT        NOT NULL DEFAULT '',
+    decided_by   TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL,
+    expires_at   TIMESTAMPTZ NOT NULL,
+    decided_at   TIMESTAMPTZ
+);
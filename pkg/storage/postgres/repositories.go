@@ -0,0 +1,424 @@
+package postgres_storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"nn-blockchain-api/pkg/storage"
+)
+
+type walletRepository struct {
+	db *sql.DB
+}
+
+func (r *walletRepository) Create(ctx context.Context, wallet *storage.Wallet) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO wallets (id, chain, network, created_at) VALUES ($1, $2, $3, $4)`,
+		wallet.Id, wallet.Chain, wallet.Network, wallet.CreatedAt)
+	return err
+}
+
+func (r *walletRepository) Get(ctx context.Context, id string) (*storage.Wallet, error) {
+	var wallet storage.Wallet
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id, chain, network, created_at FROM wallets WHERE id = $1`, id).
+		Scan(&wallet.Id, &wallet.Chain, &wallet.Network, &wallet.CreatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return &wallet, nil
+}
+
+func (r *walletRepository) List(ctx context.Context, filter storage.Filter) ([]*storage.Wallet, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, chain, network, created_at FROM wallets
+		WHERE ($1 = '' OR chain = $1) AND ($2 = '' OR network = $2)
+		ORDER BY created_at DESC LIMIT $3`,
+		filter.Chain, filter.Network, limitClause(filter.Limit))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*storage.Wallet
+	for rows.Next() {
+		var wallet storage.Wallet
+		if err := rows.Scan(&wallet.Id, &wallet.Chain, &wallet.Network, &wallet.CreatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, &wallet)
+	}
+
+	return result, rows.Err()
+}
+
+type addressRepository struct {
+	db *sql.DB
+}
+
+func (r *addressRepository) Create(ctx context.Context, address *storage.Address) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO addresses (address, wallet_id, chain, network, created_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (address) DO UPDATE SET wallet_id = EXCLUDED.wallet_id, chain = EXCLUDED.chain, network = EXCLUDED.network`,
+		address.Address, address.WalletId, address.Chain, address.Network, address.CreatedAt)
+	return err
+}
+
+func (r *addressRepository) Get(ctx context.Context, address string) (*storage.Address, error) {
+	var result storage.Address
+	err := r.db.QueryRowContext(ctx,
+		`SELECT address, wallet_id, chain, network, created_at FROM addresses WHERE address = $1`, address).
+		Scan(&result.Address, &result.WalletId, &result.Chain, &result.Network, &result.CreatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return &result, nil
+}
+
+func (r *addressRepository) ListByWallet(ctx context.Context, walletId string) ([]*storage.Address, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT address, wallet_id, chain, network, created_at FROM addresses WHERE wallet_id = $1 ORDER BY created_at`,
+		walletId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*storage.Address
+	for rows.Next() {
+		var address storage.Address
+		if err := rows.Scan(&address.Address, &address.WalletId, &address.Chain, &address.Network, &address.CreatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, &address)
+	}
+
+	return result, rows.Err()
+}
+
+type transactionRepository struct {
+	db *sql.DB
+}
+
+const transactionColumns = `id, chain, network, status, raw_tx, signed_tx, tx_id, fee, error, created_at, updated_at`
+
+func scanTransaction(row interface{ Scan(...interface{}) error }) (*storage.Transaction, error) {
+	var tx storage.Transaction
+	err := row.Scan(&tx.Id, &tx.Chain, &tx.Network, &tx.Status, &tx.RawTx, &tx.SignedTx, &tx.TxId, &tx.Fee, &tx.Error, &tx.CreatedAt, &tx.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func signedHash(tx *storage.Transaction) string {
+	if tx.SignedTx == "" {
+		return ""
+	}
+	return storage.RawHash(tx.SignedTx)
+}
+
+func (r *transactionRepository) Create(ctx context.Context, tx *storage.Transaction) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO transactions (`+transactionColumns+`, raw_tx_hash, signed_tx_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		tx.Id, tx.Chain, tx.Network, tx.Status, tx.RawTx, tx.SignedTx, tx.TxId, tx.Fee, tx.Error, tx.CreatedAt, tx.UpdatedAt,
+		storage.RawHash(tx.RawTx), signedHash(tx))
+	return err
+}
+
+func (r *transactionRepository) Update(ctx context.Context, tx *storage.Transaction) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE transactions SET status = $2, raw_tx = $3, signed_tx = $4, tx_id = $5, fee = $6, error = $7, updated_at = $8,
+		raw_tx_hash = $9, signed_tx_hash = $10 WHERE id = $1`,
+		tx.Id, tx.Status, tx.RawTx, tx.SignedTx, tx.TxId, tx.Fee, tx.Error, tx.UpdatedAt,
+		storage.RawHash(tx.RawTx), signedHash(tx))
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *transactionRepository) Get(ctx context.Context, id string) (*storage.Transaction, error) {
+	tx, err := scanTransaction(r.db.QueryRowContext(ctx, `SELECT `+transactionColumns+` FROM transactions WHERE id = $1`, id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return tx, nil
+}
+
+func (r *transactionRepository) FindByRaw(ctx context.Context, raw string) (*storage.Transaction, error) {
+	hash := storage.RawHash(raw)
+	tx, err := scanTransaction(r.db.QueryRowContext(ctx,
+		`SELECT `+transactionColumns+` FROM transactions WHERE raw_tx_hash = $1 OR signed_tx_hash = $1
+		ORDER BY updated_at DESC LIMIT 1`, hash))
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return tx, nil
+}
+
+func (r *transactionRepository) List(ctx context.Context, filter storage.Filter) ([]*storage.Transaction, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+transactionColumns+` FROM transactions
+		WHERE ($1 = '' OR chain = $1) AND ($2 = '' OR network = $2) AND ($3 = '' OR status = $3)
+		ORDER BY created_at DESC LIMIT $4`,
+		filter.Chain, filter.Network, string(filter.Status), limitClause(filter.Limit))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*storage.Transaction
+	for rows.Next() {
+		tx, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, tx)
+	}
+
+	return result, rows.Err()
+}
+
+type auditRepository struct {
+	db *sql.DB
+}
+
+func (r *auditRepository) Append(ctx context.Context, record *storage.AuditRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO audit_records (id, action, chain, network, subject, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		record.Id, record.Action, record.Chain, record.Network, record.Subject, record.Details, record.CreatedAt)
+	return err
+}
+
+func (r *auditRepository) List(ctx context.Context, filter storage.Filter) ([]*storage.AuditRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, action, chain, network, subject, details, created_at FROM audit_records
+		WHERE ($1 = '' OR chain = $1) AND ($2 = '' OR network = $2)
+		ORDER BY seq DESC LIMIT $3`,
+		filter.Chain, filter.Network, limitClause(filter.Limit))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*storage.AuditRecord
+	for rows.Next() {
+		var record storage.AuditRecord
+		err := rows.Scan(&record.Id, &record.Action, &record.Chain, &record.Network, &record.Subject, &record.Details, &record.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &record)
+	}
+
+	return result, rows.Err()
+}
+
+type apiKeyRepository struct {
+	db *sql.DB
+}
+
+const apiKeyColumns = `id, name, hash, scopes, chains, networks, revoked, created_at`
+
+func scanAPIKey(row interface{ Scan(...interface{}) error }) (*storage.APIKey, error) {
+	var (
+		key                      storage.APIKey
+		scopes, chains, networks string
+	)
+	err := row.Scan(&key.Id, &key.Name, &key.Hash, &scopes, &chains, &networks, &key.Revoked, &key.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Scopes = splitList(scopes)
+	key.Chains = splitList(chains)
+	key.Networks = splitList(networks)
+
+	return &key, nil
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *storage.APIKey) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO api_keys (`+apiKeyColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		key.Id, key.Name, key.Hash, strings.Join(key.Scopes, ","), strings.Join(key.Chains, ","),
+		strings.Join(key.Networks, ","), key.Revoked, key.CreatedAt)
+	return err
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, hash string) (*storage.APIKey, error) {
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE hash = $1`, hash))
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return key, nil
+}
+
+func (r *apiKeyRepository) List(ctx context.Context) ([]*storage.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*storage.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, key)
+	}
+
+	return result, rows.Err()
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+type policySpendRepository struct {
+	db *sql.DB
+}
+
+// Add updates the total in one statement, so replicas sharing the database do not lose spend.
+func (r *policySpendRepository) Add(ctx context.Context, day, subject string, amount *big.Int) (*big.Int, error) {
+	var total string
+	err := r.db.QueryRowContext(ctx,
+		`INSERT INTO policy_spends (day, subject, amount) VALUES ($1, $2, $3::numeric)
+		ON CONFLICT (day, subject) DO UPDATE SET amount = policy_spends.amount + EXCLUDED.amount
+		RETURNING amount::text`,
+		day, subject, amount.String()).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAmount(total)
+}
+
+func (r *policySpendRepository) Get(ctx context.Context, day, subject string) (*big.Int, error) {
+	var total string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT amount::text FROM policy_spends WHERE day = $1 AND subject = $2`, day, subject).Scan(&total)
+	if err == sql.ErrNoRows {
+		return new(big.Int), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAmount(total)
+}
+
+func (r *policySpendRepository) DeleteBefore(ctx context.Context, day string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM policy_spends WHERE day < $1`, day)
+	return err
+}
+
+func parseAmount(value string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
+
+type approvalRepository struct {
+	db *sql.DB
+}
+
+const approvalColumns = `id, status, chain, network, tx_hash, subject, spend, policy, requested_by, decided_by, created_at, expires_at, decided_at`
+
+func scanApproval(row interface{ Scan(...interface{}) error }) (*storage.Approval, error) {
+	var (
+		approval  storage.Approval
+		decidedAt sql.NullTime
+	)
+	err := row.Scan(&approval.Id, &approval.Status, &approval.Chain, &approval.Network, &approval.TxHash, &approval.Subject,
+		&approval.Spend, &approval.Policy, &approval.RequestedBy, &approval.DecidedBy, &approval.CreatedAt, &approval.ExpiresAt, &decidedAt)
+	if err != nil {
+		return nil, err
+	}
+	if decidedAt.Valid {
+		approval.DecidedAt = &decidedAt.Time
+	}
+
+	return &approval, nil
+}
+
+func (r *approvalRepository) Create(ctx context.Context, approval *storage.Approval) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO approvals (`+approvalColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		approval.Id, approval.Status, approval.Chain, approval.Network, approval.TxHash, approval.Subject, approval.Spend,
+		approval.Policy, approval.RequestedBy, approval.DecidedBy, approval.CreatedAt, approval.ExpiresAt, approval.DecidedAt)
+	return err
+}
+
+func (r *approvalRepository) Update(ctx context.Context, approval *storage.Approval) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE approvals SET status = $2, decided_by = $3, decided_at = $4 WHERE id = $1`,
+		approval.Id, approval.Status, approval.DecidedBy, approval.DecidedAt)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *approvalRepository) Get(ctx context.Context, id string) (*storage.Approval, error) {
+	approval, err := scanApproval(r.db.QueryRowContext(ctx, `SELECT `+approvalColumns+` FROM approvals WHERE id = $1`, id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return approval, nil
+}
+
+func (r *approvalRepository) List(ctx context.Context) ([]*storage.Approval, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+approvalColumns+` FROM approvals ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*storage.Approval
+	for rows.Next() {
+		approval, err := scanApproval(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, approval)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,105 @@
+package postgres_storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"nn-blockchain-api/pkg/storage"
+
+	_ "github.com/lib/pq"
+)
+
+type store struct {
+	db *sql.DB
+
+	wallets      *walletRepository
+	addresses    *addressRepository
+	transactions *transactionRepository
+	audit        *auditRepository
+	apiKeys      *apiKeyRepository
+	policySpends *policySpendRepository
+	approvals    *approvalRepository
+}
+
+func NewStorage(dsn string) (storage.Storage, error) {
+	if dsn == "" {
+		return nil, errors.New("invalid postgres dsn")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	if err := migrate(ctx, db); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return &store{
+		db:           db,
+		wallets:      &walletRepository{db: db},
+		addresses:    &addressRepository{db: db},
+		transactions: &transactionRepository{db: db},
+		audit:        &auditRepository{db: db},
+		apiKeys:      &apiKeyRepository{db: db},
+		policySpends: &policySpendRepository{db: db},
+		approvals:    &approvalRepository{db: db},
+	}, nil
+}
+
+func (s *store) Wallets() storage.WalletRepository {
+	return s.wallets
+}
+
+func (s *store) Addresses() storage.AddressRepository {
+	return s.addresses
+}
+
+func (s *store) Transactions() storage.TransactionRepository {
+	return s.transactions
+}
+
+func (s *store) Audit() storage.AuditRepository {
+	return s.audit
+}
+
+func (s *store) APIKeys() storage.APIKeyRepository {
+	return s.apiKeys
+}
+
+func (s *store) PolicySpends() storage.PolicySpendRepository {
+	return s.policySpends
+}
+
+func (s *store) Approvals() storage.ApprovalRepository {
+	return s.approvals
+}
+
+func (s *store) Close() error {
+	return s.db.Close()
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.ErrNotFound
+	}
+	return err
+}
+
+func limitClause(limit int) interface{} {
+	if limit <= 0 {
+		return nil
+	}
+	return limit
+}
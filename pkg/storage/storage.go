@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"math/big"
+)
+
+var ErrNotFound = errors.New("record not found")
+
+//go:generate mockgen -source=storage.go -destination=mocks/storage_mock.go
+type Storage interface {
+	Wallets() WalletRepository
+	Addresses() AddressRepository
+	Transactions() TransactionRepository
+	Audit() AuditRepository
+	APIKeys() APIKeyRepository
+	PolicySpends() PolicySpendRepository
+	Approvals() ApprovalRepository
+
+	Close() error
+}
+
+type WalletRepository interface {
+	Create(ctx context.Context, wallet *Wallet) error
+	Get(ctx context.Context, id string) (*Wallet, error)
+	List(ctx context.Context, filter Filter) ([]*Wallet, error)
+}
+
+type AddressRepository interface {
+	Create(ctx context.Context, address *Address) error
+	Get(ctx context.Context, address string) (*Address, error)
+	ListByWallet(ctx context.Context, walletId string) ([]*Address, error)
+}
+
+type TransactionRepository interface {
+	Create(ctx context.Context, tx *Transaction) error
+	Update(ctx context.Context, tx *Transaction) error
+	Get(ctx context.Context, id string) (*Transaction, error)
+	// FindByRaw looks up a transaction by its unsigned or signed hex.
+	FindByRaw(ctx context.Context, raw string) (*Transaction, error)
+	List(ctx context.Context, filter Filter) ([]*Transaction, error)
+}
+
+type AuditRepository interface {
+	Append(ctx context.Context, record *AuditRecord) error
+	List(ctx context.Context, filter Filter) ([]*AuditRecord, error)
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *APIKey) error
+	GetByHash(ctx context.Context, hash string) (*APIKey, error)
+	List(ctx context.Context) ([]*APIKey, error)
+}
+
+// PolicySpendRepository tracks what signers spent per UTC day, YYYY-MM-DD, for the
+// daily limits of the signing policies.
+type PolicySpendRepository interface {
+	// Add adds amount, negative to release it, to the spend of a subject on a day and
+	// returns the new total.
+	Add(ctx context.Context, day, subject string, amount *big.Int) (*big.Int, error)
+	// Get returns the spend of a subject on a day, zero when it spent nothing.
+	Get(ctx context.Context, day, subject string) (*big.Int, error)
+	// DeleteBefore drops the spend of the days before day.
+	DeleteBefore(ctx context.Context, day string) error
+}
+
+type ApprovalRepository interface {
+	Create(ctx context.Context, approval *Approval) error
+	Update(ctx context.Context, approval *Approval) error
+	Get(ctx context.Context, id string) (*Approval, error)
+	List(ctx context.Context) ([]*Approval, error)
+}
@@ -0,0 +1,18 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Logger adds the trace and span ids of ctx to the log fields.
+func Logger(ctx context.Context, logger *zap.SugaredLogger) *zap.SugaredLogger {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return logger
+	}
+
+	return logger.With("trace_id", spanContext.TraceID().String(), "span_id", spanContext.SpanID().String())
+}
@@ -0,0 +1,114 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"nn-blockchain-api/pkg/tracing"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestSetup(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    tracing.Config
+		expect func(*testing.T, func(context.Context) error, error)
+	}{
+		{
+			name: "should return no-op tracing",
+			cfg:  tracing.Config{Exporter: tracing.ExporterNone},
+			expect: func(t *testing.T, shutdown func(context.Context) error, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, shutdown(context.Background()))
+			},
+		},
+		{
+			name: "should return invalid otlp endpoint",
+			cfg:  tracing.Config{Exporter: tracing.ExporterOTLP},
+			expect: func(t *testing.T, shutdown func(context.Context) error, err error) {
+				assert.Nil(t, shutdown)
+				assert.EqualError(t, err, "invalid otlp endpoint")
+			},
+		},
+		{
+			name: "should return otlp tracing",
+			cfg:  tracing.Config{Exporter: tracing.ExporterOTLP, Endpoint: "http://localhost:4318", SampleRatio: 1},
+			expect: func(t *testing.T, shutdown func(context.Context) error, err error) {
+				assert.Nil(t, err)
+				assert.Nil(t, shutdown(context.Background()))
+			},
+		},
+		{
+			name: "should return unknown exporter",
+			cfg:  tracing.Config{Exporter: "jaeger"},
+			expect: func(t *testing.T, shutdown func(context.Context) error, err error) {
+				assert.Nil(t, shutdown)
+				assert.EqualError(t, err, `unknown tracing exporter "jaeger"`)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			shutdown, err := tracing.Setup(tc.cfg)
+			tc.expect(t, shutdown, err)
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	router := chi.NewRouter()
+	router.Use(tracing.Middleware)
+	router.Post("/api/v1/bitcoin/status", func(w http.ResponseWriter, r *http.Request) {
+		_, span := tracing.Start(r.Context(), "bitcoin.Service/StatusNode")
+		tracing.RecordError(span, errors.New("node unreachable"))
+		span.End()
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/bitcoin/status", nil)
+	req.Header.Set("traceparent", traceparent)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "bitcoin.Service/StatusNode", spans[0].Name())
+	assert.Equal(t, "POST /api/v1/bitcoin/status", spans[1].Name())
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[1].SpanContext().TraceID().String())
+}
+
+func TestLogger(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	base := zap.New(core).Sugar()
+
+	tracing.Logger(context.Background(), base).Info("without trace")
+
+	traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceId, SpanID: spanId}))
+	tracing.Logger(ctx, base).Info("with trace")
+
+	entries := logs.AllUntimed()
+	assert.Len(t, entries, 2)
+	assert.Empty(t, entries[0].ContextMap())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", entries[1].ContextMap()["trace_id"])
+	assert.Equal(t, "00f067aa0ba902b7", entries[1].ContextMap()["span_id"])
+}
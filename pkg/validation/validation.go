@@ -0,0 +1,99 @@
+package validation
+
+import (
+	"fmt"
+	"nn-blockchain-api/pkg/errors"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = newValidator()
+
+// Validate checks dto against its validate tags. Failures are reported as base with
+// a summary message and one errors entry per rejected field.
+func Validate(base error, dto interface{}) error {
+	err := validate.Struct(dto)
+	if err == nil {
+		return nil
+	}
+
+	validationErrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return errors.WithMessage(base, err.Error())
+	}
+
+	fields := make([]errors.FieldError, 0, len(validationErrs))
+	summary := make([]string, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		field := fieldName(fieldErr.Namespace())
+		message := msgForTag(fieldErr.Tag(), fieldErr.Param())
+
+		fields = append(fields, errors.FieldError{Field: field, Tag: fieldErr.Tag(), Message: message})
+		summary = append(summary, field+" "+message)
+	}
+
+	return errors.WithFields(errors.WithMessage(base, "%s", strings.Join(summary, ", ")), fields)
+}
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+
+	// Report fields by the names clients send, e.g. utxo[0].txid rather than Utxo[0].TxId.
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+
+	for tag, fn := range validators {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			panic(fmt.Sprintf("register %s validator: %v", tag, err))
+		}
+	}
+
+	return v
+}
+
+// fieldName drops the DTO type from a validator namespace.
+func fieldName(namespace string) string {
+	if i := strings.IndexByte(namespace, '.'); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+func msgForTag(tag, param string) string {
+	switch tag {
+	case "required":
+		return "is required"
+	case "gte":
+		return "must be greater than or equal to " + param
+	case "gt":
+		return "must be greater than " + param
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(param, " ", ", ")
+	case "hexadecimal":
+		return "must be hex encoded"
+	case TagNetwork:
+		return "must be a network name such as main or test"
+	case TagBtcAddress:
+		return "must be a valid bitcoin address for the network"
+	case TagEthAddress:
+		return "must be a valid ethereum address with a correct EIP-55 checksum"
+	case TagHexTx:
+		return "must be a hex encoded transaction without 0x prefix"
+	case TagTxId:
+		return "must be a 64 character hex transaction id"
+	case TagWIF:
+		return "must be a WIF private key for the network"
+	case TagHexPrivateKey:
+		return "must be a 64 character hex private key without 0x prefix"
+	case TagMnemonic:
+		return "must be a BIP39 mnemonic of 12, 15, 18, 21 or 24 words with a valid checksum"
+	}
+	return "is invalid"
+}
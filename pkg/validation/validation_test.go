@@ -0,0 +1,155 @@
+package validation
+
+import (
+	"encoding/json"
+	"nn-blockchain-api/pkg/codes"
+	"nn-blockchain-api/pkg/errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var errInvalidRequest = errors.New(codes.BadRequest, "invalid_request")
+
+type btcDTO struct {
+	Utxo []struct {
+		TxId string `json:"txid" validate:"required,txid"`
+		Vout int64  `json:"vout" validate:"gte=0"`
+	} `json:"utxo" validate:"dive"`
+	Address    string `json:"address" validate:"omitempty,btc_address"`
+	PrivateKey string `json:"privateKey" validate:"omitempty,wif"`
+	Tx         string `json:"tx" validate:"omitempty,hex_tx"`
+	Network    string `json:"network" validate:"required,network"`
+}
+
+type ethDTO struct {
+	Address    string `json:"address" validate:"omitempty,eth_address"`
+	PrivateKey string `json:"privateKey" validate:"omitempty,hex_privkey"`
+}
+
+func TestValidate(t *testing.T) {
+	key, _ := btcec.NewPrivateKey(btcec.S256())
+	mainWIF, _ := btcutil.NewWIF(key, &chaincfg.MainNetParams, true)
+	testWIF, _ := btcutil.NewWIF(key, &chaincfg.TestNet3Params, true)
+
+	const txid = "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"
+
+	tests := []struct {
+		name   string
+		dto    interface{}
+		fields []errors.FieldError
+	}{
+		{
+			name: "should accept vout 0 and testnet values",
+			dto: &btcDTO{
+				Utxo: []struct {
+					TxId string `json:"txid" validate:"required,txid"`
+					Vout int64  `json:"vout" validate:"gte=0"`
+				}{{TxId: txid, Vout: 0}},
+				Address:    "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+				PrivateKey: testWIF.String(),
+				Tx:         "0200000001",
+				Network:    "test",
+			},
+		},
+		{
+			name: "should accept mainnet values",
+			dto: &btcDTO{
+				Address:    "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+				PrivateKey: mainWIF.String(),
+				Network:    "main",
+			},
+		},
+		{
+			name: "should accept litecoin values",
+			dto: &btcDTO{
+				Address: "ltc1qw6syq5aa5z5ghkj3w7ux59wrk204txrnp208rt",
+				Network: "main",
+			},
+		},
+		{
+			name: "should accept cashaddr values",
+			dto: &btcDTO{
+				Address: "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+				Network: "main",
+			},
+		},
+		{
+			name: "should reject values for the wrong network",
+			dto: &btcDTO{
+				Address:    "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+				PrivateKey: mainWIF.String(),
+				Network:    "test",
+			},
+			fields: []errors.FieldError{
+				{Field: "address", Tag: TagBtcAddress, Message: "must be a valid bitcoin address for the network"},
+				{Field: "privateKey", Tag: TagWIF, Message: "must be a WIF private key for the network"},
+			},
+		},
+		{
+			name: "should reject malformed values",
+			dto: &btcDTO{
+				Utxo: []struct {
+					TxId string `json:"txid" validate:"required,txid"`
+					Vout int64  `json:"vout" validate:"gte=0"`
+				}{{TxId: "abc", Vout: -1}},
+				Tx:      "0x0200",
+				Network: "Main",
+			},
+			fields: []errors.FieldError{
+				{Field: "utxo[0].txid", Tag: TagTxId, Message: "must be a 64 character hex transaction id"},
+				{Field: "utxo[0].vout", Tag: "gte", Message: "must be greater than or equal to 0"},
+				{Field: "tx", Tag: TagHexTx, Message: "must be a hex encoded transaction without 0x prefix"},
+				{Field: "network", Tag: TagNetwork, Message: "must be a network name such as main or test"},
+			},
+		},
+		{
+			name: "should accept checksummed and single-case ethereum addresses",
+			dto: &ethDTO{
+				Address:    "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+				PrivateKey: "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
+			},
+		},
+		{
+			name: "should reject bad checksum and prefixed key",
+			dto: &ethDTO{
+				Address:    "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+				PrivateKey: "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
+			},
+			fields: []errors.FieldError{
+				{Field: "address", Tag: TagEthAddress, Message: "must be a valid ethereum address with a correct EIP-55 checksum"},
+				{Field: "privateKey", Tag: TagHexPrivateKey, Message: "must be a 64 character hex private key without 0x prefix"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(errInvalidRequest, tc.dto)
+			if tc.fields == nil {
+				assert.Nil(t, err)
+				return
+			}
+
+			assert.Equal(t, codes.BadRequest, errors.HTTPCode(err))
+			assert.Equal(t, tc.fields, err.(*errors.Error).Errors)
+		})
+	}
+
+	assert.Nil(t, Validate(errInvalidRequest, &ethDTO{Address: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"}))
+}
+
+func TestValidate_ResponseBody(t *testing.T) {
+	err := Validate(errInvalidRequest, &btcDTO{})
+
+	body, _ := json.Marshal(err)
+	assert.JSONEq(t, `{
+		"code": 400,
+		"status": "invalid_request",
+		"message": "network is required",
+		"errors": [{"field": "network", "tag": "required", "message": "is required"}]
+	}`, string(body))
+}
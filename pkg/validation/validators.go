@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"encoding/hex"
+	"nn-blockchain-api/pkg/hd"
+	"nn-blockchain-api/pkg/networks"
+	bitcoin_rpc "nn-blockchain-api/pkg/rpc/bitcoin"
+	"reflect"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	TagNetwork       = "network"
+	TagBtcAddress    = "btc_address"
+	TagEthAddress    = "eth_address"
+	TagHexTx         = "hex_tx"
+	TagTxId          = "txid"
+	TagWIF           = "wif"
+	TagHexPrivateKey = "hex_privkey"
+	TagMnemonic      = "mnemonic"
+)
+
+var validators = map[string]validator.Func{
+	TagNetwork:       isNetwork,
+	TagBtcAddress:    isBtcAddress,
+	TagEthAddress:    isEthAddress,
+	TagHexTx:         isHexTx,
+	TagTxId:          isTxId,
+	TagWIF:           isWIF,
+	TagHexPrivateKey: isHexPrivateKey,
+	TagMnemonic:      isMnemonic,
+}
+
+// isNetwork checks the syntax of a network name, the services refuse networks their chain
+// is not served on.
+func isNetwork(fl validator.FieldLevel) bool {
+	_, err := networks.Parse(fl.Field().String())
+	return err == nil
+}
+
+// isBtcAddress checks the address of any UTXO chain against the sibling Network field,
+// falling back to any supported network when the struct has none. The service checks it
+// belongs to the chain of the route.
+func isBtcAddress(fl validator.FieldLevel) bool {
+	for _, network := range btcNetworks(fl) {
+		for _, chain := range bitcoin_rpc.KnownChains {
+			if _, err := chain.DecodeAddress(fl.Field().String(), network); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isEthAddress(fl validator.FieldLevel) bool {
+	address := fl.Field().String()
+	if !common.IsHexAddress(address) || !strings.HasPrefix(address, "0x") {
+		return false
+	}
+
+	// Single-case addresses carry no checksum; mixed-case ones must match EIP-55.
+	body := address[2:]
+	if body == strings.ToLower(body) || body == strings.ToUpper(body) {
+		return true
+	}
+	return common.HexToAddress(address).Hex() == address
+}
+
+func isHexTx(fl validator.FieldLevel) bool {
+	data, err := hex.DecodeString(fl.Field().String())
+	return err == nil && len(data) > 0
+}
+
+func isTxId(fl validator.FieldLevel) bool {
+	data, err := hex.DecodeString(fl.Field().String())
+	return err == nil && len(data) == 32
+}
+
+func isWIF(fl validator.FieldLevel) bool {
+	wif, err := btcutil.DecodeWIF(fl.Field().String())
+	if err != nil {
+		return false
+	}
+
+	for _, network := range btcNetworks(fl) {
+		for _, chain := range bitcoin_rpc.KnownChains {
+			if params, err := chain.Params(network); err == nil && wif.IsForNet(params) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isHexPrivateKey(fl validator.FieldLevel) bool {
+	data, err := hex.DecodeString(fl.Field().String())
+	return err == nil && len(data) == 32
+}
+
+func isMnemonic(fl validator.FieldLevel) bool {
+	return hd.ValidateMnemonic(fl.Field().String()) == nil
+}
+
+// btcNetworks returns the sibling Network field, or every network of the UTXO chains when
+// the struct has none.
+func btcNetworks(fl validator.FieldLevel) []string {
+	parent := fl.Parent()
+	if parent.Kind() == reflect.Ptr {
+		parent = parent.Elem()
+	}
+
+	if parent.Kind() == reflect.Struct {
+		if network := parent.FieldByName("Network"); network.IsValid() && network.Kind() == reflect.String {
+			return []string{network.String()}
+		}
+	}
+
+	seen := map[string]bool{}
+	var all []string
+	for _, chain := range bitcoin_rpc.KnownChains {
+		for name := range chain.Networks {
+			if !seen[string(name)] {
+				seen[string(name)] = true
+				all = append(all, string(name))
+			}
+		}
+	}
+	return all
+}